@@ -28,8 +28,86 @@ const (
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
+// Defines values for AttendanceStatus.
+const (
+	Attended   AttendanceStatus = "attended"
+	Expected   AttendanceStatus = "expected"
+	LateCancel AttendanceStatus = "late_cancel"
+	NoShow     AttendanceStatus = "no_show"
+)
+
+// Defines values for BulkReimbursementStatus.
+const (
+	InvalidParticipantId BulkReimbursementStatus = "invalid_participant_id"
+	ParticipantNotFound  BulkReimbursementStatus = "participant_not_found"
+	Updated              BulkReimbursementStatus = "updated"
+)
+
+// Defines values for GameAllocationMode.
+const (
+	FirstComeFirstServed GameAllocationMode = "first_come_first_served"
+	Lottery              GameAllocationMode = "lottery"
+)
+
+// Defines values for GameRoleName.
+const (
+	CoOrganizer GameRoleName = "co_organizer"
+	Owner       GameRoleName = "owner"
+	Treasurer   GameRoleName = "treasurer"
+)
+
+// Defines values for GameSplitStrategy.
+const (
+	Even               GameSplitStrategy = "even"
+	OrganizerExempt    GameSplitStrategy = "organizer_exempt"
+	PerPlayer          GameSplitStrategy = "per_player"
+	PerPlayerGuestRate GameSplitStrategy = "per_player_guest_rate"
+)
+
+// Defines values for GameVisibility.
+const (
+	Private GameVisibility = "private"
+	Public  GameVisibility = "public"
+)
+
+// Defines values for GameWaitlistMode.
+const (
+	Disabled  GameWaitlistMode = "disabled"
+	Fixed     GameWaitlistMode = "fixed"
+	Unlimited GameWaitlistMode = "unlimited"
+)
+
+// Defines values for GroupMemberRole.
+const (
+	Admin  GroupMemberRole = "admin"
+	Member GroupMemberRole = "member"
+)
+
+// Defines values for GroupMemberTier.
+const (
+	DropIn  GroupMemberTier = "drop_in"
+	Regular GroupMemberTier = "regular"
+)
+
+// Defines values for LedgerEntryType.
+const (
+	Payment       LedgerEntryType = "payment"
+	Reimbursement LedgerEntryType = "reimbursement"
+	Share         LedgerEntryType = "share"
+)
+
+// Defines values for NotificationType.
+const (
+	NotificationTypeGamePublished         NotificationType = "game_published"
+	NotificationTypeGameUpdated           NotificationType = "game_updated"
+	NotificationTypeReimbursementReceived NotificationType = "reimbursement_received"
+	NotificationTypeWaitlistPromoted      NotificationType = "waitlist_promoted"
+)
+
 // Defines values for ParticipationStatus1.
 const (
+	Offered    ParticipationStatus1 = "offered"
+	Registered ParticipationStatus1 = "registered"
 	Waitlisted ParticipationStatus1 = "waitlisted"
 )
 
@@ -39,11 +117,52 @@ const (
 	NotGoing ParticipationStatusUpdate = "not_going"
 )
 
+// Defines values for UnmatchedStatementLineReason.
+const (
+	AlreadyReceived   UnmatchedStatementLineReason = "already_received"
+	Duplicate         UnmatchedStatementLineReason = "duplicate"
+	GameNotMentioned  UnmatchedStatementLineReason = "game_not_mentioned"
+	ReferenceNotFound UnmatchedStatementLineReason = "reference_not_found"
+)
+
 // Defines values for UpdateGameParticipationRequestConfirmed.
 const (
 	True UpdateGameParticipationRequestConfirmed = true
 )
 
+// Defines values for WebhookEventType.
+const (
+	WebhookEventTypeGameCancelled         WebhookEventType = "game_cancelled"
+	WebhookEventTypeGameDeleted           WebhookEventType = "game_deleted"
+	WebhookEventTypeGameEnded             WebhookEventType = "game_ended"
+	WebhookEventTypeGameFrozen            WebhookEventType = "game_frozen"
+	WebhookEventTypeGamePublished         WebhookEventType = "game_published"
+	WebhookEventTypeGameRescheduled       WebhookEventType = "game_rescheduled"
+	WebhookEventTypeGameStarted           WebhookEventType = "game_started"
+	WebhookEventTypeGameUpdated           WebhookEventType = "game_updated"
+	WebhookEventTypeLotteryDrawn          WebhookEventType = "lottery_drawn"
+	WebhookEventTypeParticipantJoined     WebhookEventType = "participant_joined"
+	WebhookEventTypeParticipantLeft       WebhookEventType = "participant_left"
+	WebhookEventTypeParticipantPromoted   WebhookEventType = "participant_promoted"
+	WebhookEventTypeParticipantsReordered WebhookEventType = "participants_reordered"
+	WebhookEventTypePlaceholderClaimed    WebhookEventType = "placeholder_claimed"
+	WebhookEventTypeReimbursementMarked   WebhookEventType = "reimbursement_marked"
+	WebhookEventTypeWaitlistOfferAccepted WebhookEventType = "waitlist_offer_accepted"
+	WebhookEventTypeWaitlistOfferDeclined WebhookEventType = "waitlist_offer_declined"
+	WebhookEventTypeWaitlistOffered       WebhookEventType = "waitlist_offered"
+)
+
+// Defines values for Weekday.
+const (
+	Friday    Weekday = "friday"
+	Monday    Weekday = "monday"
+	Saturday  Weekday = "saturday"
+	Sunday    Weekday = "sunday"
+	Thursday  Weekday = "thursday"
+	Tuesday   Weekday = "tuesday"
+	Wednesday Weekday = "wednesday"
+)
+
 // Defines values for GetApiAuthProviderCallbackParamsProvider.
 const (
 	GetApiAuthProviderCallbackParamsProviderGoogle GetApiAuthProviderCallbackParamsProvider = "google"
@@ -54,6 +173,35 @@ const (
 	GetApiAuthProviderLoginParamsProviderGoogle GetApiAuthProviderLoginParamsProvider = "google"
 )
 
+// ApplyReimbursementMatchesRequest defines model for ApplyReimbursementMatchesRequest.
+type ApplyReimbursementMatchesRequest struct {
+	Matches []ReimbursementMatch `json:"matches"`
+}
+
+// AttendanceRecord defines model for AttendanceRecord.
+type AttendanceRecord struct {
+	// CheckedInAt When the participant checked in
+	CheckedInAt *time.Time `json:"checkedInAt,omitempty"`
+
+	// LateCancelledAt When the participant dropped out after the cancellation deadline
+	LateCancelledAt *time.Time `json:"lateCancelledAt,omitempty"`
+	Participant     User       `json:"participant"`
+
+	// Status Attendance of a participant at a started game:
+	// - attended: they checked in
+	// - expected: they are going and didn't check in yet, while the game is running
+	// - no_show: they were going and didn't check in before the game ended
+	// - late_cancel: they dropped out after the cancellation deadline and didn't check in
+	Status AttendanceStatus `json:"status"`
+}
+
+// AttendanceStatus Attendance of a participant at a started game:
+// - attended: they checked in
+// - expected: they are going and didn't check in yet, while the game is running
+// - no_show: they were going and didn't check in before the game ended
+// - late_cancel: they dropped out after the cancellation deadline and didn't check in
+type AttendanceStatus string
+
 // AuthResponse defines model for AuthResponse.
 type AuthResponse struct {
 	// ExpiresIn Token expiration time in seconds
@@ -67,8 +215,100 @@ type AuthResponse struct {
 	User  User   `json:"user"`
 }
 
+// BulkReimbursementRequest Either the updates of the given participants, or markAllOutstanding
+type BulkReimbursementRequest struct {
+	// MarkAllOutstanding Marks the reimbursements of every participant billed for the game not received yet as received
+	MarkAllOutstanding *bool `json:"markAllOutstanding,omitempty"`
+
+	// ReceivedAt When the outstanding reimbursements were received with markAllOutstanding, defaults to now
+	ReceivedAt *time.Time                 `json:"receivedAt,omitempty"`
+	Updates    *[]BulkReimbursementUpdate `json:"updates,omitempty"`
+}
+
+// BulkReimbursementResponse defines model for BulkReimbursementResponse.
+type BulkReimbursementResponse struct {
+	// Failed Number of reimbursements that couldn't be updated
+	Failed int `json:"failed"`
+
+	// Results One result per update in the order of the request, or per outstanding reimbursement in the order participants queued
+	Results []BulkReimbursementResult `json:"results"`
+
+	// Updated Number of reimbursements updated
+	Updated int `json:"updated"`
+}
+
+// BulkReimbursementResult defines model for BulkReimbursementResult.
+type BulkReimbursementResult struct {
+	// Error Why the reimbursement couldn't be updated
+	Error         *string              `json:"error,omitempty"`
+	ParticipantId string               `json:"participantId"`
+	Record        *ReimbursementRecord `json:"record,omitempty"`
+
+	// Status - updated: the reimbursement was updated
+	// - invalid_participant_id: the participant ID isn't a valid user ID
+	// - participant_not_found: the user isn't a participant of the game
+	Status BulkReimbursementStatus `json:"status"`
+}
+
+// BulkReimbursementStatus - updated: the reimbursement was updated
+// - invalid_participant_id: the participant ID isn't a valid user ID
+// - participant_not_found: the user isn't a participant of the game
+type BulkReimbursementStatus string
+
+// BulkReimbursementUpdate defines model for BulkReimbursementUpdate.
+type BulkReimbursementUpdate struct {
+	// ParticipantId ID of the participant to update reimbursement for
+	ParticipantId string `json:"participantId"`
+
+	// ReimbursementReceivedAt When the organizer received and confirmed the reimbursement. Set to null to clear.
+	ReimbursementReceivedAt nullable.Nullable[time.Time] `json:"reimbursementReceivedAt"`
+}
+
+// CancelGameRequest defines model for CancelGameRequest.
+type CancelGameRequest struct {
+	// Reason Why the game is cancelled, shown to the participants
+	Reason string `json:"reason"`
+}
+
+// CheckInCode defines model for CheckInCode.
+type CheckInCode struct {
+	// CheckInCode Code participants check themselves in with
+	CheckInCode string `json:"checkInCode"`
+}
+
+// CheckInRequest defines model for CheckInRequest.
+type CheckInRequest struct {
+	// Code Check-in code of the game, for participants checking themselves in
+	Code *string `json:"code,omitempty"`
+
+	// ParticipantId User ID of the participant checked in by an organizer
+	ParticipantId *string `json:"participantId,omitempty"`
+}
+
+// ClaimPlaceholderRequest defines model for ClaimPlaceholderRequest.
+type ClaimPlaceholderRequest struct {
+	// Token The token of the claim link
+	Token string `json:"token"`
+}
+
+// ClaimedPlaceholder defines model for ClaimedPlaceholder.
+type ClaimedPlaceholder struct {
+	// GameId The game the user now participates in
+	GameId string `json:"gameId"`
+}
+
 // CreateGameRequest defines model for CreateGameRequest.
 type CreateGameRequest struct {
+	// AllocationMode - first_come_first_served: participants take the spots in the order they joined
+	// - lottery: participants register until registrationClosesAt, then a random draw decides who is going and the order of the waitlist. The organizer keeps their priority. Participants joining after registrationClosesAt are queued after the drawn participants.
+	AllocationMode *GameAllocationMode `json:"allocationMode,omitempty"`
+
+	// BillLateCancellations Whether participants who cancelled late still owe their share of the price when nobody took their spot
+	BillLateCancellations *bool `json:"billLateCancellations,omitempty"`
+
+	// CancellationDeadlineMinutes How many minutes before the game starts participants can still drop out without it being a late cancellation. 0 disables the deadline.
+	CancellationDeadlineMinutes *int64 `json:"cancellationDeadlineMinutes,omitempty"`
+
 	// Description Description of the game
 	Description *string `json:"description,omitempty"`
 
@@ -78,6 +318,12 @@ type CreateGameRequest struct {
 	// GameSpotsLeft Number of spots left in the game, excluding the waitlist
 	GameSpotsLeft *int64 `json:"gameSpotsLeft,omitempty"`
 
+	// GroupId ID of a group the user belongs to, the game is then only visible to the members of the group
+	GroupId *string `json:"groupId,omitempty"`
+
+	// GuestPriceCents Price of a guest's spot in cents, for the per_player_guest_rate split strategy
+	GuestPriceCents *int64 `json:"guestPriceCents,omitempty"`
+
 	// Location Location where the game will be held
 	Location *string `json:"location,omitempty"`
 
@@ -87,14 +333,121 @@ type CreateGameRequest struct {
 	// MaxPlayers Maximum number of players (must be at least 1)
 	MaxPlayers *int64 `json:"maxPlayers,omitempty"`
 
+	// MaxWaitlistSize Number of spots on the waitlist of games with a fixed waitlist, guests included
+	MaxWaitlistSize *int64 `json:"maxWaitlistSize,omitempty"`
+
 	// Name Name of the game
 	Name string `json:"name"`
 
+	// PricePerPlayerCents Price of a spot in cents, for the per_player and per_player_guest_rate split strategies
+	PricePerPlayerCents *int64 `json:"pricePerPlayerCents,omitempty"`
+
+	// ReconfirmWithinMinutes How many minutes participants have to confirm they're still coming after the start time, location, price or duration of the published game changed, before their spot is released. 0 never releases their spot.
+	ReconfirmWithinMinutes *int64 `json:"reconfirmWithinMinutes,omitempty"`
+
+	// RegistrationClosesAt When the registration of a lottery game closes and the draw happens. Required for lottery games.
+	RegistrationClosesAt *time.Time `json:"registrationClosesAt,omitempty"`
+
+	// RegularsFirst Whether the regulars of the group sort ahead of drop-ins when the game is oversubscribed, the organizer still comes first. Only available for group games.
+	RegularsFirst *bool `json:"regularsFirst,omitempty"`
+
+	// RegularsHeadStartMinutes How many minutes after the game is published only the regulars of its group can join. 0 disables the head start. Only available for group games.
+	RegularsHeadStartMinutes *int64 `json:"regularsHeadStartMinutes,omitempty"`
+
+	// SplitStrategy - even: totalPriceCents is split evenly between every spot, the cents left over are paid by the first participants to join
+	// - per_player: every spot, guests included, costs pricePerPlayerCents
+	// - per_player_guest_rate: every participant pays pricePerPlayerCents, and guestPriceCents for each of their guests
+	// - organizer_exempt: like even, but the organizer doesn't pay for their own spot
+	SplitStrategy *GameSplitStrategy `json:"splitStrategy,omitempty"`
+
 	// StartsAt When the game starts
 	StartsAt *time.Time `json:"startsAt,omitempty"`
 
 	// TotalPriceCents Total price in cents
 	TotalPriceCents *int64 `json:"totalPriceCents,omitempty"`
+
+	// Visibility - public: anyone with the link to the game can see and join it once it's published
+	// - private: users need an invite token, carried in the share link, to see and join the game
+	Visibility *GameVisibility `json:"visibility,omitempty"`
+
+	// WaitlistMode - disabled: participants can only join while there are spots left in the game
+	// - fixed: up to maxWaitlistSize participants, guests included, can be put on the waitlist
+	// - unlimited: participants can always join the waitlist
+	WaitlistMode *GameWaitlistMode `json:"waitlistMode,omitempty"`
+
+	// WaitlistOfferMinutes When set, a spot freed in the game is offered to the next waitlisted participant, who must accept it within this many minutes or the offer moves on to the next one. 0 promotes waitlisted participants directly.
+	WaitlistOfferMinutes *int64 `json:"waitlistOfferMinutes,omitempty"`
+}
+
+// CreateGroupRequest defines model for CreateGroupRequest.
+type CreateGroupRequest struct {
+	// Description Description of the group
+	Description *string `json:"description,omitempty"`
+
+	// Name Name of the group
+	Name string `json:"name"`
+
+	// RegularsFirst Default priority of the regulars in the games created in the group, see the game field of the same name
+	RegularsFirst *bool `json:"regularsFirst,omitempty"`
+
+	// RegularsHeadStartMinutes Default head start of the regulars in the games created in the group, see the game field of the same name
+	RegularsHeadStartMinutes *int64 `json:"regularsHeadStartMinutes,omitempty"`
+}
+
+// CreatePlaceholderRequest defines model for CreatePlaceholderRequest.
+type CreatePlaceholderRequest struct {
+	// Guests Number of guests the placeholder is bringing
+	Guests *int `json:"guests,omitempty"`
+
+	// Name Display name of the placeholder
+	Name string `json:"name"`
+}
+
+// CreateSeriesRequest defines model for CreateSeriesRequest.
+type CreateSeriesRequest struct {
+	GameDefaults GameFields `json:"gameDefaults"`
+
+	// PublishMinutesBefore If set, generated games are scheduled to be published this many minutes before they start, otherwise they're created as drafts
+	PublishMinutesBefore nullable.Nullable[int] `json:"publishMinutesBefore,omitempty"`
+
+	// Recurrence Weekly recurrence rule, the time of day of startsAt is used for every occurrence
+	Recurrence RecurrenceRule `json:"recurrence"`
+}
+
+// CreateWebhookRequest Exactly one of gameId and seriesId must be set
+type CreateWebhookRequest struct {
+	EventTypes []WebhookEventType `json:"eventTypes"`
+
+	// GameId The game whose events are sent
+	GameId *string `json:"gameId,omitempty"`
+
+	// SeriesId The series whose games' events are sent
+	SeriesId *string `json:"seriesId,omitempty"`
+
+	// Url HTTP or HTTPS URL receiving the events
+	Url string `json:"url"`
+}
+
+// CreatedWebhook defines model for CreatedWebhook.
+type CreatedWebhook struct {
+	// CreatedAt Timestamp when the webhook was registered
+	CreatedAt  time.Time          `json:"createdAt"`
+	EventTypes []WebhookEventType `json:"eventTypes"`
+
+	// GameId The game whose events are sent
+	GameId *string `json:"gameId,omitempty"`
+
+	// Id Unique webhook identifier
+	Id int64 `json:"id"`
+
+	// Secret Secret signing the deliveries, it's only returned when the webhook is created
+	Secret string `json:"secret"`
+
+	// SeriesId The series whose games' events are sent
+	SeriesId *string `json:"seriesId,omitempty"`
+
+	// Url URL receiving the events
+	Url string `json:"url"`
 }
 
 // Error Error message string
@@ -102,6 +455,25 @@ type Error = string
 
 // Game defines model for Game.
 type Game struct {
+	// AllocationMode - first_come_first_served: participants take the spots in the order they joined
+	// - lottery: participants register until registrationClosesAt, then a random draw decides who is going and the order of the waitlist. The organizer keeps their priority. Participants joining after registrationClosesAt are queued after the drawn participants.
+	AllocationMode *GameAllocationMode `json:"allocationMode,omitempty"`
+
+	// BillLateCancellations Whether participants who cancelled late still owe their share of the price when nobody took their spot
+	BillLateCancellations *bool `json:"billLateCancellations,omitempty"`
+
+	// CancellationDeadline After when dropping out of the game is a late cancellation, only set when the game has a start time and a cancellation deadline
+	CancellationDeadline *time.Time `json:"cancellationDeadline,omitempty"`
+
+	// CancellationDeadlineMinutes How many minutes before the game starts participants can still drop out without it being a late cancellation. 0 disables the deadline.
+	CancellationDeadlineMinutes *int64 `json:"cancellationDeadlineMinutes,omitempty"`
+
+	// CancellationReason Why the game was cancelled
+	CancellationReason *string `json:"cancellationReason,omitempty"`
+
+	// CancelledAt When the game was cancelled, cancelled games can't be joined or left
+	CancelledAt *time.Time `json:"cancelledAt,omitempty"`
+
 	// CreatedAt Timestamp when game was created
 	CreatedAt time.Time `json:"createdAt"`
 
@@ -117,27 +489,66 @@ type Game struct {
 	// GameSpotsLeft Number of spots left in the game, excluding the waitlist
 	GameSpotsLeft *int64 `json:"gameSpotsLeft,omitempty"`
 
+	// GroupId ID of the group the game belongs to, if any. Group games are only visible to the members of the group
+	GroupId *string `json:"groupId,omitempty"`
+
+	// GuestPriceCents Price of a guest's spot in cents, for the per_player_guest_rate split strategy
+	GuestPriceCents *int64 `json:"guestPriceCents,omitempty"`
+
 	// Id Unique game identifier
 	Id string `json:"id"`
 
 	// Location Location where the game will be held
 	Location *string `json:"location,omitempty"`
 
+	// LotteryDrawnAt When the draw of a lottery game happened
+	LotteryDrawnAt *time.Time `json:"lotteryDrawnAt,omitempty"`
+
+	// LotterySeed Seed of the draw of a lottery game, set once it's drawn so participants can reproduce it
+	LotterySeed *int64 `json:"lotterySeed,omitempty"`
+
 	// MaxGuestsPerPlayer Maximum guests per player (0 to disable)
 	MaxGuestsPerPlayer *int64 `json:"maxGuestsPerPlayer,omitempty"`
 
 	// MaxPlayers Maximum number of players (must be at least 1)
 	MaxPlayers *int64 `json:"maxPlayers,omitempty"`
 
+	// MaxWaitlistSize Number of spots on the waitlist of games with a fixed waitlist, guests included
+	MaxWaitlistSize *int64 `json:"maxWaitlistSize,omitempty"`
+
 	// Name Name of the game
 	Name string `json:"name"`
 
 	// OrganizerId ID of the user who organized the game
 	OrganizerId int `json:"organizerId"`
 
+	// PricePerPlayerCents Price of a spot in cents, for the per_player and per_player_guest_rate split strategies
+	PricePerPlayerCents *int64 `json:"pricePerPlayerCents,omitempty"`
+
 	// PublishedAt When the game is published (visible to others)
 	PublishedAt *time.Time `json:"publishedAt,omitempty"`
 
+	// ReconfirmWithinMinutes How many minutes participants have to confirm they're still coming after the start time, location, price or duration of the published game changed, before their spot is released. 0 never releases their spot.
+	ReconfirmWithinMinutes *int64 `json:"reconfirmWithinMinutes,omitempty"`
+
+	// RegistrationClosesAt When the registration of a lottery game closes and the draw happens. Required for lottery games.
+	RegistrationClosesAt *time.Time `json:"registrationClosesAt,omitempty"`
+
+	// RegularsFirst Whether the regulars of the group sort ahead of drop-ins when the game is oversubscribed, the organizer still comes first. Only available for group games.
+	RegularsFirst *bool `json:"regularsFirst,omitempty"`
+
+	// RegularsHeadStartMinutes How many minutes after the game is published only the regulars of its group can join. 0 disables the head start. Only available for group games.
+	RegularsHeadStartMinutes *int64 `json:"regularsHeadStartMinutes,omitempty"`
+
+	// SeriesId ID of the series this game was generated from, if any
+	SeriesId *string `json:"seriesId,omitempty"`
+
+	// SplitStrategy - even: totalPriceCents is split evenly between every spot, the cents left over are paid by the first participants to join
+	// - per_player: every spot, guests included, costs pricePerPlayerCents
+	// - per_player_guest_rate: every participant pays pricePerPlayerCents, and guestPriceCents for each of their guests
+	// - organizer_exempt: like even, but the organizer doesn't pay for their own spot
+	SplitStrategy *GameSplitStrategy `json:"splitStrategy,omitempty"`
+
 	// StartsAt When the game starts
 	StartsAt *time.Time `json:"startsAt,omitempty"`
 
@@ -146,6 +557,32 @@ type Game struct {
 
 	// UpdatedAt Timestamp when game was last updated
 	UpdatedAt time.Time `json:"updatedAt"`
+
+	// Visibility - public: anyone with the link to the game can see and join it once it's published
+	// - private: users need an invite token, carried in the share link, to see and join the game
+	Visibility *GameVisibility `json:"visibility,omitempty"`
+
+	// WaitlistMode - disabled: participants can only join while there are spots left in the game
+	// - fixed: up to maxWaitlistSize participants, guests included, can be put on the waitlist
+	// - unlimited: participants can always join the waitlist
+	WaitlistMode *GameWaitlistMode `json:"waitlistMode,omitempty"`
+
+	// WaitlistOfferMinutes When set, a spot freed in the game is offered to the next waitlisted participant, who must accept it within this many minutes or the offer moves on to the next one. 0 promotes waitlisted participants directly.
+	WaitlistOfferMinutes *int64 `json:"waitlistOfferMinutes,omitempty"`
+
+	// WaitlistSpotsLeft Number of spots left on the waitlist, guests included. Not set when the waitlist is unlimited
+	WaitlistSpotsLeft *int64 `json:"waitlistSpotsLeft,omitempty"`
+}
+
+// GameAllocationMode - first_come_first_served: participants take the spots in the order they joined
+// - lottery: participants register until registrationClosesAt, then a random draw decides who is going and the order of the waitlist. The organizer keeps their priority. Participants joining after registrationClosesAt are queued after the drawn participants.
+type GameAllocationMode string
+
+// GameAttendance defines model for GameAttendance.
+type GameAttendance struct {
+	// CheckInCode Code participants check themselves in with, not set until the organizer creates it
+	CheckInCode *string            `json:"checkInCode,omitempty"`
+	Records     []AttendanceRecord `json:"records"`
 }
 
 // GameDetail defines model for GameDetail.
@@ -156,6 +593,16 @@ type GameDetail struct {
 
 // GameFields defines model for GameFields.
 type GameFields struct {
+	// AllocationMode - first_come_first_served: participants take the spots in the order they joined
+	// - lottery: participants register until registrationClosesAt, then a random draw decides who is going and the order of the waitlist. The organizer keeps their priority. Participants joining after registrationClosesAt are queued after the drawn participants.
+	AllocationMode *GameAllocationMode `json:"allocationMode,omitempty"`
+
+	// BillLateCancellations Whether participants who cancelled late still owe their share of the price when nobody took their spot
+	BillLateCancellations *bool `json:"billLateCancellations,omitempty"`
+
+	// CancellationDeadlineMinutes How many minutes before the game starts participants can still drop out without it being a late cancellation. 0 disables the deadline.
+	CancellationDeadlineMinutes *int64 `json:"cancellationDeadlineMinutes,omitempty"`
+
 	// Description Description of the game
 	Description *string `json:"description,omitempty"`
 
@@ -165,6 +612,9 @@ type GameFields struct {
 	// GameSpotsLeft Number of spots left in the game, excluding the waitlist
 	GameSpotsLeft *int64 `json:"gameSpotsLeft,omitempty"`
 
+	// GuestPriceCents Price of a guest's spot in cents, for the per_player_guest_rate split strategy
+	GuestPriceCents *int64 `json:"guestPriceCents,omitempty"`
+
 	// Location Location where the game will be held
 	Location *string `json:"location,omitempty"`
 
@@ -174,24 +624,90 @@ type GameFields struct {
 	// MaxPlayers Maximum number of players (must be at least 1)
 	MaxPlayers *int64 `json:"maxPlayers,omitempty"`
 
+	// MaxWaitlistSize Number of spots on the waitlist of games with a fixed waitlist, guests included
+	MaxWaitlistSize *int64 `json:"maxWaitlistSize,omitempty"`
+
 	// Name Name of the game
 	Name *string `json:"name,omitempty"`
 
+	// PricePerPlayerCents Price of a spot in cents, for the per_player and per_player_guest_rate split strategies
+	PricePerPlayerCents *int64 `json:"pricePerPlayerCents,omitempty"`
+
+	// ReconfirmWithinMinutes How many minutes participants have to confirm they're still coming after the start time, location, price or duration of the published game changed, before their spot is released. 0 never releases their spot.
+	ReconfirmWithinMinutes *int64 `json:"reconfirmWithinMinutes,omitempty"`
+
+	// RegistrationClosesAt When the registration of a lottery game closes and the draw happens. Required for lottery games.
+	RegistrationClosesAt *time.Time `json:"registrationClosesAt,omitempty"`
+
+	// RegularsFirst Whether the regulars of the group sort ahead of drop-ins when the game is oversubscribed, the organizer still comes first. Only available for group games.
+	RegularsFirst *bool `json:"regularsFirst,omitempty"`
+
+	// RegularsHeadStartMinutes How many minutes after the game is published only the regulars of its group can join. 0 disables the head start. Only available for group games.
+	RegularsHeadStartMinutes *int64 `json:"regularsHeadStartMinutes,omitempty"`
+
+	// SplitStrategy - even: totalPriceCents is split evenly between every spot, the cents left over are paid by the first participants to join
+	// - per_player: every spot, guests included, costs pricePerPlayerCents
+	// - per_player_guest_rate: every participant pays pricePerPlayerCents, and guestPriceCents for each of their guests
+	// - organizer_exempt: like even, but the organizer doesn't pay for their own spot
+	SplitStrategy *GameSplitStrategy `json:"splitStrategy,omitempty"`
+
 	// StartsAt When the game starts
 	StartsAt *time.Time `json:"startsAt,omitempty"`
 
 	// TotalPriceCents Total price in cents
 	TotalPriceCents *int64 `json:"totalPriceCents,omitempty"`
+
+	// Visibility - public: anyone with the link to the game can see and join it once it's published
+	// - private: users need an invite token, carried in the share link, to see and join the game
+	Visibility *GameVisibility `json:"visibility,omitempty"`
+
+	// WaitlistMode - disabled: participants can only join while there are spots left in the game
+	// - fixed: up to maxWaitlistSize participants, guests included, can be put on the waitlist
+	// - unlimited: participants can always join the waitlist
+	WaitlistMode *GameWaitlistMode `json:"waitlistMode,omitempty"`
+
+	// WaitlistOfferMinutes When set, a spot freed in the game is offered to the next waitlisted participant, who must accept it within this many minutes or the offer moves on to the next one. 0 promotes waitlisted participants directly.
+	WaitlistOfferMinutes *int64 `json:"waitlistOfferMinutes,omitempty"`
+}
+
+// GameInviteToken defines model for GameInviteToken.
+type GameInviteToken struct {
+	// CreatedAt Timestamp when the invite token was created
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Id Unique invite token identifier
+	Id int64 `json:"id"`
+
+	// Participants Participants who joined the game with this invite token
+	Participants []User `json:"participants"`
+
+	// RevokedAt Timestamp when the invite token was revoked, it no longer lets users see or join the game
+	RevokedAt *time.Time `json:"revokedAt,omitempty"`
+
+	// Url Share link carrying the invite token
+	Url string `json:"url"`
 }
 
 // GameListItem defines model for GameListItem.
 type GameListItem struct {
+	// CancellationReason Why the game was cancelled
+	CancellationReason *string `json:"cancellationReason,omitempty"`
+
+	// CancelledAt When the game was cancelled, cancelled games can't be joined or left
+	CancelledAt *time.Time `json:"cancelledAt,omitempty"`
+
+	// GroupId ID of the group the game belongs to, if any
+	GroupId *string `json:"groupId,omitempty"`
+
 	// Id Unique game identifier
 	Id string `json:"id"`
 
 	// IsOrganizer Whether the authenticated user is the organizer of this game
 	IsOrganizer bool `json:"isOrganizer"`
 
+	// LateCancelledAt When the authenticated user cancelled late, if they dropped out of the game after its cancellation deadline
+	LateCancelledAt *time.Time `json:"lateCancelledAt,omitempty"`
+
 	// Location Location where the game will be held
 	Location *string `json:"location,omitempty"`
 
@@ -232,7 +748,8 @@ type GameParticipation struct {
 	// GameId Identifier of the game
 	GameId string `json:"gameId"`
 
-	// Status Participation status of the authenticated user
+	// Status Participation status of the authenticated user.
+	// - registered: going to a lottery game that wasn't drawn yet, the draw decides whether they get a spot
 	Status ParticipationStatus `json:"status"`
 
 	// UpdatedAt Timestamp when the participation record was last updated
@@ -248,8 +765,11 @@ type GameReimbursementEntry struct {
 	AmountOwedCents int64 `json:"amountOwedCents"`
 
 	// Guests Number of guests this participant is bringing
-	Guests      int  `json:"guests"`
-	Participant User `json:"participant"`
+	Guests int `json:"guests"`
+
+	// LateCancelledAt When the participant cancelled late, only set for participants billed for a spot nobody took after they dropped out
+	LateCancelledAt *time.Time `json:"lateCancelledAt,omitempty"`
+	Participant     User       `json:"participant"`
 
 	// ReimbursedAt When the participant claims to have sent the reimbursement
 	ReimbursedAt nullable.Nullable[time.Time] `json:"reimbursedAt,omitempty"`
@@ -261,6 +781,214 @@ type GameReimbursementEntry struct {
 	ReimbursementReference string `json:"reimbursementReference"`
 }
 
+// GameRole defines model for GameRole.
+type GameRole struct {
+	// CreatedAt Timestamp when the role was given
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+
+	// Role Role of a user in a game. The owner created the game and can do everything, except managing reimbursements once a treasurer is appointed.
+	// Co-organizers manage the game and its participants, treasurers manage its reimbursements.
+	Role GameRoleName `json:"role"`
+	User User         `json:"user"`
+}
+
+// GameRoleName Role of a user in a game. The owner created the game and can do everything, except managing reimbursements once a treasurer is appointed.
+// Co-organizers manage the game and its participants, treasurers manage its reimbursements.
+type GameRoleName string
+
+// GameSplitStrategy - even: totalPriceCents is split evenly between every spot, the cents left over are paid by the first participants to join
+// - per_player: every spot, guests included, costs pricePerPlayerCents
+// - per_player_guest_rate: every participant pays pricePerPlayerCents, and guestPriceCents for each of their guests
+// - organizer_exempt: like even, but the organizer doesn't pay for their own spot
+type GameSplitStrategy string
+
+// GameSpots defines model for GameSpots.
+type GameSpots struct {
+	// GameSpotsLeft Number of spots left in the game, excluding the waitlist
+	GameSpotsLeft int64 `json:"gameSpotsLeft"`
+
+	// WaitlistSpotsLeft Number of spots left on the waitlist, guests included. Not set when the waitlist is unlimited
+	WaitlistSpotsLeft *int64 `json:"waitlistSpotsLeft,omitempty"`
+}
+
+// GameVisibility - public: anyone with the link to the game can see and join it once it's published
+// - private: users need an invite token, carried in the share link, to see and join the game
+type GameVisibility string
+
+// GameWaitlistMode - disabled: participants can only join while there are spots left in the game
+// - fixed: up to maxWaitlistSize participants, guests included, can be put on the waitlist
+// - unlimited: participants can always join the waitlist
+type GameWaitlistMode string
+
+// Group defines model for Group.
+type Group struct {
+	// CreatedAt Timestamp when the group was created
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Description Description of the group
+	Description *string `json:"description,omitempty"`
+
+	// Id Unique group identifier
+	Id string `json:"id"`
+
+	// InviteUrl Link letting anyone join the group, only returned to admins
+	InviteUrl *string `json:"inviteUrl,omitempty"`
+
+	// Name Name of the group
+	Name string `json:"name"`
+
+	// RegularsFirst Default priority of the regulars in the games created in the group, see the game field of the same name
+	RegularsFirst *bool `json:"regularsFirst,omitempty"`
+
+	// RegularsHeadStartMinutes Default head start of the regulars in the games created in the group, see the game field of the same name
+	RegularsHeadStartMinutes *int64 `json:"regularsHeadStartMinutes,omitempty"`
+
+	// Role Role of a user in a group. Admins manage the group and its members.
+	Role GroupMemberRole `json:"role"`
+
+	// UpdatedAt Timestamp when the group was last updated
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// GroupMember defines model for GroupMember.
+type GroupMember struct {
+	// JoinedAt Timestamp when the user joined the group
+	JoinedAt time.Time `json:"joinedAt"`
+
+	// Role Role of a user in a group. Admins manage the group and its members.
+	Role GroupMemberRole `json:"role"`
+
+	// Tier Priority tier of a member in the games of the group. Regulars can get priority over drop-ins.
+	Tier GroupMemberTier `json:"tier"`
+	User User            `json:"user"`
+}
+
+// GroupMemberRole Role of a user in a group. Admins manage the group and its members.
+type GroupMemberRole string
+
+// GroupMemberTier Priority tier of a member in the games of the group. Regulars can get priority over drop-ins.
+type GroupMemberTier string
+
+// InviteGameRoleRequest defines model for InviteGameRoleRequest.
+type InviteGameRoleRequest struct {
+	// Email Email of the user, they must have signed in at least once
+	Email openapi_types.Email `json:"email"`
+
+	// Role Role of a user in a game. The owner created the game and can do everything, except managing reimbursements once a treasurer is appointed.
+	// Co-organizers manage the game and its participants, treasurers manage its reimbursements.
+	Role GameRoleName `json:"role"`
+}
+
+// JoinGroupRequest defines model for JoinGroupRequest.
+type JoinGroupRequest struct {
+	// InviteCode The code of the invite link
+	InviteCode string `json:"inviteCode"`
+}
+
+// LedgerBalance defines model for LedgerBalance.
+type LedgerBalance struct {
+	// BalanceCents The credit minus what is outstanding, negative when the participant owes money to the organizer
+	BalanceCents int64 `json:"balanceCents"`
+
+	// CreditCents What the participant paid that isn't allocated to a game yet, in cents
+	CreditCents int64 `json:"creditCents"`
+
+	// OutstandingCents What the participant still owes for the games that aren't settled, in cents
+	OutstandingCents int64 `json:"outstandingCents"`
+	User             User  `json:"user"`
+}
+
+// LedgerEntry defines model for LedgerEntry.
+type LedgerEntry struct {
+	// AmountCents Negative for debits, positive for credits
+	AmountCents int64 `json:"amountCents"`
+
+	// At When the game starts for shares, when the money was received for credits
+	At time.Time `json:"at"`
+
+	// BalanceCents Running balance after the entry
+	BalanceCents int64 `json:"balanceCents"`
+
+	// GameId The game of shares and reimbursements
+	GameId *string `json:"gameId,omitempty"`
+
+	// GameName Name of the game of shares and reimbursements
+	GameName *string `json:"gameName,omitempty"`
+
+	// Note Note the organizer left on the payment
+	Note *string `json:"note,omitempty"`
+
+	// OutstandingCents What is still owed for the share
+	OutstandingCents *int64 `json:"outstandingCents,omitempty"`
+
+	// PaymentId ID of the payment
+	PaymentId *int64 `json:"paymentId,omitempty"`
+
+	// Type - share: what the participant owes for a frozen game, a debit
+	// - payment: a payment recorded in the ledger, a credit
+	// - reimbursement: the share of a game marked as received on the game without a payment in the ledger, a credit
+	Type LedgerEntryType `json:"type"`
+
+	// UnallocatedCents What is left of the payment as credit
+	UnallocatedCents *int64 `json:"unallocatedCents,omitempty"`
+}
+
+// LedgerEntryType - share: what the participant owes for a frozen game, a debit
+// - payment: a payment recorded in the ledger, a credit
+// - reimbursement: the share of a game marked as received on the game without a payment in the ledger, a credit
+type LedgerEntryType string
+
+// LedgerStatement defines model for LedgerStatement.
+type LedgerStatement struct {
+	// BalanceCents The credit minus what is outstanding, negative when the participant owes money to the organizer
+	BalanceCents int64 `json:"balanceCents"`
+
+	// CreditCents What the participant paid that isn't allocated to a game yet, in cents
+	CreditCents int64 `json:"creditCents"`
+
+	// Entries Debits and credits, oldest first
+	Entries []LedgerEntry `json:"entries"`
+
+	// OutstandingCents What the participant still owes for the games that aren't settled, in cents
+	OutstandingCents int64 `json:"outstandingCents"`
+	Payee            User  `json:"payee"`
+	Payer            User  `json:"payer"`
+}
+
+// MemberAttendance defines model for MemberAttendance.
+type MemberAttendance struct {
+	// AttendanceRate Share of the games the member was expected at that they attended, from 0 to 1, not set when they weren't expected at any game
+	AttendanceRate *float64 `json:"attendanceRate,omitempty"`
+
+	// Attended Number of games of the group the member attended
+	Attended int `json:"attended"`
+
+	// LateCancellations Number of games of the group the member dropped out of after the cancellation deadline
+	LateCancellations int `json:"lateCancellations"`
+
+	// NoShows Number of games of the group the member was going to and didn't show up
+	NoShows int  `json:"noShows"`
+	User    User `json:"user"`
+}
+
+// NotificationPreference defines model for NotificationPreference.
+type NotificationPreference struct {
+	// Enabled Whether the user receives this type of notification
+	Enabled bool `json:"enabled"`
+
+	// Type - waitlist_promoted: the user moved from the waitlist to the list of players
+	// - game_published: a game the user joined was published
+	// - game_updated: important details of a game the user joined changed
+	// - reimbursement_received: the organizer confirmed receiving the user's reimbursement
+	Type NotificationType `json:"type"`
+}
+
+// NotificationType - waitlist_promoted: the user moved from the waitlist to the list of players
+// - game_published: a game the user joined was published
+// - game_updated: important details of a game the user joined changed
+// - reimbursement_received: the organizer confirmed receiving the user's reimbursement
+type NotificationType string
+
 // Pagination defines model for Pagination.
 type Pagination struct {
 	// Page Current page number (1-based)
@@ -278,10 +1006,26 @@ type ParticipantWithUser struct {
 	// CreatedAt Timestamp when the participation record was created
 	CreatedAt *time.Time `json:"createdAt,omitempty"`
 
+	// DrawPosition Position of the participant in the draw of a lottery game, participants who joined after the draw or changed their participation since have none
+	DrawPosition *int64 `json:"drawPosition,omitempty"`
+
 	// Guests Number of guests the participant is bringing
 	Guests int `json:"guests"`
 
-	// Status Participation status of the authenticated user
+	// LateCancelledAt When the participant dropped out of the list of players after the cancellation deadline, only set until they join again
+	LateCancelledAt *time.Time `json:"lateCancelledAt,omitempty"`
+
+	// OfferExpiresAt When the spot offered to the participant expires, only set when the status is offered
+	OfferExpiresAt *time.Time `json:"offerExpiresAt,omitempty"`
+
+	// ReconfirmationRequestedAt When the participant was asked to confirm they're still coming because important details of the game changed, only set until they confirm or leave the game
+	ReconfirmationRequestedAt *time.Time `json:"reconfirmationRequestedAt,omitempty"`
+
+	// Regular Whether the participant is a regular of the group of the game
+	Regular *bool `json:"regular,omitempty"`
+
+	// Status Participation status of the authenticated user.
+	// - registered: going to a lottery game that wasn't drawn yet, the draw decides whether they get a spot
 	Status ParticipationStatus `json:"status"`
 
 	// UpdatedAt Timestamp when the participation record was last updated
@@ -289,7 +1033,8 @@ type ParticipantWithUser struct {
 	User      User       `json:"user"`
 }
 
-// ParticipationStatus Participation status of the authenticated user
+// ParticipationStatus Participation status of the authenticated user.
+// - registered: going to a lottery game that wasn't drawn yet, the draw decides whether they get a spot
 type ParticipationStatus struct {
 	union json.RawMessage
 }
@@ -300,14 +1045,84 @@ type ParticipationStatus1 string
 // ParticipationStatusUpdate Allowed participation statuses that a user can set directly
 type ParticipationStatusUpdate string
 
-// PublicGameDetail defines model for PublicGameDetail.
-type PublicGameDetail struct {
-	union json.RawMessage
-}
+// PaymentInstructions How the participant can pay what they owe for the game. The money goes to the treasurer of the game, or its owner until they appoint one.
+// Only provided once the game is frozen, to the participants billed for it, when the treasurer or owner set their payout details.
+type PaymentInstructions struct {
+	// AmountOwedCents Amount owed by the participant in cents
+	AmountOwedCents int64 `json:"amountOwedCents"`
 
-// PublicGameDetail0 Published game with available spots and start time
-type PublicGameDetail0 struct {
-	// GameSpotsLeft Number of spots left in the game
+	// BeneficiaryName Name of the account holder to transfer to
+	BeneficiaryName *string `json:"beneficiaryName,omitempty"`
+
+	// Bic BIC of the bank to transfer to
+	Bic *string `json:"bic,omitempty"`
+
+	// EpcQrPayload EPC069-12 "SEPA credit transfer" QR code payload, prefilled with the amount owed in euros and the remittance information.
+	// Encode it in a QR code for banking apps to scan. Only provided with an IBAN, when something is owed.
+	EpcQrPayload *string `json:"epcQrPayload,omitempty"`
+
+	// Iban IBAN to transfer to
+	Iban *string `json:"iban,omitempty"`
+
+	// MbwayPhone Phone number to send an MB WAY payment to
+	MbwayPhone *string `json:"mbwayPhone,omitempty"`
+
+	// PaypalMeUrl PayPal.me link prefilled with the amount owed
+	PaypalMeUrl *string `json:"paypalMeUrl,omitempty"`
+
+	// RemittanceInformation What to write in the description of the transfer, the game ID and the reimbursement reference, so the organizer can match it
+	RemittanceInformation string `json:"remittanceInformation"`
+}
+
+// PayoutDetails defines model for PayoutDetails.
+type PayoutDetails struct {
+	// BeneficiaryName Name of the account holder, required with the IBAN
+	BeneficiaryName *string `json:"beneficiaryName,omitempty"`
+
+	// Bic BIC of the bank of the account, optional for SEPA transfers within the EEA
+	Bic *string `json:"bic,omitempty"`
+
+	// Iban IBAN of the account to reimburse, spaces are removed
+	Iban *string `json:"iban,omitempty"`
+
+	// MbwayPhone Phone number to send MB WAY payments to, in international format
+	MbwayPhone *string `json:"mbwayPhone,omitempty"`
+
+	// PaypalMeHandle PayPal.me handle, without the paypal.me/ prefix
+	PaypalMeHandle *string `json:"paypalMeHandle,omitempty"`
+}
+
+// PendingReconfirmation defines model for PendingReconfirmation.
+type PendingReconfirmation struct {
+	// ReleaseAt When the spot of the participant is released if they don't reconfirm, not set when the game doesn't release spots
+	ReleaseAt *time.Time `json:"releaseAt,omitempty"`
+
+	// RequestedAt When the participant was asked to confirm they're still coming
+	RequestedAt time.Time `json:"requestedAt"`
+	User        User      `json:"user"`
+}
+
+// PlaceholderParticipant defines model for PlaceholderParticipant.
+type PlaceholderParticipant struct {
+	// ClaimUrl One-time link letting a real user claim the placeholder
+	ClaimUrl string `json:"claimUrl"`
+	User     User   `json:"user"`
+}
+
+// PublicGameDetail defines model for PublicGameDetail.
+type PublicGameDetail struct {
+	union json.RawMessage
+}
+
+// PublicGameDetail0 Published game with available spots and start time
+type PublicGameDetail0 struct {
+	// CancellationReason Why the game was cancelled
+	CancellationReason *string `json:"cancellationReason,omitempty"`
+
+	// CancelledAt When the game was cancelled, cancelled games can't be joined or left
+	CancelledAt *time.Time `json:"cancelledAt,omitempty"`
+
+	// GameSpotsLeft Number of spots left in the game
 	GameSpotsLeft int64 `json:"gameSpotsLeft"`
 
 	// Id Unique game identifier
@@ -325,6 +1140,9 @@ type PublicGameDetail0 struct {
 
 	// StartsAt When the game starts
 	StartsAt time.Time `json:"startsAt"`
+
+	// WaitlistSpotsLeft Number of spots left on the waitlist. Not set when the waitlist is unlimited
+	WaitlistSpotsLeft *int64 `json:"waitlistSpotsLeft,omitempty"`
 }
 
 // PublicGameDetail1 Unpublished game with scheduled publish time
@@ -346,6 +1164,51 @@ type PublicGameDetail1 struct {
 	PublishedAt time.Time `json:"publishedAt"`
 }
 
+// RecordLedgerPaymentRequest defines model for RecordLedgerPaymentRequest.
+type RecordLedgerPaymentRequest struct {
+	// AmountCents Amount received in cents
+	AmountCents int64 `json:"amountCents"`
+
+	// Note Note about the payment, e.g. how it was paid
+	Note *string `json:"note,omitempty"`
+
+	// PayerId ID of the participant who paid
+	PayerId string `json:"payerId"`
+
+	// ReceivedAt When the money was received, defaults to now
+	ReceivedAt *time.Time `json:"receivedAt,omitempty"`
+}
+
+// RecurrenceRule Weekly recurrence rule, the time of day of startsAt is used for every occurrence
+type RecurrenceRule struct {
+	// EndsAt No games are generated after this time
+	EndsAt nullable.Nullable[time.Time] `json:"endsAt,omitempty"`
+
+	// IntervalWeeks Number of weeks between occurrences (1 = every week)
+	IntervalWeeks *int `json:"intervalWeeks,omitempty"`
+
+	// OccurrenceCount No games are generated after this many occurrences
+	OccurrenceCount nullable.Nullable[int] `json:"occurrenceCount,omitempty"`
+
+	// StartsAt First possible occurrence of the series
+	StartsAt time.Time `json:"startsAt"`
+
+	// Timezone IANA time zone used to compute occurrences, so games keep their local time across daylight saving changes
+	Timezone *string `json:"timezone,omitempty"`
+
+	// Weekdays Days of the week games take place on
+	Weekdays []Weekday `json:"weekdays"`
+}
+
+// ReimbursementMatch defines model for ReimbursementMatch.
+type ReimbursementMatch struct {
+	// ParticipantId ID of the participant whose reimbursement was received
+	ParticipantId string `json:"participantId"`
+
+	// ReceivedAt When the reimbursement was received, typically when the bank booked it. Defaults to now.
+	ReceivedAt *time.Time `json:"receivedAt,omitempty"`
+}
+
 // ReimbursementRecord defines model for ReimbursementRecord.
 type ReimbursementRecord struct {
 	// CreatedAt Timestamp when the reimbursement record was created
@@ -357,6 +1220,10 @@ type ReimbursementRecord struct {
 	// ParticipantId ID of the participant
 	ParticipantId string `json:"participantId"`
 
+	// PaymentInstructions How the participant can pay what they owe for the game. The money goes to the treasurer of the game, or its owner until they appoint one.
+	// Only provided once the game is frozen, to the participants billed for it, when the treasurer or owner set their payout details.
+	PaymentInstructions *PaymentInstructions `json:"paymentInstructions,omitempty"`
+
 	// ReimbursedAt When the participant sent the reimbursement
 	ReimbursedAt nullable.Nullable[time.Time] `json:"reimbursedAt,omitempty"`
 
@@ -370,23 +1237,151 @@ type ReimbursementRecord struct {
 	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
 }
 
+// ReorderParticipantsRequest defines model for ReorderParticipantsRequest.
+type ReorderParticipantsRequest struct {
+	// UserIds The IDs of every participant going to the game except the organizer, in the new order
+	UserIds []string `json:"userIds"`
+}
+
+// Series defines model for Series.
+type Series struct {
+	// CreatedAt Timestamp when the series was created
+	CreatedAt    time.Time  `json:"createdAt"`
+	GameDefaults GameFields `json:"gameDefaults"`
+
+	// Id Unique series identifier
+	Id string `json:"id"`
+
+	// OrganizerId ID of the user who organizes the series
+	OrganizerId int `json:"organizerId"`
+
+	// PublishMinutesBefore If set, generated games are scheduled to be published this many minutes before they start, otherwise they're created as drafts
+	PublishMinutesBefore nullable.Nullable[int] `json:"publishMinutesBefore,omitempty"`
+
+	// Recurrence Weekly recurrence rule, the time of day of startsAt is used for every occurrence
+	Recurrence RecurrenceRule `json:"recurrence"`
+
+	// UpdatedAt Timestamp when the series was last updated
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// SeriesFields defines model for SeriesFields.
+type SeriesFields struct {
+	GameDefaults *GameFields `json:"gameDefaults,omitempty"`
+
+	// PublishMinutesBefore If set, generated games are scheduled to be published this many minutes before they start, otherwise they're created as drafts
+	PublishMinutesBefore nullable.Nullable[int] `json:"publishMinutesBefore,omitempty"`
+
+	// Recurrence Weekly recurrence rule, the time of day of startsAt is used for every occurrence
+	Recurrence *RecurrenceRule `json:"recurrence,omitempty"`
+}
+
+// StatementLine defines model for StatementLine.
+type StatementLine struct {
+	// AmountCents Amount received in cents
+	AmountCents int64 `json:"amountCents"`
+
+	// BookedAt When the bank booked the transaction, missing when the statement doesn't say
+	BookedAt *time.Time `json:"bookedAt,omitempty"`
+
+	// Description Description of the transfer
+	Description string `json:"description"`
+
+	// Line Line of the transaction in a CSV file, or number of its entry in a CAMT.053 statement
+	Line int `json:"line"`
+}
+
+// StatementMatch defines model for StatementMatch.
+type StatementMatch struct {
+	// AmountCents Amount received in cents
+	AmountCents int64 `json:"amountCents"`
+
+	// AmountMismatch Whether the amount received differs from the amount owed
+	AmountMismatch bool `json:"amountMismatch"`
+
+	// AmountOwedCents Amount owed by the participant in cents
+	AmountOwedCents int64 `json:"amountOwedCents"`
+
+	// BookedAt When the bank booked the transaction, missing when the statement doesn't say
+	BookedAt *time.Time `json:"bookedAt,omitempty"`
+
+	// Description Description of the transfer
+	Description string `json:"description"`
+
+	// Line Line of the transaction in a CSV file, or number of its entry in a CAMT.053 statement
+	Line        int  `json:"line"`
+	Participant User `json:"participant"`
+
+	// ReimbursementReference Reimbursement reference found in the description
+	ReimbursementReference string `json:"reimbursementReference"`
+}
+
+// StatementMatchReport defines model for StatementMatchReport.
+type StatementMatchReport struct {
+	// Matches Lines of the statement matched with a reimbursement not received yet
+	Matches []StatementMatch `json:"matches"`
+
+	// Unmatched Money received that couldn't be matched with a reimbursement
+	Unmatched []UnmatchedStatementLine `json:"unmatched"`
+}
+
+// UnmatchedStatementLine defines model for UnmatchedStatementLine.
+type UnmatchedStatementLine struct {
+	// AmountCents Amount received in cents
+	AmountCents int64 `json:"amountCents"`
+
+	// BookedAt When the bank booked the transaction, missing when the statement doesn't say
+	BookedAt *time.Time `json:"bookedAt,omitempty"`
+
+	// Description Description of the transfer
+	Description string `json:"description"`
+
+	// Line Line of the transaction in a CSV file, or number of its entry in a CAMT.053 statement
+	Line int `json:"line"`
+
+	// Reason - game_not_mentioned: the description doesn't contain the game ID
+	// - reference_not_found: the description doesn't contain the reference of a participant billed for the game
+	// - already_received: the reimbursement of the participant was already marked as received
+	// - duplicate: an earlier line of the statement was matched with the same reimbursement
+	Reason UnmatchedStatementLineReason `json:"reason"`
+}
+
+// UnmatchedStatementLineReason - game_not_mentioned: the description doesn't contain the game ID
+// - reference_not_found: the description doesn't contain the reference of a participant billed for the game
+// - already_received: the reimbursement of the participant was already marked as received
+// - duplicate: an earlier line of the statement was matched with the same reimbursement
+type UnmatchedStatementLineReason string
+
 // UpdateGameParticipationRequest defines model for UpdateGameParticipationRequest.
 type UpdateGameParticipationRequest struct {
-	// Confirmed If the participant has confirmed (can only be set to false by the server when important details are changed on the game)
+	// Confirmed If the participant has confirmed, also used to reconfirm after important details changed on the game (can only be set to false by the server when important details are changed on the game)
 	Confirmed *UpdateGameParticipationRequestConfirmed `json:"confirmed,omitempty"`
 
 	// Guests Number of guests the participant is bringing
 	Guests *int `json:"guests,omitempty"`
 
+	// InviteToken Invite token from the share link, required to join private games
+	InviteToken *string `json:"inviteToken,omitempty"`
+
 	// Status Allowed participation statuses that a user can set directly
 	Status ParticipationStatusUpdate `json:"status"`
 }
 
-// UpdateGameParticipationRequestConfirmed If the participant has confirmed (can only be set to false by the server when important details are changed on the game)
+// UpdateGameParticipationRequestConfirmed If the participant has confirmed, also used to reconfirm after important details changed on the game (can only be set to false by the server when important details are changed on the game)
 type UpdateGameParticipationRequestConfirmed bool
 
 // UpdateGameRequest defines model for UpdateGameRequest.
 type UpdateGameRequest struct {
+	// AllocationMode - first_come_first_served: participants take the spots in the order they joined
+	// - lottery: participants register until registrationClosesAt, then a random draw decides who is going and the order of the waitlist. The organizer keeps their priority. Participants joining after registrationClosesAt are queued after the drawn participants.
+	AllocationMode *GameAllocationMode `json:"allocationMode,omitempty"`
+
+	// BillLateCancellations Whether participants who cancelled late still owe their share of the price when nobody took their spot
+	BillLateCancellations *bool `json:"billLateCancellations,omitempty"`
+
+	// CancellationDeadlineMinutes How many minutes before the game starts participants can still drop out without it being a late cancellation. 0 disables the deadline.
+	CancellationDeadlineMinutes *int64 `json:"cancellationDeadlineMinutes,omitempty"`
+
 	// Description Description of the game
 	Description *string `json:"description,omitempty"`
 
@@ -399,6 +1394,9 @@ type UpdateGameRequest struct {
 	// GameSpotsLeft Number of spots left in the game, excluding the waitlist
 	GameSpotsLeft *int64 `json:"gameSpotsLeft,omitempty"`
 
+	// GuestPriceCents Price of a guest's spot in cents, for the per_player_guest_rate split strategy
+	GuestPriceCents *int64 `json:"guestPriceCents,omitempty"`
+
 	// Location Location where the game will be held
 	Location *string `json:"location,omitempty"`
 
@@ -408,17 +1406,84 @@ type UpdateGameRequest struct {
 	// MaxPlayers Maximum number of players (must be at least 1)
 	MaxPlayers *int64 `json:"maxPlayers,omitempty"`
 
+	// MaxWaitlistSize Number of spots on the waitlist of games with a fixed waitlist, guests included
+	MaxWaitlistSize *int64 `json:"maxWaitlistSize,omitempty"`
+
 	// Name Name of the game
 	Name *string `json:"name,omitempty"`
 
+	// PricePerPlayerCents Price of a spot in cents, for the per_player and per_player_guest_rate split strategies
+	PricePerPlayerCents *int64 `json:"pricePerPlayerCents,omitempty"`
+
 	// PublishedAt When the game should become publicly visible. Past timestamps publish immediately. While in the future, it can be rescheduled or cleared.
 	PublishedAt nullable.Nullable[time.Time] `json:"publishedAt,omitempty"`
 
+	// ReconfirmWithinMinutes How many minutes participants have to confirm they're still coming after the start time, location, price or duration of the published game changed, before their spot is released. 0 never releases their spot.
+	ReconfirmWithinMinutes *int64 `json:"reconfirmWithinMinutes,omitempty"`
+
+	// RegistrationClosesAt When the registration of a lottery game closes and the draw happens. Required for lottery games.
+	RegistrationClosesAt *time.Time `json:"registrationClosesAt,omitempty"`
+
+	// RegularsFirst Whether the regulars of the group sort ahead of drop-ins when the game is oversubscribed, the organizer still comes first. Only available for group games.
+	RegularsFirst *bool `json:"regularsFirst,omitempty"`
+
+	// RegularsHeadStartMinutes How many minutes after the game is published only the regulars of its group can join. 0 disables the head start. Only available for group games.
+	RegularsHeadStartMinutes *int64 `json:"regularsHeadStartMinutes,omitempty"`
+
+	// SplitStrategy - even: totalPriceCents is split evenly between every spot, the cents left over are paid by the first participants to join
+	// - per_player: every spot, guests included, costs pricePerPlayerCents
+	// - per_player_guest_rate: every participant pays pricePerPlayerCents, and guestPriceCents for each of their guests
+	// - organizer_exempt: like even, but the organizer doesn't pay for their own spot
+	SplitStrategy *GameSplitStrategy `json:"splitStrategy,omitempty"`
+
 	// StartsAt When the game starts
 	StartsAt *time.Time `json:"startsAt,omitempty"`
 
 	// TotalPriceCents Total price in cents
 	TotalPriceCents *int64 `json:"totalPriceCents,omitempty"`
+
+	// Visibility - public: anyone with the link to the game can see and join it once it's published
+	// - private: users need an invite token, carried in the share link, to see and join the game
+	Visibility *GameVisibility `json:"visibility,omitempty"`
+
+	// WaitlistMode - disabled: participants can only join while there are spots left in the game
+	// - fixed: up to maxWaitlistSize participants, guests included, can be put on the waitlist
+	// - unlimited: participants can always join the waitlist
+	WaitlistMode *GameWaitlistMode `json:"waitlistMode,omitempty"`
+
+	// WaitlistOfferMinutes When set, a spot freed in the game is offered to the next waitlisted participant, who must accept it within this many minutes or the offer moves on to the next one. 0 promotes waitlisted participants directly.
+	WaitlistOfferMinutes *int64 `json:"waitlistOfferMinutes,omitempty"`
+}
+
+// UpdateGameRoleRequest defines model for UpdateGameRoleRequest.
+type UpdateGameRoleRequest struct {
+	// Role Role of a user in a game. The owner created the game and can do everything, except managing reimbursements once a treasurer is appointed.
+	// Co-organizers manage the game and its participants, treasurers manage its reimbursements.
+	Role GameRoleName `json:"role"`
+}
+
+// UpdateGroupMemberRequest defines model for UpdateGroupMemberRequest.
+type UpdateGroupMemberRequest struct {
+	// Role Role of a user in a group. Admins manage the group and its members.
+	Role *GroupMemberRole `json:"role,omitempty"`
+
+	// Tier Priority tier of a member in the games of the group. Regulars can get priority over drop-ins.
+	Tier *GroupMemberTier `json:"tier,omitempty"`
+}
+
+// UpdateGroupRequest defines model for UpdateGroupRequest.
+type UpdateGroupRequest struct {
+	// Description Description of the group
+	Description *string `json:"description,omitempty"`
+
+	// Name Name of the group
+	Name *string `json:"name,omitempty"`
+
+	// RegularsFirst Default priority of the regulars in the games created in the group, see the game field of the same name
+	RegularsFirst *bool `json:"regularsFirst,omitempty"`
+
+	// RegularsHeadStartMinutes Default head start of the regulars in the games created in the group, see the game field of the same name
+	RegularsHeadStartMinutes *int64 `json:"regularsHeadStartMinutes,omitempty"`
 }
 
 // UpdateReimbursementRequest defines model for UpdateReimbursementRequest.
@@ -441,6 +1506,19 @@ type UpdateReimbursementRequest1 struct {
 	ReimbursedAt nullable.Nullable[time.Time] `json:"reimbursedAt"`
 }
 
+// UpdateSeriesRequest defines model for UpdateSeriesRequest.
+type UpdateSeriesRequest struct {
+	// ApplyToFutureGames Whether the game defaults should also be applied to the generated games that haven't started and aren't frozen
+	ApplyToFutureGames *bool       `json:"applyToFutureGames,omitempty"`
+	GameDefaults       *GameFields `json:"gameDefaults,omitempty"`
+
+	// PublishMinutesBefore If set, generated games are scheduled to be published this many minutes before they start, otherwise they're created as drafts
+	PublishMinutesBefore nullable.Nullable[int] `json:"publishMinutesBefore,omitempty"`
+
+	// Recurrence Weekly recurrence rule, the time of day of startsAt is used for every occurrence
+	Recurrence *RecurrenceRule `json:"recurrence,omitempty"`
+}
+
 // User defines model for User.
 type User struct {
 	// CreatedAt Timestamp when user was created
@@ -455,6 +1533,9 @@ type User struct {
 	// IsDemo Whether the user is a demo user
 	IsDemo bool `json:"isDemo"`
 
+	// IsPlaceholder Whether the user is a placeholder added by an organizer for a player without an account
+	IsPlaceholder bool `json:"isPlaceholder"`
+
 	// Name User's full name
 	Name *string `json:"name,omitempty"`
 
@@ -465,6 +1546,59 @@ type User struct {
 	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
 }
 
+// Webhook defines model for Webhook.
+type Webhook struct {
+	// CreatedAt Timestamp when the webhook was registered
+	CreatedAt  time.Time          `json:"createdAt"`
+	EventTypes []WebhookEventType `json:"eventTypes"`
+
+	// GameId The game whose events are sent
+	GameId *string `json:"gameId,omitempty"`
+
+	// Id Unique webhook identifier
+	Id int64 `json:"id"`
+
+	// SeriesId The series whose games' events are sent
+	SeriesId *string `json:"seriesId,omitempty"`
+
+	// Url URL receiving the events
+	Url string `json:"url"`
+}
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	// Attempts Number of failed attempts
+	Attempts    int64      `json:"attempts"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	DeliveredAt *time.Time `json:"deliveredAt,omitempty"`
+
+	// EventId ID of the event, the same event may be delivered more than once
+	EventId   int64            `json:"eventId"`
+	EventType WebhookEventType `json:"eventType"`
+
+	// FailedAt When delivery attempts stopped after too many failures
+	FailedAt *time.Time `json:"failedAt,omitempty"`
+
+	// Id Unique delivery identifier, sent in the X-Opengym-Delivery header
+	Id int64 `json:"id"`
+
+	// LastError Error of the last failed attempt
+	LastError *string `json:"lastError,omitempty"`
+
+	// LastStatusCode Response status of the last attempt, missing if no response was received
+	LastStatusCode *int `json:"lastStatusCode,omitempty"`
+
+	// NextAttemptAt When the delivery is retried after a failed attempt
+	NextAttemptAt *time.Time `json:"nextAttemptAt,omitempty"`
+	WebhookId     int64      `json:"webhookId"`
+}
+
+// WebhookEventType defines model for WebhookEventType.
+type WebhookEventType string
+
+// Weekday defines model for Weekday.
+type Weekday string
+
 // GetApiAuthProviderCallbackParams defines parameters for GetApiAuthProviderCallback.
 type GetApiAuthProviderCallbackParams struct {
 	// Code Authorization code returned by the provider on success
@@ -495,18 +1629,105 @@ type GetApiGamesParams struct {
 	PageSize *int `form:"pageSize,omitempty" json:"pageSize,omitempty"`
 }
 
+// GetApiGamesIdParams defines parameters for GetApiGamesId.
+type GetApiGamesIdParams struct {
+	// InviteToken Invite token from the share link, required to see private games without a role or participation
+	InviteToken *string `form:"inviteToken,omitempty" json:"inviteToken,omitempty"`
+}
+
+// GetApiGamesIdEventsParams defines parameters for GetApiGamesIdEvents.
+type GetApiGamesIdEventsParams struct {
+	// LastEventID ID of the last event received, sent by the browser when reconnecting
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
+}
+
+// GetApiLedgerParams defines parameters for GetApiLedger.
+type GetApiLedgerParams struct {
+	// PayerId ID of the participant
+	PayerId string `form:"payerId" json:"payerId"`
+
+	// PayeeId ID of the organizer
+	PayeeId string `form:"payeeId" json:"payeeId"`
+}
+
+// PutApiUsersMeNotificationPreferencesJSONBody defines parameters for PutApiUsersMeNotificationPreferences.
+type PutApiUsersMeNotificationPreferencesJSONBody = []NotificationPreference
+
+// GetPublicApiGamesIdParams defines parameters for GetPublicApiGamesId.
+type GetPublicApiGamesIdParams struct {
+	// InviteToken Invite token from the share link, required to see private games without a role or participation
+	InviteToken *string `form:"inviteToken,omitempty" json:"inviteToken,omitempty"`
+}
+
 // PostApiGamesJSONRequestBody defines body for PostApiGames for application/json ContentType.
 type PostApiGamesJSONRequestBody = CreateGameRequest
 
 // PatchApiGamesIdJSONRequestBody defines body for PatchApiGamesId for application/json ContentType.
 type PatchApiGamesIdJSONRequestBody = UpdateGameRequest
 
+// PostApiGamesIdCancelJSONRequestBody defines body for PostApiGamesIdCancel for application/json ContentType.
+type PostApiGamesIdCancelJSONRequestBody = CancelGameRequest
+
+// PostApiGamesIdCheckInsJSONRequestBody defines body for PostApiGamesIdCheckIns for application/json ContentType.
+type PostApiGamesIdCheckInsJSONRequestBody = CheckInRequest
+
 // PutApiGamesIdParticipantsJSONRequestBody defines body for PutApiGamesIdParticipants for application/json ContentType.
 type PutApiGamesIdParticipantsJSONRequestBody = UpdateGameParticipationRequest
 
+// PutApiGamesIdParticipantsOrderJSONRequestBody defines body for PutApiGamesIdParticipantsOrder for application/json ContentType.
+type PutApiGamesIdParticipantsOrderJSONRequestBody = ReorderParticipantsRequest
+
+// PostApiGamesIdPlaceholdersJSONRequestBody defines body for PostApiGamesIdPlaceholders for application/json ContentType.
+type PostApiGamesIdPlaceholdersJSONRequestBody = CreatePlaceholderRequest
+
 // PutApiGamesIdReimbursementsJSONRequestBody defines body for PutApiGamesIdReimbursements for application/json ContentType.
 type PutApiGamesIdReimbursementsJSONRequestBody = UpdateReimbursementRequest
 
+// PostApiGamesIdReimbursementsBulkJSONRequestBody defines body for PostApiGamesIdReimbursementsBulk for application/json ContentType.
+type PostApiGamesIdReimbursementsBulkJSONRequestBody = BulkReimbursementRequest
+
+// PostApiGamesIdReimbursementsMatchesJSONRequestBody defines body for PostApiGamesIdReimbursementsMatches for application/json ContentType.
+type PostApiGamesIdReimbursementsMatchesJSONRequestBody = ApplyReimbursementMatchesRequest
+
+// PostApiGamesIdRolesJSONRequestBody defines body for PostApiGamesIdRoles for application/json ContentType.
+type PostApiGamesIdRolesJSONRequestBody = InviteGameRoleRequest
+
+// PutApiGamesIdRolesUserIdJSONRequestBody defines body for PutApiGamesIdRolesUserId for application/json ContentType.
+type PutApiGamesIdRolesUserIdJSONRequestBody = UpdateGameRoleRequest
+
+// PostApiGroupsJSONRequestBody defines body for PostApiGroups for application/json ContentType.
+type PostApiGroupsJSONRequestBody = CreateGroupRequest
+
+// PostApiGroupsJoinJSONRequestBody defines body for PostApiGroupsJoin for application/json ContentType.
+type PostApiGroupsJoinJSONRequestBody = JoinGroupRequest
+
+// PatchApiGroupsIdJSONRequestBody defines body for PatchApiGroupsId for application/json ContentType.
+type PatchApiGroupsIdJSONRequestBody = UpdateGroupRequest
+
+// PutApiGroupsIdMembersUserIdJSONRequestBody defines body for PutApiGroupsIdMembersUserId for application/json ContentType.
+type PutApiGroupsIdMembersUserIdJSONRequestBody = UpdateGroupMemberRequest
+
+// PostApiLedgerPaymentsJSONRequestBody defines body for PostApiLedgerPayments for application/json ContentType.
+type PostApiLedgerPaymentsJSONRequestBody = RecordLedgerPaymentRequest
+
+// PostApiPlaceholdersClaimJSONRequestBody defines body for PostApiPlaceholdersClaim for application/json ContentType.
+type PostApiPlaceholdersClaimJSONRequestBody = ClaimPlaceholderRequest
+
+// PostApiSeriesJSONRequestBody defines body for PostApiSeries for application/json ContentType.
+type PostApiSeriesJSONRequestBody = CreateSeriesRequest
+
+// PatchApiSeriesIdJSONRequestBody defines body for PatchApiSeriesId for application/json ContentType.
+type PatchApiSeriesIdJSONRequestBody = UpdateSeriesRequest
+
+// PutApiUsersMeNotificationPreferencesJSONRequestBody defines body for PutApiUsersMeNotificationPreferences for application/json ContentType.
+type PutApiUsersMeNotificationPreferencesJSONRequestBody = PutApiUsersMeNotificationPreferencesJSONBody
+
+// PutApiUsersMePayoutDetailsJSONRequestBody defines body for PutApiUsersMePayoutDetails for application/json ContentType.
+type PutApiUsersMePayoutDetailsJSONRequestBody = PayoutDetails
+
+// PostApiWebhooksJSONRequestBody defines body for PostApiWebhooks for application/json ContentType.
+type PostApiWebhooksJSONRequestBody = CreateWebhookRequest
+
 // AsParticipationStatusUpdate returns the union data inside the ParticipationStatus as a ParticipationStatusUpdate
 func (t ParticipationStatus) AsParticipationStatusUpdate() (ParticipationStatusUpdate, error) {
 	var body ParticipationStatusUpdate
@@ -719,30 +1940,186 @@ type ServerInterface interface {
 	// Create a new game
 	// (POST /api/games)
 	PostApiGames(w http.ResponseWriter, r *http.Request)
+	// Delete a draft game
+	// (DELETE /api/games/{id})
+	DeleteApiGamesId(w http.ResponseWriter, r *http.Request, id string)
 	// Get a game by ID
 	// (GET /api/games/{id})
-	GetApiGamesId(w http.ResponseWriter, r *http.Request, id string)
+	GetApiGamesId(w http.ResponseWriter, r *http.Request, id string, params GetApiGamesIdParams)
 	// Update a game
 	// (PATCH /api/games/{id})
 	PatchApiGamesId(w http.ResponseWriter, r *http.Request, id string)
+	// List the attendance of a started game
+	// (GET /api/games/{id}/attendance)
+	GetApiGamesIdAttendance(w http.ResponseWriter, r *http.Request, id string)
+	// Cancel a published game
+	// (POST /api/games/{id}/cancel)
+	PostApiGamesIdCancel(w http.ResponseWriter, r *http.Request, id string)
+	// Reset the check-in code of a game
+	// (POST /api/games/{id}/check-in-code)
+	PostApiGamesIdCheckInCode(w http.ResponseWriter, r *http.Request, id string)
+	// Check a participant in
+	// (POST /api/games/{id}/check-ins)
+	PostApiGamesIdCheckIns(w http.ResponseWriter, r *http.Request, id string)
+	// Undo the check-in of a participant
+	// (DELETE /api/games/{id}/check-ins/{userId})
+	DeleteApiGamesIdCheckInsUserId(w http.ResponseWriter, r *http.Request, id string, userId string)
+	// Stream game updates
+	// (GET /api/games/{id}/events)
+	GetApiGamesIdEvents(w http.ResponseWriter, r *http.Request, id string, params GetApiGamesIdEventsParams)
+	// List the invite tokens of a game
+	// (GET /api/games/{id}/invite-tokens)
+	GetApiGamesIdInviteTokens(w http.ResponseWriter, r *http.Request, id string)
+	// Rotate the invite token of a game
+	// (POST /api/games/{id}/invite-tokens)
+	PostApiGamesIdInviteTokens(w http.ResponseWriter, r *http.Request, id string)
+	// Revoke an invite token
+	// (DELETE /api/games/{id}/invite-tokens/{tokenId})
+	DeleteApiGamesIdInviteTokensTokenId(w http.ResponseWriter, r *http.Request, id string, tokenId int64)
 	// List game participants
 	// (GET /api/games/{id}/participants)
 	GetApiGamesIdParticipants(w http.ResponseWriter, r *http.Request, id string)
 	// Set participation status
 	// (PUT /api/games/{id}/participants)
 	PutApiGamesIdParticipants(w http.ResponseWriter, r *http.Request, id string)
+	// Reorder the participants
+	// (PUT /api/games/{id}/participants/order)
+	PutApiGamesIdParticipantsOrder(w http.ResponseWriter, r *http.Request, id string)
+	// Remove a participant
+	// (DELETE /api/games/{id}/participants/{userId})
+	DeleteApiGamesIdParticipantsUserId(w http.ResponseWriter, r *http.Request, id string, userId string)
+	// Add a placeholder participant
+	// (POST /api/games/{id}/placeholders)
+	PostApiGamesIdPlaceholders(w http.ResponseWriter, r *http.Request, id string)
+	// Create a new claim link for a placeholder
+	// (POST /api/games/{id}/placeholders/{userId}/claim-link)
+	PostApiGamesIdPlaceholdersUserIdClaimLink(w http.ResponseWriter, r *http.Request, id string, userId string)
+	// List the participants who still have to reconfirm
+	// (GET /api/games/{id}/reconfirmations)
+	GetApiGamesIdReconfirmations(w http.ResponseWriter, r *http.Request, id string)
 	// List reimbursements for a game
 	// (GET /api/games/{id}/reimbursements)
 	GetApiGamesIdReimbursements(w http.ResponseWriter, r *http.Request, id string)
 	// Update reimbursement status for a participant
 	// (PUT /api/games/{id}/reimbursements)
 	PutApiGamesIdReimbursements(w http.ResponseWriter, r *http.Request, id string)
+	// Update the reimbursement status of many participants
+	// (POST /api/games/{id}/reimbursements/bulk)
+	PostApiGamesIdReimbursementsBulk(w http.ResponseWriter, r *http.Request, id string)
+	// Mark matched reimbursements as received
+	// (POST /api/games/{id}/reimbursements/matches)
+	PostApiGamesIdReimbursementsMatches(w http.ResponseWriter, r *http.Request, id string)
+	// Match a bank statement against the open reimbursements
+	// (POST /api/games/{id}/reimbursements/statement)
+	PostApiGamesIdReimbursementsStatement(w http.ResponseWriter, r *http.Request, id string)
 	// Get reimbursement record for a participant
 	// (GET /api/games/{id}/reimbursements/{participant_id})
 	GetApiGamesIdReimbursementsParticipantId(w http.ResponseWriter, r *http.Request, id string, participantId string)
+	// List the roles of a game
+	// (GET /api/games/{id}/roles)
+	GetApiGamesIdRoles(w http.ResponseWriter, r *http.Request, id string)
+	// Invite a user to a role
+	// (POST /api/games/{id}/roles)
+	PostApiGamesIdRoles(w http.ResponseWriter, r *http.Request, id string)
+	// Revoke the role of a user
+	// (DELETE /api/games/{id}/roles/{userId})
+	DeleteApiGamesIdRolesUserId(w http.ResponseWriter, r *http.Request, id string, userId string)
+	// Change the role of a user
+	// (PUT /api/games/{id}/roles/{userId})
+	PutApiGamesIdRolesUserId(w http.ResponseWriter, r *http.Request, id string, userId string)
+	// Accept the spot offered to the user
+	// (POST /api/games/{id}/waitlist-offer/accept)
+	PostApiGamesIdWaitlistOfferAccept(w http.ResponseWriter, r *http.Request, id string)
+	// Decline the spot offered to the user
+	// (POST /api/games/{id}/waitlist-offer/decline)
+	PostApiGamesIdWaitlistOfferDecline(w http.ResponseWriter, r *http.Request, id string)
+	// List the user's groups
+	// (GET /api/groups)
+	GetApiGroups(w http.ResponseWriter, r *http.Request)
+	// Create a group
+	// (POST /api/groups)
+	PostApiGroups(w http.ResponseWriter, r *http.Request)
+	// Join a group
+	// (POST /api/groups/join)
+	PostApiGroupsJoin(w http.ResponseWriter, r *http.Request)
+	// Get a group
+	// (GET /api/groups/{id})
+	GetApiGroupsId(w http.ResponseWriter, r *http.Request, id string)
+	// Update a group
+	// (PATCH /api/groups/{id})
+	PatchApiGroupsId(w http.ResponseWriter, r *http.Request, id string)
+	// List the attendance of the members of a group
+	// (GET /api/groups/{id}/attendance)
+	GetApiGroupsIdAttendance(w http.ResponseWriter, r *http.Request, id string)
+	// Reset the invite link of a group
+	// (POST /api/groups/{id}/invite-link)
+	PostApiGroupsIdInviteLink(w http.ResponseWriter, r *http.Request, id string)
+	// List the members of a group
+	// (GET /api/groups/{id}/members)
+	GetApiGroupsIdMembers(w http.ResponseWriter, r *http.Request, id string)
+	// Remove a member
+	// (DELETE /api/groups/{id}/members/{userId})
+	DeleteApiGroupsIdMembersUserId(w http.ResponseWriter, r *http.Request, id string, userId string)
+	// Change the role or tier of a member
+	// (PUT /api/groups/{id}/members/{userId})
+	PutApiGroupsIdMembersUserId(w http.ResponseWriter, r *http.Request, id string, userId string)
+	// Get the ledger between a participant and an organizer
+	// (GET /api/ledger)
+	GetApiLedger(w http.ResponseWriter, r *http.Request, params GetApiLedgerParams)
+	// Record a payment from a participant
+	// (POST /api/ledger/payments)
+	PostApiLedgerPayments(w http.ResponseWriter, r *http.Request)
+	// Claim a placeholder
+	// (POST /api/placeholders/claim)
+	PostApiPlaceholdersClaim(w http.ResponseWriter, r *http.Request)
+	// List the user's series
+	// (GET /api/series)
+	GetApiSeries(w http.ResponseWriter, r *http.Request)
+	// Create a new series
+	// (POST /api/series)
+	PostApiSeries(w http.ResponseWriter, r *http.Request)
+	// Get a series by ID
+	// (GET /api/series/{id})
+	GetApiSeriesId(w http.ResponseWriter, r *http.Request, id string)
+	// Update a series
+	// (PATCH /api/series/{id})
+	PatchApiSeriesId(w http.ResponseWriter, r *http.Request, id string)
+	// List the user's balances with organizers
+	// (GET /api/users/me/balances)
+	GetApiUsersMeBalances(w http.ResponseWriter, r *http.Request)
+	// Get the user's notification preferences
+	// (GET /api/users/me/notification-preferences)
+	GetApiUsersMeNotificationPreferences(w http.ResponseWriter, r *http.Request)
+	// Update the user's notification preferences
+	// (PUT /api/users/me/notification-preferences)
+	PutApiUsersMeNotificationPreferences(w http.ResponseWriter, r *http.Request)
+	// List the balances of the participants with the user
+	// (GET /api/users/me/organizer-balances)
+	GetApiUsersMeOrganizerBalances(w http.ResponseWriter, r *http.Request)
+	// Get the user's payout details
+	// (GET /api/users/me/payout-details)
+	GetApiUsersMePayoutDetails(w http.ResponseWriter, r *http.Request)
+	// Update the user's payout details
+	// (PUT /api/users/me/payout-details)
+	PutApiUsersMePayoutDetails(w http.ResponseWriter, r *http.Request)
+	// List the user's webhooks
+	// (GET /api/webhooks)
+	GetApiWebhooks(w http.ResponseWriter, r *http.Request)
+	// Register a webhook
+	// (POST /api/webhooks)
+	PostApiWebhooks(w http.ResponseWriter, r *http.Request)
+	// Delete a webhook
+	// (DELETE /api/webhooks/{id})
+	DeleteApiWebhooksId(w http.ResponseWriter, r *http.Request, id int)
+	// List the deliveries of a webhook
+	// (GET /api/webhooks/{id}/deliveries)
+	GetApiWebhooksIdDeliveries(w http.ResponseWriter, r *http.Request, id int)
+	// Redeliver a webhook delivery
+	// (POST /api/webhooks/{id}/deliveries/{deliveryId}/redeliver)
+	PostApiWebhooksIdDeliveriesDeliveryIdRedeliver(w http.ResponseWriter, r *http.Request, id int, deliveryId int)
 	// Get public game information
 	// (GET /public/api/games/{id})
-	GetPublicApiGamesId(w http.ResponseWriter, r *http.Request, id string)
+	GetPublicApiGamesId(w http.ResponseWriter, r *http.Request, id string, params GetPublicApiGamesIdParams)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -754,8 +2131,1561 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
-// PostApiAuthLogout operation middleware
-func (siw *ServerInterfaceWrapper) PostApiAuthLogout(w http.ResponseWriter, r *http.Request) {
+// PostApiAuthLogout operation middleware
+func (siw *ServerInterfaceWrapper) PostApiAuthLogout(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiAuthLogout(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiAuthMe operation middleware
+func (siw *ServerInterfaceWrapper) GetApiAuthMe(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiAuthMe(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiAuthProviderCallback operation middleware
+func (siw *ServerInterfaceWrapper) GetApiAuthProviderCallback(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "provider" -------------
+	var provider GetApiAuthProviderCallbackParamsProvider
+
+	err = runtime.BindStyledParameterWithOptions("simple", "provider", r.PathValue("provider"), &provider, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "provider", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiAuthProviderCallbackParams
+
+	// ------------- Optional query parameter "code" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "code", r.URL.Query(), &params.Code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "state" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "state", r.URL.Query(), &params.State)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "state", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "error" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "error", r.URL.Query(), &params.Error)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "error", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "error_description" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "error_description", r.URL.Query(), &params.ErrorDescription)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "error_description", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiAuthProviderCallback(w, r, provider, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiAuthProviderLogin operation middleware
+func (siw *ServerInterfaceWrapper) GetApiAuthProviderLogin(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "provider" -------------
+	var provider GetApiAuthProviderLoginParamsProvider
+
+	err = runtime.BindStyledParameterWithOptions("simple", "provider", r.PathValue("provider"), &provider, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "provider", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiAuthProviderLogin(w, r, provider)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiDemoUsers operation middleware
+func (siw *ServerInterfaceWrapper) GetApiDemoUsers(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiDemoUsers(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiDemoUsersUserIdImpersonate operation middleware
+func (siw *ServerInterfaceWrapper) PostApiDemoUsersUserIdImpersonate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", r.PathValue("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiDemoUsersUserIdImpersonate(w, r, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiGames operation middleware
+func (siw *ServerInterfaceWrapper) GetApiGames(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiGamesParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pageSize" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiGames(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiGames operation middleware
+func (siw *ServerInterfaceWrapper) PostApiGames(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiGames(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteApiGamesId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiGamesId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteApiGamesId(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiGamesId operation middleware
+func (siw *ServerInterfaceWrapper) GetApiGamesId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiGamesIdParams
+
+	// ------------- Optional query parameter "inviteToken" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "inviteToken", r.URL.Query(), &params.InviteToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "inviteToken", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiGamesId(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchApiGamesId operation middleware
+func (siw *ServerInterfaceWrapper) PatchApiGamesId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchApiGamesId(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiGamesIdAttendance operation middleware
+func (siw *ServerInterfaceWrapper) GetApiGamesIdAttendance(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiGamesIdAttendance(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiGamesIdCancel operation middleware
+func (siw *ServerInterfaceWrapper) PostApiGamesIdCancel(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiGamesIdCancel(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiGamesIdCheckInCode operation middleware
+func (siw *ServerInterfaceWrapper) PostApiGamesIdCheckInCode(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiGamesIdCheckInCode(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiGamesIdCheckIns operation middleware
+func (siw *ServerInterfaceWrapper) PostApiGamesIdCheckIns(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiGamesIdCheckIns(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteApiGamesIdCheckInsUserId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiGamesIdCheckInsUserId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", r.PathValue("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteApiGamesIdCheckInsUserId(w, r, id, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiGamesIdEvents operation middleware
+func (siw *ServerInterfaceWrapper) GetApiGamesIdEvents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiGamesIdEventsParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Last-Event-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Last-Event-ID", Err: err})
+			return
+		}
+
+		params.LastEventID = &LastEventID
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiGamesIdEvents(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiGamesIdInviteTokens operation middleware
+func (siw *ServerInterfaceWrapper) GetApiGamesIdInviteTokens(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiGamesIdInviteTokens(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiGamesIdInviteTokens operation middleware
+func (siw *ServerInterfaceWrapper) PostApiGamesIdInviteTokens(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiGamesIdInviteTokens(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteApiGamesIdInviteTokensTokenId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiGamesIdInviteTokensTokenId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "tokenId" -------------
+	var tokenId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tokenId", r.PathValue("tokenId"), &tokenId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tokenId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteApiGamesIdInviteTokensTokenId(w, r, id, tokenId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiGamesIdParticipants operation middleware
+func (siw *ServerInterfaceWrapper) GetApiGamesIdParticipants(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiGamesIdParticipants(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutApiGamesIdParticipants operation middleware
+func (siw *ServerInterfaceWrapper) PutApiGamesIdParticipants(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutApiGamesIdParticipants(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutApiGamesIdParticipantsOrder operation middleware
+func (siw *ServerInterfaceWrapper) PutApiGamesIdParticipantsOrder(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutApiGamesIdParticipantsOrder(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteApiGamesIdParticipantsUserId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiGamesIdParticipantsUserId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", r.PathValue("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteApiGamesIdParticipantsUserId(w, r, id, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiGamesIdPlaceholders operation middleware
+func (siw *ServerInterfaceWrapper) PostApiGamesIdPlaceholders(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiGamesIdPlaceholders(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiGamesIdPlaceholdersUserIdClaimLink operation middleware
+func (siw *ServerInterfaceWrapper) PostApiGamesIdPlaceholdersUserIdClaimLink(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", r.PathValue("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiGamesIdPlaceholdersUserIdClaimLink(w, r, id, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiGamesIdReconfirmations operation middleware
+func (siw *ServerInterfaceWrapper) GetApiGamesIdReconfirmations(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiGamesIdReconfirmations(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiGamesIdReimbursements operation middleware
+func (siw *ServerInterfaceWrapper) GetApiGamesIdReimbursements(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiGamesIdReimbursements(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutApiGamesIdReimbursements operation middleware
+func (siw *ServerInterfaceWrapper) PutApiGamesIdReimbursements(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutApiGamesIdReimbursements(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiGamesIdReimbursementsBulk operation middleware
+func (siw *ServerInterfaceWrapper) PostApiGamesIdReimbursementsBulk(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiGamesIdReimbursementsBulk(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiGamesIdReimbursementsMatches operation middleware
+func (siw *ServerInterfaceWrapper) PostApiGamesIdReimbursementsMatches(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiGamesIdReimbursementsMatches(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiGamesIdReimbursementsStatement operation middleware
+func (siw *ServerInterfaceWrapper) PostApiGamesIdReimbursementsStatement(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiGamesIdReimbursementsStatement(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiGamesIdReimbursementsParticipantId operation middleware
+func (siw *ServerInterfaceWrapper) GetApiGamesIdReimbursementsParticipantId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "participant_id" -------------
+	var participantId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "participant_id", r.PathValue("participant_id"), &participantId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "participant_id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiGamesIdReimbursementsParticipantId(w, r, id, participantId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiGamesIdRoles operation middleware
+func (siw *ServerInterfaceWrapper) GetApiGamesIdRoles(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiGamesIdRoles(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiGamesIdRoles operation middleware
+func (siw *ServerInterfaceWrapper) PostApiGamesIdRoles(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiGamesIdRoles(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteApiGamesIdRolesUserId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiGamesIdRolesUserId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", r.PathValue("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteApiGamesIdRolesUserId(w, r, id, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutApiGamesIdRolesUserId operation middleware
+func (siw *ServerInterfaceWrapper) PutApiGamesIdRolesUserId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", r.PathValue("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutApiGamesIdRolesUserId(w, r, id, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiGamesIdWaitlistOfferAccept operation middleware
+func (siw *ServerInterfaceWrapper) PostApiGamesIdWaitlistOfferAccept(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiGamesIdWaitlistOfferAccept(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiGamesIdWaitlistOfferDecline operation middleware
+func (siw *ServerInterfaceWrapper) PostApiGamesIdWaitlistOfferDecline(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiGamesIdWaitlistOfferDecline(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiGroups operation middleware
+func (siw *ServerInterfaceWrapper) GetApiGroups(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiGroups(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiGroups operation middleware
+func (siw *ServerInterfaceWrapper) PostApiGroups(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiGroups(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiGroupsJoin operation middleware
+func (siw *ServerInterfaceWrapper) PostApiGroupsJoin(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiGroupsJoin(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiGroupsId operation middleware
+func (siw *ServerInterfaceWrapper) GetApiGroupsId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiGroupsId(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchApiGroupsId operation middleware
+func (siw *ServerInterfaceWrapper) PatchApiGroupsId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchApiGroupsId(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiGroupsIdAttendance operation middleware
+func (siw *ServerInterfaceWrapper) GetApiGroupsIdAttendance(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiGroupsIdAttendance(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiGroupsIdInviteLink operation middleware
+func (siw *ServerInterfaceWrapper) PostApiGroupsIdInviteLink(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostApiGroupsIdInviteLink(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiGroupsIdMembers operation middleware
+func (siw *ServerInterfaceWrapper) GetApiGroupsIdMembers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiGroupsIdMembers(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteApiGroupsIdMembersUserId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiGroupsIdMembersUserId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", r.PathValue("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteApiGroupsIdMembersUserId(w, r, id, userId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PutApiGroupsIdMembersUserId operation middleware
+func (siw *ServerInterfaceWrapper) PutApiGroupsIdMembersUserId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "userId" -------------
+	var userId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", r.PathValue("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
+		return
+	}
 
 	ctx := r.Context()
 
@@ -764,7 +3694,7 @@ func (siw *ServerInterfaceWrapper) PostApiAuthLogout(w http.ResponseWriter, r *h
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostApiAuthLogout(w, r)
+		siw.Handler.PutApiGroupsIdMembersUserId(w, r, id, userId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -774,8 +3704,10 @@ func (siw *ServerInterfaceWrapper) PostApiAuthLogout(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// GetApiAuthMe operation middleware
-func (siw *ServerInterfaceWrapper) GetApiAuthMe(w http.ResponseWriter, r *http.Request) {
+// GetApiLedger operation middleware
+func (siw *ServerInterfaceWrapper) GetApiLedger(w http.ResponseWriter, r *http.Request) {
+
+	var err error
 
 	ctx := r.Context()
 
@@ -783,68 +3715,61 @@ func (siw *ServerInterfaceWrapper) GetApiAuthMe(w http.ResponseWriter, r *http.R
 
 	r = r.WithContext(ctx)
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetApiAuthMe(w, r)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
-
-	handler.ServeHTTP(w, r)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetApiLedgerParams
 
-// GetApiAuthProviderCallback operation middleware
-func (siw *ServerInterfaceWrapper) GetApiAuthProviderCallback(w http.ResponseWriter, r *http.Request) {
+	// ------------- Required query parameter "payerId" -------------
 
-	var err error
+	if paramValue := r.URL.Query().Get("payerId"); paramValue != "" {
 
-	// ------------- Path parameter "provider" -------------
-	var provider GetApiAuthProviderCallbackParamsProvider
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "payerId"})
+		return
+	}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "provider", r.PathValue("provider"), &provider, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, true, "payerId", r.URL.Query(), &params.PayerId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "provider", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "payerId", Err: err})
 		return
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetApiAuthProviderCallbackParams
+	// ------------- Required query parameter "payeeId" -------------
 
-	// ------------- Optional query parameter "code" -------------
+	if paramValue := r.URL.Query().Get("payeeId"); paramValue != "" {
 
-	err = runtime.BindQueryParameter("form", true, false, "code", r.URL.Query(), &params.Code)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "payeeId"})
 		return
 	}
 
-	// ------------- Optional query parameter "state" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "state", r.URL.Query(), &params.State)
+	err = runtime.BindQueryParameter("form", true, true, "payeeId", r.URL.Query(), &params.PayeeId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "state", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "payeeId", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "error" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiLedger(w, r, params)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "error", r.URL.Query(), &params.Error)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "error", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "error_description" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "error_description", r.URL.Query(), &params.ErrorDescription)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "error_description", Err: err})
-		return
-	}
+// PostApiLedgerPayments operation middleware
+func (siw *ServerInterfaceWrapper) PostApiLedgerPayments(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetApiAuthProviderCallback(w, r, provider, params)
+		siw.Handler.PostApiLedgerPayments(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -854,22 +3779,17 @@ func (siw *ServerInterfaceWrapper) GetApiAuthProviderCallback(w http.ResponseWri
 	handler.ServeHTTP(w, r)
 }
 
-// GetApiAuthProviderLogin operation middleware
-func (siw *ServerInterfaceWrapper) GetApiAuthProviderLogin(w http.ResponseWriter, r *http.Request) {
+// PostApiPlaceholdersClaim operation middleware
+func (siw *ServerInterfaceWrapper) PostApiPlaceholdersClaim(w http.ResponseWriter, r *http.Request) {
 
-	var err error
+	ctx := r.Context()
 
-	// ------------- Path parameter "provider" -------------
-	var provider GetApiAuthProviderLoginParamsProvider
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "provider", r.PathValue("provider"), &provider, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "provider", Err: err})
-		return
-	}
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetApiAuthProviderLogin(w, r, provider)
+		siw.Handler.PostApiPlaceholdersClaim(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -879,11 +3799,17 @@ func (siw *ServerInterfaceWrapper) GetApiAuthProviderLogin(w http.ResponseWriter
 	handler.ServeHTTP(w, r)
 }
 
-// GetApiDemoUsers operation middleware
-func (siw *ServerInterfaceWrapper) GetApiDemoUsers(w http.ResponseWriter, r *http.Request) {
+// GetApiSeries operation middleware
+func (siw *ServerInterfaceWrapper) GetApiSeries(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetApiDemoUsers(w, r)
+		siw.Handler.GetApiSeries(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -893,22 +3819,17 @@ func (siw *ServerInterfaceWrapper) GetApiDemoUsers(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// PostApiDemoUsersUserIdImpersonate operation middleware
-func (siw *ServerInterfaceWrapper) PostApiDemoUsersUserIdImpersonate(w http.ResponseWriter, r *http.Request) {
+// PostApiSeries operation middleware
+func (siw *ServerInterfaceWrapper) PostApiSeries(w http.ResponseWriter, r *http.Request) {
 
-	var err error
+	ctx := r.Context()
 
-	// ------------- Path parameter "userId" -------------
-	var userId string
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "userId", r.PathValue("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "userId", Err: err})
-		return
-	}
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostApiDemoUsersUserIdImpersonate(w, r, userId)
+		siw.Handler.PostApiSeries(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -918,38 +3839,59 @@ func (siw *ServerInterfaceWrapper) PostApiDemoUsersUserIdImpersonate(w http.Resp
 	handler.ServeHTTP(w, r)
 }
 
-// GetApiGames operation middleware
-func (siw *ServerInterfaceWrapper) GetApiGames(w http.ResponseWriter, r *http.Request) {
+// GetApiSeriesId operation middleware
+func (siw *ServerInterfaceWrapper) GetApiSeriesId(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	r = r.WithContext(ctx)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetApiGamesParams
-
-	// ------------- Optional query parameter "page" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiSeriesId(w, r, id)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "pageSize" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "pageSize", r.URL.Query(), &params.PageSize)
+// PatchApiSeriesId operation middleware
+func (siw *ServerInterfaceWrapper) PatchApiSeriesId(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pageSize", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
 		return
 	}
 
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	r = r.WithContext(ctx)
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetApiGames(w, r, params)
+		siw.Handler.PatchApiSeriesId(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -959,8 +3901,8 @@ func (siw *ServerInterfaceWrapper) GetApiGames(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
-// PostApiGames operation middleware
-func (siw *ServerInterfaceWrapper) PostApiGames(w http.ResponseWriter, r *http.Request) {
+// GetApiUsersMeBalances operation middleware
+func (siw *ServerInterfaceWrapper) GetApiUsersMeBalances(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
@@ -969,7 +3911,7 @@ func (siw *ServerInterfaceWrapper) PostApiGames(w http.ResponseWriter, r *http.R
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostApiGames(w, r)
+		siw.Handler.GetApiUsersMeBalances(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -979,22 +3921,17 @@ func (siw *ServerInterfaceWrapper) PostApiGames(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// GetApiGamesId operation middleware
-func (siw *ServerInterfaceWrapper) GetApiGamesId(w http.ResponseWriter, r *http.Request) {
+// GetApiUsersMeNotificationPreferences operation middleware
+func (siw *ServerInterfaceWrapper) GetApiUsersMeNotificationPreferences(w http.ResponseWriter, r *http.Request) {
 
-	var err error
+	ctx := r.Context()
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
-	}
+	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetApiGamesId(w, r, id)
+		siw.Handler.GetApiUsersMeNotificationPreferences(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1004,20 +3941,29 @@ func (siw *ServerInterfaceWrapper) GetApiGamesId(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// PatchApiGamesId operation middleware
-func (siw *ServerInterfaceWrapper) PatchApiGamesId(w http.ResponseWriter, r *http.Request) {
+// PutApiUsersMeNotificationPreferences operation middleware
+func (siw *ServerInterfaceWrapper) PutApiUsersMeNotificationPreferences(w http.ResponseWriter, r *http.Request) {
 
-	var err error
+	ctx := r.Context()
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutApiUsersMeNotificationPreferences(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
+	handler.ServeHTTP(w, r)
+}
+
+// GetApiUsersMeOrganizerBalances operation middleware
+func (siw *ServerInterfaceWrapper) GetApiUsersMeOrganizerBalances(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -1025,7 +3971,7 @@ func (siw *ServerInterfaceWrapper) PatchApiGamesId(w http.ResponseWriter, r *htt
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchApiGamesId(w, r, id)
+		siw.Handler.GetApiUsersMeOrganizerBalances(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1035,20 +3981,29 @@ func (siw *ServerInterfaceWrapper) PatchApiGamesId(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// GetApiGamesIdParticipants operation middleware
-func (siw *ServerInterfaceWrapper) GetApiGamesIdParticipants(w http.ResponseWriter, r *http.Request) {
+// GetApiUsersMePayoutDetails operation middleware
+func (siw *ServerInterfaceWrapper) GetApiUsersMePayoutDetails(w http.ResponseWriter, r *http.Request) {
 
-	var err error
+	ctx := r.Context()
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiUsersMePayoutDetails(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
+	handler.ServeHTTP(w, r)
+}
+
+// PutApiUsersMePayoutDetails operation middleware
+func (siw *ServerInterfaceWrapper) PutApiUsersMePayoutDetails(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -1056,7 +4011,7 @@ func (siw *ServerInterfaceWrapper) GetApiGamesIdParticipants(w http.ResponseWrit
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetApiGamesIdParticipants(w, r, id)
+		siw.Handler.PutApiUsersMePayoutDetails(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1066,20 +4021,29 @@ func (siw *ServerInterfaceWrapper) GetApiGamesIdParticipants(w http.ResponseWrit
 	handler.ServeHTTP(w, r)
 }
 
-// PutApiGamesIdParticipants operation middleware
-func (siw *ServerInterfaceWrapper) PutApiGamesIdParticipants(w http.ResponseWriter, r *http.Request) {
+// GetApiWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) GetApiWebhooks(w http.ResponseWriter, r *http.Request) {
 
-	var err error
+	ctx := r.Context()
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiWebhooks(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
+	handler.ServeHTTP(w, r)
+}
+
+// PostApiWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) PostApiWebhooks(w http.ResponseWriter, r *http.Request) {
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
@@ -1087,7 +4051,7 @@ func (siw *ServerInterfaceWrapper) PutApiGamesIdParticipants(w http.ResponseWrit
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutApiGamesIdParticipants(w, r, id)
+		siw.Handler.PostApiWebhooks(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1097,13 +4061,13 @@ func (siw *ServerInterfaceWrapper) PutApiGamesIdParticipants(w http.ResponseWrit
 	handler.ServeHTTP(w, r)
 }
 
-// GetApiGamesIdReimbursements operation middleware
-func (siw *ServerInterfaceWrapper) GetApiGamesIdReimbursements(w http.ResponseWriter, r *http.Request) {
+// DeleteApiWebhooksId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiWebhooksId(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id string
+	var id int
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
@@ -1118,7 +4082,7 @@ func (siw *ServerInterfaceWrapper) GetApiGamesIdReimbursements(w http.ResponseWr
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetApiGamesIdReimbursements(w, r, id)
+		siw.Handler.DeleteApiWebhooksId(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1128,13 +4092,13 @@ func (siw *ServerInterfaceWrapper) GetApiGamesIdReimbursements(w http.ResponseWr
 	handler.ServeHTTP(w, r)
 }
 
-// PutApiGamesIdReimbursements operation middleware
-func (siw *ServerInterfaceWrapper) PutApiGamesIdReimbursements(w http.ResponseWriter, r *http.Request) {
+// GetApiWebhooksIdDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) GetApiWebhooksIdDeliveries(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id string
+	var id int
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
@@ -1149,7 +4113,7 @@ func (siw *ServerInterfaceWrapper) PutApiGamesIdReimbursements(w http.ResponseWr
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutApiGamesIdReimbursements(w, r, id)
+		siw.Handler.GetApiWebhooksIdDeliveries(w, r, id)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1159,13 +4123,13 @@ func (siw *ServerInterfaceWrapper) PutApiGamesIdReimbursements(w http.ResponseWr
 	handler.ServeHTTP(w, r)
 }
 
-// GetApiGamesIdReimbursementsParticipantId operation middleware
-func (siw *ServerInterfaceWrapper) GetApiGamesIdReimbursementsParticipantId(w http.ResponseWriter, r *http.Request) {
+// PostApiWebhooksIdDeliveriesDeliveryIdRedeliver operation middleware
+func (siw *ServerInterfaceWrapper) PostApiWebhooksIdDeliveriesDeliveryIdRedeliver(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// ------------- Path parameter "id" -------------
-	var id string
+	var id int
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
@@ -1173,12 +4137,12 @@ func (siw *ServerInterfaceWrapper) GetApiGamesIdReimbursementsParticipantId(w ht
 		return
 	}
 
-	// ------------- Path parameter "participant_id" -------------
-	var participantId string
+	// ------------- Path parameter "deliveryId" -------------
+	var deliveryId int
 
-	err = runtime.BindStyledParameterWithOptions("simple", "participant_id", r.PathValue("participant_id"), &participantId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "deliveryId", r.PathValue("deliveryId"), &deliveryId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "participant_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "deliveryId", Err: err})
 		return
 	}
 
@@ -1189,7 +4153,7 @@ func (siw *ServerInterfaceWrapper) GetApiGamesIdReimbursementsParticipantId(w ht
 	r = r.WithContext(ctx)
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetApiGamesIdReimbursementsParticipantId(w, r, id, participantId)
+		siw.Handler.PostApiWebhooksIdDeliveriesDeliveryIdRedeliver(w, r, id, deliveryId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1213,8 +4177,19 @@ func (siw *ServerInterfaceWrapper) GetPublicApiGamesId(w http.ResponseWriter, r
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPublicApiGamesIdParams
+
+	// ------------- Optional query parameter "inviteToken" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "inviteToken", r.URL.Query(), &params.InviteToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "inviteToken", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetPublicApiGamesId(w, r, id)
+		siw.Handler.GetPublicApiGamesId(w, r, id, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1352,13 +4327,65 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 	m.HandleFunc("POST "+options.BaseURL+"/api/demo/users/{userId}/impersonate", wrapper.PostApiDemoUsersUserIdImpersonate)
 	m.HandleFunc("GET "+options.BaseURL+"/api/games", wrapper.GetApiGames)
 	m.HandleFunc("POST "+options.BaseURL+"/api/games", wrapper.PostApiGames)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/games/{id}", wrapper.DeleteApiGamesId)
 	m.HandleFunc("GET "+options.BaseURL+"/api/games/{id}", wrapper.GetApiGamesId)
 	m.HandleFunc("PATCH "+options.BaseURL+"/api/games/{id}", wrapper.PatchApiGamesId)
+	m.HandleFunc("GET "+options.BaseURL+"/api/games/{id}/attendance", wrapper.GetApiGamesIdAttendance)
+	m.HandleFunc("POST "+options.BaseURL+"/api/games/{id}/cancel", wrapper.PostApiGamesIdCancel)
+	m.HandleFunc("POST "+options.BaseURL+"/api/games/{id}/check-in-code", wrapper.PostApiGamesIdCheckInCode)
+	m.HandleFunc("POST "+options.BaseURL+"/api/games/{id}/check-ins", wrapper.PostApiGamesIdCheckIns)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/games/{id}/check-ins/{userId}", wrapper.DeleteApiGamesIdCheckInsUserId)
+	m.HandleFunc("GET "+options.BaseURL+"/api/games/{id}/events", wrapper.GetApiGamesIdEvents)
+	m.HandleFunc("GET "+options.BaseURL+"/api/games/{id}/invite-tokens", wrapper.GetApiGamesIdInviteTokens)
+	m.HandleFunc("POST "+options.BaseURL+"/api/games/{id}/invite-tokens", wrapper.PostApiGamesIdInviteTokens)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/games/{id}/invite-tokens/{tokenId}", wrapper.DeleteApiGamesIdInviteTokensTokenId)
 	m.HandleFunc("GET "+options.BaseURL+"/api/games/{id}/participants", wrapper.GetApiGamesIdParticipants)
 	m.HandleFunc("PUT "+options.BaseURL+"/api/games/{id}/participants", wrapper.PutApiGamesIdParticipants)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/games/{id}/participants/order", wrapper.PutApiGamesIdParticipantsOrder)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/games/{id}/participants/{userId}", wrapper.DeleteApiGamesIdParticipantsUserId)
+	m.HandleFunc("POST "+options.BaseURL+"/api/games/{id}/placeholders", wrapper.PostApiGamesIdPlaceholders)
+	m.HandleFunc("POST "+options.BaseURL+"/api/games/{id}/placeholders/{userId}/claim-link", wrapper.PostApiGamesIdPlaceholdersUserIdClaimLink)
+	m.HandleFunc("GET "+options.BaseURL+"/api/games/{id}/reconfirmations", wrapper.GetApiGamesIdReconfirmations)
 	m.HandleFunc("GET "+options.BaseURL+"/api/games/{id}/reimbursements", wrapper.GetApiGamesIdReimbursements)
 	m.HandleFunc("PUT "+options.BaseURL+"/api/games/{id}/reimbursements", wrapper.PutApiGamesIdReimbursements)
+	m.HandleFunc("POST "+options.BaseURL+"/api/games/{id}/reimbursements/bulk", wrapper.PostApiGamesIdReimbursementsBulk)
+	m.HandleFunc("POST "+options.BaseURL+"/api/games/{id}/reimbursements/matches", wrapper.PostApiGamesIdReimbursementsMatches)
+	m.HandleFunc("POST "+options.BaseURL+"/api/games/{id}/reimbursements/statement", wrapper.PostApiGamesIdReimbursementsStatement)
 	m.HandleFunc("GET "+options.BaseURL+"/api/games/{id}/reimbursements/{participant_id}", wrapper.GetApiGamesIdReimbursementsParticipantId)
+	m.HandleFunc("GET "+options.BaseURL+"/api/games/{id}/roles", wrapper.GetApiGamesIdRoles)
+	m.HandleFunc("POST "+options.BaseURL+"/api/games/{id}/roles", wrapper.PostApiGamesIdRoles)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/games/{id}/roles/{userId}", wrapper.DeleteApiGamesIdRolesUserId)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/games/{id}/roles/{userId}", wrapper.PutApiGamesIdRolesUserId)
+	m.HandleFunc("POST "+options.BaseURL+"/api/games/{id}/waitlist-offer/accept", wrapper.PostApiGamesIdWaitlistOfferAccept)
+	m.HandleFunc("POST "+options.BaseURL+"/api/games/{id}/waitlist-offer/decline", wrapper.PostApiGamesIdWaitlistOfferDecline)
+	m.HandleFunc("GET "+options.BaseURL+"/api/groups", wrapper.GetApiGroups)
+	m.HandleFunc("POST "+options.BaseURL+"/api/groups", wrapper.PostApiGroups)
+	m.HandleFunc("POST "+options.BaseURL+"/api/groups/join", wrapper.PostApiGroupsJoin)
+	m.HandleFunc("GET "+options.BaseURL+"/api/groups/{id}", wrapper.GetApiGroupsId)
+	m.HandleFunc("PATCH "+options.BaseURL+"/api/groups/{id}", wrapper.PatchApiGroupsId)
+	m.HandleFunc("GET "+options.BaseURL+"/api/groups/{id}/attendance", wrapper.GetApiGroupsIdAttendance)
+	m.HandleFunc("POST "+options.BaseURL+"/api/groups/{id}/invite-link", wrapper.PostApiGroupsIdInviteLink)
+	m.HandleFunc("GET "+options.BaseURL+"/api/groups/{id}/members", wrapper.GetApiGroupsIdMembers)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/groups/{id}/members/{userId}", wrapper.DeleteApiGroupsIdMembersUserId)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/groups/{id}/members/{userId}", wrapper.PutApiGroupsIdMembersUserId)
+	m.HandleFunc("GET "+options.BaseURL+"/api/ledger", wrapper.GetApiLedger)
+	m.HandleFunc("POST "+options.BaseURL+"/api/ledger/payments", wrapper.PostApiLedgerPayments)
+	m.HandleFunc("POST "+options.BaseURL+"/api/placeholders/claim", wrapper.PostApiPlaceholdersClaim)
+	m.HandleFunc("GET "+options.BaseURL+"/api/series", wrapper.GetApiSeries)
+	m.HandleFunc("POST "+options.BaseURL+"/api/series", wrapper.PostApiSeries)
+	m.HandleFunc("GET "+options.BaseURL+"/api/series/{id}", wrapper.GetApiSeriesId)
+	m.HandleFunc("PATCH "+options.BaseURL+"/api/series/{id}", wrapper.PatchApiSeriesId)
+	m.HandleFunc("GET "+options.BaseURL+"/api/users/me/balances", wrapper.GetApiUsersMeBalances)
+	m.HandleFunc("GET "+options.BaseURL+"/api/users/me/notification-preferences", wrapper.GetApiUsersMeNotificationPreferences)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/users/me/notification-preferences", wrapper.PutApiUsersMeNotificationPreferences)
+	m.HandleFunc("GET "+options.BaseURL+"/api/users/me/organizer-balances", wrapper.GetApiUsersMeOrganizerBalances)
+	m.HandleFunc("GET "+options.BaseURL+"/api/users/me/payout-details", wrapper.GetApiUsersMePayoutDetails)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/users/me/payout-details", wrapper.PutApiUsersMePayoutDetails)
+	m.HandleFunc("GET "+options.BaseURL+"/api/webhooks", wrapper.GetApiWebhooks)
+	m.HandleFunc("POST "+options.BaseURL+"/api/webhooks", wrapper.PostApiWebhooks)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/webhooks/{id}", wrapper.DeleteApiWebhooksId)
+	m.HandleFunc("GET "+options.BaseURL+"/api/webhooks/{id}/deliveries", wrapper.GetApiWebhooksIdDeliveries)
+	m.HandleFunc("POST "+options.BaseURL+"/api/webhooks/{id}/deliveries/{deliveryId}/redeliver", wrapper.PostApiWebhooksIdDeliveriesDeliveryIdRedeliver)
 	m.HandleFunc("GET "+options.BaseURL+"/public/api/games/{id}", wrapper.GetPublicApiGamesId)
 
 	return m
@@ -1367,78 +4394,288 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xcbXPbOJL+KzjeVk1SJVt24pna9afzJJusUs7GZcebq0v5piCyJWEGBDgAaFtJ6b9v",
-	"NQBSBAnqxbYSe3a+zMQiiG40up9+QYNfk1TmhRQgjE6OvyY6nUFO7T9PSjM7B11IoQH/LpQsQBkG9inc",
-	"FkyBHgn8IwOdKlYYJkVynHyUv4EgdgDFn4hhORAmiIZUikwngwRuaV5wSI5f/nRwMEjMvIDkOGHCwBRU",
-	"shgkCiYK9MxO1aXwwf6DcuKHEWNJTqQicmwoE0xMiYAbQtMUtHaPka4npI1iYop0TJzAu08fiVREg9Z2",
-	"AfX0tDQzEIal1CANXY41/F6CMETh/7UJVpfA/N1s/DZlH9i70eWX0eE/2UiPxPmP6avRT6Pfiv/916t3",
-	"f9vf349xVmpQyNhfFEyS4+S/h8uNGvpdGl7imIUV1+8lU5Alx5/9kvwEV/XMcvwrpAZnfqWAGnhLczh3",
-	"PCMZyvmHSXL8eTVBfOkNA57pZDH4GpAVNIcIuavFIPm7UlJ1hWx/JjloTadA/MojkkCid2Yx1NrULj07",
-	"MRGtZTloQ/OC3MxAkCnNgdxQTfwrySCZSJVTkxwnGTWwh0od43ai5BcQMQqfcF4zAzc308QN3XhmlnXn",
-	"vBTs97KaMUPNnDBQgQ7Sn9PD2HRSTalgX0CNIvOOXhM5scyiGpGbmSTV+KxeQ5PM4YuXRz/GLLkox5zp",
-	"WVzoHZHUo8mza6bZmAMxkkgzA6Wfbyypssi23WZOtSH+vQ3ptOyO4XtNoQ4a6tbkabDKVlB9X4OhjHcx",
-	"d+oNYZ36B7t7Jwzxu7ucJIYjDUvrsBoIvb0Hr5d/VVrWVqdkJDIpFbmWnMN8TDknewT/y+EauCY3wFOZ",
-	"w38lgySnt6cgpmaWHB8eNLzJUh2y0jmi90yUJsJf8toPaHKDDiv3LwR6ftDQDibMT0dJTO1xiotCGn0K",
-	"k4ga/rPMx6CQnsZBhMPEIMWK+oDAbcrLDJ0M/nZDmeFMm4CVGCc5Eywv8+Q46la5TGl8T079EzQLBUsp",
-	"3DDOyRjIDHgWbNDhi5fkPWWCXJgBeS1vhJE3ImaMOb19az3jGagzTucQcQXv6S1yTaZ2IClAkcIOJc8O",
-	"EAAypumYw/MmBy+2Xn1Obx0Dup8DUW+MY0CTZ3mpDYqAGsIBYeLweagRqxg5jDEivCG3dALl3WcPF6XI",
-	"6Jy8l8oGN/+q7aJjAZZ4/XdkQ7Shyuj1cOzGbQy6RhrKzxRL4VUVTbbjQkM5KXAEqnpqRzUF+ePBJra1",
-	"6IGiU6bNyEDeBaMHdpxMf2iCa0eE6KysFBvBImTOkTJtn9TA6vab6c6GG1VCTXssJQcqvpcFP7C69kci",
-	"m/mqbxJTiJJzhJzWTjy0Fa0l8/1CGeH2tKntza1qsna1wiSbKeRmEfwZnTLhlLwbwTMDeQRbTpSic9RG",
-	"lIK2qRruQFoqhclZQad2LdXL6yKoGkuWYEORQldSdsb+UO6MKsNSVtQ2e9d8BFdTNCcjClKpsjvlKCik",
-	"aNRfA2HLsGPab8q1ogxWf+Fe2UqnV676DnrusuLNll7TFWatwXhx1NPXIu6zjHNg+bhUGnIQ5u/CqHlX",
-	"OWguS2E+3EDW41BP7AAibyAj47nzIw2uayc7IExUwaSPsKjIGhFmFV1C1nw/AK0Vka4re6wIcT3NLn+a",
-	"jFGcQd7fTB0bO7ChZ1CVXFe7hiYXKacs1+gSZvQaiEa8wDGquUV3xu9glnNIgV2v4W0ZG7Q4U/7t3XE3",
-	"AQUijfj6o710RhVNDXJFNexpEJoZdg2E8mJGRZmDYilR1RQY7WTIuY+t5oHIA7I6jGCPgvj1CLXAGFDI",
-	"xf9/Ptn7P7r35WDvb1dfjxZ/WWuWoQn3LHbQMbRap2PW2/BOHYu1bqYjvFcNJ1TlF88O98ZUQxZmEnEj",
-	"mMIF+wKrDMx6IZc1OUcX5IjdOW2o3hegi3Da5mxHL6LheFh9xKkd20mD+7go6+35xMzs0lc8H4eL3BzV",
-	"YCtQe9Ke807FpMA5rjOu7rI7Kw0GETd75bS7KRcGrQI2Cjs7tC+tiGwUCgKz+c/J0lU2FlAJ6Sq+BD9N",
-	"139zbn13EVkQoGZRQ6jLG1MqiAZDMqYgNXyOVuk5mkqnakKaX9y/ryK7d4ZJUBpWF5dyaQm4Tph8Amlm",
-	"hF5TZn2JL1hhAGFTG+L1pVuqvEf5655lrgdO+L9p/hvKMU66zsh+0GRSck5Em/47ORPktYwacsFSU6rI",
-	"tJfnpzYzbsxeKDlhHEj1TpPGzJhCHw+H/pf9VOZDek0NVfu/FtMmrpSKrfXVPTXxHZWrViS8zRQ3VOMG",
-	"LxFGuwpXROwI0SYrOVq9exq3nz81+A+kwVuUq6piXf3Kw+pzk5N40eK8la9Ild03Jgvi7p2ULV6vK1c0",
-	"ArTVE6xM+u+UXj6ifLJOINF5p1JMmMr/TCdXpJPbBty9mn7/kmyowbUt9Mo8hkMuDu2UJRtdIC0zr1Qk",
-	"YjEdcyEztOhaqZ5hxCoFnyOaYeRqJJlQrsFVqvA3dW07C0AQlhdSGZwks8GpJlQBSWdUTCEjcomPz5dx",
-	"L6rgVeyE5PFlblUaEc+LVu/UfVt0wh3dtDlFz2TJMzKGVObg+1T2yRlqsanUXpOJAvgChOU5ZIwa4PN9",
-	"MjI2VxmjMSxjHalIyoEqyPbvDC5beNGQfftiyufEn/50F1KFYsFKPs0wbPBJyaTE4GFA2I6Wt4g6Y6cG",
-	"LZdc60Nf+vahAfh2MNnz2ENoU+F/0C3AqhP1dlVre+eJ5u5phjQmUiXfya3tkwuHQ7gX+H+7Z/fYsVX4",
-	"3LegDRKHs8C1VTtoMXQGTBF5I1pCtfDS3rYHi1V2KreAy3hIet+6oOtgu0O8CbkvlLSSDG0TC/uU0CxT",
-	"oMOuTyT4P42coknQzbldd5/rHIhnfLbvLt6l8BpyubpBoWpJoCSDXFblsnpy669jLjae03m5PHw6V+pd",
-	"Z3LbBHq1Oj3QOXulEX7DujaAoQekpWJmfoFO3un/GP2NOikxrK3+elOx8O7Tx2Tgmrnt1tmnS3ZQWskC",
-	"J2ZiEtGRk7ORa6YuQEznqL+GGStn/ws5ORslg+QalHZvHO4f7B/YSkABghYsOU5e2p9swD2zHA9pwYa0",
-	"NLMhl1NZuoBT6ojEXyHA6HZBd9mJjUDP5VQTWZpakRNL3DXyoZ9KzqQ2JwVDEZ06gih9141gGXpxcNSl",
-	"fVHannHU4jkSsRFoaaOyo4NDHxYbcEeSBm7NsOCUiWXz/LoA0XVDW+m3rR0XK5Xtsd0jTFxTzmxwkTOt",
-	"bSegbe1uKoR1/01V+Hy1uBokusxzqua2K2haCSlaGzd0qlEVTwI5J1dIZLlhztynENmrczClErrZbsHn",
-	"PXX4YHveQrU776G7MwctSdOi4J654a9abiFvfybREfdJl8Unusdvwdxnc78WSl6zDNRimFLOxzT9rXe3",
-	"/0FFxsHt9gecllQv22jSHU8QOsHM3gLlVFFhNC4wA8FAk2r9jpV+pTjz876qWLJVHJqDsf2bnaj3Y4cj",
-	"xFR8ggBUlcOOk8bTJRS7SGW5t8uzFTnlEDlQ6UZtJ811kVRmGEWhaVTNGVAzhhmtdjBT8fh7CWq+ZBJf",
-	"T5oMrSWPmaYN5pyELHy/ujh/g0QNpF7YMVoY9W9JzN3csGtkk3BHyYQybv1hjBZYu9iK1j/KnIo9BTSz",
-	"p092BtIcsoLSL+G4fqpXO8Sf4B5VBBICh0OjoPTy4EUMeL21GYkZOnKauTMG5ySlIpW4Z0Az3/V82ts/",
-	"+qaaA6OuyDyYClshOy3TKwW6sFB6sEsoHXnsrFKkms+JkvkSBHaP6SG6VgZgEbyGaIdMFbwSEFkhmasx",
-	"b4vRXE4d2z3u2GmFXgb4RsbhOrRb37CxDo5PLfWtsdiH8Z07dEv73QlKt+16rSE1xOMWEBXSRvbkk5fN",
-	"Rf8orKneMF1AiulmW5NHghmGvsYtymqjbxFoet61ao0Z5xD1U68NLSnnywRV9ygpJk+X/vm9sHyjJuGq",
-	"37DVHNwR6ylDZJo02MeoQDG4hqyKASzyO5x6ucstRqtE9NmzjoJqIqRxp+aQIbhbHnN06lq6Zs0KpgjT",
-	"1RWcbL+lD3aFwe5UW+/S2ciGD7+6NtnFkOUFKC2Fb4+JZ4NVzukLrzGOB/jXzYylM3ue1M4aGUq94DQF",
-	"7yGXdP2tr7ACEs0jawW7tMyPGqyvgcNlhbQmYs+yghkiIFg3E/dD4LcMZPoSqSCAaSwqa8j0qWp3Y5db",
-	"OtKj5fb+wUaI5m4q9N8TAmbrdL7OjamhVM1WMTElrC+Bemu5WKOWZ7F+VOuhi2WPazy67nisDCa05Mb2",
-	"sK66+9aN7xvHclYgVRcreZbTW/Lix+crWLCdpXE2DuwpsOPjxY9rmNql1XTuwazwEk4CqxzEEyxA4dp8",
-	"BXfq1bKyHaemV4tBXx3QFuw1ofZjDvj2PvnYuNnlC/qE2hK2ohNjS4NMu5Pnxm2v8MSoFIZxwkx4O+ya",
-	"URc0u3OrCh72+xxCZWQ+AflZZvMH05ruNxoWYfEYHcFix2rrO0UjmoJPa+l3tfSb5n0ZNfSpGofb5oZ+",
-	"R4wj8CzDryxbrHIvFjnQHJAl7k1lPCfMaDJ6vcpd2EhjbVpn57MTRQIW9niClQ30dxXOHu1SlSx1DE8m",
-	"shTt/MoWc+tts5KOwCU16SySclrk0oQKArdM2xjBoeYHxMPW7SIqKqhz58pupG/+tuGFJkwpsKc8iKNS",
-	"pGAVCSYTSG27lv20z4xqUlCtIdsnbxSADVaqaL1qkhg0OyYGrZaJFr7i6r6zWj48pHd7eh4fpPvTzD8h",
-	"PYT0nScvb6QasywDQfYsMNSdTMvm3e8DS5s6ssuqv2hjJzYMbppukjA1X6hveNdlqkaPvYMzXHppeq7X",
-	"uDjS3x3CSLIabLOgquVQqgxUSFezqcAkrSDP7GWb5zbixME5vf2l+mYIZzmLBI6Btz0Lb9o+Kc+7UY0s",
-	"dr1vi5JZIPbHnxM9avO0MrUK1LrfHQktyhWJmFTeRfQVLn7QUXPrMdeI6y8fk4XsMgiItmB/h4gg/EJF",
-	"RO+i1y3/DBSeHghcgIka52buunXJYpN2oLBJ1Sia/oayAoFYrokAyNytjnHJeKRhV9tC4D45sTrmUxDM",
-	"Y2QkQLJ+2D523SfdDy2u8cfn7VskfzyP3PPlkQ2cciicle54pwDwM80aPdEtbaHKK8jysvLE9QN88d9H",
-	"0v+xWYWMFwCqD+Q2z0OeQizT2nnbVtCXe/TENJex6wk1SC1nbIZM+6S+XKGr024StP5bGOpp/t8nZ0Eq",
-	"Ee/oz06MTWNkaTwJ5CcgsiZuegRItqvIKXoX5htHTbErsusg8xHGTcFxolV95u9Y2o9FtfR62KPTZFLd",
-	"NfvPrtY0vugYitVlPqx11+pbYWyLmftVd2K3xTxQtu5NbxtODr82JvhlzRFHT3zpr9s6fnyemYbg3RNI",
-	"BlYwg1wDv3bJbjfM3CqIPGtdCvumODyIEQiv/9lWgz5y4Y48moOdO6Gv142nUUX65tjV1P+o0j8ttHoL",
-	"ZhU0rIcqd2F4+0NXW/WtvuySEibclTDMtOkYwznqz9hGk/gHagf+yoBufhYp/NKSfRkFM8dsvvuiu09v",
-	"uh8SiQKX+yrUH/MEuPPFq1hty+2U/977crse79FwEec4psnWZtR1fCdPZUo5yeAauCycP7djk0FSKu7v",
-	"CB4PhxzHzaQ2x389+OtBsrha/DsAAP//QYy3iDNmAAA=",
+	"H4sIAAAAAAAC/+x9a3MbN7bgX8Fyb5WT2hYlO07ujKq2ahXbySjlh65kj+fuOOuA7EMSoybQA6BFMyn/",
+	"9y0cAN1AN5ps6m1HXxKZ3Q0cAOeF8/xjNBXLUnDgWo0O/xip6QKWFP88KstifQpsOamkgiVw/Yrq6QLU",
+	"Kfy7AqXNO6UUJUjNAL9Y2ufmT6ZhiX/8h4TZ6HD0P/ebefbdJPvdwUefs9GSfjq2X39/cJCNloy7fz7O",
+	"RnpdwuhwRKWk69Hnz9lIwr8rJiEfHf6znv7X+j0x+RdMtRn0SGvgOeVTOIWpkHkX+OkCpueQH/MjXFkO",
+	"aipZqZngo8PR+wVwohdASio1m7KSck3cF4TxUTaaCbmkenQ4yqmGPc2WMKrBUFoyPjdgFFTDMwNFUUA+",
+	"eKJcirKEnIhKEzrTIPGNqR2Hmi9JDjQvGIfBkATjbzumdwqk+URpqquth9rs9Jl9v31M4cz1oJvP7Kye",
+	"Od6t5g0iZoRGm0Y1oURpKjXkZE6XcPiB7xGKX0B+aLZwHRyheQifSpjq+iGVQOaC8TmhPCc5y/kjd+iE",
+	"cbIGnZHVghWAp2FmIEwRWXHO+NwMx8VHtRArN9oKNg43gZmQwVAIpRnFoMxHe9ZupB3QITXVBz7KRsCr",
+	"pTkMvx/mJ7f6UTZykI+yUTD76NcEGh1VenEKqhRcQZem4FPJJKhj3j26t+IcOMEXLMgGUc1GKJgKnisE",
+	"iC7LAkaH3/1wcFDPzbiGuUVICTMJaoFDdWd4g3/QgrjXiMYpZ0ISMdGUmWMiHFaETqeglH2sUrSi0xP8",
+	"8v4tEZIoUAoXUA9PK70ArtmUajOHqibKsEyuibSsM1rdCNa/LCY/T9kb9svxu9+PH79mx+qYn34/fXb8",
+	"w/F5+Y+/P/vlr+PxOAVZpUAOI98WEdoluQFSxPdjVZxH/Dng+vE2vGB64XCwKg3PUYYYzT/n7AJ4SJQq",
+	"Mxu2pPL8qCjeVFppynOzlKwjSTqvdOZ9ReW5wnlkCCfODhcg1+HMZMIMy8XjqWmMC3MiU2AXkBt6JlTV",
+	"/252eyJEAZRbhLMPN3Ju0QDdhgx5QD3jiulFYjMyksOMVoU2KEm4WA3m6W77B8vfzhm/wwESQrgjd4fg",
+	"Sx9XmFFWQN7dwdfVcgLSHF9r2/SCajIVVYF8bOLxLB+lmYIye5fgBxyIfUhKkG4MwtyhydxObfEJUR2R",
+	"1bzae6Lx1yGik39XUCGElzuJUwR09Lm99f6Qd9m+/t1qMYXmRXdEzW4OZBEIdFcMSClkimLWXfLdfM5J",
+	"/eUYN6PzhqwVvcE6qNMNB+s6nR3YrvIc55uVnr4hO7u353fnMLGJK1ofu9EhGL+gBcs/BnB8ZO7D4Ddy",
+	"/JwwZfaeEvyAGPFAjp+bMcJvudAfZ6Libgh8y38YjuflAF1CpHU055qGbJSNktMlVZA+LtZBww7KxBt6",
+	"/NzDGy5BC88p4h2eCZlCS9lCpwHiQs4pZ7+DbASDUdqmgs+YXELePd0xOQMEjFdFYf4/LYDKcZ+YMG/R",
+	"SQGjQy0r6MC8BVX7FpTCXXuz+ZkuofeKKIEqwft5gVejp/6SlBGjiXKzzNbRxErUW6MCi0pqMhHi3HDq",
+	"GZjdWUhRzRcjlGgvgc/1orlX+n8/3rYpDurkmo1WfcyfiRx67pTNw3jJ5tdYZuDrZplLBcUFKMI4Kgmj",
+	"bfCF82wAsvdUpmkAzVd7jBPzOKTlDNWoLuhm1yPoBzHueNJ3luOkSLG5rJHJmlDekE5yg7q7UFC2PCno",
+	"FBaiyEH2bkePvm9QDB954KZmQFIwfr71hOyQv/ZBBXkAVxcgs+nHeRoi86zhwlysmj3TPYfQgs2NngRO",
+	"AtXQomhaFG9mo8N/bpaN5qOfGBS5Gn3OOguSoir7mTAl+EKzrAkUgs+NQpxFbEIbLip4sSYXTLFJAZ5P",
+	"LMEoQ81NxAy3dSM4Xabo59dmI8wwvWgTraT1z9Hz5l9bgMosHF39zqx526cS5lVBpfqJydRd7bm9WZBS",
+	"MiGZXjcar/3MK7RmfxWZ4qLz+kczaUYUBGaKmTlhP4oyvyDw6cuTneNvQPMzTaV+xXilQfVDuQCaWxPO",
+	"zcFZi0zG9Q9PRygX2NKoKQdb9eUefPHYMoTVzCs0B2xQ5u0blhk2Axrkn5hDt7fnTUD34dNzpsqCrnEj",
+	"anbbzBBJ118oBzKTYklWQp7H0vTxrtJ0y7adgWSgduY39rOA47RZnMMqhUrNtJIS+HQzub+HyUKI837L",
+	"xyc61cWaCI4baPkoam8KgTnOybJSeJNRoDtGDrgArt+uyx1u7A6iF/7L0eeNBvJsu+RYLYQCgqAotHgq",
+	"QLtsh7P4JaXHsk/daEiVj4YMWsmiO97f3r49IUIS8/8z8u70pdOMnXrhxo3wc6F1qQ73990v46lY7osS",
+	"+Hy9DGm8kmyrEDAgZeHZ9GNq7o5jOJL6D7oSUcFUQgLHzvB3otic+/XnULAL3O+MMP1IWQEoQVeSG7OS",
+	"v1ms7GSoTlt4ty7eQZEmihfpazz+TJagFJ0DcQMnzvpnx4WuQX0Ibd3Pveej6x1A2zhuB5rMzf4Zm3mg",
+	"yZq9oWhij+znmd1SBbrZTXx9QZX3KliDtSF2ekVXTPj16ZD7kbnd1xekDSNuuXl2x8qaP71opc4U8y/B",
+	"DHIJSQqY6eFrs4iXguMtW4LSdFnaPW6gqXF12BQzKX4Hvn2lTBH76uCRt2ipta7RzBHqqWxGKF+PCSqN",
+	"bjuphF3U1YbBzU8PkvoeS12hOPt35RedA9dsxlrynP44fZwarhBag1w/l3S1eUdzSVdWU3efePooS+A7",
+	"HJ77+gxSFk3zq9+S9IQZ0qjgU7Cc0LzFiRKtyynlREIpRV7hiym1r6s11bfLzRiA95PVQtS30bxGh3DL",
+	"Hz/57un3qWnKalIwtRhCq0yR+m3yTYBCQi9Aqm8H73q/LG/WZd8hesFUQ5tz4CBR1zbKoMfxCLU+/ffv",
+	"f+13TuzECQqqdGADHra2FWW6YEqflUKrlzBLzNco18q8hByNCCc03eeZV7wZnxZVDvmYvBY6Fgn+XXMw",
+	"FS/YkrUAvcSdAo2fIeqFPDTcxWzTddVIz6OiEFMUKq+S5p09MmNS6Y9TsYSP9k8F8gLyw5h6ND23Fym7",
+	"WZHLA/3RVjSgr9qSZmsACXOmNEhScc0K90/r9X1WCAXqSGf2Jk+JpDwXS0vtOUxZbpVKs8ON77zjsPEH",
+	"MSZvI4vqOUCJVycm6yvvmJyEwBngcVxUFlKwIdO27pzA3W45TbjOcWTi7tnbUc3ykrZsPLc6qOHGDIoZ",
+	"4Q6X7ZHEdmiLb8oyyh63yvArSyfy5vOWMB4/QUrv/hnvcZqyIm0k2waM+T7i7Zdynjve3gzSB6pTYDug",
+	"0g5pbgO6Rcyfs5FxaL9swonwmUpKEHTPR3hhKKrR9FD9VZoVBRErcOSiFqirOLOAZFOwfI+LicjXRJuL",
+	"hXuzFDpp8Elp6b02n7+JFVlSviZL+0YnIAaVbtUV7BZwo+Cjcm/Q2/yfGa0VCbur3o/JAcmZMk4R5W5U",
+	"FrpxJLGfPj3YkZdnu9sAW2rC6JjnQkhyIYoC1hNaFGSPmP8WcAGFiSAoDE/5Hx0TzEGCVPPKcrJ+Q5t7",
+	"Ib4ScX8Gsf5yMEhrMkPsKnoDY15G4JORt/6u6zl7BMrux4Ky/MRg8TMf6RjDhc+c6dm8+0ghgAa0KWDg",
+	"io8cKUF+NHYzkB/xzY8SyacsmCYoOmAeKUTfHewOrqf1Lpwv3RNDjCF1rAwRTIAsoMjD2UePn3xHXlHG",
+	"yZnOyHOx4lqskn6ZJf30M2o8JyBPcH2pkJtPBmqvGpUgid0K8s0B0cIT1bchBE92Xv2SfrIAqH4IeI1H",
+	"FgBFvvGmNqpJAUZzfPxtjMCbAHncA8h7r02y32E7Nrd0SG8UVDbUh5IZ+wR5/bijYobg/rDztg3wGrSZ",
+	"zVnFc7omr4REHejvNdPZ1cKbjVBE1Kizncq2UhdqegOIjcV86vtLkJsE521/z/SC8eEyKhJGC3qBlzE3",
+	"FurGj6SXrFOxbNRMvXDyDI1IGfH0njlJKyTJW6y5ufghvU8XlM8hzwIx6UQxBqGCoQBzZzkgHC5A+l9U",
+	"8OLVhV1KW95whw1fT9gOpjhCreDjFcDaE9SYnDr1CxEl/EyNB98Mt/jGvKYU+ZkiM48SUhOKXikxQ5Vj",
+	"j3HVMhEyRcQFSBP0OZVsYs4oVq9rfABlb2Bj8saYhOgFZRiogWucN1aj8RXdaR20bZCwa1ZA61R7C5hW",
+	"DiCjcZkrU0eFanx1Q5ZzNbxD+j/zsnaA9nwWfWDDu6RW2y0u9r3BGKaFpsUmNeOtecERuWd+0Xak2Vd3",
+	"C9D4wwqmB63/783bgYVk6N3jffh+8P2b2QxkL9bhRirQmWf2MwmBj9aTihkDcm8H5fBJ1/IR8pDBZnhr",
+	"QSFvArZLVPFXyLCtkSpCcSdOcHiyFBdghXMwi+BgkLiUYinMF+lZFcmZBOPlG2/XhreYeZJXxGN+wTTU",
+	"Ieyt6/5g67lZFsOhXIjKZSzpG4zJ0diRUXkAsob7mVAK2jdT52oIdFu9sEccQjE0tNZnr7Q9oxIuxPnl",
+	"99Z9nhks5IIYsz9IUoBWaBFWRAHKcbOYUPkaGMmd8oue4ZXchBuRKZVy7e9IrV3Z7OFD+6L1cYZ2xeiI",
+	"+swZL5nSxtWcQNSv2IV1HV6gW3DdMPUmNGr16zZBdgrkPna3paPgupzhP5w9iiANlJHheW2J2WNLFG5Z",
+	"J88pNFBY9YVpdUW/613csq/5ktYZf0fD5q24n7YEIF+XNrZ1mtvxQKX4rYs1C0k0PKoQtE28N0ypGRZF",
+	"cULnjFsk70ZR1OKzFTZhJGRjuvA3cxsxpUlJ5zBU9kZCY5vd347Y78+q9QRPs1fRlcpwMGJdDpfSmfoC",
+	"rI5r7t0i7BT2D0g1iVbv00x2wemNq76Up9Uw72FLj1N+t4Qg2bXVw2eb4pNtZHKQofCCa7lOOFyWouL6",
+	"zQrynovZEb5g/B+5CS5H4RdAHViqrLHOcGJnvaM8D4zWPdeIYYryDtGgbfiicNDuyJdLPG9L5joqqhP/",
+	"H6RXuute6CiqDQ6RUL/JXPU6bWWHxZpAfMy5REueAq67uTeXFj5XSQxqQVbnCd0YdDOwwbEd4J7uTRdU",
+	"0qlGtU3BngKumGYXQGhRLiivliDZlEg/hNHw8HbvtNk4KTeaVsVG56eRyfmpwQKtQRoo/t8/j/b+L937",
+	"/WDvr7/+8fTzf4x2SGoa9S4263CJmiB7WY8o4KqSSIrCxdaYbOnhxkw39Tbxa0B87bzel84Wxw/dnJv2",
+	"4nVSuzVPrL3XXja4cbLRJbhojRWvYw6CSz/mwFFOcmGTufUCU6PhE5p+lpTTeScdV9lIMEq0BKoqaW82",
+	"tCwF4xry8Qf+TOzVZKXsKBDPyVo+5qwZrP6AadWaOI79wCWNstFUfIxSlPxAvaEfZ23DZjtiBy6AH5KW",
+	"mdEs0rpEzONiTSagVwDcbhxyY2uDRgnmop2MU4BKwwGZk3hgrdGt4B9rjsEE0NoXcxiN3HJiZWQqzL8T",
+	"DqF4lMCjc5hI2C/pOjlIhsfUcuii4AE6XTiNg0kHlpmyPoOP8AmWpT4kBTu3YeQZmVS6xW1zAZjKWtK1",
+	"V36ZNGiK640OGizFNmuK/hEsMFT3HRQbkEBolQ5vuRnH+o7W9y88wC7exz5u9vfIvt4mQ7wGTw+NZUfw",
+	"2kDpbHPOyGx9WpSjIdCgLFoCWRitWt+mkTAku0BSsOZDDpgIHBn3MrT7scaKrmqLYGamjWZKJl5bwEfZ",
+	"yM3Wi4TvWz6C9g443087VtCsF5VEBKGujiPBZoEkMdMsHh3jh8QY0QRpud1b7LjLbignEyBl1UExM3KN",
+	"NglIabEyTKberua7YMv8Sg3aGShH2ageM719GLx9Ra3AWhQvcyG9tizETfZJhK7HQNkbqo6Y/C5l2X5p",
+	"6KYArW2EJxJVg8Q2nS9OctGC0HzJ0vV6hudPBvnjFShjZttsXntIr7zJ9MqBWq2Z/xUmTKD+vbMVpKGt",
+	"6zXvIfB94dpJQdOspMsvrLdi4JJQsQ79Zg6/r/Eu0d11zUDu8NlbZs/4Wm4hbvas2aYtG+yvagOuJuaj",
+	"MTlC9hLdEcyD+pLgUnbGYTE184nBcXzULxyC/UiFSFnWoZ39jLqZYpoM+ZiJjnF0a6TaHEIGZPR8H6kS",
+	"wupIfZSNzNOPjCfBtd5pf73rzWKGpYvIbmUGmp/DPJnM2oDQiW+NLGzOLWepo/aEvYvXmGvHvpYbcAuf",
+	"/Mi919pfBOObU/6tTEuH49uKJE3lDPvusHIRwbgpwF5CPgf5Iy3SWQIT+6AvBsXAJSFnGqMljM+dooYt",
+	"whpkHOYU7TqrlK1MrECRpeCw9upueNEdYOm0APRA+N4A1J4SL6ragoqVhmxEvNMFrBjCmoxBXM0AQIJF",
+	"7wJNHS6vopJyrlYZlWBAVKA1uqR3A+mqDLKzpHi7sxg/+vFroy29Z7Nee7Qxu5LDhBmVvRSK1T9aUAZu",
+	"BR3oE8SR8TaksgZjLYKugqp+fRBsFI+byenUFvwk7q0guA5w/wZHzW9M0xcztzyUPx27adIt9XqQr3nn",
+	"obnQqWGFhpYhJbz1l3S97KkBMJACmWqIrqnjiJAPjUJCELaV/lq3Den9I9pfNhNqQEq+YEPFa961ZcF2",
+	"ByPIiL0S5sOyWFsMAh8jVWURGe/GEt66ZbdtAngWh1acJAWG9Q7ZJGxnlKKWSdgCc7jAQ0L9n85D2Vw9",
+	"CgTCfGW3wHwWIexhgxQumcNguKmxCXlY3dOjZR1fhrV063l7pwsUKI95DcZEkCTVKbuJxntr33mQ3FeQ",
+	"3MC1ZOn7rRE71oNgWX1GRJGD0tbCPTR6IZSCiQDCe6g5lHQNMDjyx+f47KxnlN7UjdPtqnA0J5fiM/Zu",
+	"tCkHltbPTqmGvjBJMYu21pdYQG3AV7omeDxUu3LfOK7ZdCx0hAlNj5tMWU9NtpK3OaRwGMrX3QhPUU2K",
+	"QKOwOUtWrbFTbfT0d+554TLqEbY4+/vSQofO04rB2159vgsNF2cLsboCDCvqk7+1CAuam8KQpCqTk15V",
+	"hw7218Of2tcUBr8WxihqAwhPZOhLb12aubUrbwzWNOB4qeXCPsyEZq94ME/SIjhEPQmBtfpJWmXwwG5b",
+	"cJ9u4E3rH128fVg51oTn24ISsdPHySefSecS/YzMN/jysfaeHHoh0raEGcSJfCz4XV02ly1LITU2WMCU",
+	"chWoDO2hXL5VR+H46DWKw3bMRl2/NS5eZQZ91HIfR3pFZ6dcBFSzXv9DY7hMg5RUQYKIwESB3HmqrEAQ",
+	"+OfzLr95vDehCvI4wzItkuawLXkSpbHNJrXBhVGqb3dM9H/3JdfweNhwtKdPtmvIOLQFexRAn8L7IHHB",
+	"JA6+Uykz7h2FJZrsuRO8dqfcQP5Jqsoq4xvr7ZQ92RpxZQwipCcZX34jWplifArWBMgFh2uPjoNbCI5L",
+	"hKe3WNU2cRkE1NV1OJzrlM7pDm1lMM3phW22sRF+jMxr5VyFa3INO1L1z2xcZpCztUPepWOGLj0Ebak7",
+	"bLTBf6rOLcQbs2snMKWVgjRnb5zyPnc2tft+fMwSMfi5c+6Ot6xvlOst9KTe9RYrQcnI4UDCf9Ghw5dS",
+	"z6Lo4M0Beqll9yefWbaEb/lt76aqjK38t+WMjMxv1NI4lxlvFSuK111bKQgvuDV7bEob1SixRscNbYKM",
+	"BIdBEf6dVdZdPP7oKBV4RA39NktJ6Aq/pvewqa7fiqAuCrTMlYkdrW+3dcIPkp1PrQy0H9xQVLr1R/t3",
+	"WomxpjyutKymPVcck/acCGjGuK5VfesTK4ju4TYo0RpI5gKU55JNSGFUCF3YRCQbxBiwERd0iFmmHzgm",
+	"RJdSXLAcbU9TSJQlzFI17sPAaqYD23YAj3TTmy31wnYtqpr52eDEqwbEQzIefpjkngCHGZsyKtfbjdJ0",
+	"OsWpXaFjsymScjXDv5P2eTbtjvjj8TM/4ITy8wHDQDn9L3lC14WgiUvZi5NnBz/8de/xE/JhdPbi5Mgb",
+	"4vygH0bkv06tv6+0Y2SklDCzx1dHiNFgWxknUEnRlDyQsGRaoxeBcbutplrRB/6C48BMWw+1n8gghVkc",
+	"Rs2UJeKqmlI+JjHC4eyUk+Mfj147FFJiCRhSiwJ9hcGxqU1hE5rQH81AA3Z0OVnR9clCpIqz4s9eWTdw",
+	"A8d4t1c/kvdH/11bYtMDl3Rd0uJVOpjohK5PaDFeunC8zYeQrCJshx8vYf9flEMuYP/xk/H3B2lh74/s",
+	"uDmxPkugICvJmp5DeTcYy29oUOX++HmAH2EHktq0kBEl2rdPygm2Q7RF3Jolzh8fTcjR48mTrS7objB8",
+	"eq1p0Wv4jy3UlghlvQI7yIiHsTlOg43dauXPBcQJBf95cFneEUOSEeE7yxkCRGbgz001FQmAvHhxFIH1",
+	"/MW7t89f/PTTjnTW2gctGjzIiCrp1NWVlYCGlNaUf/nrd/958PTg4OnTg++/e3Lw+LuDg4NrodWYUF2y",
+	"MyeMa5CcNhu0pDEG/q/vvn/8V6yG+sN//mUTcf+N8ryATfS9wDey2oHj3GSOdi3lf4omd/Q8rF/ICaBJ",
+	"+zS6vqRa2mBpnSH3rpTq7z7Pm7RnYbTG+tLUtUBbvuDD1N33OIXa4ZJyY7ewm78E+ECwYA1JLtQ0MziJ",
+	"M8haJhqTXpUUJW+4hZ4UUYAqkUALp8qabxOtEzaXnL/62muYkwvHKO+4VmZzm2hRU1xXyqoKdekeRCrb",
+	"0aAuV9XRJb/q4g9Xy7q4YtnCay4RcavFB2IcSU9dp8M/UmRmmpnx9vy/iAV3orwrKNhUVzIxrGkZoZuC",
+	"3Gb0UooZK4D4b7Y1j6AXVFM5/lc537V/RG93kxuqOXVN6Tg7ZN809vRrKHXN27V02zQXbFxiV7vUUSYY",
+	"muGoeWW4gnuaZmQP5PYVkdsOhU18WZfQw3bFXIAQn0NI0uUtbGVsG2/ibFu9Iccbwy+d1aYOc9pkptlc",
+	"+3RDlB+dBMq2gTUjMJ6PiXHHM6solpTl8e3r+2SFYowkGd6M0/ib3NApL8P2nPpuROhluy2nQmKwWkV4",
+	"Pim8PK17T51WqevNe4BzzHTyrxFZmUuOAd+AYvYltwVaPGNEBq2cldBm0oqp/7zD5YDnSSn0WgRNSppG",
+	"D96HxZRnmpcrMYAXwwtamPU5zMV9R/zrk1cr83Kdz9wsSpFvHpP/7dZqXmo7ojcjdzPQM3NYl9kLrDYY",
+	"ANQiqJ7tCGDoVwd+smnYQtkCS80kcWuO4VUp2RJ+ry/2btNH794+G7U3/vjo9ZFFMvN+XbvB3E0qHUKi",
+	"0OZkt+gcoHR2ZxPXV9gB6FQKpQymFmy+0ERRDIGwvre4edeLyuDn/kumJiJphDQHnNN1KtTP5G/6JhAA",
+	"5w4kbFmBFzIiBhcKfG9n2dJSreuPsqcYQJmm+sB098pY5q6pP7HtuNbt/tztY78jq+wfMiN6XbIpLYp1",
+	"oyyiocy03oWcMD0mz2OuOr4sWw23ZOvGntYtv69UkaNlZr3+2lDPt9WEugwqpMdJOso2OxS7n1yuoM49",
+	"qqAztLX2QwGdS1TL20gzV0+xbXckd1TVu+dpLiFkbAZUvQq2rTvWE35//BzlTbdcSR0JUN8qXKmaCBEz",
+	"bxzisLItjELp1JXcm0SPhzO1XtsM9QrNU6/CP30v0OutQOxG7bmD93Udu2wTNxUrWtvbuO1GLsEWXVMO",
+	"etwyrL/f7fD89F9rPOprYxTNcrhDP09/JXYlCn7EHgaJ85nZAuKNAt4o5Y1JR4vo7p4oBt70SFjbO1Nm",
+	"S5aumILaheG2hVDsIjjTqmXM2q7RB7u8ZTNad8Ck96nOEnrpmpvekBmguw6rxW2UrKG2V/utKWoLGVky",
+	"pbAUYhg6aAWDd1opur6xgibeF5saK90o1uxw9LVdiQ22eHb2d2JsWRjrEwYXK5vg6d46evV2fPD9d81a",
+	"t2fjuYyJOBEvhOzXTWhRXyIGcvgInbos3kLxiqmlH3dDBekWeuVshr7vOoQ/DrDoxi3ebvTRVcpHblHn",
+	"TtMRGWQmKp4n4jxurlJh6/x62HmEPKdQCplQgHCEVGKdQZ36ut0QtX3fhxm1VEEuAkRZw+DkuxaeJ/Lv",
+	"Ku4mTnSJQjNfUCeTajIVVZE7Z+AmiAf3FfDTtylrs9bmNzeEP0XnPcNfG73L2lG7+yKdk7e9NDdkGvU2",
+	"jpRIGMIMFxMFal5lgvsMm+C9WppMBdc0bCxy/NwG6jqCwXGQJIeNUX9oEyBCzhOEY4b1y2ghgebrVjZQ",
+	"TAlilo6psJ8mkpTNwHlVFhiAfEgoJ0BlwUCSIhBYDR2a0SLMrosx9WcbdbcZ2U5n40bZqL3GUTaqoUtG",
+	"6dpI4U7p7t6LV30vT2qC7b1bmOuF/yIjtFCivivXwTO+V0AnH8Anp4R54N/U1esmYINpBZnRQoEXQdjC",
+	"1XWU7w5JJaSG/bbZbS0r+DUlD28ss4XF3WVamxr2NKlld1hT0FO3LwPqixRaPfxai5n7oPV0vH+SQ9bo",
+	"FWDUMOYYXUbaaDi0n7xaGIlick7EElwMt2kurGyYDN74FHY9+h0IWy4hZ1RDsR6TY+2LFkpo7jHCRBMB",
+	"lZCPL22G2sHpGYOPH06bxvTdhbih45W8xwqPjm3OKl1JwI44N7K87i3p16wTsFX4UrbmdJGKDTq7ey4G",
+	"9+Nt74IWDH+ytWA7LdzcbRDdHJS0GqkagjwgWMyi6VX5gZu2LyvymGBQ3wx5EeNKu3Zxxk3ik0B9L4UL",
+	"WlRgorV7EgKQGYXSivKcePzETbYMxwRvxxSxqVrXlQtn9RbMcgAEVdeuBMM1lZz7vBnSXhivrZLm8HqU",
+	"DwUnb7XgZD9mtNxKNYb0hVG+CVwN+DLZc0wnViDbqd+kTmi7Bl+gFn7OeI6ZkKM7cqiMyZlVpAxvN/9H",
+	"GXAFCbDJM9C3oAFxYyeRU8WfYJNRZcp/x5ua27Le7VvVNXnJbnTfIih7rmuIR9b8u7N6tcWbQMuyWL8V",
+	"P6G68DNqkmFkAqrc2Qbzkw1/9/5lp8eg/j8BYgZnTXJz23SMpgCTd45WSMOKHA67wjtWuCb43ef0Nl0x",
+	"59/6HS7hKOmpuPnOVpfAp4TmuQQVx1qYCf9PEHk3qNLmBq8MLqDHJ4O+kuR46jksxYDCJ5gZncMSb3bR",
+	"4A5JulKJqSDsf+gUQey+2TVrdKQ84He2dJnrTF0XDOM+K2cQaGk14J26oXjMSt10KOYuXq8a06/J3+WR",
+	"1eFS++BT2ul7mCyEOL9qeMjKDuNCYuoE6sGUewFcmzI5cQ+2zZFJOOML/2XKGrq1gqQNFMLZnQetpx7j",
+	"Bmr3S9+1Aax1d/aB552hCCCy6UdD4Ez2SDW4HxfcsSPtGlYctEkNDix0nm7AsOdQMBMgkK5dtiw3m3pm",
+	"lJn7cv3q0Kp8DQoP9ashlLt9hJuxWSPFV7JGMcd/kyVFs1o9KVlalyya3KYDK8DUR3EZirH72quXOcjW",
+	"9cYTpW2BFxf1KYT1KptxKrlD/OUGgqonbSgqsxqhu/v8Y+9NCXy+Xu55pMJb1FDCM8z2hZQiIQnxZ39m",
+	"5r0W4iXdp1Rpa65Ll7z2DSpbhTRwdDds4x5mM8IFkf6TdNBisBYOn/SRHWSjct3sqRlQS1YfIe0ucWCO",
+	"jUUni/e7Fl1FVtKM0NBQiM9xWEZN+xuYzIuQFur+Ls3F4qPNeIt7O390yW/hT+06Y41sxn8GNrzeSmS1",
+	"1oz/cmq1/6evoxcXKbPujhgU9VHaOC37oBHnHzHdEX+ty6M1VUzinz7a7vCpJzlMC7cnrlrLRyzO4iEN",
+	"cxLxhxwK6Gvz4sOFg91fCpO9M8pG2jYTwXPPuf9bLyrp/pxJZv9QVFfS/Ym5P4m5UIBOK8n0+szwN59E",
+	"TyXIo0ovmn/95FHzl/dvzYj49ujQPW1Q1eh9o8+f0UEwSyjiRyfHqPEKy3vMh0yjxuh+IUcnx6NsdAFS",
+	"2S8ejw/GB2ZbzAu0ZKPD0Xf4kzlgvUCI92nJ9k1Rnf1CzEVlLW4iZdZ6Zi67ql2EhwnunBXmwlaIORa+",
+	"rVX5EU5uDbXHua1ypo9KZrbopZ0wG3l2gwA9OXjanfusmk5BKaOPr80kc9es8nM2enrw2PmptKveq+GT",
+	"3i8LytBCaKXPNtlk+THuflsmmMUKyX6HnOyZfgHGSE2ErFkmrj5CCLyLh6jwz1+NVVxVyyU1CsjIrryn",
+	"npE5WDpXBnePon0e/WomaQ7MXlzmoFM8X1eSq9CuXazTc8XH8zP403kF3ZM5aO003ustcPv/cj7jYfvt",
+	"0qg7233UBfELPeOfQV/lcP9whWLk530T1D+h0/Pe07ZFGexpvzHD+ioztqilrejkxK0BgswlxcaMkuTA",
+	"GSji1+/rlvYhxYkb95kHCUUFXYIGqRIW2LcdiEbZyJwZMiCfmXc4Cp42UtpazZqzbcpRiXkBSZ78RwKd",
+	"6nXZ+jx1tyofwuSmJoITZdmMh/HfFch1A6T5fBQCtHV6DKsg9Q4h+352dvqTmVTD1G12ai4MINhtMqs7",
+	"4hrZLD5Rp2L1zAXmw93m+lu1pHxPAs2xIgGO0Aqp6p3pY/xe/6y/3iD/MYjhFeMUS4gEDk0ype8OnqQY",
+	"r6M2E6YgEVIf94FCUkjit9teGXBlL8W0p0bRT34Mc4dOjEMYJ7jJFsvUxg39jKz04CZZ6bHjnd5cX8OJ",
+	"oQw1md88T4+5qyeAz59DFm05k2evBHiO9eEuw6MLMWe8l0F7rFCNidOZwjvsOqZbV/F2Gzt+ibPvzIud",
+	"QdLaUeOV3iSXbtP1VkIKtscuILlJg+jJmWGHb/29oKb6wFQJUzZjHUw+5kwzqv2iEBt9IGVwalvROoel",
+	"2K+U28aNqiUtisYLoHqQ1JiB37nnV+Llw6I/FciuGba7rS9dReAGfGeTML5TFXB+y6e+u8kjfouB+9Pz",
+	"PRQUVNnCUs4HxriFcWmEOtaTY6pmU4SpuuPruIUPuMLodPzRmxNJHvj+HzZ36fM+W5YgleCuomj6Nujv",
+	"nC6oKAUx5lWtFmy6wKy69q0RLUFoT3ASspnXxW/EbqbkPbJGsHcI/HEA+hZ22NhG60kwoy8aIcEE7S5t",
+	"ZIG3qcj0XaQiBSZYVB7s6ZeK3cEpt3CkB8vn3p+9laM1rUm6Sp8ByTkfsadPUFSXz81qhMQAWEqkwAC8",
+	"rC4SWZWuGHZcCCdur4HzGoN8IfDK2cNTf3Zhnhux+yTVFwAFfdn0Gkgr6R3BF9aE2FTC4XPW7z+xq/Xd",
+	"BMg3S/qJPPn+2w0gYIX/NBgHmFJr4Xjy/RagbpL4zFEYVrvpJuGFjd2BTXLmC7RjmbU5l3YdfexI0KKp",
+	"icfoMSeiad1QC4dVUOW5FexJkKAw+Q+piSkbgOlCYjv9sly9Z4YMpKG2C0YdIWIolucy4z654onM3WN+",
+	"FPn62rDGrjyMkf78+XNbnny+YbR1RQgTmGKe1rvfxdJbvT7mVNMvlTjsMQf4nSCOSEDt/8Hyz5ZQCkgV",
+	"enqOv6umHU7dva1BdCxp35YymAVRF2F08c8xuS1YngO3OfSrBUggk0qTcygxUpEsmNJCrl0Ra6Q4LG9u",
+	"Iw33avKz3Y7tApry7R0qsyvxdHacD7m7uiSitFbGrqqRJZwOP9uQtgLugBDCo6lP8z5Rwo2rjj8JObE4",
+	"uYdaY4NzGPUVIl1UuAUhe3qTkCFeGJBsEtZOTMEifi3RethC1quoovJgOIA5i8LhyGSNmc/Hz8fkJMwF",
+	"QrpvS0t73/VJlqJotQ+yOmv0kkUBFiQmjTepprdPzdnVkqgUQJxDFTQfRU1etDol9WitYV7XXV0HB4j2",
+	"TSrordNN7C6r0fn4eZIqynQavI2LVpiM+YkpvI5ZhXKQtHI6YS2tiCu/7BoxMCkBfeqGgLBNiCE2mM1g",
+	"iiWCNMOq54qUVCmTzvSTyS4zH3vbiA/VyMLcq6yVfNVSQ81K71g+Xr/m280OvH+ar4u0edB8H+T9Ncj7",
+	"dz7daPAFYJ9GbXa3hnc0r/sKX8lSWHF7Xd8pyVRXai4GhQEVmwTCFEsGBhYs10RHNk2Q8C3i/AwDWa1t",
+	"IRpBnbVaLznr4BYVI+hFfOdXh+tlT8HKUm7N8LT75fjt3E18y19zYmQN+k/Nr6LMlC+HQb3sUqR1vXgr",
+	"/XDGZRnJhgBCfI6pPan+FsSWKsEO0jzVdi2zpZRtWkyT+jEVldRY98oc/wxMat5Cimq+GJNnrc4TQVnc",
+	"cOTQxJGh4SPS933HCpvXTShfY5y8Z43uns4f+UokA5mh3a+AG9emS+C52myaPM7t0r4SxdAu5p6bRGtc",
+	"ugeqYdbGvMgCaDsgEhvk/aBE3kcl0kz419uyIPpyRjUK72jJxs86fHugVDBq4h7je1OXnTLEHVR3Uoy4",
+	"tBZO6dQLWCoojCWMca/RXgCvXEn+UsIFE5Wy4ygtSkVWQhoBMZA3S3Bp5jjEVl5soDrmz2yA6FejjobL",
+	"SmDYM3eyPq7W7Nh99Wo+qIPDSP20wfvodFEl3J3oVT/B27LwdQ/iqPrCAmtMVqVt4hxqomPyJiBUfwOl",
+	"PL7s2tBu5qLdj59nmO8YdH5HCkTxaGoqdS61MX+py7Z1tqQeajfNzZKV+lp0N7ucO1LcmguxRagUQZyE",
+	"zaZr28Y9U+Pal+kHHW4LJ0UDepcsmSIrKfj8thhtrKRclu8iEbXYIOM7Mts6hHNTEMEpdqRV8c61S2qm",
+	"r9qmq4+EkIQma4MeprPLUF8Lz0U9rdoeGOB55TsfdXm3jsW3sUnikfIS5gZjRRORCbXm5doLP6hd90Xt",
+	"uh5u8M5QyUYCHcYZXJmNPjfCmZZAl4qcYQnVvTPgmmAGu2rVXnQNosbkBZ0uyBKUonMgUyolgyjJ1Ba+",
+	"JZK6ej6UE4qVyA8/8N/Cu9xv5BsMzzcLC8Tze6YXhtS/zchv2BbzN/LNz77z5LfIU34zELmfrcXm2/EH",
+	"/kwsl1FxElKCZCJ3HZGoIgugUk+AamUDn2KImbJf4bqngnOYagzyrXgBCtf4gf/2kiq9hzu0d/z8N1f0",
+	"AdVVtwsFA67DG7fVYXOqwfby3+DSeOFrotxx7MTzqEIEolDQZUpBrV+TiRQr5WvvSmi2zUNWV8VwsEX7",
+	"d8UYCaRdhG5PIR7HJNwesBsgjx/5yFzn9lW3qAdGm+Fw6b7x7nt7T3WnFx3dIJZog3T2cI1qkIM1DHxS",
+	"sQLKYQVKkxmTSmfNPTEyWhkXq+tzjS+AYaF2lOUuXtMIjC3e0eMmEkl9aQapQZleZp3BIockfR1Hx3j/",
+	"4/AfLFY7OjA7dEr7wyuH2KBZFEQY9BZAKpVwIc5BxeZmwUFlzvTURBvaolWNAfqkzR68suDYhNJ0Hdbe",
+	"HWqyFtqHsW2O1YytUfeLVzy+Vo9exCI2s4QNGQ8P/OCLsmCnyWBXC3akJ+z/gf/falmxLIHyaOYM40Ub",
+	"dtD2R90UO0BotqkNbXtLyA3e2jXfC6NLdJR9E+ka4P7ZtheKG2SBiViH3eoH1nGfrDDUBmdHeHNpnxhS",
+	"Uoush7GR8CowKAG5E5Zk9q4u+xDES1ktw3UBz+OAJVfq0Ro67N+YUulfxnRg3w8Hi/vF8yo259YB9w22",
+	"UP22jnVa0k8fbcFlRQq2ZHrLTSRkbl/lTSRhvNqlBEW07V/GpeR+3wQQgcoY6xL6f7VB/RfSmxR6CgE8",
+	"Us0MAbn1kGtCAa/uE4XcZJpHssfYHYT2RXBsdBGH5/mQCvIFGgZBJ4lzd3G9j4LRLCLJLc7AlfPC1xKd",
+	"X1S3/XjmyuHkMGW5ra6OGWbcyVbmbH1G2rpnTkj7orlWpDfKFS1WdI2SHZS1Q+LHy0pZ59MEcMTBMcmu",
+	"1m8r7tqGt3SbYe3A2t64vupfA3/b0Lj+BnjbTaohJ7H64eo83xXDw+nrsI4Y1R7CmL/4XDhHNh0+eQnW",
+	"vEugSxxUU2eDW37M+LSocnO2QWVzNZhZmhkuzSvbtpeQGB/iXfobgfWFvNxaQY4HjnTfONL1hN1YjnGZ",
+	"QJuQefSHPB/leZsddbtWZeScmwS4OoaZ4wK56eyK5NhMReYClNcvgdetF/9dQQW2h2o7MjqhkiJvs5UK",
+	"XEcHTASWBgJMyiss0bukOvyyLqItuG3QgZbtgWyT5nnEbXfSLyPf1Um47V9JNDUaIYKF7aRZXp/7LIAg",
+	"4L5JHbLTp+1eBFY/aJFfpxZ5lOet5oBX4tZN2VtkfnuGj21KWnGWeowQiJif7QdKmdUugymyJj4gFQ0w",
+	"LFUYuYIbH0fZhTVaZfKZ+filWd6fUas8uAPO+Kw+rofogi9AgQxYymWzOMKSjw2xNo1K/QTD+JQE18QZ",
+	"0XRYtGJkgKTq3JY4c+MQ7TrtK82KgriaxDYtji1LITXlmuQYVR2FOroI8NxWjHGpSn5QIUkB9CKo4bIG",
+	"3YprqJditEq0q7tWkKxVW806N5vm1cp2XDYEv2ONmeUW1+Rpa3e/Su8kcGPciJc6yDBoPyQtFHyIm/z6",
+	"4iY70cqWOSyQokWDAUN5VtBQcBjLij4hWtIpVnIBrjG9hAPklotNKlYk2tkrrCk+JkeIkK5MX7EOb7mP",
+	"FNESqKqkYxv4QisfuLl2krcNj5kiH8W3miHshVVw/IiWWEt6a82q03hrvta47GiZL7iW6yEMJ96cuytu",
+	"9SNtLpJ7bTyr66nSC8qwtz+Kdos1rgL6n5UVCi+bGyqZWquWzZ8Kejt8CZyxdfJWg+uPJ095iF3tvx72",
+	"1owYcuAxeeu3T/nOPeHz49xFnwdjnrrkrCPX4PkSjCtW1lwJCCbNUM1c+VFjr7SgmXVEwG1xCN8DDnhT",
+	"0S6n8YncSaRLC4a+cgjRa/cw1qVtzbfdorVwzroWPez30AKZMShy9ae/ZDe039pWG62GXZiCDfzCEqVT",
+	"LDaIxruEKycaS+1PqmKDPfAVlecqpZH6ewC7AN6+kwfZtL4ENI6xxH8vcUhbwDVeV3gf50J/4H4cLBcS",
+	"jDsmpxW3pbF8nXgtKVcUO45mXa0fa/DYGoeTpgIylWZZpfDNmuwyVVWYVfD8Azc/CL0A6dKu8d7gvr5O",
+	"dfwD32LsjOXKj+bIvg7ZYpZyHyRLAo7+jkctVd4hRNbUVvIUKmY2G9YiVR0+m0BNURV5jJ23fhVgA7xM",
+	"BsQ/uaPp67kGvGvaAiTli5iRZcu9ri4lYpZUTxegbkrKYJ2eqjjPiF6Xrh6GDTfFaUkpRSkU5DY0ipIJ",
+	"xRw1qnGS8Qf+yr1IJRBkIZDb4IKmoVYNipmoPuDrkDQfuE89uYeSxu3MVyJsjsqyWEcLdOv7AoWO0aIg",
+	"D8ngQf48yJ8vSv4Yru+YdN4xRDZ4fSmRU7P3TaEONFdeHMAnw5yxoe+zs7+bMz4+e0OeHBw8eUKeHb16",
+	"Oz74/jvyj1cvbTSZkygYmmYIMSW6GPoPNWU8Wo0RN4LDuv6hqariOGidihgNSCTMQEJdvT6uo4cZ2MHy",
+	"DMf3O0vnBgadgtEcXHjHGn/gr4X2fYFqvTbGNhPrAStfGMKJVi9rDexWhOILS7u6kzdnb8kwJaHeHwVh",
+	"MLOLHTRgsTkX8k6l4lmNXPdVLn5aFpurRWWWDUzVxdaqUrcnD+t9RbF8ivpS0nebwjpzrAXjkJBojhJu",
+	"XaJ5SWwJOIRIAs0fZNsXJtuy0dPH39/soaiqdJeEJeSMmgsNkL0GieztwEmoUC7tKnn1dNG5CUWSQpTA",
+	"W+LiUoL4j0BQfXTtX3d0mLuuT9bc6VKPp7FPqUcUREb2puS1kElBsZN3+yQ00X9pAYfxmdybwMNLuXcc",
+	"djzE7SSdIyEF9KD9l+UQ+Rn0JvawuzdEFDAsiscFc87iMmnmH7aZbPOOSdibW7uP7a+qhQvt6/SmDese",
+	"xQ3kELBtLAmB/2rjbEQBgyJrzC48MIA2A+jg15cSr4eYf6n6hj8zmw/boSwtCEXiywjLgWtbvMRV+YUl",
+	"tclZa18XqK/n47QpkYSUjHWZ2+G5S8rpHGIuwbRqZdHqJgrGfcB0y1OtBtxL74wBXL911lYc84R/R1lh",
+	"Dd9J8xnningomHJvkivuJJUCtevbbnaGk/raiQuaYnS7cVxLcY4zWh5pBtxBcRpYp6CpJIsAI2vHOS0z",
+	"zUPTXRibHJR5NI5I28wf3WROkzIv4IXPkCXWovdRfdurESDvvEdlCFzhrdsuPYBcrb/A443XHMCTfqQs",
+	"ZtSOUwfQA5O7KyZXM7faTIkEa9hOoFoyfalKk11OsEMRO9uqYzA3Sahwrs2t+Vo1DcA9ImJIcISM9v2t",
+	"sb9/Dn5yk9X0dlb8Dm5P8XNI8KD6PXDF6+aKlqUN44oJTcyXztsTsxnIfTqdQrnB732Ez5Ur5Ck0wc8a",
+	"ttktB2r73zBNVtS4TMH5hNcEq8nUPloPhx+o8CVgbTndbQn9793nbww4Fsi7N20lFCaEj9hdfqhku5Eg",
+	"Spdkixh2S7cknMvEc5gjr6FCRGcIVCH4HGST4bZjdQ48917iuTTV5jA13ut+sn1uX9iFbh2VtnLXvb7j",
+	"t6Q1DIdPuiblsO421ztRsIP3HpOw2/KHSmsPfOPm+YYjh0szDimqcpifyr7aJ8qZIpQsYTlBBarPvWRn",
+	"uxVHj5lqiJfHwvQFuHku5fNwl7W53/gaD+wPQ1o64bdZ37lPwJZQZrouo5wvWb9/ocGAmyoBh1PclZnf",
+	"Yl0Plm0oZvSnvutdqlIQomUKo2PWtm+aAfVrP78IFrI3vCLVvjhbmvEVMrWmH6ENZyLnAN4sbC5X480Y",
+	"b+a5Iaw3Q++O8we3hfO+GdMDyt+qNvRaOIxeUBfh6JB6KvIdVYxfEOkHE9wfW6LxUMzWgsUZUpeOyKaU",
+	"EwXQUOR4oyox0CSKG3FPwlSuQEoPbW7cRvRo+cxmHng9dPcAsA1IbtBMTxd9xUqsDOH+Hhy84gJOAlxH",
+	"Bcl19W9SJXuw/cTMeuf4fmPG+fsrtx562NybKnFIMLVZ3l/svlhOY1F/R4m6T7UGnlM+ha239YVYETHT",
+	"wG2WpoWR2AEgry12KtpSm96Cb2SmUCUu0HToJ0YtRgfnFIrCFTnPiBZkAUXpuZntzINK8hy0IqVkwuxG",
+	"l+fVkajNirYJ+aNm7V+auB9krLBXjGCVA+wWzdsPIap/Bq7xsks2fnFeeRazXZmKa9a7uXZ3srt3XZ63",
+	"3t0sbumd6tjbZgUSXMW06Ma9+SbtW+0OLsj9xSv/x8GO2x17IPOvlsxPUyRxCcq2cw+z7Hf5R+JWXott",
+	"9+M2me3MZl+nwMZFvnLHu11Wu714uMDfioC8vDh0X+7YJc1+FTRIsxR0FMq5JfZH4p6kfLRxSGAYb4w+",
+	"dRuqXFDvTDGPzb4EDve0saAJRY6JcIfoweskxWT4oF3yXTVEsxtyl73Qth7rg0C/M4EuPEVeuRGaHabH",
+	"kFglS5Wdh8yk2QdZ/2hDiimRMK8K6vp/5FKUe4x3deswGBk/1Axbhpk/nSvf9cBFTxZFPFSaCA52mN6w",
+	"5D8rb7lRI6jdzbs0hXp1pk99uU8W0VptCLjpytRBIRMgOSyF/pPneHzhfLQTuSyRfVmVrp+3ep2ugHwO",
+	"ctDdRy2oBNWtabACVZs36kTcRypqWUC+yWHCtPq2SfYo6drVU+E5KUHumRdb6bcfeDRoUynrm6mE3I4n",
+	"ihyUdhE1dSUvWXFusGpCCzSbDqlP4iGr50tl/9qr20u7bVtY+fHzRM92z2n/XYFchwVB1jvz2qx/wnoN",
+	"G6aDe9TxzG5oU9YrQTD2lfvStsNzMCc/H5pybCaluIoUEMd3bp7NnsTlVxqArlD9pFkAmYBeAXBCO8sP",
+	"ezYFHNhxjjYH3vfscFO1xKmQuSJLLM7XDTJMsElXbjcALbNVWvia8MpFoLrQLOTTrv+vhaU+RctsP/AC",
+	"Zq4gIFBZMJD+TUUUaF1AGw8eKc+eRaWVpjam2AkS5NhZKFqQkklJmS3X2y2w+oELHoP73rjimCIImtJG",
+	"SafKAVxLJQyodx+c0HWr0Y6tXuN3q9OqSqwgRuUPnFqANxSHsKfs57qhcDaLENFUdxTMOYB7OwDr3c5c",
+	"g2cVlHjO21S1la3cixK5fyqr5Em6gNStJAiEqJALwHqNHfI0zA0LqO5qjzB4iczSImqXd27i4lG/X2zL",
+	"2c/IbY2cCLeb8KdgoE1pRcYSYY4dy9qYT3wNCtuY3Ve86Slga9m8ayVsEKcxq07QwJwTkQw2cOwt7PyL",
+	"HWhvKkzdjH3ZRuUH1wsGhP2OtzUp913uH0Kibpc7HTdT+zItiM0Bqt9FqZiGzkFdvlgMouHgJr8KJBtQ",
+	"V48WWHiL2NdrNlqX5uoyn54r8Zmd7zZ8im6qAe7Ely4B2q3u605dUv4EPEq4fRqSuiTNpOaeXyPCzGvM",
+	"iFioLs+Bm3NvGmhM6PTc2Mu4Lf5Yla7ds5ia0YBPm8g5Cf4nIqsCMlIp157ZzfgI5yM5zGhV6P5c9QDP",
+	"biovyk5xR7q0R+4uEtknD6lR15gaxWG1gWxiVjo8XcM1RLOfGUbKtKkCE1bCiewiLo2DSffJeCOLHeZC",
+	"cpN/AdFcWxH+IRbE78QVbFY0QMfj5yls35q3QTmBT0zpRkqMSV0LSqS4vG/6MIOpM8DY2GnT+NtWdgIe",
+	"SBXs6d8aMRIK9pqCcGGPqUnTLcq/Xg+WmE5pipXd0TwnoSn3359Mcsckd1OO1EtIuFsk+IeEkvtT6Kl1",
+	"L5D3ntnVOSPb5TpWsNxfwr7zEm6/La0W1G5LIr8fHaGYRhLYoqZSOI9H5A7VC1g/kkAmDPNEZkImHKMt",
+	"q3Ntp55U2qSdGPZFi0IYEGzpUDO2ayKEMR7oKKirOJO5sGyV6aD2vI0PsD/aol62f7eB0HfvJoJDvy/U",
+	"hLWoV/Cj38PbuAFa+5ubcshF0EPXo0xkZomkBNlG9C//Vuhx2/rG6+WpTdbMmjC4MIW67VntlbX9cAih",
+	"AIZtIj2YwzF4GI5GmCLA6cShfw9Rjcnr4Bt7C/VfVbzw3kRLf1gMTFR6vBlRwxFPgiXdBt6m5x6CwOGX",
+	"JDiJr8ym4d2rDnl5z6oD5I0QpD9q8AVijRFkJGfK/t00NjW7r9ooqjYgZuY+Qf3S6ZKuXJfBUXRHVnxL",
+	"2dIBCHk5BfDacfF6lcO7IpUHvfLK9Bl0LL48iXbETC2V9nbTxFC6dILRevSzPl1stRAKmqiKNZmKooCp",
+	"zghVrVZoQvrfUFX6wNO6UtaKnCBN4IQ183gVb6tS9cZvzFeiXQVn9cXrV7Vi1Y3xU00gYquqXL+qVdK1",
+	"qPReDpqyYpCCJaG3WizCoIWt4+5cwI2e1dxBan1wTF5cgFwTOz02L3VbVKO4HVoBdlbdomOd4GKeu7Xc",
+	"oC0hnigddyLMfcm+8XVrS2W01gDr8FT6daNTQJeij0mINswhd0oD8q+EOlApxQXLnRY0LQzs29SfLq5c",
+	"v9UrgSa3Z+/aFUfvVfYAjOdjV+gNnx7/ePQa5SC3fxpGZ4CnZAIcZmzKqFxjeZ+vR8XZSleema9gshDi",
+	"fLjX3X9AJMyZ0iAv4Xh/7ye9DdXATbaL7z1Y49fsfV81x+DRoz6Zfg/8qTt3RSh5d/rSRbo2nnGjiEJO",
+	"4MKstLHlodVOzMzvco2/2GfeLdNquWHU0WnUJi2o3F9nCNWP/VM7WuYScy2g9TrHH/iLizqSVmG8sCK/",
+	"nL15TRi3/cYdJ1GZ7+pWa0UKphK0CwGF3Ja3DwiCsNrPfWiTQH77x96bEvh8vdw7Y3NOdSXhN7IAmtts",
+	"aDwJXtIcLaRUwQ9PK1mQv706erZ39rejJ9//4Fc1EfnahEEXhVhZcvvt8LdaYw/mecuWoDRdln6e8Qf+",
+	"E2XG9pRDwS5wd3D1FrPd+tBNZnGT0QJjJMRstiFYOCLgm4ppcJPcUVCDhSGvuUeXRt2jkBE+XNPvQZ+P",
+	"Nk+Yu/5rqo7BuqWGb+pKXiHPZwn1LCbNp9uSvA766CtuYAsJqGZcQk2ZcssKmFaeVaxJIeZZXVe9xUDM",
+	"qhSk6vvXhQo8kMN80R6YqzmjGdeAaTrDSgZ4GrZ79VBjq79jzqpRo26YfPyZXJJuLPpdimr2GxwfVltH",
+	"KEwPAa5D8kC9xg2cEQ6rOttzvEUhPs6fNxDcC5q5OYXcrXQ9RDFvduWhHt7XRKv1jaSHei5Hu/t/uL/X",
+	"x6bnKLh/9ae3nAHPjTz0n7kq8I3ubwR6SdeFoHlWO4qZq9UGOaYymj0Yk2OTQUi1hmWZjjqmiighuPl/",
+	"KWx693ibmh2yhef10k7rhd0Bp0iW6Kj3r2+W5lyuypeeXJui32FHvexnTcw3eVU89Bi9a35jfOL+UC6t",
+	"X7sRAkXYj9nPd8pqUrBpqy/XgBDrgi2xzaj9njA+E3JpPZB0Yq2RtvXocXBpYcq+rxZh9qkqhXbpw8YE",
+	"gCGiRDP3sdmNNejUh3VHPlYUZALNK2NyItkF1d7P4vV73NXAXmox0NUuROxLKjQnuMamxdeddzY9DiBu",
+	"SrlhRjSWYMyIH5xoQRQAKaP9aHbAFyyJ0iB7ClbYfXprJh3dVaS6PQpzDtaYn7Tl4zsO4wK83KRp3WKz",
+	"L0vVkQOpTEOcSi0z34K8SCPeSzGlBcnhAgpRYr6pfXeUjSpZjA5HC63Lw/19E7FYLITSh385+MvB6POv",
+	"n///AE7gOs2NygEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
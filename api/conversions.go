@@ -2,9 +2,12 @@ package api
 
 import (
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/dmateusp/opengym/db"
 	"github.com/dmateusp/opengym/ptr"
+	"github.com/dmateusp/opengym/recurrence"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
@@ -28,6 +31,12 @@ func (game *Game) FromDb(dbGame db.Game) {
 		game.FrozenAt = &t
 	}
 
+	if dbGame.CancelledAt.Valid {
+		t := dbGame.CancelledAt.Time
+		game.CancelledAt = &t
+		game.CancellationReason = &dbGame.CancellationReason.String
+	}
+
 	game.TotalPriceCents = ptr.Ptr(dbGame.TotalPriceCents)
 
 	if dbGame.Location.Valid {
@@ -44,6 +53,57 @@ func (game *Game) FromDb(dbGame db.Game) {
 	game.MaxPlayers = ptr.Ptr(dbGame.MaxPlayers)
 	game.MaxGuestsPerPlayer = ptr.Ptr(dbGame.MaxGuestsPerPlayer)
 	game.GameSpotsLeft = ptr.Ptr(dbGame.GameSpotsLeft)
+	game.WaitlistOfferMinutes = ptr.Ptr(dbGame.WaitlistOfferMinutes)
+	game.AllocationMode = ptr.Ptr(GameAllocationMode(dbGame.AllocationMode))
+	game.RegularsHeadStartMinutes = ptr.Ptr(dbGame.RegularsHeadStartMinutes)
+	game.RegularsFirst = ptr.Ptr(dbGame.RegularsFirst)
+	game.WaitlistMode = ptr.Ptr(GameWaitlistMode(dbGame.WaitlistMode))
+	game.MaxWaitlistSize = ptr.Ptr(dbGame.MaxWaitlistSize)
+	game.ReconfirmWithinMinutes = ptr.Ptr(dbGame.ReconfirmWithinMinutes)
+	game.CancellationDeadlineMinutes = ptr.Ptr(dbGame.CancellationDeadlineMinutes)
+	game.BillLateCancellations = ptr.Ptr(dbGame.BillLateCancellations)
+	game.SplitStrategy = ptr.Ptr(GameSplitStrategy(dbGame.SplitStrategy))
+	game.PricePerPlayerCents = ptr.Ptr(dbGame.PricePerPlayerCents)
+	game.GuestPriceCents = ptr.Ptr(dbGame.GuestPriceCents)
+
+	if dbGame.CancellationDeadlineMinutes > 0 && dbGame.StartsAt.Valid {
+		t := dbGame.StartsAt.Time.Add(-time.Duration(dbGame.CancellationDeadlineMinutes) * time.Minute)
+		game.CancellationDeadline = &t
+	}
+
+	if dbGame.WaitlistMode != string(Unlimited) {
+		game.WaitlistSpotsLeft = ptr.Ptr(dbGame.WaitlistSpotsLeft)
+	}
+
+	if dbGame.RegistrationClosesAt.Valid {
+		t := dbGame.RegistrationClosesAt.Time
+		game.RegistrationClosesAt = &t
+	}
+
+	if dbGame.LotterySeed.Valid {
+		game.LotterySeed = ptr.Ptr(dbGame.LotterySeed.Int64)
+	}
+
+	if dbGame.LotteryDrawnAt.Valid {
+		t := dbGame.LotteryDrawnAt.Time
+		game.LotteryDrawnAt = &t
+	}
+
+	if dbGame.SeriesID.Valid {
+		seriesID := dbGame.SeriesID.String
+		game.SeriesId = &seriesID
+	}
+
+	if dbGame.GroupID.Valid {
+		groupID := dbGame.GroupID.String
+		game.GroupId = &groupID
+	}
+
+	if dbGame.IsPrivate {
+		game.Visibility = ptr.Ptr(Private)
+	} else {
+		game.Visibility = ptr.Ptr(Public)
+	}
 
 	game.CreatedAt = dbGame.CreatedAt
 	game.UpdatedAt = dbGame.UpdatedAt
@@ -58,6 +118,7 @@ func (user *User) FromDb(dbUser db.User) {
 	user.Id = strconv.FormatInt(dbUser.ID, 10)
 	user.Email = openapi_types.Email(dbUser.Email)
 	user.IsDemo = dbUser.IsDemo
+	user.IsPlaceholder = dbUser.IsPlaceholder
 
 	if dbUser.Name.Valid {
 		name := dbUser.Name.String
@@ -75,3 +136,170 @@ func (user *User) FromDb(dbUser db.User) {
 	tUpdated := dbUser.UpdatedAt
 	user.UpdatedAt = &tUpdated
 }
+
+var weekdaysToTime = map[Weekday]time.Weekday{
+	Monday:    time.Monday,
+	Tuesday:   time.Tuesday,
+	Wednesday: time.Wednesday,
+	Thursday:  time.Thursday,
+	Friday:    time.Friday,
+	Saturday:  time.Saturday,
+	Sunday:    time.Sunday,
+}
+
+func (weekday Weekday) ToTime() (time.Weekday, bool) {
+	t, ok := weekdaysToTime[weekday]
+	return t, ok
+}
+
+func WeekdayFromTime(t time.Weekday) Weekday {
+	for weekday, candidate := range weekdaysToTime {
+		if candidate == t {
+			return weekday
+		}
+	}
+	return ""
+}
+
+func (series *Series) FromDb(dbSeries db.GameSeries) {
+	series.Id = dbSeries.ID
+	series.OrganizerId = int(dbSeries.OrganizerID)
+
+	series.GameDefaults.Name = ptr.Ptr(dbSeries.Name)
+	if dbSeries.Description.Valid {
+		desc := dbSeries.Description.String
+		series.GameDefaults.Description = &desc
+	}
+	series.GameDefaults.TotalPriceCents = ptr.Ptr(dbSeries.TotalPriceCents)
+	if dbSeries.Location.Valid {
+		loc := dbSeries.Location.String
+		series.GameDefaults.Location = &loc
+	}
+	series.GameDefaults.DurationMinutes = ptr.Ptr(dbSeries.DurationMinutes)
+	series.GameDefaults.MaxPlayers = ptr.Ptr(dbSeries.MaxPlayers)
+	series.GameDefaults.MaxGuestsPerPlayer = ptr.Ptr(dbSeries.MaxGuestsPerPlayer)
+
+	series.Recurrence.StartsAt = dbSeries.StartsAt
+	series.Recurrence.Timezone = ptr.Ptr(dbSeries.Timezone)
+	series.Recurrence.IntervalWeeks = ptr.Ptr(int(dbSeries.IntervalWeeks))
+	// weekdays are validated before being stored
+	weekdays, _ := recurrence.ParseWeekdays(dbSeries.Weekdays)
+	series.Recurrence.Weekdays = make([]Weekday, 0, len(weekdays))
+	for _, weekday := range weekdays {
+		series.Recurrence.Weekdays = append(series.Recurrence.Weekdays, WeekdayFromTime(weekday))
+	}
+	if dbSeries.EndsAt.Valid {
+		series.Recurrence.EndsAt.Set(dbSeries.EndsAt.Time)
+	}
+	if dbSeries.OccurrenceCount.Valid {
+		series.Recurrence.OccurrenceCount.Set(int(dbSeries.OccurrenceCount.Int64))
+	}
+
+	if dbSeries.PublishMinutesBefore.Valid {
+		series.PublishMinutesBefore.Set(int(dbSeries.PublishMinutesBefore.Int64))
+	}
+
+	series.CreatedAt = dbSeries.CreatedAt
+	series.UpdatedAt = dbSeries.UpdatedAt
+}
+
+func (webhook *Webhook) FromDb(dbWebhook db.Webhook) {
+	webhook.Id = dbWebhook.ID
+	webhook.Url = dbWebhook.Url
+
+	if dbWebhook.GameID.Valid {
+		gameID := dbWebhook.GameID.String
+		webhook.GameId = &gameID
+	}
+	if dbWebhook.SeriesID.Valid {
+		seriesID := dbWebhook.SeriesID.String
+		webhook.SeriesId = &seriesID
+	}
+
+	webhook.EventTypes = make([]WebhookEventType, 0)
+	for eventType := range strings.SplitSeq(dbWebhook.EventTypes, ",") {
+		if eventType != "" {
+			webhook.EventTypes = append(webhook.EventTypes, WebhookEventType(eventType))
+		}
+	}
+
+	webhook.CreatedAt = dbWebhook.CreatedAt
+}
+
+func (delivery *WebhookDelivery) FromDb(dbDelivery db.WebhookDelivery) {
+	delivery.Id = dbDelivery.ID
+	delivery.WebhookId = dbDelivery.WebhookID
+	delivery.EventId = dbDelivery.EventID
+	delivery.EventType = WebhookEventType(dbDelivery.EventType)
+	delivery.CreatedAt = dbDelivery.CreatedAt
+	delivery.Attempts = dbDelivery.Attempts
+
+	if dbDelivery.NextAttemptAt.Valid {
+		t := dbDelivery.NextAttemptAt.Time
+		delivery.NextAttemptAt = &t
+	}
+	if dbDelivery.LastStatusCode.Valid {
+		delivery.LastStatusCode = ptr.Ptr(int(dbDelivery.LastStatusCode.Int64))
+	}
+	if dbDelivery.LastError.Valid {
+		lastError := dbDelivery.LastError.String
+		delivery.LastError = &lastError
+	}
+	if dbDelivery.DeliveredAt.Valid {
+		t := dbDelivery.DeliveredAt.Time
+		delivery.DeliveredAt = &t
+	}
+	if dbDelivery.FailedAt.Valid {
+		t := dbDelivery.FailedAt.Time
+		delivery.FailedAt = &t
+	}
+}
+
+func (role *GameRole) FromDb(dbRole db.GameRole, dbUser db.User) {
+	role.Role = GameRoleName(dbRole.Role)
+	role.User.FromDb(dbUser)
+
+	createdAt := dbRole.CreatedAt
+	role.CreatedAt = &createdAt
+}
+
+func (group *Group) FromDb(dbGroup db.Group, role string) {
+	group.Id = dbGroup.ID
+	group.Name = dbGroup.Name
+	group.Role = GroupMemberRole(role)
+	group.RegularsHeadStartMinutes = ptr.Ptr(dbGroup.RegularsHeadStartMinutes)
+	group.RegularsFirst = ptr.Ptr(dbGroup.RegularsFirst)
+
+	if dbGroup.Description.Valid {
+		desc := dbGroup.Description.String
+		group.Description = &desc
+	}
+
+	group.CreatedAt = dbGroup.CreatedAt
+	group.UpdatedAt = dbGroup.UpdatedAt
+}
+
+func (member *GroupMember) FromDb(dbMember db.GroupMember, dbUser db.User) {
+	member.Role = GroupMemberRole(dbMember.Role)
+	member.Tier = GroupMemberTier(dbMember.Tier)
+	member.User.FromDb(dbUser)
+	member.JoinedAt = dbMember.CreatedAt
+}
+
+func (details *PayoutDetails) FromDb(dbDetails db.PayoutDetail) {
+	if dbDetails.Iban.Valid {
+		details.Iban = ptr.Ptr(dbDetails.Iban.String)
+	}
+	if dbDetails.Bic.Valid {
+		details.Bic = ptr.Ptr(dbDetails.Bic.String)
+	}
+	if dbDetails.BeneficiaryName.Valid {
+		details.BeneficiaryName = ptr.Ptr(dbDetails.BeneficiaryName.String)
+	}
+	if dbDetails.MbwayPhone.Valid {
+		details.MbwayPhone = ptr.Ptr(dbDetails.MbwayPhone.String)
+	}
+	if dbDetails.PaypalMeHandle.Valid {
+		details.PaypalMeHandle = ptr.Ptr(dbDetails.PaypalMeHandle.String)
+	}
+}
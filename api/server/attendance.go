@@ -0,0 +1,376 @@
+package server
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dmateusp/opengym/api"
+	"github.com/dmateusp/opengym/auth"
+	"github.com/dmateusp/opengym/db"
+	"github.com/dmateusp/opengym/ptr"
+	"github.com/dmateusp/opengym/queue"
+)
+
+// checkInCodeLength is short enough for participants to type the code at the venue,
+// the code is only accepted while the game is running.
+const checkInCodeLength = 6
+
+// gameStarted reports whether the game started at now.
+func gameStarted(game db.Game, now time.Time) bool {
+	return game.StartsAt.Valid && !now.Before(game.StartsAt.Time)
+}
+
+// gameEnded reports whether the game ended at now.
+func gameEnded(game db.Game, now time.Time) bool {
+	return game.StartsAt.Valid && !now.Before(game.StartsAt.Time.Add(time.Duration(game.DurationMinutes)*time.Minute))
+}
+
+// attendanceStatus returns the attendance of a participant at a started game,
+// it reports false when they weren't expected at the game and didn't check in.
+func attendanceStatus(row db.ParticipantsListRow, entry queue.Entry, ended bool) (api.AttendanceStatus, bool) {
+	switch {
+	case row.GameParticipant.CheckedInAt.Valid:
+		return api.Attended, true
+	case entry.Status == queue.StatusGoing && ended:
+		return api.NoShow, true
+	case entry.Status == queue.StatusGoing:
+		return api.Expected, true
+	case entry.Status == queue.StatusNotGoing && row.GameParticipant.LateCancelledAt.Valid:
+		return api.LateCancel, true
+	default:
+		return "", false
+	}
+}
+
+// attendanceRecords returns the attendance records of the participants of a started game, in the order of the participants.
+func attendanceRecords(game db.Game, rows []db.ParticipantsListRow, now time.Time) []api.AttendanceRecord {
+	q := queueOf(rows, game.MaxPlayers)
+	ended := gameEnded(game, now)
+
+	records := make([]api.AttendanceRecord, 0, len(rows))
+	for i, row := range rows {
+		status, ok := attendanceStatus(row, q.Entries[i], ended)
+		if !ok {
+			continue
+		}
+
+		record := api.AttendanceRecord{Status: status}
+		record.Participant.FromDb(row.User)
+		if row.GameParticipant.CheckedInAt.Valid {
+			record.CheckedInAt = ptr.Ptr(row.GameParticipant.CheckedInAt.Time)
+		}
+		if row.GameParticipant.LateCancelledAt.Valid {
+			record.LateCancelledAt = ptr.Ptr(row.GameParticipant.LateCancelledAt.Time)
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func (s *server) GetApiGamesIdAttendance(w http.ResponseWriter, r *http.Request, id string) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	game, err := s.querier.GameGetById(r.Context(), id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			http.Error(w, "game not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("failed to retrieve game: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	if !authorize(w, r, s.querier, game, int64(authInfo.UserId), permissionManage) {
+		return
+	}
+
+	now := s.clock.Now()
+	if !gameStarted(game, now) {
+		http.Error(w, "attendance is only available for started games", http.StatusBadRequest)
+		return
+	}
+
+	rows, err := s.querier.ParticipantsList(r.Context(), db.ParticipantsListParams{
+		OrganizerID: game.OrganizerID,
+		GameID:      id,
+	})
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to list participants: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	resp := api.GameAttendance{Records: attendanceRecords(game, rows, now)}
+	if game.CheckInCode.Valid {
+		resp.CheckInCode = ptr.Ptr(game.CheckInCode.String)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		http.Error(w, fmt.Sprintf("failed to encode response: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (s *server) PostApiGamesIdCheckInCode(w http.ResponseWriter, r *http.Request, id string) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	game, err := s.querier.GameGetById(r.Context(), id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			http.Error(w, "game not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("failed to retrieve game: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	if !authorize(w, r, s.querier, game, int64(authInfo.UserId), permissionManage) {
+		return
+	}
+
+	// codes are compared case-insensitively, participants type them on their phones
+	code := strings.ToUpper(s.randomAlphanumericGenerator.Generate(checkInCodeLength))
+	if err := s.querier.GameUpdateCheckInCode(r.Context(), db.GameUpdateCheckInCodeParams{
+		CheckInCode: sql.NullString{String: code, Valid: true},
+		ID:          id,
+	}); err != nil {
+		http.Error(w, fmt.Sprintf("failed to reset check-in code: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(api.CheckInCode{CheckInCode: code}); err != nil {
+		http.Error(w, fmt.Sprintf("failed to encode response: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (s *server) PostApiGamesIdCheckIns(w http.ResponseWriter, r *http.Request, id string) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req api.CheckInRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("failed to decode request body: %s", err.Error()), http.StatusBadRequest)
+		return
+	}
+	if (req.ParticipantId == nil) == (req.Code == nil) {
+		http.Error(w, "exactly one of participantId and code is required", http.StatusBadRequest)
+		return
+	}
+
+	game, err := s.querier.GameGetById(r.Context(), id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			http.Error(w, "game not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("failed to retrieve game: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	if game.CancelledAt.Valid {
+		http.Error(w, "game is cancelled", http.StatusBadRequest)
+		return
+	}
+
+	now := s.clock.Now()
+	if !gameStarted(game, now) {
+		http.Error(w, "participants can only check in once the game started", http.StatusBadRequest)
+		return
+	}
+
+	participantID := int64(authInfo.UserId)
+	if req.ParticipantId != nil {
+		// organizers check participants in, even after the game ended to fix mistakes
+		if !authorize(w, r, s.querier, game, int64(authInfo.UserId), permissionManage) {
+			return
+		}
+		participantID, err = strconv.ParseInt(*req.ParticipantId, 10, 64)
+		if err != nil {
+			http.Error(w, "participant not found", http.StatusNotFound)
+			return
+		}
+	} else {
+		if !game.CheckInCode.Valid || !strings.EqualFold(*req.Code, game.CheckInCode.String) {
+			http.Error(w, "forbidden: the check-in code is wrong", http.StatusForbidden)
+			return
+		}
+		if gameEnded(game, now) {
+			http.Error(w, "the game ended, ask an organizer to check you in", http.StatusBadRequest)
+			return
+		}
+	}
+
+	rowsAffected, err := s.querier.ParticipantSetCheckedIn(r.Context(), db.ParticipantSetCheckedInParams{
+		CheckedInAt: sql.NullTime{Time: now, Valid: true},
+		GameID:      id,
+		UserID:      participantID,
+	})
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to check participant in: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+	if rowsAffected == 0 {
+		http.Error(w, "participant not found", http.StatusNotFound)
+		return
+	}
+
+	rows, err := s.querier.ParticipantsList(r.Context(), db.ParticipantsListParams{
+		OrganizerID: game.OrganizerID,
+		GameID:      id,
+	})
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to list participants: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	var resp api.AttendanceRecord
+	for _, record := range attendanceRecords(game, rows, now) {
+		if record.Participant.Id == strconv.FormatInt(participantID, 10) {
+			resp = record
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		http.Error(w, fmt.Sprintf("failed to encode response: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (s *server) DeleteApiGamesIdCheckInsUserId(w http.ResponseWriter, r *http.Request, id string, userId string) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	participantID, err := strconv.ParseInt(userId, 10, 64)
+	if err != nil {
+		http.Error(w, "participant not found", http.StatusNotFound)
+		return
+	}
+
+	game, err := s.querier.GameGetById(r.Context(), id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			http.Error(w, "game not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("failed to retrieve game: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	if !authorize(w, r, s.querier, game, int64(authInfo.UserId), permissionManage) {
+		return
+	}
+
+	rowsAffected, err := s.querier.ParticipantSetCheckedIn(r.Context(), db.ParticipantSetCheckedInParams{
+		GameID: id,
+		UserID: participantID,
+	})
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to undo check-in: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+	if rowsAffected == 0 {
+		http.Error(w, "participant not found", http.StatusNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *server) GetApiGroupsIdAttendance(w http.ResponseWriter, r *http.Request, id string) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	_, role, ok := groupForMember(w, r, s.querier, id, int64(authInfo.UserId))
+	if !ok {
+		return
+	}
+	if role != api.Admin {
+		http.Error(w, "forbidden: you are not an admin of this group", http.StatusForbidden)
+		return
+	}
+
+	dbMembers, err := s.querier.GroupMemberListByGroup(r.Context(), id)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to list members: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	games, err := s.querier.GameListByGroup(r.Context(), sql.NullString{String: id, Valid: true})
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to list games: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	// only the games that ended count, participants can still show up to the running ones
+	now := s.clock.Now()
+	counts := make(map[string]map[api.AttendanceStatus]int)
+	for _, game := range games {
+		if !gameEnded(game, now) {
+			continue
+		}
+
+		rows, err := s.querier.ParticipantsList(r.Context(), db.ParticipantsListParams{
+			OrganizerID: game.OrganizerID,
+			GameID:      game.ID,
+		})
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed to list participants: %s", err.Error()), http.StatusInternalServerError)
+			return
+		}
+		for _, record := range attendanceRecords(game, rows, now) {
+			if counts[record.Participant.Id] == nil {
+				counts[record.Participant.Id] = make(map[api.AttendanceStatus]int)
+			}
+			counts[record.Participant.Id][record.Status]++
+		}
+	}
+
+	resp := make([]api.MemberAttendance, 0, len(dbMembers))
+	for _, dbMember := range dbMembers {
+		attendance := api.MemberAttendance{}
+		attendance.User.FromDb(dbMember.User)
+		memberCounts := counts[attendance.User.Id]
+		attendance.Attended = memberCounts[api.Attended]
+		attendance.NoShows = memberCounts[api.NoShow]
+		attendance.LateCancellations = memberCounts[api.LateCancel]
+		if expected := attendance.Attended + attendance.NoShows + attendance.LateCancellations; expected > 0 {
+			attendance.AttendanceRate = ptr.Ptr(float64(attendance.Attended) / float64(expected))
+		}
+		resp = append(resp, attendance)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		http.Error(w, fmt.Sprintf("failed to encode response: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+}
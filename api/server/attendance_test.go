@@ -0,0 +1,207 @@
+package server_test
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/dmateusp/opengym/api"
+	"github.com/dmateusp/opengym/api/server"
+	"github.com/dmateusp/opengym/auth"
+	"github.com/dmateusp/opengym/clock"
+	"github.com/dmateusp/opengym/db"
+	dbtesting "github.com/dmateusp/opengym/db/testing"
+	"github.com/dmateusp/opengym/ptr"
+)
+
+func checkIn(t *testing.T, srv api.ServerInterface, gameID string, userID int64, req api.CheckInRequest) *httptest.ResponseRecorder {
+	t.Helper()
+
+	body, _ := json.Marshal(req)
+	r := httptest.NewRequest(http.MethodPost, "/api/games/"+gameID+"/check-ins", bytes.NewReader(body))
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(userID)}))
+	w := httptest.NewRecorder()
+	srv.PostApiGamesIdCheckIns(w, r, gameID)
+	return w
+}
+
+func attendanceStatuses(t *testing.T, srv api.ServerInterface, gameID string, userID int64) map[int64]api.AttendanceStatus {
+	t.Helper()
+
+	r := httptest.NewRequest(http.MethodGet, "/api/games/"+gameID+"/attendance", nil)
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(userID)}))
+	w := httptest.NewRecorder()
+	srv.GetApiGamesIdAttendance(w, r, gameID)
+	if w.Code != http.StatusOK {
+		t.Fatalf("failed to list attendance: status %d, body %s", w.Code, w.Body.String())
+	}
+
+	var attendance api.GameAttendance
+	if err := json.NewDecoder(w.Body).Decode(&attendance); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	statuses := make(map[int64]api.AttendanceStatus, len(attendance.Records))
+	for _, record := range attendance.Records {
+		statuses[mustParseInt(t, record.Participant.Id)] = record.Status
+	}
+	return statuses
+}
+
+func startGame(t *testing.T, sqlDB *sql.DB, gameID string, startsAt time.Time) {
+	t.Helper()
+	if _, err := sqlDB.Exec(`update games set starts_at = ? where id = ?`, startsAt, gameID); err != nil {
+		t.Fatalf("failed to set the start of the game: %v", err)
+	}
+}
+
+func TestAttendance_CheckIns(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	now := time.Now()
+
+	organizerID := dbtesting.UpsertTestUser(t, sqlDB, "organizer@example.com")
+	selfID := dbtesting.UpsertTestUser(t, sqlDB, "self@example.com")
+	absentID := dbtesting.UpsertTestUser(t, sqlDB, "absent@example.com")
+	mistakeID := dbtesting.UpsertTestUser(t, sqlDB, "mistake@example.com")
+
+	querier := db.New(sqlDB)
+	earlier := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), clock.StaticClock{Time: now.Add(-2 * time.Hour)}, sqlDB)
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), clock.StaticClock{Time: now}, sqlDB)
+	later := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), clock.StaticClock{Time: now.Add(time.Hour)}, sqlDB)
+	createGame(t, querier, "g1", organizerID, sql.NullTime{Time: now.Add(-3 * time.Hour), Valid: true})
+	// the game lasts an hour, it's running at now
+	startGame(t, sqlDB, "g1", now.Add(-30*time.Minute))
+	for _, userID := range []int64{selfID, absentID, mistakeID} {
+		updateParticipation(t, earlier, "g1", userID, api.Going)
+	}
+
+	r := httptest.NewRequest(http.MethodPost, "/api/games/g1/check-in-code", nil)
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(organizerID)}))
+	w := httptest.NewRecorder()
+	srv.PostApiGamesIdCheckInCode(w, r, "g1")
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	var code api.CheckInCode
+	if err := json.NewDecoder(w.Body).Decode(&code); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	if w := checkIn(t, earlier, "g1", selfID, api.CheckInRequest{Code: ptr.Ptr(code.CheckInCode)}); w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d before the game starts, got %d", http.StatusBadRequest, w.Code)
+	}
+	if w := checkIn(t, srv, "g1", absentID, api.CheckInRequest{Code: ptr.Ptr("wrong")}); w.Code != http.StatusForbidden {
+		t.Fatalf("expected status %d with a wrong code, got %d", http.StatusForbidden, w.Code)
+	}
+	if w := checkIn(t, srv, "g1", selfID, api.CheckInRequest{ParticipantId: ptr.Ptr(strconv.FormatInt(absentID, 10))}); w.Code != http.StatusForbidden {
+		t.Fatalf("expected status %d when a participant checks someone else in, got %d", http.StatusForbidden, w.Code)
+	}
+
+	w = checkIn(t, srv, "g1", selfID, api.CheckInRequest{Code: ptr.Ptr(strings.ToLower(code.CheckInCode))})
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	var record api.AttendanceRecord
+	if err := json.NewDecoder(w.Body).Decode(&record); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if record.Status != api.Attended || record.CheckedInAt == nil {
+		t.Fatalf("expected the participant to have attended, got %+v", record)
+	}
+
+	if w := checkIn(t, srv, "g1", organizerID, api.CheckInRequest{ParticipantId: ptr.Ptr(strconv.FormatInt(mistakeID, 10))}); w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	r = httptest.NewRequest(http.MethodDelete, "/api/games/g1/check-ins/"+strconv.FormatInt(mistakeID, 10), nil)
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(organizerID)}))
+	w = httptest.NewRecorder()
+	srv.DeleteApiGamesIdCheckInsUserId(w, r, "g1", strconv.FormatInt(mistakeID, 10))
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusNoContent, w.Code, w.Body.String())
+	}
+
+	statuses := attendanceStatuses(t, srv, "g1", organizerID)
+	if len(statuses) != 3 || statuses[selfID] != api.Attended || statuses[absentID] != api.Expected || statuses[mistakeID] != api.Expected {
+		t.Fatalf("expected the participants who didn't check in to still be expected while the game runs, got %v", statuses)
+	}
+
+	if w := checkIn(t, later, "g1", absentID, api.CheckInRequest{Code: ptr.Ptr(code.CheckInCode)}); w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d once the game ended, got %d", http.StatusBadRequest, w.Code)
+	}
+	statuses = attendanceStatuses(t, later, "g1", organizerID)
+	if statuses[selfID] != api.Attended || statuses[absentID] != api.NoShow || statuses[mistakeID] != api.NoShow {
+		t.Fatalf("expected the participants who didn't check in to be no-shows once the game ended, got %v", statuses)
+	}
+}
+
+func TestAttendance_GroupMembers(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	now := time.Now()
+
+	adminID := dbtesting.UpsertTestUser(t, sqlDB, "admin@example.com")
+	memberID := dbtesting.UpsertTestUser(t, sqlDB, "member@example.com")
+
+	querier := db.New(sqlDB)
+	earlier := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), clock.StaticClock{Time: now.Add(-4 * time.Hour)}, sqlDB)
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), clock.StaticClock{Time: now}, sqlDB)
+	group := createGroup(t, srv, adminID, "Tuesday volleyball")
+	if w := joinGroup(t, srv, memberID, inviteCode(t, group)); w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+
+	for i, startsAt := range []time.Time{now.Add(-3 * time.Hour), now.Add(-2 * time.Hour), now.Add(-30 * time.Minute)} {
+		gameID := "g" + strconv.Itoa(i)
+		createGame(t, querier, gameID, adminID, sql.NullTime{Time: now.Add(-5 * time.Hour), Valid: true})
+		if _, err := sqlDB.Exec(`update games set group_id = ? where id = ?`, group.Id, gameID); err != nil {
+			t.Fatalf("failed to move game to group: %v", err)
+		}
+		startGame(t, sqlDB, gameID, startsAt)
+		updateParticipation(t, earlier, gameID, memberID, api.Going)
+	}
+	// the member showed up to the first game only, the last game is still running
+	if w := checkIn(t, srv, "g0", adminID, api.CheckInRequest{ParticipantId: ptr.Ptr(strconv.FormatInt(memberID, 10))}); w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+
+	listAttendance := func(userID int64) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, "/api/groups/"+group.Id+"/attendance", nil)
+		r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(userID)}))
+		w := httptest.NewRecorder()
+		srv.GetApiGroupsIdAttendance(w, r, group.Id)
+		return w
+	}
+
+	if w := listAttendance(memberID); w.Code != http.StatusForbidden {
+		t.Fatalf("expected status %d for members, got %d", http.StatusForbidden, w.Code)
+	}
+
+	w := listAttendance(adminID)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	var attendance []api.MemberAttendance
+	if err := json.NewDecoder(w.Body).Decode(&attendance); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	for _, member := range attendance {
+		switch mustParseInt(t, member.User.Id) {
+		case memberID:
+			if member.Attended != 1 || member.NoShows != 1 || member.AttendanceRate == nil || *member.AttendanceRate != 0.5 {
+				t.Fatalf("expected the member to have attended half of the games that ended, got %+v", member)
+			}
+		case adminID:
+			if member.AttendanceRate != nil {
+				t.Fatalf("expected no attendance rate for the admin who never joined, got %+v", member)
+			}
+		}
+	}
+}
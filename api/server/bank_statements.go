@@ -0,0 +1,302 @@
+package server
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/dmateusp/opengym/api"
+	"github.com/dmateusp/opengym/auth"
+	"github.com/dmateusp/opengym/bankstatement"
+	"github.com/dmateusp/opengym/db"
+	"github.com/dmateusp/opengym/ptr"
+	"github.com/oapi-codegen/nullable"
+)
+
+// maxStatementBytes is the size of the largest bank statement that can be uploaded.
+const maxStatementBytes = 5 << 20
+
+func (s *server) PostApiGamesIdReimbursementsStatement(w http.ResponseWriter, r *http.Request, id string) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	game, err := s.querier.GameGetById(r.Context(), id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			http.Error(w, "game not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("failed to retrieve game: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	if !authorize(w, r, s.querier, game, int64(authInfo.UserId), permissionManageReimbursements) {
+		return
+	}
+
+	if game.CancelledAt.Valid {
+		http.Error(w, "cancelled games aren't billed", http.StatusBadRequest)
+		return
+	}
+
+	if !game.FrozenAt.Valid || game.FrozenAt.Time.After(s.clock.Now()) {
+		http.Error(w, "reimbursements are only available for frozen games", http.StatusBadRequest)
+		return
+	}
+
+	var parse func(io.Reader) ([]bankstatement.Transaction, error)
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	switch mediaType {
+	case "text/csv":
+		parse = bankstatement.ParseCSV
+	case "application/xml", "text/xml":
+		parse = bankstatement.ParseCAMT053
+	default:
+		http.Error(w, "statements must be CSV (text/csv) or CAMT.053 XML (application/xml)", http.StatusUnsupportedMediaType)
+		return
+	}
+
+	transactions, err := parse(http.MaxBytesReader(w, r.Body, maxStatementBytes))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("invalid statement: %s", err.Error()), http.StatusBadRequest)
+		return
+	}
+
+	rows, err := s.querier.ParticipantsList(r.Context(), db.ParticipantsListParams{
+		OrganizerID: game.OrganizerID,
+		GameID:      id,
+	})
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to retrieve reimbursements: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	report := matchStatement(game.ID, billedParticipants(game, rows), transactions)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		http.Error(w, fmt.Sprintf("failed to encode response: %s", err.Error()), http.StatusInternalServerError)
+	}
+}
+
+// matchStatement matches the money received in the statement with the reimbursements of the billed participants.
+// A transfer matches when its description contains the game ID and the reference of a participant, next to each other
+// or not. References are case-sensitive, but banks sometimes change the case of descriptions, so a reference matching
+// regardless of case is used when it's the only one.
+func matchStatement(gameID string, billed []billedParticipant, transactions []bankstatement.Transaction) api.StatementMatchReport {
+	byReference := make(map[string]billedParticipant, len(billed))
+	for _, participant := range billed {
+		byReference[participant.row.GameParticipant.ReimbursementReference] = participant
+	}
+
+	report := api.StatementMatchReport{
+		Matches:   []api.StatementMatch{},
+		Unmatched: []api.UnmatchedStatementLine{},
+	}
+	matched := make(map[int64]bool)
+	for _, transaction := range transactions {
+		// money sent from the account isn't a reimbursement
+		if transaction.AmountCents <= 0 {
+			continue
+		}
+
+		var bookedAt *time.Time
+		if !transaction.BookedAt.IsZero() {
+			bookedAt = ptr.Ptr(transaction.BookedAt)
+		}
+		unmatched := func(reason api.UnmatchedStatementLineReason) {
+			report.Unmatched = append(report.Unmatched, api.UnmatchedStatementLine{
+				Line:        transaction.Line,
+				BookedAt:    bookedAt,
+				AmountCents: transaction.AmountCents,
+				Description: transaction.Description,
+				Reason:      reason,
+			})
+		}
+
+		references, mentioned := statementReferences(gameID, transaction.Description)
+		if !mentioned {
+			unmatched(api.GameNotMentioned)
+			continue
+		}
+		participant, ok := findReference(byReference, references)
+		if !ok {
+			unmatched(api.ReferenceNotFound)
+			continue
+		}
+		userID := participant.row.User.ID
+		if participant.row.GameParticipant.ReimbursementReceivedAt.Valid {
+			unmatched(api.AlreadyReceived)
+			continue
+		}
+		if matched[userID] {
+			unmatched(api.Duplicate)
+			continue
+		}
+		matched[userID] = true
+
+		report.Matches = append(report.Matches, api.StatementMatch{
+			Line:                   transaction.Line,
+			BookedAt:               bookedAt,
+			AmountCents:            transaction.AmountCents,
+			Description:            transaction.Description,
+			Participant:            reimbursementUser(participant.row.User),
+			ReimbursementReference: participant.row.GameParticipant.ReimbursementReference,
+			AmountOwedCents:        participant.amountOwedCents,
+			AmountMismatch:         transaction.AmountCents != participant.amountOwedCents,
+		})
+	}
+	return report
+}
+
+// statementReferences returns the words of the description that can be references, and whether it mentions the game.
+// The game ID and the reference can be written as a single word, the reference following the game ID.
+func statementReferences(gameID, description string) ([]string, bool) {
+	words := strings.FieldsFunc(description, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	var references []string
+	mentioned := false
+	for _, word := range words {
+		switch {
+		case !mentioned && strings.EqualFold(word, gameID):
+			mentioned = true
+		case !mentioned && len(word) > len(gameID) && strings.EqualFold(word[:len(gameID)], gameID):
+			mentioned = true
+			references = append(references, word[len(gameID):])
+		default:
+			references = append(references, word)
+		}
+	}
+	return references, mentioned
+}
+
+// findReference returns the participant with one of the references, preferring the ones matching case-sensitively.
+func findReference(byReference map[string]billedParticipant, references []string) (billedParticipant, bool) {
+	for _, reference := range references {
+		if participant, ok := byReference[reference]; ok {
+			return participant, true
+		}
+	}
+
+	found := make(map[int64]billedParticipant)
+	for _, reference := range references {
+		for known, participant := range byReference {
+			if strings.EqualFold(reference, known) {
+				found[participant.row.User.ID] = participant
+			}
+		}
+	}
+	if len(found) != 1 {
+		return billedParticipant{}, false
+	}
+	for _, participant := range found {
+		return participant, true
+	}
+	return billedParticipant{}, false
+}
+
+func (s *server) PostApiGamesIdReimbursementsMatches(w http.ResponseWriter, r *http.Request, id string) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req api.ApplyReimbursementMatchesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("invalid request body: %s", err.Error()), http.StatusBadRequest)
+		return
+	}
+	switch {
+	case len(req.Matches) == 0:
+		http.Error(w, "matches are required", http.StatusBadRequest)
+		return
+	case len(req.Matches) > maxBulkReimbursements:
+		http.Error(w, fmt.Sprintf("cannot update more than %d reimbursements at once", maxBulkReimbursements), http.StatusBadRequest)
+		return
+	}
+
+	now := s.clock.Now()
+
+	// the matches are applied like bulk updates, reporting the participants that can't be updated in the results
+	updates := make([]api.BulkReimbursementUpdate, len(req.Matches))
+	seen := make(map[string]bool, len(req.Matches))
+	for i, match := range req.Matches {
+		if seen[match.ParticipantId] {
+			http.Error(w, fmt.Sprintf("participant %s is matched more than once", match.ParticipantId), http.StatusBadRequest)
+			return
+		}
+		seen[match.ParticipantId] = true
+
+		receivedAt := now
+		if match.ReceivedAt != nil {
+			receivedAt = *match.ReceivedAt
+		}
+		updates[i] = api.BulkReimbursementUpdate{
+			ParticipantId:           match.ParticipantId,
+			ReimbursementReceivedAt: nullable.NewNullableWithValue(receivedAt),
+		}
+	}
+
+	tx, err := s.dbConn.BeginTx(r.Context(), nil)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to begin transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	querierWithTx := s.querier.WithTx(tx)
+
+	game, err := querierWithTx.GameGetById(r.Context(), id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			http.Error(w, "game not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("failed to retrieve game: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	if !authorize(w, r, querierWithTx, game, int64(authInfo.UserId), permissionManageReimbursements) {
+		return
+	}
+
+	if game.CancelledAt.Valid {
+		http.Error(w, "cancelled games aren't billed", http.StatusBadRequest)
+		return
+	}
+
+	if !game.FrozenAt.Valid || game.FrozenAt.Time.After(now) {
+		http.Error(w, "reimbursements are only available for frozen games", http.StatusBadRequest)
+		return
+	}
+
+	resp, err := updateReimbursements(r.Context(), querierWithTx, id, updates)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		http.Error(w, fmt.Sprintf("failed to commit transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		http.Error(w, fmt.Sprintf("failed to encode response: %s", err.Error()), http.StatusInternalServerError)
+	}
+}
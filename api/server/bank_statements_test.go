@@ -0,0 +1,228 @@
+package server_test
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/dmateusp/opengym/api"
+	"github.com/dmateusp/opengym/api/server"
+	"github.com/dmateusp/opengym/auth"
+	"github.com/dmateusp/opengym/clock"
+	"github.com/dmateusp/opengym/db"
+	dbtesting "github.com/dmateusp/opengym/db/testing"
+	"github.com/dmateusp/opengym/ptr"
+)
+
+func matchStatement(t *testing.T, srv api.ServerInterface, gameID string, userID int64, contentType, statement string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	r := httptest.NewRequest(http.MethodPost, "/api/games/"+gameID+"/reimbursements/statement", strings.NewReader(statement))
+	r.Header.Set("Content-Type", contentType)
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(userID)}))
+	w := httptest.NewRecorder()
+	srv.PostApiGamesIdReimbursementsStatement(w, r, gameID)
+	return w
+}
+
+// setupBilledGame creates a frozen game costing 3000 cents, with the organizer and two participants going,
+// whose references are Aa11, Bb22 and Cc33.
+func setupBilledGame(t *testing.T, sqlDB *sql.DB, now time.Time) (api.ServerInterface, [3]int64) {
+	t.Helper()
+
+	organizerID := dbtesting.UpsertTestUser(t, sqlDB, "organizer@example.com")
+	firstID := dbtesting.UpsertTestUser(t, sqlDB, "first@example.com")
+	secondID := dbtesting.UpsertTestUser(t, sqlDB, "second@example.com")
+
+	querier := db.New(sqlDB)
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), clock.StaticClock{Time: now}, sqlDB)
+	createGame(t, querier, "g1", organizerID, sql.NullTime{Time: now.Add(-time.Hour), Valid: true})
+	if code, _ := patchGame(t, srv, "g1", organizerID, api.UpdateGameRequest{TotalPriceCents: ptr.Ptr(int64(3000))}); code != http.StatusOK {
+		t.Fatalf("failed to set the price: status %d", code)
+	}
+
+	userIDs := [3]int64{organizerID, firstID, secondID}
+	for i, userID := range userIDs {
+		updateParticipation(t, srv, "g1", userID, api.Going)
+		reference := []string{"Aa11", "Bb22", "Cc33"}[i]
+		if _, err := sqlDB.Exec(`update game_participants set reimbursement_reference = ? where game_id = ? and user_id = ?`, reference, "g1", userID); err != nil {
+			t.Fatalf("failed to set reimbursement reference: %v", err)
+		}
+	}
+	freezeGameForReimbursements(t, sqlDB, now, "g1")
+	return srv, userIDs
+}
+
+func TestPostApiGamesIdReimbursementsStatement(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	now := time.Now()
+
+	srv, userIDs := setupBilledGame(t, sqlDB, now)
+	organizerID, firstID, secondID := userIDs[0], userIDs[1], userIDs[2]
+	if _, err := sqlDB.Exec(`update game_participants set reimbursement_received_at = ? where game_id = ? and user_id = ?`, now, "g1", organizerID); err != nil {
+		t.Fatalf("failed to mark reimbursement received: %v", err)
+	}
+
+	statement := "Date,Description,Amount\n" +
+		"2026-10-12,opengym g1 Bb22,10.00\n" +
+		"2026-10-12,G1CC33,9.50\n" +
+		"2026-10-13,g1 Bb22 again,10.00\n" +
+		"2026-10-13,Court rental,-40.00\n" +
+		"2026-10-13,Birthday gift,10.00\n" +
+		"2026-10-14,g1 Zz99,10.00\n" +
+		"2026-10-14,g1 Aa11,10.00\n"
+
+	if w := matchStatement(t, srv, "g1", firstID, "text/csv", statement); w.Code != http.StatusForbidden {
+		t.Fatalf("expected status %d for a participant, got %d", http.StatusForbidden, w.Code)
+	}
+	if w := matchStatement(t, srv, "g1", organizerID, "application/pdf", statement); w.Code != http.StatusUnsupportedMediaType {
+		t.Fatalf("expected status %d for a PDF, got %d", http.StatusUnsupportedMediaType, w.Code)
+	}
+	if w := matchStatement(t, srv, "g1", organizerID, "text/csv", "Date,Description,Amount\n2026-10-12,g1 Bb22,ten\n"); w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d for an invalid statement, got %d", http.StatusBadRequest, w.Code)
+	}
+
+	w := matchStatement(t, srv, "g1", organizerID, "text/csv; charset=utf-8", statement)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	var report api.StatementMatchReport
+	if err := json.NewDecoder(w.Body).Decode(&report); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	if len(report.Matches) != 2 {
+		t.Fatalf("expected 2 matches, got %+v", report.Matches)
+	}
+	first, second := report.Matches[0], report.Matches[1]
+	if first.Line != 2 || first.Participant.Id != strconv.FormatInt(firstID, 10) || first.AmountOwedCents != 1000 || first.AmountMismatch {
+		t.Fatalf("expected the first participant to be matched with the amount they owe, got %+v", first)
+	}
+	// banks can change the case of descriptions, and write the game ID and the reference together
+	if second.Line != 3 || second.Participant.Id != strconv.FormatInt(secondID, 10) || second.ReimbursementReference != "Cc33" || !second.AmountMismatch {
+		t.Fatalf("expected the second participant to be matched with an amount mismatch, got %+v", second)
+	}
+
+	reasons := map[int]api.UnmatchedStatementLineReason{}
+	for _, line := range report.Unmatched {
+		reasons[line.Line] = line.Reason
+	}
+	want := map[int]api.UnmatchedStatementLineReason{
+		4: api.Duplicate,
+		6: api.GameNotMentioned,
+		7: api.ReferenceNotFound,
+		8: api.AlreadyReceived,
+	}
+	if len(reasons) != len(want) {
+		t.Fatalf("expected unmatched lines %v, got %v", want, reasons)
+	}
+	for line, reason := range want {
+		if reasons[line] != reason {
+			t.Fatalf("expected unmatched lines %v, got %v", want, reasons)
+		}
+	}
+
+	// nothing is marked as received until the matches are applied
+	if entry := listReimbursements(t, srv, "g1", organizerID)[strconv.FormatInt(firstID, 10)]; entry.ReimbursementReceivedAt.IsSpecified() && !entry.ReimbursementReceivedAt.IsNull() {
+		t.Fatalf("expected the reimbursement not to be received yet, got %+v", entry)
+	}
+}
+
+func TestPostApiGamesIdReimbursementsStatement_CAMT053(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	now := time.Now()
+
+	srv, userIDs := setupBilledGame(t, sqlDB, now)
+
+	statement := `<?xml version="1.0" encoding="UTF-8"?>
+<Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.053.001.02">
+  <BkToCstmrStmt><Stmt><Ntry>
+    <Amt Ccy="EUR">10.00</Amt>
+    <CdtDbtInd>CRDT</CdtDbtInd>
+    <BookgDt><Dt>2026-10-12</Dt></BookgDt>
+    <NtryDtls><TxDtls><RmtInf><Ustrd>g1 Bb22</Ustrd></RmtInf></TxDtls></NtryDtls>
+  </Ntry></Stmt></BkToCstmrStmt>
+</Document>`
+
+	w := matchStatement(t, srv, "g1", userIDs[0], "application/xml", statement)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	var report api.StatementMatchReport
+	if err := json.NewDecoder(w.Body).Decode(&report); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(report.Matches) != 1 || report.Matches[0].Participant.Id != strconv.FormatInt(userIDs[1], 10) || report.Matches[0].BookedAt == nil {
+		t.Fatalf("expected the transfer to be matched, got %+v", report)
+	}
+}
+
+func TestPostApiGamesIdReimbursementsMatches(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	now := time.Now()
+
+	srv, userIDs := setupBilledGame(t, sqlDB, now)
+	organizerID, firstID, secondID := userIDs[0], userIDs[1], userIDs[2]
+
+	apply := func(userID int64, req api.ApplyReimbursementMatchesRequest) *httptest.ResponseRecorder {
+		body, _ := json.Marshal(req)
+		r := httptest.NewRequest(http.MethodPost, "/api/games/g1/reimbursements/matches", bytes.NewReader(body))
+		r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(userID)}))
+		w := httptest.NewRecorder()
+		srv.PostApiGamesIdReimbursementsMatches(w, r, "g1")
+		return w
+	}
+
+	bookedAt := now.Add(-24 * time.Hour).Truncate(time.Second)
+	req := api.ApplyReimbursementMatchesRequest{Matches: []api.ReimbursementMatch{
+		{ParticipantId: strconv.FormatInt(firstID, 10), ReceivedAt: &bookedAt},
+		{ParticipantId: strconv.FormatInt(secondID, 10)},
+	}}
+
+	if w := apply(firstID, req); w.Code != http.StatusForbidden {
+		t.Fatalf("expected status %d for a participant, got %d", http.StatusForbidden, w.Code)
+	}
+	if w := apply(organizerID, api.ApplyReimbursementMatchesRequest{Matches: append(req.Matches, req.Matches[0])}); w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d when a participant is matched twice, got %d", http.StatusBadRequest, w.Code)
+	}
+
+	// an unknown participant is reported in its result, like in bulk updates, without preventing the other matches
+	w := apply(organizerID, api.ApplyReimbursementMatchesRequest{Matches: append(req.Matches, api.ReimbursementMatch{ParticipantId: "999"})})
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	var resp api.BulkReimbursementResponse
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if resp.Updated != 2 || resp.Failed != 1 || len(resp.Results) != 3 {
+		t.Fatalf("expected 2 updated and 1 failed, got %+v", resp)
+	}
+	if resp.Results[0].Status != api.Updated || resp.Results[0].Record == nil || resp.Results[2].Status != api.ParticipantNotFound {
+		t.Fatalf("expected the results in the order of the matches, got %+v", resp.Results)
+	}
+
+	reimbursements := listReimbursements(t, srv, "g1", organizerID)
+	firstReceivedAt, err := reimbursements[strconv.FormatInt(firstID, 10)].ReimbursementReceivedAt.Get()
+	if err != nil || !firstReceivedAt.Equal(bookedAt) {
+		t.Fatalf("expected the first reimbursement received when it was booked, got %v, %v", firstReceivedAt, err)
+	}
+	secondReceivedAt, err := reimbursements[strconv.FormatInt(secondID, 10)].ReimbursementReceivedAt.Get()
+	if err != nil || !secondReceivedAt.Equal(now) {
+		t.Fatalf("expected the second reimbursement received now, got %v, %v", secondReceivedAt, err)
+	}
+	if organizer := reimbursements[strconv.FormatInt(organizerID, 10)]; organizer.ReimbursementReceivedAt.IsSpecified() && !organizer.ReimbursementReceivedAt.IsNull() {
+		t.Fatalf("expected the organizer's reimbursement not to be received, got %+v", organizer)
+	}
+}
@@ -0,0 +1,178 @@
+package server
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/dmateusp/opengym/api"
+	"github.com/dmateusp/opengym/auth"
+	"github.com/dmateusp/opengym/db"
+	"github.com/dmateusp/opengym/outbox"
+)
+
+func (s *server) DeleteApiGamesId(w http.ResponseWriter, r *http.Request, id string) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	tx, err := s.dbConn.BeginTx(r.Context(), nil)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to begin transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	querierWithTx := s.querier.WithTx(tx)
+
+	game, err := querierWithTx.GameGetById(r.Context(), id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			http.Error(w, "game not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("failed to retrieve game: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	if !authorize(w, r, querierWithTx, game, int64(authInfo.UserId), permissionManage) {
+		return
+	}
+
+	// Participants may have joined published games, they're cancelled instead so they know what happened
+	now := s.clock.Now()
+	if game.PublishedAt.Valid && !game.PublishedAt.Time.After(now) {
+		http.Error(w, "published games can't be deleted, cancel them instead", http.StatusBadRequest)
+		return
+	}
+
+	rowsAffected, err := querierWithTx.GameDelete(r.Context(), db.GameDeleteParams{
+		DeletedAt: sql.NullTime{Time: now, Valid: true},
+		ID:        id,
+	})
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to delete game: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+	if rowsAffected == 0 {
+		http.Error(w, "game not found", http.StatusNotFound)
+		return
+	}
+
+	// the webhooks of the co-organizers and the open streams learn the game is gone
+	if err := outbox.Publish(r.Context(), querierWithTx, id, outbox.GameDeleted{DeletedAt: now}); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		http.Error(w, fmt.Sprintf("failed to commit transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *server) PostApiGamesIdCancel(w http.ResponseWriter, r *http.Request, id string) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req api.CancelGameRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("failed to decode request body: %s", err.Error()), http.StatusBadRequest)
+		return
+	}
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" {
+		http.Error(w, "reason is required", http.StatusBadRequest)
+		return
+	}
+	if len(reason) > 500 {
+		http.Error(w, "reason cannot exceed 500 characters", http.StatusBadRequest)
+		return
+	}
+
+	tx, err := s.dbConn.BeginTx(r.Context(), nil)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to begin transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	querierWithTx := s.querier.WithTx(tx)
+
+	game, err := querierWithTx.GameGetById(r.Context(), id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			http.Error(w, "game not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("failed to retrieve game: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	if !authorize(w, r, querierWithTx, game, int64(authInfo.UserId), permissionManage) {
+		return
+	}
+
+	now := s.clock.Now()
+	if game.CancelledAt.Valid {
+		http.Error(w, "game is already cancelled", http.StatusConflict)
+		return
+	}
+	if !game.PublishedAt.Valid || game.PublishedAt.Time.After(now) {
+		http.Error(w, "only published games can be cancelled, delete drafts instead", http.StatusBadRequest)
+		return
+	}
+	if gameEnded(game, now) {
+		http.Error(w, "the game already ended", http.StatusBadRequest)
+		return
+	}
+
+	rowsAffected, err := querierWithTx.GameCancel(r.Context(), db.GameCancelParams{
+		CancelledAt:        sql.NullTime{Time: now, Valid: true},
+		CancellationReason: sql.NullString{String: reason, Valid: true},
+		ID:                 id,
+	})
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to cancel game: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+	if rowsAffected == 0 {
+		http.Error(w, "game is already cancelled", http.StatusConflict)
+		return
+	}
+
+	if err := outbox.Publish(r.Context(), querierWithTx, id, outbox.GameCancelled{CancelledAt: now, Reason: reason}); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	gameWithOrganizer, err := querierWithTx.GameGetByIdWithOrganizer(r.Context(), id)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to retrieve game: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		http.Error(w, fmt.Sprintf("failed to commit transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	var resp api.GameDetail
+	resp.FromDb(gameWithOrganizer)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		http.Error(w, fmt.Sprintf("failed to encode response: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+}
@@ -0,0 +1,185 @@
+package server_test
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/dmateusp/opengym/api"
+	"github.com/dmateusp/opengym/api/server"
+	"github.com/dmateusp/opengym/auth"
+	"github.com/dmateusp/opengym/clock"
+	"github.com/dmateusp/opengym/db"
+	dbtesting "github.com/dmateusp/opengym/db/testing"
+	"github.com/dmateusp/opengym/ptr"
+	"github.com/dmateusp/opengym/webhook"
+)
+
+func cancelGame(t *testing.T, srv api.ServerInterface, gameID string, userID int64, reason string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	body, _ := json.Marshal(api.CancelGameRequest{Reason: reason})
+	r := httptest.NewRequest(http.MethodPost, "/api/games/"+gameID+"/cancel", bytes.NewReader(body))
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(userID)}))
+	w := httptest.NewRecorder()
+	srv.PostApiGamesIdCancel(w, r, gameID)
+	return w
+}
+
+func deleteGame(t *testing.T, srv api.ServerInterface, gameID string, userID int64) *httptest.ResponseRecorder {
+	t.Helper()
+
+	r := httptest.NewRequest(http.MethodDelete, "/api/games/"+gameID, nil)
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(userID)}))
+	w := httptest.NewRecorder()
+	srv.DeleteApiGamesId(w, r, gameID)
+	return w
+}
+
+func TestDeleteApiGamesId(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	now := time.Now()
+
+	organizerID := dbtesting.UpsertTestUser(t, sqlDB, "organizer@example.com")
+	otherID := dbtesting.UpsertTestUser(t, sqlDB, "other@example.com")
+
+	querier := db.New(sqlDB)
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), clock.StaticClock{Time: now}, sqlDB)
+	createGame(t, querier, "draft", organizerID, sql.NullTime{})
+	createGame(t, querier, "published", organizerID, sql.NullTime{Time: now.Add(-time.Hour), Valid: true})
+
+	if w := deleteGame(t, srv, "draft", otherID); w.Code != http.StatusForbidden {
+		t.Fatalf("expected status %d, got %d", http.StatusForbidden, w.Code)
+	}
+	if w := deleteGame(t, srv, "published", organizerID); w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d for a published game, got %d", http.StatusBadRequest, w.Code)
+	}
+	if w := postWebhook(t, srv, organizerID, api.CreateWebhookRequest{
+		Url:        "https://hooks.example.com/opengym",
+		GameId:     ptr.Ptr("draft"),
+		EventTypes: []api.WebhookEventType{"game_deleted"},
+	}); w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusCreated, w.Code, w.Body.String())
+	}
+	if w := deleteGame(t, srv, "draft", organizerID); w.Code != http.StatusNoContent {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusNoContent, w.Code, w.Body.String())
+	}
+
+	// the webhooks of the game learn it's gone
+	deliverer := webhook.NewDeliverer(db.NewQuerierWrapper(querier), clock.StaticClock{Time: now}, http.DefaultClient)
+	dispatchEvents(t, querier, clock.StaticClock{Time: now}, deliverer.Enqueue)
+	var deliveries int
+	if err := sqlDB.QueryRow(`select count(*) from webhook_deliveries where event_type = ?`, "game_deleted").Scan(&deliveries); err != nil {
+		t.Fatalf("failed to count deliveries: %v", err)
+	}
+	if deliveries != 1 {
+		t.Fatalf("expected the deletion to be delivered to the webhook of the game, got %d deliveries", deliveries)
+	}
+
+	r := httptest.NewRequest(http.MethodGet, "/api/games/draft", nil)
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(organizerID)}))
+	w := httptest.NewRecorder()
+	srv.GetApiGamesId(w, r, "draft", api.GetApiGamesIdParams{})
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d for a deleted game, got %d", http.StatusNotFound, w.Code)
+	}
+
+	r = httptest.NewRequest(http.MethodGet, "/api/games", nil)
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(organizerID)}))
+	w = httptest.NewRecorder()
+	srv.GetApiGames(w, r, api.GetApiGamesParams{})
+	var games api.GameListResponse
+	if err := json.NewDecoder(w.Body).Decode(&games); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if games.Total != 1 || games.Items[0].Id != "published" {
+		t.Fatalf("expected the deleted game to be hidden from the list of games, got %+v", games)
+	}
+
+	// the row is kept for history
+	var deletedAt sql.NullTime
+	if err := sqlDB.QueryRow(`select deleted_at from games where id = ?`, "draft").Scan(&deletedAt); err != nil || !deletedAt.Valid {
+		t.Fatalf("expected the deleted game to be kept, got %v, %v", deletedAt, err)
+	}
+}
+
+func TestPostApiGamesIdCancel(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	now := time.Now()
+
+	organizerID := dbtesting.UpsertTestUser(t, sqlDB, "organizer@example.com")
+	participantID := dbtesting.UpsertTestUser(t, sqlDB, "participant@example.com")
+	lateID := dbtesting.UpsertTestUser(t, sqlDB, "late@example.com")
+
+	querier := db.New(sqlDB)
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), clock.StaticClock{Time: now}, sqlDB)
+	createGame(t, querier, "draft", organizerID, sql.NullTime{})
+	createGame(t, querier, "g1", organizerID, sql.NullTime{Time: now.Add(-time.Hour), Valid: true})
+	updateParticipation(t, srv, "g1", participantID, api.Going)
+
+	if w := cancelGame(t, srv, "draft", organizerID, "Nobody is available"); w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d for a draft, got %d", http.StatusBadRequest, w.Code)
+	}
+	if w := cancelGame(t, srv, "g1", organizerID, " "); w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d without a reason, got %d", http.StatusBadRequest, w.Code)
+	}
+	if w := cancelGame(t, srv, "g1", participantID, "I can't make it"); w.Code != http.StatusForbidden {
+		t.Fatalf("expected status %d for a participant, got %d", http.StatusForbidden, w.Code)
+	}
+
+	w := cancelGame(t, srv, "g1", organizerID, "The court booking fell through")
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	var detail api.GameDetail
+	if err := json.NewDecoder(w.Body).Decode(&detail); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if detail.Game.CancelledAt == nil || detail.Game.CancellationReason == nil || *detail.Game.CancellationReason != "The court booking fell through" {
+		t.Fatalf("expected the game to be cancelled with its reason, got %+v", detail.Game)
+	}
+	if w := cancelGame(t, srv, "g1", organizerID, "Again"); w.Code != http.StatusConflict {
+		t.Fatalf("expected status %d when cancelling twice, got %d", http.StatusConflict, w.Code)
+	}
+
+	body, _ := json.Marshal(api.UpdateGameParticipationRequest{Status: api.Going})
+	r := httptest.NewRequest(http.MethodPut, "/api/games/g1/participants", bytes.NewReader(body))
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(lateID)}))
+	w = httptest.NewRecorder()
+	srv.PutApiGamesIdParticipants(w, r, "g1")
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d when joining a cancelled game, got %d", http.StatusBadRequest, w.Code)
+	}
+	if statuses := participantStatuses(t, listParticipants(t, srv, "g1", organizerID)); len(statuses) != 1 {
+		t.Fatalf("expected the participants to be kept for history, got %v", statuses)
+	}
+
+	r = httptest.NewRequest(http.MethodGet, "/public/api/games/g1", nil)
+	w = httptest.NewRecorder()
+	srv.GetPublicApiGamesId(w, r, "g1", api.GetPublicApiGamesIdParams{})
+	var public api.PublicGameDetail
+	if err := json.NewDecoder(w.Body).Decode(&public); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	published, err := public.AsPublicGameDetail0()
+	if err != nil || published.CancelledAt == nil {
+		t.Fatalf("expected the public game to show it's cancelled, got %+v, %v", published, err)
+	}
+
+	freezeGameForReimbursements(t, sqlDB, now, "g1")
+	r = httptest.NewRequest(http.MethodGet, "/api/games/g1/reimbursements", nil)
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(organizerID)}))
+	w = httptest.NewRecorder()
+	srv.GetApiGamesIdReimbursements(w, r, "g1")
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d for the reimbursements of a cancelled game, got %d", http.StatusBadRequest, w.Code)
+	}
+}
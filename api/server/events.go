@@ -0,0 +1,150 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dmateusp/opengym/db"
+	"github.com/dmateusp/opengym/outbox"
+	"github.com/dmateusp/opengym/queue"
+	"github.com/dmateusp/opengym/scheduler"
+)
+
+// RecordLifecycleEvent is a [scheduler.Handler] publishing the lifecycle events of games to the outbox.
+func (srv *server) RecordLifecycleEvent(ctx context.Context, querier db.QuerierWithTxSupport, event scheduler.Event) error {
+	var payload outbox.Payload
+	switch event.Type {
+	case scheduler.EventGamePublished:
+		payload = outbox.GamePublished{PublishedAt: event.At}
+	case scheduler.EventGameFrozen:
+		payload = outbox.GameFrozen{FrozenAt: event.At}
+	case scheduler.EventGameStarted:
+		payload = outbox.GameStarted{StartsAt: event.At}
+	case scheduler.EventGameEnded:
+		payload = outbox.GameEnded{EndsAt: event.At}
+	default:
+		return fmt.Errorf("unknown lifecycle event %s", event.Type)
+	}
+	return outbox.Publish(ctx, querier, event.Game.ID, payload)
+}
+
+// changedGameFields returns the API names of the details that differ between the two versions of the game.
+func changedGameFields(before, after db.Game) []string {
+	var fields []string
+	if before.Name != after.Name {
+		fields = append(fields, "name")
+	}
+	if before.Description != after.Description {
+		fields = append(fields, "description")
+	}
+	if before.StartsAt.Valid != after.StartsAt.Valid || !before.StartsAt.Time.Equal(after.StartsAt.Time) {
+		fields = append(fields, "startsAt")
+	}
+	if before.DurationMinutes != after.DurationMinutes {
+		fields = append(fields, "durationMinutes")
+	}
+	if before.Location != after.Location {
+		fields = append(fields, "location")
+	}
+	if before.TotalPriceCents != after.TotalPriceCents {
+		fields = append(fields, "totalPriceCents")
+	}
+	if before.SplitStrategy != after.SplitStrategy {
+		fields = append(fields, "splitStrategy")
+	}
+	if before.PricePerPlayerCents != after.PricePerPlayerCents {
+		fields = append(fields, "pricePerPlayerCents")
+	}
+	if before.GuestPriceCents != after.GuestPriceCents {
+		fields = append(fields, "guestPriceCents")
+	}
+	if before.MaxPlayers != after.MaxPlayers {
+		fields = append(fields, "maxPlayers")
+	}
+	if before.MaxGuestsPerPlayer != after.MaxGuestsPerPlayer {
+		fields = append(fields, "maxGuestsPerPlayer")
+	}
+	return fields
+}
+
+// publishGameUpdated publishes the events describing how the organizer changed the game,
+// and who has to reconfirm because of it if anyone.
+func publishGameUpdated(ctx context.Context, querier db.Querier, before, after db.Game, reconfirmation *outbox.Reconfirmation) error {
+	changedFields := changedGameFields(before, after)
+	if len(changedFields) == 0 {
+		return nil
+	}
+
+	if err := outbox.Publish(ctx, querier, after.ID, outbox.GameUpdated{ChangedFields: changedFields, Reconfirmation: reconfirmation}); err != nil {
+		return err
+	}
+
+	if before.StartsAt.Valid != after.StartsAt.Valid || !before.StartsAt.Time.Equal(after.StartsAt.Time) {
+		if err := outbox.Publish(ctx, querier, after.ID, outbox.GameRescheduled{
+			PreviousStartsAt: nullTimePtr(before.StartsAt.Time, before.StartsAt.Valid),
+			StartsAt:         nullTimePtr(after.StartsAt.Time, after.StartsAt.Valid),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// publishPromotions publishes a [outbox.ParticipantPromoted] event for every participant that moved from the waitlist to the main list.
+// In games where freed spots are offered, the participants are offered the spot instead.
+// Nothing is published before the draw of lottery games, the order of the participants is only provisional.
+func publishPromotions(ctx context.Context, querier db.Querier, game db.Game, now time.Time, before []db.ParticipantsListRow, maxPlayersBefore int64, after []db.ParticipantsListRow, maxPlayersAfter int64, excludedUserID int64) error {
+	if lotteryPending(game) {
+		return nil
+	}
+	for _, userID := range promotedUserIDs(before, maxPlayersBefore, after, maxPlayersAfter) {
+		if userID == excludedUserID {
+			continue
+		}
+		if game.WaitlistOfferMinutes > 0 {
+			if err := offerSpot(ctx, querier, game, userID, now); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := outbox.Publish(ctx, querier, game.ID, outbox.ParticipantPromoted{UserID: userID}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// queueOf computes the queue of the game from its participants, in the order they are listed.
+func queueOf(rows []db.ParticipantsListRow, maxPlayers int64) queue.Queue {
+	participants := make([]queue.Participant, 0, len(rows))
+	for _, row := range rows {
+		participants = append(participants, queue.Participant{
+			UserID: row.User.ID,
+			Going:  row.GameParticipant.Going.Valid && row.GameParticipant.Going.Bool,
+			Guests: row.GameParticipant.Guests.Int64,
+		})
+	}
+	return queue.Compute(maxPlayers, participants)
+}
+
+// promotedUserIDs returns the participants that moved from the waitlist to the main list.
+func promotedUserIDs(before []db.ParticipantsListRow, maxPlayersBefore int64, after []db.ParticipantsListRow, maxPlayersAfter int64) []int64 {
+	queueBefore := queueOf(before, maxPlayersBefore)
+
+	var promoted []int64
+	for _, entry := range queueOf(after, maxPlayersAfter).Entries {
+		if entry.Status == queue.StatusGoing && queueBefore.Status(entry.UserID) == queue.StatusWaitlisted {
+			promoted = append(promoted, entry.UserID)
+		}
+	}
+	return promoted
+}
+
+func nullTimePtr(t time.Time, valid bool) *time.Time {
+	if !valid {
+		return nil
+	}
+	return &t
+}
@@ -1,8 +1,10 @@
 package server
 
 import (
+	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
 	"net/http"
@@ -12,6 +14,7 @@ import (
 	"github.com/dmateusp/opengym/api"
 	"github.com/dmateusp/opengym/auth"
 	"github.com/dmateusp/opengym/db"
+	"github.com/dmateusp/opengym/ptr"
 	"github.com/oapi-codegen/nullable"
 )
 
@@ -34,34 +37,36 @@ func (srv *server) PostApiGames(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Validate name
-	if len(req.Name) == 0 {
-		http.Error(w, "name cannot be empty", http.StatusBadRequest)
-		return
-	}
-	if len(req.Name) > 100 {
-		http.Error(w, "name cannot exceed 100 characters", http.StatusBadRequest)
-		return
-	}
-
-	// Validate description
-	if req.Description != nil && len(*req.Description) > 1000 {
-		http.Error(w, "description cannot exceed 1000 characters", http.StatusBadRequest)
+	if err := validateGameFields(createGameFields(req)); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
 		return
 	}
 
-	if req.DurationMinutes != nil && *req.DurationMinutes <= 0 {
-		http.Error(w, "duration must be positive", http.StatusBadRequest)
+	if req.AllocationMode != nil && *req.AllocationMode == api.Lottery && req.RegistrationClosesAt == nil {
+		http.Error(w, "registrationClosesAt is required for lottery games", http.StatusBadRequest)
 		return
 	}
 
-	if req.MaxPlayers != nil && *req.MaxPlayers < 1 {
-		http.Error(w, "maxPlayers must be at least 1", http.StatusBadRequest)
-		return
-	}
+	// Games can only be created in the groups the organizer belongs to
+	var group db.Group
+	if req.GroupId != nil {
+		role, err := groupRole(r.Context(), srv.querier, *req.GroupId, int64(authInfo.UserId))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if role == "" {
+			http.Error(w, "group not found", http.StatusNotFound)
+			return
+		}
 
-	if req.MaxGuestsPerPlayer != nil && *req.MaxGuestsPerPlayer < 0 {
-		http.Error(w, "maxGuestsPerPlayer cannot be negative", http.StatusBadRequest)
+		group, err = srv.querier.GroupGetById(r.Context(), *req.GroupId)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed to retrieve group: %s", err.Error()), http.StatusInternalServerError)
+			return
+		}
+	} else if hasPriorityTiers(req.RegularsHeadStartMinutes, req.RegularsFirst) {
+		http.Error(w, "regulars can only get priority in group games", http.StatusBadRequest)
 		return
 	}
 
@@ -93,7 +98,7 @@ func (srv *server) PostApiGames(w http.ResponseWriter, r *http.Request) {
 		}
 
 		if req.StartsAt != nil {
-			params.StartsAt.Time = *req.StartsAt
+			params.StartsAt.Time = req.StartsAt.UTC()
 			params.StartsAt.Valid = true
 		}
 
@@ -112,6 +117,67 @@ func (srv *server) PostApiGames(w http.ResponseWriter, r *http.Request) {
 			params.MaxGuestsPerPlayer = int64(*req.MaxGuestsPerPlayer)
 		}
 
+		if req.GroupId != nil {
+			params.GroupID = sql.NullString{String: *req.GroupId, Valid: true}
+		}
+
+		params.IsPrivate = req.Visibility != nil && *req.Visibility == api.Private
+
+		if req.WaitlistOfferMinutes != nil {
+			params.WaitlistOfferMinutes = *req.WaitlistOfferMinutes
+		}
+
+		if req.AllocationMode != nil {
+			params.AllocationMode = string(*req.AllocationMode)
+		}
+
+		if req.RegistrationClosesAt != nil {
+			params.RegistrationClosesAt = sql.NullTime{Time: req.RegistrationClosesAt.UTC(), Valid: true}
+		}
+
+		if req.WaitlistMode != nil {
+			params.WaitlistMode = string(*req.WaitlistMode)
+		}
+
+		if req.MaxWaitlistSize != nil {
+			params.MaxWaitlistSize = *req.MaxWaitlistSize
+			params.WaitlistSpotsLeft = *req.MaxWaitlistSize
+		}
+
+		if req.ReconfirmWithinMinutes != nil {
+			params.ReconfirmWithinMinutes = *req.ReconfirmWithinMinutes
+		}
+
+		if req.CancellationDeadlineMinutes != nil {
+			params.CancellationDeadlineMinutes = *req.CancellationDeadlineMinutes
+		}
+
+		if req.BillLateCancellations != nil {
+			params.BillLateCancellations = *req.BillLateCancellations
+		}
+
+		if req.SplitStrategy != nil {
+			params.SplitStrategy = string(*req.SplitStrategy)
+		}
+
+		if req.PricePerPlayerCents != nil {
+			params.PricePerPlayerCents = *req.PricePerPlayerCents
+		}
+
+		if req.GuestPriceCents != nil {
+			params.GuestPriceCents = *req.GuestPriceCents
+		}
+
+		// Group games follow the priority tiers of the group unless set
+		params.RegularsHeadStartMinutes = group.RegularsHeadStartMinutes
+		if req.RegularsHeadStartMinutes != nil {
+			params.RegularsHeadStartMinutes = *req.RegularsHeadStartMinutes
+		}
+		params.RegularsFirst = group.RegularsFirst
+		if req.RegularsFirst != nil {
+			params.RegularsFirst = *req.RegularsFirst
+		}
+
 		game, err = srv.querier.GameCreate(r.Context(), params)
 		if err == nil {
 			// Successfully created, break out of retry loop
@@ -176,20 +242,24 @@ func (srv *server) GetApiGames(w http.ResponseWriter, r *http.Request, params ap
 		}
 	}
 
-	offset := int64((page - 1) * pageSize)
-	limit := int64(pageSize)
+	// published_at and starts_at are written in UTC, see GameListByUser
+	now := sql.NullTime{Time: srv.clock.Now().UTC(), Valid: true}
 
 	rows, err := srv.querier.GameListByUser(r.Context(), db.GameListByUserParams{
 		UserID: int64(authInfo.UserId),
-		Limit:  limit,
-		Offset: offset,
+		Now:    now,
+		Limit:  int64(pageSize),
+		Offset: int64((page - 1) * pageSize),
 	})
 	if err != nil {
 		http.Error(w, fmt.Sprintf("failed to list games: %s", err.Error()), http.StatusInternalServerError)
 		return
 	}
 
-	total, err := srv.querier.GameCountByUser(r.Context(), int64(authInfo.UserId))
+	total, err := srv.querier.GameCountByUser(r.Context(), db.GameCountByUserParams{
+		UserID: int64(authInfo.UserId),
+		Now:    now,
+	})
 	if err != nil {
 		http.Error(w, fmt.Sprintf("failed to count games: %s", err.Error()), http.StatusInternalServerError)
 		return
@@ -214,6 +284,19 @@ func (srv *server) GetApiGames(w http.ResponseWriter, r *http.Request, params ap
 			item.PublishedAt.Set(row.PublishedAt.Time)
 		}
 		item.UpdatedAt = row.UpdatedAt
+		if row.GroupID.Valid {
+			groupID := row.GroupID.String
+			item.GroupId = &groupID
+		}
+		if row.LateCancelledAt.Valid {
+			lateCancelledAt := row.LateCancelledAt.Time
+			item.LateCancelledAt = &lateCancelledAt
+		}
+		if row.CancelledAt.Valid {
+			cancelledAt := row.CancelledAt.Time
+			item.CancelledAt = &cancelledAt
+			item.CancellationReason = &row.CancellationReason.String
+		}
 
 		// Map organizer information
 		item.Organizer.FromDb(row.User)
@@ -236,7 +319,7 @@ func (srv *server) GetApiGames(w http.ResponseWriter, r *http.Request, params ap
 	}
 }
 
-func (srv *server) GetPublicApiGamesId(w http.ResponseWriter, r *http.Request, id string) {
+func (srv *server) GetPublicApiGamesId(w http.ResponseWriter, r *http.Request, id string, params api.GetPublicApiGamesIdParams) {
 	game, err := srv.querier.GameGetPublicInfoById(r.Context(), id)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -247,6 +330,25 @@ func (srv *server) GetPublicApiGamesId(w http.ResponseWriter, r *http.Request, i
 		return
 	}
 
+	// Group games are only visible to the members of the group
+	if game.GroupID.Valid {
+		http.Error(w, "game not found", http.StatusNotFound)
+		return
+	}
+
+	// Private games reveal nothing without a valid invite token
+	if game.IsPrivate {
+		_, invited, err := activeInviteToken(r.Context(), srv.querier, game.ID, params.InviteToken)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if !invited {
+			http.Error(w, "game not found", http.StatusNotFound)
+			return
+		}
+	}
+
 	now := srv.clock.Now()
 	isPublished := game.PublishedAt.Valid && !game.PublishedAt.Time.After(now)
 
@@ -266,6 +368,13 @@ func (srv *server) GetPublicApiGamesId(w http.ResponseWriter, r *http.Request, i
 		if game.StartsAt.Valid {
 			detail.StartsAt = game.StartsAt.Time
 		}
+		if game.WaitlistMode != waitlistUnlimited {
+			detail.WaitlistSpotsLeft = ptr.Ptr(game.WaitlistSpotsLeft)
+		}
+		if game.CancelledAt.Valid {
+			detail.CancelledAt = ptr.Ptr(game.CancelledAt.Time)
+			detail.CancellationReason = ptr.Ptr(game.CancellationReason.String)
+		}
 		if err := resp.FromPublicGameDetail0(detail); err != nil {
 			http.Error(w, fmt.Sprintf("failed to create response: %s", err.Error()), http.StatusInternalServerError)
 			return
@@ -302,7 +411,7 @@ func isConstraintError(err error) bool {
 	return strings.Contains(err.Error(), "UNIQUE constraint failed: games.id")
 }
 
-func (srv *server) GetApiGamesId(w http.ResponseWriter, r *http.Request, id string) {
+func (srv *server) GetApiGamesId(w http.ResponseWriter, r *http.Request, id string, params api.GetApiGamesIdParams) {
 	game, err := srv.querier.GameGetByIdWithOrganizer(r.Context(), id)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -313,11 +422,23 @@ func (srv *server) GetApiGamesId(w http.ResponseWriter, r *http.Request, id stri
 		return
 	}
 
+	// Only users with a role in the game can see unpublished games, and private games need an invite token
 	authInfo, hasAuth := auth.FromCtx(r.Context())
-	isOrganizer := hasAuth && int64(authInfo.UserId) == game.Game.OrganizerID
-
-	// Only organizers can see unpublished games
-	if !isOrganizer && (!game.Game.PublishedAt.Valid || game.Game.PublishedAt.Time.After(srv.clock.Now())) {
+	var userID int64
+	if hasAuth {
+		userID = int64(authInfo.UserId)
+	}
+	_, invited, err := activeInviteToken(r.Context(), srv.querier, game.Game.ID, params.InviteToken)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	hidden, err := srv.isHidden(r.Context(), srv.querier, game.Game, userID, invited)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if hidden {
 		http.Error(w, "game not found", http.StatusNotFound)
 		return
 	}
@@ -361,8 +482,7 @@ func (srv *server) PatchApiGamesId(w http.ResponseWriter, r *http.Request, id st
 		return
 	}
 
-	if game.OrganizerID != int64(authInfo.UserId) {
-		http.Error(w, "forbidden: you are not the organizer of this game", http.StatusForbidden)
+	if !authorize(w, r, querierWithTx, game, int64(authInfo.UserId), permissionManage) {
 		return
 	}
 
@@ -372,30 +492,38 @@ func (srv *server) PatchApiGamesId(w http.ResponseWriter, r *http.Request, id st
 		return
 	}
 
-	// Validate name
-	if req.Name != nil {
-		if len(*req.Name) == 0 {
-			http.Error(w, "name cannot be empty", http.StatusBadRequest)
-			return
-		}
-		if len(*req.Name) > 100 {
-			http.Error(w, "name cannot exceed 100 characters", http.StatusBadRequest)
-			return
-		}
+	if err := validateGameFields(updateGameFields(req)); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if !game.GroupID.Valid && hasPriorityTiers(req.RegularsHeadStartMinutes, req.RegularsFirst) {
+		http.Error(w, "regulars can only get priority in group games", http.StatusBadRequest)
+		return
+	}
+
+	if game.LotteryDrawnAt.Valid && (req.AllocationMode != nil || req.RegistrationClosesAt != nil) {
+		http.Error(w, "the lottery was already drawn", http.StatusBadRequest)
+		return
+	}
+	isLottery := game.AllocationMode == allocationLottery
+	if req.AllocationMode != nil {
+		isLottery = *req.AllocationMode == api.Lottery
+	}
+	if isLottery && req.RegistrationClosesAt == nil && !game.RegistrationClosesAt.Valid {
+		http.Error(w, "registrationClosesAt is required for lottery games", http.StatusBadRequest)
+		return
 	}
 
-	// Validate description
-	if req.Description != nil && len(*req.Description) > 1000 {
-		http.Error(w, "description cannot exceed 1000 characters", http.StatusBadRequest)
+	if game.CancelledAt.Valid {
+		http.Error(w, "game is cancelled", http.StatusBadRequest)
 		return
 	}
 
 	isFrozen := game.FrozenAt.Valid && !game.FrozenAt.Time.After(now)
-	if isFrozen {
-		if req.Name != nil || req.PublishedAt.IsSpecified() || req.TotalPriceCents != nil || req.Location != nil || req.StartsAt != nil || req.DurationMinutes != nil || req.MaxPlayers != nil || req.MaxGuestsPerPlayer != nil || req.GameSpotsLeft != nil {
-			http.Error(w, "frozen game can only update description or frozenAt", http.StatusBadRequest)
-			return
-		}
+	if frozenFields := frozenFieldsIn(req); isFrozen && len(frozenFields) > 0 {
+		http.Error(w, fmt.Sprintf("frozen game can only update description or frozenAt, not %s", strings.Join(frozenFields, ", ")), http.StatusBadRequest)
+		return
 	}
 
 	params := db.GameUpdateParams{ID: id}
@@ -420,9 +548,9 @@ func (srv *server) PatchApiGamesId(w http.ResponseWriter, r *http.Request, id st
 	if req.PublishedAt.IsSpecified() {
 		var publishAt sql.NullTime
 		if !req.PublishedAt.IsNull() {
-			publishAt = sql.NullTime{Time: req.PublishedAt.MustGet(), Valid: true}
+			publishAt = sql.NullTime{Time: req.PublishedAt.MustGet().UTC(), Valid: true}
 			if publishAt.Time.Before(now) {
-				publishAt = sql.NullTime{Time: now, Valid: true}
+				publishAt = sql.NullTime{Time: now.UTC(), Valid: true}
 			}
 		}
 
@@ -445,7 +573,7 @@ func (srv *server) PatchApiGamesId(w http.ResponseWriter, r *http.Request, id st
 	if req.FrozenAt.IsSpecified() {
 		var freezeAt sql.NullTime
 		if !req.FrozenAt.IsNull() {
-			freezeAt = sql.NullTime{Time: req.FrozenAt.MustGet(), Valid: true}
+			freezeAt = sql.NullTime{Time: req.FrozenAt.MustGet().UTC(), Valid: true}
 			if freezeAt.Time.Before(now) {
 				freezeAt = sql.NullTime{Time: now, Valid: true}
 			}
@@ -464,7 +592,7 @@ func (srv *server) PatchApiGamesId(w http.ResponseWriter, r *http.Request, id st
 	}
 
 	if req.StartsAt != nil {
-		params.StartsAt.Time = *req.StartsAt
+		params.StartsAt.Time = req.StartsAt.UTC()
 		params.StartsAt.Valid = true
 	}
 
@@ -475,14 +603,6 @@ func (srv *server) PatchApiGamesId(w http.ResponseWriter, r *http.Request, id st
 	if req.MaxPlayers != nil {
 		newMaxPlayers := int64(*req.MaxPlayers)
 		params.MaxPlayers = sql.NullInt64{Valid: true, Int64: newMaxPlayers}
-		newGameSpotsLeft := game.GameSpotsLeft + (newMaxPlayers - game.MaxPlayers)
-		if newGameSpotsLeft < 0 {
-			newGameSpotsLeft = 0
-		}
-		if newGameSpotsLeft > newMaxPlayers {
-			newGameSpotsLeft = newMaxPlayers
-		}
-		params.GameSpotsLeft = sql.NullInt64{Valid: true, Int64: newGameSpotsLeft}
 	}
 
 	if req.MaxGuestsPerPlayer != nil {
@@ -492,15 +612,65 @@ func (srv *server) PatchApiGamesId(w http.ResponseWriter, r *http.Request, id st
 		}
 	}
 
-	err = querierWithTx.GameUpdate(r.Context(), params)
-	if err != nil {
-		http.Error(w, fmt.Sprintf("failed to update game: %s", err.Error()), http.StatusInternalServerError)
-		return
+	if req.Visibility != nil {
+		params.IsPrivate = sql.NullBool{Bool: *req.Visibility == api.Private, Valid: true}
+	}
+
+	if req.WaitlistOfferMinutes != nil {
+		params.WaitlistOfferMinutes = sql.NullInt64{Int64: *req.WaitlistOfferMinutes, Valid: true}
+	}
+
+	if req.AllocationMode != nil {
+		params.AllocationMode = sql.NullString{String: string(*req.AllocationMode), Valid: true}
+	}
+
+	if req.RegistrationClosesAt != nil {
+		params.RegistrationClosesAt = sql.NullTime{Time: req.RegistrationClosesAt.UTC(), Valid: true}
+	}
+
+	if req.RegularsHeadStartMinutes != nil {
+		params.RegularsHeadStartMinutes = sql.NullInt64{Int64: *req.RegularsHeadStartMinutes, Valid: true}
+	}
+
+	if req.RegularsFirst != nil {
+		params.RegularsFirst = sql.NullBool{Bool: *req.RegularsFirst, Valid: true}
+	}
+
+	if req.WaitlistMode != nil {
+		params.WaitlistMode = sql.NullString{String: string(*req.WaitlistMode), Valid: true}
+	}
+
+	if req.MaxWaitlistSize != nil {
+		params.MaxWaitlistSize = sql.NullInt64{Int64: *req.MaxWaitlistSize, Valid: true}
+	}
+
+	if req.ReconfirmWithinMinutes != nil {
+		params.ReconfirmWithinMinutes = sql.NullInt64{Int64: *req.ReconfirmWithinMinutes, Valid: true}
+	}
+
+	if req.CancellationDeadlineMinutes != nil {
+		params.CancellationDeadlineMinutes = sql.NullInt64{Int64: *req.CancellationDeadlineMinutes, Valid: true}
+	}
+
+	if req.BillLateCancellations != nil {
+		params.BillLateCancellations = sql.NullBool{Bool: *req.BillLateCancellations, Valid: true}
+	}
+
+	if req.SplitStrategy != nil {
+		params.SplitStrategy = sql.NullString{String: string(*req.SplitStrategy), Valid: true}
+	}
+
+	if req.PricePerPlayerCents != nil {
+		params.PricePerPlayerCents = sql.NullInt64{Int64: *req.PricePerPlayerCents, Valid: true}
 	}
 
-	updatedGame, err := querierWithTx.GameGetById(r.Context(), id)
+	if req.GuestPriceCents != nil {
+		params.GuestPriceCents = sql.NullInt64{Int64: *req.GuestPriceCents, Valid: true}
+	}
+
+	updatedGame, err := applyGameUpdate(r.Context(), querierWithTx, game, params, now)
 	if err != nil {
-		http.Error(w, fmt.Sprintf("failed to retrieve updated game: %s", err.Error()), http.StatusInternalServerError)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
 
@@ -511,7 +681,10 @@ func (srv *server) PatchApiGamesId(w http.ResponseWriter, r *http.Request, id st
 		return
 	}
 
-	tx.Commit()
+	if err := tx.Commit(); err != nil {
+		http.Error(w, fmt.Sprintf("failed to commit transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
@@ -529,3 +702,203 @@ func (srv *server) PatchApiGamesId(w http.ResponseWriter, r *http.Request, id st
 		return
 	}
 }
+
+// applyGameUpdate updates the game and publishes what changed for its participants: the participants going
+// reconfirm when important details change, and the participants moved off the waitlist are promoted.
+// It returns the updated game.
+func applyGameUpdate(ctx context.Context, querier db.Querier, game db.Game, params db.GameUpdateParams, now time.Time) (db.Game, error) {
+	participantsBefore, err := querier.ParticipantsList(ctx, db.ParticipantsListParams{
+		OrganizerID: game.OrganizerID,
+		GameID:      game.ID,
+	})
+	if err != nil {
+		return db.Game{}, fmt.Errorf("failed to list participants: %w", err)
+	}
+
+	if err := querier.GameUpdate(ctx, params); err != nil {
+		return db.Game{}, fmt.Errorf("failed to update game: %w", err)
+	}
+
+	updatedGame, err := querier.GameGetById(ctx, game.ID)
+	if err != nil {
+		return db.Game{}, fmt.Errorf("failed to retrieve updated game: %w", err)
+	}
+
+	// Participants going to the game have to confirm they're still coming when important details change
+	reconfirmation, err := requestReconfirmations(ctx, querier, game, updatedGame, now)
+	if err != nil {
+		return db.Game{}, err
+	}
+
+	if err := publishGameUpdated(ctx, querier, game, updatedGame, reconfirmation); err != nil {
+		return db.Game{}, fmt.Errorf("failed to publish event: %w", err)
+	}
+
+	participantsAfter := participantsBefore
+	if game.MaxPlayers != updatedGame.MaxPlayers || game.RegularsFirst != updatedGame.RegularsFirst || game.WaitlistMode != updatedGame.WaitlistMode || game.MaxWaitlistSize != updatedGame.MaxWaitlistSize || game.AllocationMode != updatedGame.AllocationMode {
+		// The capacity changed, giving priority to the regulars reordered the participants, or the spots are now allocated by a draw
+		participantsAfter, err = updateGameSpotsLeft(ctx, querier, updatedGame)
+		if err != nil {
+			return db.Game{}, err
+		}
+		q := spotsQueue(updatedGame, participantsAfter)
+		updatedGame.GameSpotsLeft = q.SpotsLeft
+		updatedGame.WaitlistSpotsLeft = waitlistSpotsLeft(updatedGame, q)
+	}
+
+	// Raising max players moves participants off the waitlist
+	if err := publishPromotions(ctx, querier, updatedGame, now, participantsBefore, game.MaxPlayers, participantsAfter, updatedGame.MaxPlayers, 0); err != nil {
+		return db.Game{}, fmt.Errorf("failed to publish event: %w", err)
+	}
+
+	return updatedGame, nil
+}
+
+// gameFields are the fields validated the same way when a game is created and updated, nil when they aren't set.
+type gameFields struct {
+	Name                        *string
+	Description                 *string
+	DurationMinutes             *int64
+	MaxPlayers                  *int64
+	MaxGuestsPerPlayer          *int64
+	Visibility                  *api.GameVisibility
+	WaitlistOfferMinutes        *int64
+	AllocationMode              *api.GameAllocationMode
+	RegularsHeadStartMinutes    *int64
+	WaitlistMode                *api.GameWaitlistMode
+	MaxWaitlistSize             *int64
+	ReconfirmWithinMinutes      *int64
+	CancellationDeadlineMinutes *int64
+	SplitStrategy               *api.GameSplitStrategy
+	PricePerPlayerCents         *int64
+	GuestPriceCents             *int64
+}
+
+func createGameFields(req api.CreateGameRequest) gameFields {
+	return gameFields{
+		Name:                        &req.Name,
+		Description:                 req.Description,
+		DurationMinutes:             req.DurationMinutes,
+		MaxPlayers:                  req.MaxPlayers,
+		MaxGuestsPerPlayer:          req.MaxGuestsPerPlayer,
+		Visibility:                  req.Visibility,
+		WaitlistOfferMinutes:        req.WaitlistOfferMinutes,
+		AllocationMode:              req.AllocationMode,
+		RegularsHeadStartMinutes:    req.RegularsHeadStartMinutes,
+		WaitlistMode:                req.WaitlistMode,
+		MaxWaitlistSize:             req.MaxWaitlistSize,
+		ReconfirmWithinMinutes:      req.ReconfirmWithinMinutes,
+		CancellationDeadlineMinutes: req.CancellationDeadlineMinutes,
+		SplitStrategy:               req.SplitStrategy,
+		PricePerPlayerCents:         req.PricePerPlayerCents,
+		GuestPriceCents:             req.GuestPriceCents,
+	}
+}
+
+func updateGameFields(req api.UpdateGameRequest) gameFields {
+	return gameFields{
+		Name:                        req.Name,
+		Description:                 req.Description,
+		DurationMinutes:             req.DurationMinutes,
+		MaxPlayers:                  req.MaxPlayers,
+		MaxGuestsPerPlayer:          req.MaxGuestsPerPlayer,
+		Visibility:                  req.Visibility,
+		WaitlistOfferMinutes:        req.WaitlistOfferMinutes,
+		AllocationMode:              req.AllocationMode,
+		RegularsHeadStartMinutes:    req.RegularsHeadStartMinutes,
+		WaitlistMode:                req.WaitlistMode,
+		MaxWaitlistSize:             req.MaxWaitlistSize,
+		ReconfirmWithinMinutes:      req.ReconfirmWithinMinutes,
+		CancellationDeadlineMinutes: req.CancellationDeadlineMinutes,
+		SplitStrategy:               req.SplitStrategy,
+		PricePerPlayerCents:         req.PricePerPlayerCents,
+		GuestPriceCents:             req.GuestPriceCents,
+	}
+}
+
+// validateGameFields returns why the fields set aren't valid, the checks depending on the game are left to the handlers.
+// Updates are validated like creations, so a zero duration or no players is refused instead of keeping the current value.
+func validateGameFields(fields gameFields) error {
+	negative := func(value *int64) bool {
+		return value != nil && *value < 0
+	}
+
+	switch {
+	case fields.Name != nil && len(*fields.Name) == 0:
+		return errors.New("name cannot be empty")
+	case fields.Name != nil && len(*fields.Name) > 100:
+		return errors.New("name cannot exceed 100 characters")
+	case fields.Description != nil && len(*fields.Description) > 1000:
+		return errors.New("description cannot exceed 1000 characters")
+	case fields.DurationMinutes != nil && *fields.DurationMinutes <= 0:
+		return errors.New("duration must be positive")
+	case fields.MaxPlayers != nil && *fields.MaxPlayers < 1:
+		return errors.New("maxPlayers must be at least 1")
+	case negative(fields.MaxGuestsPerPlayer):
+		return errors.New("maxGuestsPerPlayer cannot be negative")
+	case fields.Visibility != nil && *fields.Visibility != api.Public && *fields.Visibility != api.Private:
+		return errors.New("visibility must be public or private")
+	case negative(fields.WaitlistOfferMinutes):
+		return errors.New("waitlistOfferMinutes cannot be negative")
+	case fields.AllocationMode != nil && *fields.AllocationMode != api.FirstComeFirstServed && *fields.AllocationMode != api.Lottery:
+		return errors.New("allocationMode must be first_come_first_served or lottery")
+	case negative(fields.RegularsHeadStartMinutes):
+		return errors.New("regularsHeadStartMinutes cannot be negative")
+	case fields.WaitlistMode != nil && *fields.WaitlistMode != api.Disabled && *fields.WaitlistMode != api.Fixed && *fields.WaitlistMode != api.Unlimited:
+		return errors.New("waitlistMode must be disabled, fixed or unlimited")
+	case negative(fields.MaxWaitlistSize):
+		return errors.New("maxWaitlistSize cannot be negative")
+	case negative(fields.ReconfirmWithinMinutes):
+		return errors.New("reconfirmWithinMinutes cannot be negative")
+	case negative(fields.CancellationDeadlineMinutes):
+		return errors.New("cancellationDeadlineMinutes cannot be negative")
+	case fields.SplitStrategy != nil && *fields.SplitStrategy != api.Even && *fields.SplitStrategy != api.PerPlayer && *fields.SplitStrategy != api.PerPlayerGuestRate && *fields.SplitStrategy != api.OrganizerExempt:
+		return errors.New("splitStrategy must be even, per_player, per_player_guest_rate or organizer_exempt")
+	case negative(fields.PricePerPlayerCents):
+		return errors.New("pricePerPlayerCents cannot be negative")
+	case negative(fields.GuestPriceCents):
+		return errors.New("guestPriceCents cannot be negative")
+	}
+	return nil
+}
+
+// frozenFieldsIn returns the API names of the fields set in the update that can't change once the game is frozen,
+// its participants were billed for them. Only the description and frozenAt can change then.
+func frozenFieldsIn(req api.UpdateGameRequest) []string {
+	set := []struct {
+		name string
+		set  bool
+	}{
+		{"name", req.Name != nil},
+		{"publishedAt", req.PublishedAt.IsSpecified()},
+		{"totalPriceCents", req.TotalPriceCents != nil},
+		{"location", req.Location != nil},
+		{"startsAt", req.StartsAt != nil},
+		{"durationMinutes", req.DurationMinutes != nil},
+		{"maxPlayers", req.MaxPlayers != nil},
+		{"maxGuestsPerPlayer", req.MaxGuestsPerPlayer != nil},
+		{"gameSpotsLeft", req.GameSpotsLeft != nil},
+		{"visibility", req.Visibility != nil},
+		{"waitlistOfferMinutes", req.WaitlistOfferMinutes != nil},
+		{"allocationMode", req.AllocationMode != nil},
+		{"registrationClosesAt", req.RegistrationClosesAt != nil},
+		{"regularsHeadStartMinutes", req.RegularsHeadStartMinutes != nil},
+		{"regularsFirst", req.RegularsFirst != nil},
+		{"waitlistMode", req.WaitlistMode != nil},
+		{"maxWaitlistSize", req.MaxWaitlistSize != nil},
+		{"reconfirmWithinMinutes", req.ReconfirmWithinMinutes != nil},
+		{"cancellationDeadlineMinutes", req.CancellationDeadlineMinutes != nil},
+		{"billLateCancellations", req.BillLateCancellations != nil},
+		{"splitStrategy", req.SplitStrategy != nil},
+		{"pricePerPlayerCents", req.PricePerPlayerCents != nil},
+		{"guestPriceCents", req.GuestPriceCents != nil},
+	}
+
+	var fields []string
+	for _, field := range set {
+		if field.set {
+			fields = append(fields, field.name)
+		}
+	}
+	return fields
+}
@@ -1232,7 +1232,7 @@ func TestGetApiGamesId_Success(t *testing.T) {
 	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(testUserID)}))
 	w = httptest.NewRecorder()
 
-	srv.GetApiGamesId(w, r, gameID)
+	srv.GetApiGamesId(w, r, gameID, api.GetApiGamesIdParams{})
 
 	if w.Code != http.StatusOK {
 		t.Errorf("Expected status %d, got %d. Body: %s", http.StatusOK, w.Code, w.Body.String())
@@ -1284,7 +1284,7 @@ func TestGetApiGamesId_NotFound(t *testing.T) {
 	r := httptest.NewRequest(http.MethodGet, "/api/games/nonexistent", nil)
 	w := httptest.NewRecorder()
 
-	srv.GetApiGamesId(w, r, "nonexistent")
+	srv.GetApiGamesId(w, r, "nonexistent", api.GetApiGamesIdParams{})
 
 	if w.Code != http.StatusNotFound {
 		t.Errorf("Expected status %d, got %d", http.StatusNotFound, w.Code)
@@ -1313,7 +1313,7 @@ func TestGetApiGamesId_DraftHiddenFromNonOrganizer(t *testing.T) {
 	// Unauthenticated user should not see draft
 	r = httptest.NewRequest(http.MethodGet, "/api/games/"+created.Game.Id, nil)
 	w = httptest.NewRecorder()
-	srv.GetApiGamesId(w, r, created.Game.Id)
+	srv.GetApiGamesId(w, r, created.Game.Id, api.GetApiGamesIdParams{})
 	if w.Code != http.StatusNotFound {
 		t.Fatalf("expected 404 for draft game, got %d", w.Code)
 	}
@@ -1327,7 +1327,7 @@ func TestGetApiGamesId_DraftHiddenFromNonOrganizer(t *testing.T) {
 	r = httptest.NewRequest(http.MethodGet, "/api/games/"+created.Game.Id, nil)
 	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(otherID)}))
 	w = httptest.NewRecorder()
-	srv.GetApiGamesId(w, r, created.Game.Id)
+	srv.GetApiGamesId(w, r, created.Game.Id, api.GetApiGamesIdParams{})
 	if w.Code != http.StatusNotFound {
 		t.Fatalf("expected 404 for draft game to other user, got %d", w.Code)
 	}
@@ -1336,7 +1336,7 @@ func TestGetApiGamesId_DraftHiddenFromNonOrganizer(t *testing.T) {
 	r = httptest.NewRequest(http.MethodGet, "/api/games/"+created.Game.Id, nil)
 	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(organizerID)}))
 	w = httptest.NewRecorder()
-	srv.GetApiGamesId(w, r, created.Game.Id)
+	srv.GetApiGamesId(w, r, created.Game.Id, api.GetApiGamesIdParams{})
 	if w.Code != http.StatusOK {
 		t.Fatalf("expected 200 for organizer viewing draft, got %d", w.Code)
 	}
@@ -1381,7 +1381,7 @@ func TestGetApiGamesId_ScheduledHiddenUntilPublished(t *testing.T) {
 	r = httptest.NewRequest(http.MethodGet, "/api/games/"+created.Game.Id, nil)
 	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(viewerID)}))
 	w = httptest.NewRecorder()
-	srv.GetApiGamesId(w, r, created.Game.Id)
+	srv.GetApiGamesId(w, r, created.Game.Id, api.GetApiGamesIdParams{})
 	if w.Code != http.StatusNotFound {
 		t.Fatalf("expected 404 for scheduled game before publish time, got %d", w.Code)
 	}
@@ -1390,7 +1390,7 @@ func TestGetApiGamesId_ScheduledHiddenUntilPublished(t *testing.T) {
 	r = httptest.NewRequest(http.MethodGet, "/api/games/"+created.Game.Id, nil)
 	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(organizerID)}))
 	w = httptest.NewRecorder()
-	srv.GetApiGamesId(w, r, created.Game.Id)
+	srv.GetApiGamesId(w, r, created.Game.Id, api.GetApiGamesIdParams{})
 	if w.Code != http.StatusOK {
 		t.Fatalf("expected 200 for organizer viewing scheduled game, got %d", w.Code)
 	}
@@ -1738,6 +1738,9 @@ func TestPatchApiGamesId_WhenFrozenOnlyDescriptionAndFrozenAtCanChange(t *testin
 	if w.Code != http.StatusBadRequest {
 		t.Fatalf("expected frozen game name update to fail with 400, got %d", w.Code)
 	}
+	if !strings.Contains(w.Body.String(), "not name") {
+		t.Fatalf("expected the error to name the field, got %q", w.Body.String())
+	}
 
 	newDescription := "Allowed change"
 	updateReq = api.UpdateGameRequest{Description: &newDescription}
@@ -1757,6 +1760,61 @@ func TestPatchApiGamesId_WhenFrozenOnlyDescriptionAndFrozenAtCanChange(t *testin
 	}
 }
 
+func TestPatchApiGamesId_ValidatesFieldsLikeCreation(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	staticClock := clock.StaticClock{Time: time.Now()}
+
+	organizerID := dbtesting.UpsertTestUser(t, sqlDB, "john@example.com")
+	querier := db.New(sqlDB)
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), staticClock, sqlDB)
+	createGame(t, querier, "g1", organizerID, sql.NullTime{})
+
+	// zero used to keep the current value, it's refused like when the game is created
+	for name, req := range map[string]api.UpdateGameRequest{
+		"no players":          {MaxPlayers: ptr.Ptr[int64](0)},
+		"no duration":         {DurationMinutes: ptr.Ptr[int64](0)},
+		"negative guests":     {MaxGuestsPerPlayer: ptr.Ptr[int64](-1)},
+		"negative guest rate": {GuestPriceCents: ptr.Ptr[int64](-1)},
+	} {
+		if code, _ := patchGame(t, srv, "g1", organizerID, req); code != http.StatusBadRequest {
+			t.Errorf("%s: expected status %d, got %d", name, http.StatusBadRequest, code)
+		}
+	}
+}
+
+func TestPatchApiGamesId_FrozenGameLocksSettings(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	staticClock := clock.StaticClock{Time: time.Now()}
+
+	organizerID := dbtesting.UpsertTestUser(t, sqlDB, "john@example.com")
+	querier := db.New(sqlDB)
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), staticClock, sqlDB)
+	createGame(t, querier, "g1", organizerID, sql.NullTime{})
+	if err := querier.GameUpdate(t.Context(), db.GameUpdateParams{
+		ID:       "g1",
+		FrozenAt: sql.NullTime{Time: staticClock.Time.Add(-time.Hour), Valid: true},
+	}); err != nil {
+		t.Fatalf("failed to freeze game: %v", err)
+	}
+
+	for field, req := range map[string]api.UpdateGameRequest{
+		"visibility":            {Visibility: ptr.Ptr(api.Private)},
+		"waitlistOfferMinutes":  {WaitlistOfferMinutes: ptr.Ptr[int64](30)},
+		"billLateCancellations": {BillLateCancellations: ptr.Ptr(true)},
+	} {
+		body, _ := json.Marshal(req)
+		r := httptest.NewRequest(http.MethodPatch, "/api/games/g1", bytes.NewReader(body))
+		r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(organizerID)}))
+		w := httptest.NewRecorder()
+		srv.PatchApiGamesId(w, r, "g1")
+		if w.Code != http.StatusBadRequest || !strings.Contains(w.Body.String(), "not "+field) {
+			t.Errorf("%s: expected status %d naming the field, got %d: %s", field, http.StatusBadRequest, w.Code, w.Body.String())
+		}
+	}
+}
+
 func TestPatchApiGamesId_NameValidation(t *testing.T) {
 	sqlDB := dbtesting.SetupTestDB(t)
 	defer sqlDB.Close()
@@ -1941,7 +1999,7 @@ func TestGetPublicApiGames_PublishedGame(t *testing.T) {
 	r := httptest.NewRequest(http.MethodGet, "/public/api/games/pub123", nil)
 	w := httptest.NewRecorder()
 
-	srv.GetPublicApiGamesId(w, r, "pub123")
+	srv.GetPublicApiGamesId(w, r, "pub123", api.GetPublicApiGamesIdParams{})
 
 	if w.Code != http.StatusOK {
 		t.Errorf("Expected status %d, got %d. Body: %s", http.StatusOK, w.Code, w.Body.String())
@@ -2022,7 +2080,7 @@ func TestGetPublicApiGames_UnpublishedGame(t *testing.T) {
 	r := httptest.NewRequest(http.MethodGet, "/public/api/games/unpub456", nil)
 	w := httptest.NewRecorder()
 
-	srv.GetPublicApiGamesId(w, r, "unpub456")
+	srv.GetPublicApiGamesId(w, r, "unpub456", api.GetPublicApiGamesIdParams{})
 
 	if w.Code != http.StatusOK {
 		t.Errorf("Expected status %d, got %d. Body: %s", http.StatusOK, w.Code, w.Body.String())
@@ -0,0 +1,600 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/dmateusp/opengym/api"
+	"github.com/dmateusp/opengym/auth"
+	"github.com/dmateusp/opengym/db"
+)
+
+// groupInviteCodeLength is long enough for invite codes not to be guessed
+const groupInviteCodeLength = 16
+
+// groupRole returns the role of the user in the group, it's empty if they aren't a member.
+func groupRole(ctx context.Context, querier db.Querier, groupID string, userID int64) (api.GroupMemberRole, error) {
+	role, err := querier.GroupMemberGetRole(ctx, db.GroupMemberGetRoleParams{
+		GroupID: groupID,
+		UserID:  userID,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to retrieve group role: %w", err)
+	}
+	return api.GroupMemberRole(role), nil
+}
+
+// groupForMember retrieves the group and the role of the user in it, writing the error response if the group doesn't exist
+// or the user isn't a member. Groups are hidden from non-members, so both cases are reported as not found.
+func groupForMember(w http.ResponseWriter, r *http.Request, querier db.Querier, id string, userID int64) (db.Group, api.GroupMemberRole, bool) {
+	group, err := querier.GroupGetById(r.Context(), id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			http.Error(w, "group not found", http.StatusNotFound)
+			return db.Group{}, "", false
+		}
+		http.Error(w, fmt.Sprintf("failed to retrieve group: %s", err.Error()), http.StatusInternalServerError)
+		return db.Group{}, "", false
+	}
+
+	role, err := groupRole(r.Context(), querier, id, userID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return db.Group{}, "", false
+	}
+	if role == "" {
+		http.Error(w, "group not found", http.StatusNotFound)
+		return db.Group{}, "", false
+	}
+	return group, role, true
+}
+
+// groupResponse converts the group for the API, the invite link is only shared with admins.
+func groupResponse(group db.Group, role api.GroupMemberRole) (api.Group, error) {
+	var resp api.Group
+	resp.FromDb(group, string(role))
+
+	if role == api.Admin {
+		joinUrl, err := url.JoinPath(*frontendBaseUrl, "groups", "join")
+		if err != nil {
+			return api.Group{}, fmt.Errorf("failed to build invite link: %w", err)
+		}
+		inviteUrl := joinUrl + "?" + url.Values{"code": {group.InviteCode}}.Encode()
+		resp.InviteUrl = &inviteUrl
+	}
+	return resp, nil
+}
+
+func writeGroup(w http.ResponseWriter, group db.Group, role api.GroupMemberRole, code int) {
+	resp, err := groupResponse(group, role)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		http.Error(w, fmt.Sprintf("failed to encode response: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+}
+
+func validateGroupFields(name, description *string, regularsHeadStartMinutes *int64) error {
+	if name != nil {
+		if strings.TrimSpace(*name) == "" {
+			return fmt.Errorf("name is required")
+		}
+		if len(*name) > 100 {
+			return fmt.Errorf("name cannot exceed 100 characters")
+		}
+	}
+	if description != nil && len(*description) > 1000 {
+		return fmt.Errorf("description cannot exceed 1000 characters")
+	}
+	if regularsHeadStartMinutes != nil && *regularsHeadStartMinutes < 0 {
+		return fmt.Errorf("regularsHeadStartMinutes cannot be negative")
+	}
+	return nil
+}
+
+func (s *server) GetApiGroups(w http.ResponseWriter, r *http.Request) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	dbGroups, err := s.querier.GroupListByUser(r.Context(), int64(authInfo.UserId))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to list groups: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	resp := make([]api.Group, 0, len(dbGroups))
+	for _, dbGroup := range dbGroups {
+		group, err := groupResponse(dbGroup.Group, api.GroupMemberRole(dbGroup.Role))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		resp = append(resp, group)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		http.Error(w, fmt.Sprintf("failed to encode response: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (s *server) PostApiGroups(w http.ResponseWriter, r *http.Request) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req api.CreateGroupRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("invalid request body: %s", err.Error()), http.StatusBadRequest)
+		return
+	}
+
+	if err := validateGroupFields(&req.Name, req.Description, req.RegularsHeadStartMinutes); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	params := db.GroupCreateParams{
+		Name: strings.TrimSpace(req.Name),
+	}
+	if req.Description != nil {
+		params.Description = sql.NullString{String: *req.Description, Valid: true}
+	}
+	if req.RegularsHeadStartMinutes != nil {
+		params.RegularsHeadStartMinutes = *req.RegularsHeadStartMinutes
+	}
+	if req.RegularsFirst != nil {
+		params.RegularsFirst = *req.RegularsFirst
+	}
+
+	tx, err := s.dbConn.BeginTx(r.Context(), nil)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to begin transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	querierWithTx := s.querier.WithTx(tx)
+
+	var group db.Group
+	for attempt := range maxGameIDAttempts {
+		params.ID = s.randomAlphanumericGenerator.Generate(*gameIDLength)
+		params.InviteCode = s.randomAlphanumericGenerator.Generate(groupInviteCodeLength)
+
+		group, err = querierWithTx.GroupCreate(r.Context(), params)
+		if err == nil {
+			break
+		}
+
+		if attempt < maxGameIDAttempts-1 && isGroupConstraintError(err) {
+			continue
+		}
+
+		http.Error(w, fmt.Sprintf("failed to create group: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	if _, err := querierWithTx.GroupMemberCreate(r.Context(), db.GroupMemberCreateParams{
+		GroupID: group.ID,
+		UserID:  int64(authInfo.UserId),
+		Role:    string(api.Admin),
+	}); err != nil {
+		http.Error(w, fmt.Sprintf("failed to add group admin: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		http.Error(w, fmt.Sprintf("failed to commit transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	writeGroup(w, group, api.Admin, http.StatusCreated)
+}
+
+func (s *server) PostApiGroupsJoin(w http.ResponseWriter, r *http.Request) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req api.JoinGroupRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("invalid request body: %s", err.Error()), http.StatusBadRequest)
+		return
+	}
+
+	inviteCode := strings.TrimSpace(req.InviteCode)
+	if inviteCode == "" {
+		http.Error(w, "inviteCode is required", http.StatusBadRequest)
+		return
+	}
+
+	group, err := s.querier.GroupGetByInviteCode(r.Context(), inviteCode)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			http.Error(w, "invalid or expired invite link", http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("failed to retrieve group: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	// Members who follow the link again keep their role
+	if _, err := s.querier.GroupMemberCreate(r.Context(), db.GroupMemberCreateParams{
+		GroupID: group.ID,
+		UserID:  int64(authInfo.UserId),
+		Role:    string(api.Member),
+	}); err != nil {
+		http.Error(w, fmt.Sprintf("failed to join group: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	role, err := groupRole(r.Context(), s.querier, group.ID, int64(authInfo.UserId))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	writeGroup(w, group, role, http.StatusOK)
+}
+
+func (s *server) GetApiGroupsId(w http.ResponseWriter, r *http.Request, id string) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	group, role, ok := groupForMember(w, r, s.querier, id, int64(authInfo.UserId))
+	if !ok {
+		return
+	}
+
+	writeGroup(w, group, role, http.StatusOK)
+}
+
+func (s *server) PatchApiGroupsId(w http.ResponseWriter, r *http.Request, id string) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req api.UpdateGroupRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("invalid request body: %s", err.Error()), http.StatusBadRequest)
+		return
+	}
+
+	if err := validateGroupFields(req.Name, req.Description, req.RegularsHeadStartMinutes); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	_, role, ok := groupForMember(w, r, s.querier, id, int64(authInfo.UserId))
+	if !ok {
+		return
+	}
+	if role != api.Admin {
+		http.Error(w, "forbidden: you are not an admin of this group", http.StatusForbidden)
+		return
+	}
+
+	params := db.GroupUpdateParams{ID: id}
+	if req.Name != nil {
+		params.Name = sql.NullString{String: strings.TrimSpace(*req.Name), Valid: true}
+	}
+	if req.Description != nil {
+		params.Description = sql.NullString{String: *req.Description, Valid: true}
+	}
+	if req.RegularsHeadStartMinutes != nil {
+		params.RegularsHeadStartMinutes = sql.NullInt64{Int64: *req.RegularsHeadStartMinutes, Valid: true}
+	}
+	if req.RegularsFirst != nil {
+		params.RegularsFirst = sql.NullBool{Bool: *req.RegularsFirst, Valid: true}
+	}
+
+	if err := s.querier.GroupUpdate(r.Context(), params); err != nil {
+		http.Error(w, fmt.Sprintf("failed to update group: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	group, err := s.querier.GroupGetById(r.Context(), id)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to retrieve group: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	writeGroup(w, group, role, http.StatusOK)
+}
+
+func (s *server) PostApiGroupsIdInviteLink(w http.ResponseWriter, r *http.Request, id string) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	_, role, ok := groupForMember(w, r, s.querier, id, int64(authInfo.UserId))
+	if !ok {
+		return
+	}
+	if role != api.Admin {
+		http.Error(w, "forbidden: you are not an admin of this group", http.StatusForbidden)
+		return
+	}
+
+	for attempt := range maxGameIDAttempts {
+		err := s.querier.GroupUpdateInviteCode(r.Context(), db.GroupUpdateInviteCodeParams{
+			ID:         id,
+			InviteCode: s.randomAlphanumericGenerator.Generate(groupInviteCodeLength),
+		})
+		if err == nil {
+			break
+		}
+
+		if attempt < maxGameIDAttempts-1 && isGroupConstraintError(err) {
+			continue
+		}
+
+		http.Error(w, fmt.Sprintf("failed to reset invite link: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	group, err := s.querier.GroupGetById(r.Context(), id)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to retrieve group: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	writeGroup(w, group, role, http.StatusOK)
+}
+
+func (s *server) GetApiGroupsIdMembers(w http.ResponseWriter, r *http.Request, id string) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	if _, _, ok := groupForMember(w, r, s.querier, id, int64(authInfo.UserId)); !ok {
+		return
+	}
+
+	dbMembers, err := s.querier.GroupMemberListByGroup(r.Context(), id)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to list members: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	resp := make([]api.GroupMember, 0, len(dbMembers))
+	for _, dbMember := range dbMembers {
+		var member api.GroupMember
+		member.FromDb(dbMember.GroupMember, dbMember.User)
+		resp = append(resp, member)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		http.Error(w, fmt.Sprintf("failed to encode response: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (s *server) PutApiGroupsIdMembersUserId(w http.ResponseWriter, r *http.Request, id string, userId string) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req api.UpdateGroupMemberRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("invalid request body: %s", err.Error()), http.StatusBadRequest)
+		return
+	}
+
+	if req.Role != nil && *req.Role != api.Admin && *req.Role != api.Member {
+		http.Error(w, "role must be admin or member", http.StatusBadRequest)
+		return
+	}
+
+	if req.Tier != nil && *req.Tier != api.Regular && *req.Tier != api.DropIn {
+		http.Error(w, "tier must be regular or drop_in", http.StatusBadRequest)
+		return
+	}
+
+	tx, err := s.dbConn.BeginTx(r.Context(), nil)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to begin transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	querierWithTx := s.querier.WithTx(tx)
+
+	_, role, ok := groupForMember(w, r, querierWithTx, id, int64(authInfo.UserId))
+	if !ok {
+		return
+	}
+	if role != api.Admin {
+		http.Error(w, "forbidden: you are not an admin of this group", http.StatusForbidden)
+		return
+	}
+
+	targetUserID, targetRole, ok := groupMemberForUpdate(w, r, querierWithTx, id, userId)
+	if !ok {
+		return
+	}
+
+	if req.Role != nil {
+		if targetRole == api.Admin && *req.Role != api.Admin && !hasOtherAdmin(w, r, querierWithTx, id) {
+			return
+		}
+
+		if _, err := querierWithTx.GroupMemberUpdateRole(r.Context(), db.GroupMemberUpdateRoleParams{
+			GroupID: id,
+			UserID:  targetUserID,
+			Role:    string(*req.Role),
+		}); err != nil {
+			http.Error(w, fmt.Sprintf("failed to change role: %s", err.Error()), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	if req.Tier != nil {
+		if _, err := querierWithTx.GroupMemberUpdateTier(r.Context(), db.GroupMemberUpdateTierParams{
+			GroupID: id,
+			UserID:  targetUserID,
+			Tier:    string(*req.Tier),
+		}); err != nil {
+			http.Error(w, fmt.Sprintf("failed to change tier: %s", err.Error()), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	dbMembers, err := querierWithTx.GroupMemberListByGroup(r.Context(), id)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to list members: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		http.Error(w, fmt.Sprintf("failed to commit transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	var resp api.GroupMember
+	for _, dbMember := range dbMembers {
+		if dbMember.GroupMember.UserID == targetUserID {
+			resp.FromDb(dbMember.GroupMember, dbMember.User)
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		http.Error(w, fmt.Sprintf("failed to encode response: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (s *server) DeleteApiGroupsIdMembersUserId(w http.ResponseWriter, r *http.Request, id string, userId string) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	tx, err := s.dbConn.BeginTx(r.Context(), nil)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to begin transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	querierWithTx := s.querier.WithTx(tx)
+
+	_, role, ok := groupForMember(w, r, querierWithTx, id, int64(authInfo.UserId))
+	if !ok {
+		return
+	}
+
+	targetUserID, targetRole, ok := groupMemberForUpdate(w, r, querierWithTx, id, userId)
+	if !ok {
+		return
+	}
+
+	// Members can leave the group, only admins can remove others
+	if targetUserID != int64(authInfo.UserId) && role != api.Admin {
+		http.Error(w, "forbidden: you are not an admin of this group", http.StatusForbidden)
+		return
+	}
+
+	if targetRole == api.Admin && !hasOtherAdmin(w, r, querierWithTx, id) {
+		return
+	}
+
+	if _, err := querierWithTx.GroupMemberDelete(r.Context(), db.GroupMemberDeleteParams{
+		GroupID: id,
+		UserID:  targetUserID,
+	}); err != nil {
+		http.Error(w, fmt.Sprintf("failed to remove member: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		http.Error(w, fmt.Sprintf("failed to commit transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// groupMemberForUpdate parses the user ID of the member and retrieves their role, writing the error response if they aren't a member.
+func groupMemberForUpdate(w http.ResponseWriter, r *http.Request, querier db.Querier, groupID string, userId string) (int64, api.GroupMemberRole, bool) {
+	targetUserID, err := strconv.ParseInt(userId, 10, 64)
+	if err != nil {
+		http.Error(w, "member not found", http.StatusNotFound)
+		return 0, "", false
+	}
+
+	targetRole, err := groupRole(r.Context(), querier, groupID, targetUserID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return 0, "", false
+	}
+	if targetRole == "" {
+		http.Error(w, "member not found", http.StatusNotFound)
+		return 0, "", false
+	}
+	return targetUserID, targetRole, true
+}
+
+// hasOtherAdmin checks that an admin is left when one of them steps down, groups always keep at least one admin.
+func hasOtherAdmin(w http.ResponseWriter, r *http.Request, querier db.Querier, groupID string) bool {
+	admins, err := querier.GroupMemberCountByRole(r.Context(), db.GroupMemberCountByRoleParams{
+		GroupID: groupID,
+		Role:    string(api.Admin),
+	})
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to count admins: %s", err.Error()), http.StatusInternalServerError)
+		return false
+	}
+	if admins <= 1 {
+		http.Error(w, "the last admin of the group can't step down, make another member admin first", http.StatusBadRequest)
+		return false
+	}
+	return true
+}
+
+func isGroupConstraintError(err error) bool {
+	if err == nil {
+		return false
+	}
+	return strings.Contains(err.Error(), "UNIQUE constraint failed: groups.")
+}
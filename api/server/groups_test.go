@@ -0,0 +1,307 @@
+package server_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/dmateusp/opengym/api"
+	"github.com/dmateusp/opengym/api/server"
+	"github.com/dmateusp/opengym/auth"
+	"github.com/dmateusp/opengym/clock"
+	"github.com/dmateusp/opengym/db"
+	dbtesting "github.com/dmateusp/opengym/db/testing"
+	"github.com/dmateusp/opengym/ptr"
+)
+
+func createGroup(t *testing.T, srv api.ServerInterface, userID int64, name string) api.Group {
+	t.Helper()
+
+	body, _ := json.Marshal(api.CreateGroupRequest{Name: name})
+	r := httptest.NewRequest(http.MethodPost, "/api/groups", bytes.NewReader(body))
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(userID)}))
+	w := httptest.NewRecorder()
+	srv.PostApiGroups(w, r)
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusCreated, w.Code, w.Body.String())
+	}
+
+	var group api.Group
+	if err := json.NewDecoder(w.Body).Decode(&group); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	return group
+}
+
+func joinGroup(t *testing.T, srv api.ServerInterface, userID int64, inviteCode string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	body, _ := json.Marshal(api.JoinGroupRequest{InviteCode: inviteCode})
+	r := httptest.NewRequest(http.MethodPost, "/api/groups/join", bytes.NewReader(body))
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(userID)}))
+	w := httptest.NewRecorder()
+	srv.PostApiGroupsJoin(w, r)
+	return w
+}
+
+func inviteCode(t *testing.T, group api.Group) string {
+	t.Helper()
+
+	if group.InviteUrl == nil {
+		t.Fatalf("expected an invite link for the admin, got %+v", group)
+	}
+	u, err := url.Parse(*group.InviteUrl)
+	if err != nil {
+		t.Fatalf("failed to parse invite link: %v", err)
+	}
+	return u.Query().Get("code")
+}
+
+func TestGroups_InviteLinkAndMembers(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	staticClock := clock.StaticClock{Time: time.Now()}
+
+	adminID := dbtesting.UpsertTestUser(t, sqlDB, "admin@example.com")
+	memberID := dbtesting.UpsertTestUser(t, sqlDB, "member@example.com")
+	outsiderID := dbtesting.UpsertTestUser(t, sqlDB, "outsider@example.com")
+
+	querier := db.New(sqlDB)
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), staticClock, sqlDB)
+
+	group := createGroup(t, srv, adminID, "Tuesday Volleyball")
+	if group.Role != api.Admin {
+		t.Fatalf("expected the creator to be admin, got %q", group.Role)
+	}
+
+	if w := joinGroup(t, srv, memberID, "wrong-code"); w.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+	w := joinGroup(t, srv, memberID, inviteCode(t, group))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	var joined api.Group
+	if err := json.NewDecoder(w.Body).Decode(&joined); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if joined.Role != api.Member || joined.InviteUrl != nil {
+		t.Fatalf("expected a member without the invite link, got %+v", joined)
+	}
+
+	getGroup := func(userID int64) int {
+		r := httptest.NewRequest(http.MethodGet, "/api/groups/"+group.Id, nil)
+		r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(userID)}))
+		w := httptest.NewRecorder()
+		srv.GetApiGroupsId(w, r, group.Id)
+		return w.Code
+	}
+	if code := getGroup(memberID); code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, code)
+	}
+	if code := getGroup(outsiderID); code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, code)
+	}
+
+	// resetting the link invalidates the previous one
+	r := httptest.NewRequest(http.MethodPost, "/", nil)
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(memberID)}))
+	w = httptest.NewRecorder()
+	srv.PostApiGroupsIdInviteLink(w, r, group.Id)
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("expected status %d, got %d", http.StatusForbidden, w.Code)
+	}
+
+	r = httptest.NewRequest(http.MethodPost, "/", nil)
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(adminID)}))
+	w = httptest.NewRecorder()
+	srv.PostApiGroupsIdInviteLink(w, r, group.Id)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	var reset api.Group
+	if err := json.NewDecoder(w.Body).Decode(&reset); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if w := joinGroup(t, srv, outsiderID, inviteCode(t, group)); w.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+	if w := joinGroup(t, srv, outsiderID, inviteCode(t, reset)); w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+
+	r = httptest.NewRequest(http.MethodGet, "/", nil)
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(memberID)}))
+	w = httptest.NewRecorder()
+	srv.GetApiGroupsIdMembers(w, r, group.Id)
+	var members []api.GroupMember
+	if err := json.NewDecoder(w.Body).Decode(&members); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(members) != 3 {
+		t.Fatalf("expected 3 members, got %+v", members)
+	}
+}
+
+func TestGroups_LastAdminCannotStepDown(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	staticClock := clock.StaticClock{Time: time.Now()}
+
+	adminID := dbtesting.UpsertTestUser(t, sqlDB, "admin@example.com")
+	memberID := dbtesting.UpsertTestUser(t, sqlDB, "member@example.com")
+
+	querier := db.New(sqlDB)
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), staticClock, sqlDB)
+
+	group := createGroup(t, srv, adminID, "Tuesday Volleyball")
+	if w := joinGroup(t, srv, memberID, inviteCode(t, group)); w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+
+	setRole := func(userID, targetUserID int64, role api.GroupMemberRole) int {
+		body, _ := json.Marshal(api.UpdateGroupMemberRequest{Role: &role})
+		r := httptest.NewRequest(http.MethodPut, "/", bytes.NewReader(body))
+		r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(userID)}))
+		w := httptest.NewRecorder()
+		srv.PutApiGroupsIdMembersUserId(w, r, group.Id, strconv.FormatInt(targetUserID, 10))
+		return w.Code
+	}
+	remove := func(userID, targetUserID int64) int {
+		r := httptest.NewRequest(http.MethodDelete, "/", nil)
+		r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(userID)}))
+		w := httptest.NewRecorder()
+		srv.DeleteApiGroupsIdMembersUserId(w, r, group.Id, strconv.FormatInt(targetUserID, 10))
+		return w.Code
+	}
+
+	for _, tt := range []struct {
+		name     string
+		code     func() int
+		wantCode int
+	}{
+		{"member promotes themselves", func() int { return setRole(memberID, memberID, api.Admin) }, http.StatusForbidden},
+		{"member removes the admin", func() int { return remove(memberID, adminID) }, http.StatusForbidden},
+		{"last admin steps down", func() int { return setRole(adminID, adminID, api.Member) }, http.StatusBadRequest},
+		{"last admin leaves", func() int { return remove(adminID, adminID) }, http.StatusBadRequest},
+		{"admin promotes the member", func() int { return setRole(adminID, memberID, api.Admin) }, http.StatusOK},
+		{"former last admin leaves", func() int { return remove(adminID, adminID) }, http.StatusNoContent},
+		{"removed admin is gone", func() int { return remove(memberID, adminID) }, http.StatusNotFound},
+	} {
+		if code := tt.code(); code != tt.wantCode {
+			t.Fatalf("%s: expected status %d, got %d", tt.name, tt.wantCode, code)
+		}
+	}
+}
+
+func TestGroups_GamesAreOnlyVisibleToMembers(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	staticClock := clock.StaticClock{Time: time.Now()}
+
+	adminID := dbtesting.UpsertTestUser(t, sqlDB, "admin@example.com")
+	memberID := dbtesting.UpsertTestUser(t, sqlDB, "member@example.com")
+	outsiderID := dbtesting.UpsertTestUser(t, sqlDB, "outsider@example.com")
+
+	querier := db.New(sqlDB)
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), staticClock, sqlDB)
+
+	group := createGroup(t, srv, adminID, "Tuesday Volleyball")
+	if w := joinGroup(t, srv, memberID, inviteCode(t, group)); w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+
+	createGroupGame := func(userID int64, name string) *httptest.ResponseRecorder {
+		body, _ := json.Marshal(api.CreateGameRequest{Name: name, GroupId: ptr.Ptr(group.Id)})
+		r := httptest.NewRequest(http.MethodPost, "/api/games", bytes.NewReader(body))
+		r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(userID)}))
+		w := httptest.NewRecorder()
+		srv.PostApiGames(w, r)
+		return w
+	}
+
+	if w := createGroupGame(outsiderID, "Intruder"); w.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+
+	gameIDs := make(map[string]string)
+	for _, name := range []string{"upcoming", "later", "past", "draft"} {
+		w := createGroupGame(adminID, name)
+		if w.Code != http.StatusCreated {
+			t.Fatalf("expected status %d, got %d: %s", http.StatusCreated, w.Code, w.Body.String())
+		}
+		var game api.GameDetail
+		if err := json.NewDecoder(w.Body).Decode(&game); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		gameIDs[name] = game.Game.Id
+	}
+
+	// the API writes the timestamps of games in UTC
+	now := staticClock.Time.UTC()
+	for name, startsAt := range map[string]time.Time{"upcoming": now.Add(24 * time.Hour), "past": now.Add(-24 * time.Hour)} {
+		if _, err := sqlDB.Exec(`update games set published_at = ?, starts_at = ? where id = ?`, now.Add(-48*time.Hour), startsAt, gameIDs[name]); err != nil {
+			t.Fatalf("failed to publish game: %v", err)
+		}
+	}
+	if _, err := sqlDB.Exec(`update games set published_at = ?, starts_at = ? where id = ?`, now.Add(-47*time.Hour), now.Add(48*time.Hour), gameIDs["later"]); err != nil {
+		t.Fatalf("failed to publish game: %v", err)
+	}
+
+	getGame := func(userID int64) int {
+		r := httptest.NewRequest(http.MethodGet, "/api/games/"+gameIDs["upcoming"], nil)
+		r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(userID)}))
+		w := httptest.NewRecorder()
+		srv.GetApiGamesId(w, r, gameIDs["upcoming"], api.GetApiGamesIdParams{})
+		return w.Code
+	}
+	if code := getGame(memberID); code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, code)
+	}
+	if code := getGame(outsiderID); code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, code)
+	}
+
+	r := httptest.NewRequest(http.MethodGet, "/api/public/games/"+gameIDs["upcoming"], nil)
+	w := httptest.NewRecorder()
+	srv.GetPublicApiGamesId(w, r, gameIDs["upcoming"], api.GetPublicApiGamesIdParams{})
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+
+	// members find the upcoming games of their groups in their games
+	listGames := func(params api.GetApiGamesParams) api.GameListResponse {
+		r := httptest.NewRequest(http.MethodGet, "/api/games", nil)
+		r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(memberID)}))
+		w := httptest.NewRecorder()
+		srv.GetApiGames(w, r, params)
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+		var games api.GameListResponse
+		if err := json.NewDecoder(w.Body).Decode(&games); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		return games
+	}
+
+	games := listGames(api.GetApiGamesParams{})
+	if games.Total != 2 || len(games.Items) != 2 || games.Items[0].Id != gameIDs["later"] || games.Items[1].Id != gameIDs["upcoming"] {
+		t.Fatalf("expected only the upcoming group games, got %+v", games)
+	}
+	if games.Items[1].GroupId == nil || *games.Items[1].GroupId != group.Id {
+		t.Fatalf("expected the group of the game, got %+v", games.Items[1])
+	}
+
+	games = listGames(api.GetApiGamesParams{Page: ptr.Ptr(2), PageSize: ptr.Ptr(1)})
+	if games.Total != 2 || len(games.Items) != 1 || games.Items[0].Id != gameIDs["upcoming"] {
+		t.Fatalf("expected the second upcoming group game on the second page, got %+v", games)
+	}
+}
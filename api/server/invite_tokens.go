@@ -0,0 +1,235 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/dmateusp/opengym/api"
+	"github.com/dmateusp/opengym/auth"
+	"github.com/dmateusp/opengym/db"
+)
+
+// activeInviteToken returns the invite token of the game matching the token from the share link.
+// It reports false when the token is missing, revoked or from another game.
+func activeInviteToken(ctx context.Context, querier db.Querier, gameID string, token *string) (db.GameInviteToken, bool, error) {
+	if token == nil || *token == "" {
+		return db.GameInviteToken{}, false, nil
+	}
+
+	inviteToken, err := querier.GameInviteTokenGetActive(ctx, db.GameInviteTokenGetActiveParams{
+		GameID: gameID,
+		Token:  *token,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return db.GameInviteToken{}, false, nil
+		}
+		return db.GameInviteToken{}, false, fmt.Errorf("failed to retrieve invite token: %w", err)
+	}
+	return inviteToken, true, nil
+}
+
+// createInviteToken revokes the active invite tokens of the game and creates a new one.
+func (s *server) createInviteToken(ctx context.Context, querier db.Querier, gameID string) (db.GameInviteToken, error) {
+	if err := querier.GameInviteTokenRevokeAll(ctx, db.GameInviteTokenRevokeAllParams{
+		GameID:    gameID,
+		RevokedAt: sql.NullTime{Time: s.clock.Now(), Valid: true},
+	}); err != nil {
+		return db.GameInviteToken{}, fmt.Errorf("failed to revoke invite tokens: %w", err)
+	}
+
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return db.GameInviteToken{}, fmt.Errorf("failed to generate invite token: %w", err)
+	}
+
+	inviteToken, err := querier.GameInviteTokenCreate(ctx, db.GameInviteTokenCreateParams{
+		GameID: gameID,
+		Token:  base64.RawURLEncoding.EncodeToString(b),
+	})
+	if err != nil {
+		return db.GameInviteToken{}, fmt.Errorf("failed to create invite token: %w", err)
+	}
+	return inviteToken, nil
+}
+
+func inviteTokenResponse(inviteToken db.GameInviteToken, participants []api.User) (api.GameInviteToken, error) {
+	gameUrl, err := url.JoinPath(*frontendBaseUrl, "games", inviteToken.GameID)
+	if err != nil {
+		return api.GameInviteToken{}, fmt.Errorf("failed to build share link: %w", err)
+	}
+
+	resp := api.GameInviteToken{
+		Id:           inviteToken.ID,
+		Url:          gameUrl + "?" + url.Values{"inviteToken": {inviteToken.Token}}.Encode(),
+		CreatedAt:    inviteToken.CreatedAt,
+		Participants: participants,
+	}
+	if inviteToken.RevokedAt.Valid {
+		t := inviteToken.RevokedAt.Time
+		resp.RevokedAt = &t
+	}
+	return resp, nil
+}
+
+func (s *server) GetApiGamesIdInviteTokens(w http.ResponseWriter, r *http.Request, id string) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	game, err := s.querier.GameGetById(r.Context(), id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			http.Error(w, "game not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("failed to retrieve game: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	if !authorize(w, r, s.querier, game, int64(authInfo.UserId), permissionManage) {
+		return
+	}
+
+	inviteTokens, err := s.querier.GameInviteTokenListByGame(r.Context(), id)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to list invite tokens: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	participantRows, err := s.querier.GameInviteTokenParticipantsList(r.Context(), id)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to list participants: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	participantsByToken := make(map[int64][]api.User)
+	for _, row := range participantRows {
+		var user api.User
+		user.FromDb(row.User)
+		participantsByToken[row.InviteTokenID] = append(participantsByToken[row.InviteTokenID], user)
+	}
+
+	resp := make([]api.GameInviteToken, 0, len(inviteTokens))
+	for _, inviteToken := range inviteTokens {
+		participants := participantsByToken[inviteToken.ID]
+		if participants == nil {
+			participants = []api.User{}
+		}
+
+		item, err := inviteTokenResponse(inviteToken, participants)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		resp = append(resp, item)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		http.Error(w, fmt.Sprintf("failed to encode response: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (s *server) PostApiGamesIdInviteTokens(w http.ResponseWriter, r *http.Request, id string) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	tx, err := s.dbConn.BeginTx(r.Context(), nil)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to begin transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	querierWithTx := s.querier.WithTx(tx)
+
+	game, err := querierWithTx.GameGetById(r.Context(), id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			http.Error(w, "game not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("failed to retrieve game: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	if !authorize(w, r, querierWithTx, game, int64(authInfo.UserId), permissionManage) {
+		return
+	}
+
+	inviteToken, err := s.createInviteToken(r.Context(), querierWithTx, id)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		http.Error(w, fmt.Sprintf("failed to commit transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	resp, err := inviteTokenResponse(inviteToken, []api.User{})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		http.Error(w, fmt.Sprintf("failed to encode response: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (s *server) DeleteApiGamesIdInviteTokensTokenId(w http.ResponseWriter, r *http.Request, id string, tokenId int64) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	game, err := s.querier.GameGetById(r.Context(), id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			http.Error(w, "game not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("failed to retrieve game: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	if !authorize(w, r, s.querier, game, int64(authInfo.UserId), permissionManage) {
+		return
+	}
+
+	rowsAffected, err := s.querier.GameInviteTokenRevoke(r.Context(), db.GameInviteTokenRevokeParams{
+		GameID:    id,
+		ID:        tokenId,
+		RevokedAt: sql.NullTime{Time: s.clock.Now(), Valid: true},
+	})
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to revoke invite token: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+	if rowsAffected == 0 {
+		http.Error(w, "invite token not found", http.StatusNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,166 @@
+package server_test
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/dmateusp/opengym/api"
+	"github.com/dmateusp/opengym/api/server"
+	"github.com/dmateusp/opengym/auth"
+	"github.com/dmateusp/opengym/clock"
+	"github.com/dmateusp/opengym/db"
+	dbtesting "github.com/dmateusp/opengym/db/testing"
+	"github.com/dmateusp/opengym/ptr"
+)
+
+func rotateInviteToken(t *testing.T, srv api.ServerInterface, gameID string, userID int64) string {
+	t.Helper()
+
+	r := httptest.NewRequest(http.MethodPost, "/api/games/"+gameID+"/invite-tokens", nil)
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(userID)}))
+	w := httptest.NewRecorder()
+	srv.PostApiGamesIdInviteTokens(w, r, gameID)
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusCreated, w.Code, w.Body.String())
+	}
+
+	var inviteToken api.GameInviteToken
+	if err := json.NewDecoder(w.Body).Decode(&inviteToken); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	u, err := url.Parse(inviteToken.Url)
+	if err != nil {
+		t.Fatalf("failed to parse share link: %v", err)
+	}
+	return u.Query().Get("inviteToken")
+}
+
+func TestInviteTokens_PrivateGames(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	staticClock := clock.StaticClock{Time: time.Now()}
+
+	organizerID := dbtesting.UpsertTestUser(t, sqlDB, "organizer@example.com")
+	invitedID := dbtesting.UpsertTestUser(t, sqlDB, "invited@example.com")
+	lateID := dbtesting.UpsertTestUser(t, sqlDB, "late@example.com")
+
+	querier := db.New(sqlDB)
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), staticClock, sqlDB)
+	createGame(t, querier, "g1", organizerID, sql.NullTime{Time: staticClock.Time.Add(-time.Hour), Valid: true})
+
+	body, _ := json.Marshal(api.UpdateGameRequest{Visibility: ptr.Ptr(api.Private)})
+	r := httptest.NewRequest(http.MethodPatch, "/api/games/g1", bytes.NewReader(body))
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(organizerID)}))
+	w := httptest.NewRecorder()
+	srv.PatchApiGamesId(w, r, "g1")
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+
+	getPublicGame := func(inviteToken *string) int {
+		r := httptest.NewRequest(http.MethodGet, "/public/api/games/g1", nil)
+		w := httptest.NewRecorder()
+		srv.GetPublicApiGamesId(w, r, "g1", api.GetPublicApiGamesIdParams{InviteToken: inviteToken})
+		return w.Code
+	}
+	getGame := func(userID int64, inviteToken *string) int {
+		r := httptest.NewRequest(http.MethodGet, "/api/games/g1", nil)
+		r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(userID)}))
+		w := httptest.NewRecorder()
+		srv.GetApiGamesId(w, r, "g1", api.GetApiGamesIdParams{InviteToken: inviteToken})
+		return w.Code
+	}
+	join := func(userID int64, inviteToken *string) int {
+		body, _ := json.Marshal(api.UpdateGameParticipationRequest{Status: api.Going, InviteToken: inviteToken})
+		r := httptest.NewRequest(http.MethodPut, "/api/games/g1/participants", bytes.NewReader(body))
+		r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(userID)}))
+		w := httptest.NewRecorder()
+		srv.PutApiGamesIdParticipants(w, r, "g1")
+		return w.Code
+	}
+
+	token := rotateInviteToken(t, srv, "g1", organizerID)
+
+	for _, tt := range []struct {
+		name     string
+		code     int
+		wantCode int
+	}{
+		{"public teaser without token", getPublicGame(nil), http.StatusNotFound},
+		{"public teaser with wrong token", getPublicGame(ptr.Ptr("wrong")), http.StatusNotFound},
+		{"game without token", getGame(invitedID, nil), http.StatusNotFound},
+		{"join without token", join(invitedID, nil), http.StatusNotFound},
+		{"public teaser with token", getPublicGame(&token), http.StatusOK},
+		{"game with token", getGame(invitedID, &token), http.StatusOK},
+		{"join with token", join(invitedID, &token), http.StatusOK},
+	} {
+		if tt.code != tt.wantCode {
+			t.Fatalf("%s: expected status %d, got %d", tt.name, tt.wantCode, tt.code)
+		}
+	}
+
+	// rotating the token locks out new users, participants keep their spot
+	newToken := rotateInviteToken(t, srv, "g1", organizerID)
+	if code := join(lateID, &token); code != http.StatusNotFound {
+		t.Fatalf("expected status %d with the revoked token, got %d", http.StatusNotFound, code)
+	}
+	if code := getGame(invitedID, nil); code != http.StatusOK {
+		t.Fatalf("expected the participant to see the game, got status %d", code)
+	}
+	if code := join(lateID, &newToken); code != http.StatusOK {
+		t.Fatalf("expected status %d with the new token, got %d", http.StatusOK, code)
+	}
+
+	r = httptest.NewRequest(http.MethodGet, "/api/games/g1/invite-tokens", nil)
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(organizerID)}))
+	w = httptest.NewRecorder()
+	srv.GetApiGamesIdInviteTokens(w, r, "g1")
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	var inviteTokens []api.GameInviteToken
+	if err := json.NewDecoder(w.Body).Decode(&inviteTokens); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(inviteTokens) != 2 {
+		t.Fatalf("expected 2 invite tokens, got %+v", inviteTokens)
+	}
+	latest, revoked := inviteTokens[0], inviteTokens[1]
+	if latest.RevokedAt != nil || revoked.RevokedAt == nil {
+		t.Fatalf("expected only the previous token to be revoked, got %+v", inviteTokens)
+	}
+	if len(latest.Participants) != 1 || latest.Participants[0].Email != "late@example.com" {
+		t.Fatalf("expected the late user to have joined with the latest token, got %+v", latest.Participants)
+	}
+	if len(revoked.Participants) != 1 || revoked.Participants[0].Email != "invited@example.com" {
+		t.Fatalf("expected the invited user to have joined with the revoked token, got %+v", revoked.Participants)
+	}
+
+	revoke := func(userID int64) int {
+		r := httptest.NewRequest(http.MethodDelete, "/", nil)
+		r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(userID)}))
+		w := httptest.NewRecorder()
+		srv.DeleteApiGamesIdInviteTokensTokenId(w, r, "g1", latest.Id)
+		return w.Code
+	}
+	if code := revoke(invitedID); code != http.StatusForbidden {
+		t.Fatalf("expected status %d, got %d", http.StatusForbidden, code)
+	}
+	if code := revoke(organizerID); code != http.StatusNoContent {
+		t.Fatalf("expected status %d, got %d", http.StatusNoContent, code)
+	}
+	if code := getPublicGame(&newToken); code != http.StatusNotFound {
+		t.Fatalf("expected status %d with the revoked token, got %d", http.StatusNotFound, code)
+	}
+	if code := revoke(organizerID); code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, code)
+	}
+}
@@ -0,0 +1,54 @@
+package server
+
+import (
+	"slices"
+	"time"
+
+	"github.com/dmateusp/opengym/db"
+	"github.com/dmateusp/opengym/queue"
+)
+
+// cancellationDeadline returns after when dropping out of the game is a late cancellation,
+// it reports false when the game has no deadline or no start time.
+func cancellationDeadline(game db.Game) (time.Time, bool) {
+	if game.CancellationDeadlineMinutes <= 0 || !game.StartsAt.Valid {
+		return time.Time{}, false
+	}
+	return game.StartsAt.Time.Add(-time.Duration(game.CancellationDeadlineMinutes) * time.Minute), true
+}
+
+// isLateCancellation reports whether a participant dropping out of the game now cancels late.
+// Participants of lottery games that weren't drawn yet don't hold a spot, so they can't cancel late.
+func isLateCancellation(game db.Game, now time.Time) bool {
+	deadline, ok := cancellationDeadline(game)
+	return ok && !now.Before(deadline) && !lotteryPending(game)
+}
+
+// billedLateCancellations returns how many spots each participant who cancelled late is billed for, by index in rows.
+// The spots taken by participants from the waitlist go to the participants who cancelled first,
+// so the participants who cancelled last are billed for the spots left in the game.
+func billedLateCancellations(rows []db.ParticipantsListRow, q queue.Queue) map[int]int64 {
+	var cancelled []int
+	lateSpots := int64(0)
+	for i, row := range rows {
+		if q.Entries[i].Status == queue.StatusNotGoing && row.GameParticipant.LateCancelledAt.Valid {
+			cancelled = append(cancelled, i)
+			lateSpots += row.GameParticipant.LateCancelledSpots
+		}
+	}
+	slices.SortStableFunc(cancelled, func(a, b int) int {
+		return rows[a].GameParticipant.LateCancelledAt.Time.Compare(rows[b].GameParticipant.LateCancelledAt.Time)
+	})
+
+	replaced := max(lateSpots-q.SpotsLeft, 0)
+	billed := make(map[int]int64)
+	for _, i := range cancelled {
+		spots := rows[i].GameParticipant.LateCancelledSpots
+		credited := min(spots, replaced)
+		replaced -= credited
+		if spots > credited {
+			billed[i] = spots - credited
+		}
+	}
+	return billed
+}
@@ -0,0 +1,129 @@
+package server_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/dmateusp/opengym/api"
+	"github.com/dmateusp/opengym/api/server"
+	"github.com/dmateusp/opengym/auth"
+	"github.com/dmateusp/opengym/clock"
+	"github.com/dmateusp/opengym/db"
+	dbtesting "github.com/dmateusp/opengym/db/testing"
+	"github.com/dmateusp/opengym/ptr"
+)
+
+func listReimbursements(t *testing.T, srv api.ServerInterface, gameID string, userID int64) map[string]api.GameReimbursementEntry {
+	t.Helper()
+
+	r := httptest.NewRequest(http.MethodGet, "/api/games/"+gameID+"/reimbursements", nil)
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(userID)}))
+	w := httptest.NewRecorder()
+	srv.GetApiGamesIdReimbursements(w, r, gameID)
+	if w.Code != http.StatusOK {
+		t.Fatalf("failed to list reimbursements: status %d, body %s", w.Code, w.Body.String())
+	}
+
+	var entries []api.GameReimbursementEntry
+	if err := json.NewDecoder(w.Body).Decode(&entries); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	byParticipant := make(map[string]api.GameReimbursementEntry, len(entries))
+	for _, entry := range entries {
+		byParticipant[entry.Participant.Id] = entry
+	}
+	return byParticipant
+}
+
+func TestLateCancellations_BilledUntilReplaced(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	now := time.Now()
+
+	organizerID := dbtesting.UpsertTestUser(t, sqlDB, "organizer@example.com")
+	lateID := dbtesting.UpsertTestUser(t, sqlDB, "late@example.com")
+	earlyID := dbtesting.UpsertTestUser(t, sqlDB, "early@example.com")
+	stayingID := dbtesting.UpsertTestUser(t, sqlDB, "staying@example.com")
+	replacementID := dbtesting.UpsertTestUser(t, sqlDB, "replacement@example.com")
+	otherReplacementID := dbtesting.UpsertTestUser(t, sqlDB, "other-replacement@example.com")
+
+	querier := db.New(sqlDB)
+	earlier := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), clock.StaticClock{Time: now.Add(-2 * time.Hour)}, sqlDB)
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), clock.StaticClock{Time: now}, sqlDB)
+	createGame(t, querier, "g1", organizerID, sql.NullTime{Time: now.Add(-3 * time.Hour), Valid: true})
+	setMaxPlayers(t, querier, "g1", 4)
+
+	startsAt := now.Add(30 * time.Minute)
+	code, detail := patchGame(t, earlier, "g1", organizerID, api.UpdateGameRequest{
+		StartsAt:                    &startsAt,
+		TotalPriceCents:             ptr.Ptr(int64(3000)),
+		CancellationDeadlineMinutes: ptr.Ptr(int64(60)),
+		BillLateCancellations:       ptr.Ptr(true),
+	})
+	if code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, code)
+	}
+	if detail.Game.CancellationDeadline == nil || !detail.Game.CancellationDeadline.Equal(startsAt.Add(-time.Hour)) {
+		t.Fatalf("expected the cancellation deadline an hour before the game starts, got %v", detail.Game.CancellationDeadline)
+	}
+
+	for _, userID := range []int64{organizerID, lateID, earlyID, stayingID} {
+		updateParticipation(t, earlier, "g1", userID, api.Going)
+	}
+	// before the deadline
+	updateParticipation(t, earlier, "g1", earlyID, api.NotGoing)
+	// after the deadline
+	updateParticipation(t, srv, "g1", lateID, api.NotGoing)
+
+	for _, participant := range listParticipants(t, srv, "g1", organizerID) {
+		lateCancelled := participant.LateCancelledAt != nil
+		if lateCancelled != (participant.User.Id == strconv.FormatInt(lateID, 10)) {
+			t.Fatalf("expected only the participant dropping out after the deadline to cancel late, got %+v", participant)
+		}
+	}
+
+	r := httptest.NewRequest(http.MethodGet, "/api/games", nil)
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(lateID)}))
+	w := httptest.NewRecorder()
+	srv.GetApiGames(w, r, api.GetApiGamesParams{})
+	var games api.GameListResponse
+	if err := json.NewDecoder(w.Body).Decode(&games); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if games.Total != 1 || games.Items[0].LateCancelledAt == nil {
+		t.Fatalf("expected the late cancellation in the history of the participant, got %+v", games)
+	}
+
+	freezeGameForReimbursements(t, sqlDB, now, "g1")
+	reimbursements := listReimbursements(t, srv, "g1", organizerID)
+	late, ok := reimbursements[strconv.FormatInt(lateID, 10)]
+	if len(reimbursements) != 3 || !ok || late.LateCancelledAt == nil || late.AmountOwedCents != 1000 {
+		t.Fatalf("expected the late cancellation to be billed its share while nobody took the spot, got %+v", reimbursements)
+	}
+	if _, ok := reimbursements[strconv.FormatInt(earlyID, 10)]; ok {
+		t.Fatalf("expected the participant dropping out before the deadline not to be billed, got %+v", reimbursements)
+	}
+
+	if _, err := sqlDB.Exec(`update games set frozen_at = null where id = ?`, "g1"); err != nil {
+		t.Fatalf("failed to unfreeze game: %v", err)
+	}
+	// the spot of the participant who dropped out before the deadline goes first
+	updateParticipation(t, srv, "g1", replacementID, api.Going)
+	updateParticipation(t, srv, "g1", otherReplacementID, api.Going)
+	freezeGameForReimbursements(t, sqlDB, now, "g1")
+
+	reimbursements = listReimbursements(t, srv, "g1", organizerID)
+	if _, ok := reimbursements[strconv.FormatInt(lateID, 10)]; ok || len(reimbursements) != 4 {
+		t.Fatalf("expected the late cancellation not to be billed once the spot was taken, got %+v", reimbursements)
+	}
+	if entry := reimbursements[strconv.FormatInt(replacementID, 10)]; entry.AmountOwedCents != 750 {
+		t.Fatalf("expected the replacement to pay their share, got %+v", reimbursements)
+	}
+}
@@ -0,0 +1,541 @@
+package server
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/dmateusp/opengym/api"
+	"github.com/dmateusp/opengym/auth"
+	"github.com/dmateusp/opengym/db"
+	"github.com/dmateusp/opengym/ptr"
+)
+
+const maxLedgerNoteLength = 500
+
+// ledgerShare is what a participant owes the payee of a frozen game.
+type ledgerShare struct {
+	game            db.Game
+	at              time.Time
+	amountOwedCents int64
+	receivedAt      sql.NullTime
+	allocatedCents  int64
+}
+
+// outstandingCents is what is left to pay for the share, nothing once it's marked as received on the game.
+func (share *ledgerShare) outstandingCents() int64 {
+	if share.receivedAt.Valid {
+		return 0
+	}
+	return max(share.amountOwedCents-share.allocatedCents, 0)
+}
+
+type ledgerPayment struct {
+	db.LedgerPayment
+	allocatedCents int64
+}
+
+func (payment *ledgerPayment) unallocatedCents() int64 {
+	return payment.AmountCents - payment.allocatedCents
+}
+
+// ledgerAccount holds the shares a participant owes a payee across their games, and the payments they made them, oldest first.
+type ledgerAccount struct {
+	payer    db.User
+	payee    db.User
+	shares   []*ledgerShare
+	payments []*ledgerPayment
+}
+
+func (account *ledgerAccount) outstandingCents() int64 {
+	var outstanding int64
+	for _, share := range account.shares {
+		outstanding += share.outstandingCents()
+	}
+	return outstanding
+}
+
+func (account *ledgerAccount) creditCents() int64 {
+	var credit int64
+	for _, payment := range account.payments {
+		credit += payment.unallocatedCents()
+	}
+	return credit
+}
+
+type ledgerKey struct {
+	payerID int64
+	payeeID int64
+}
+
+// loadLedger returns the accounts between participants and the payees of their games, restricted to the payer and/or the payee when they're given.
+// The payee of a game is its treasurer, or its owner until they appoint one, see [gamePayee].
+// Only frozen games bill participants, the owner paid for the game and the payee doesn't owe themselves their own share.
+func loadLedger(ctx context.Context, querier db.Querier, now time.Time, payerID, payeeID sql.NullInt64) (map[ledgerKey]*ledgerAccount, error) {
+	users := make(map[int64]db.User)
+	userByID := func(id int64) (db.User, error) {
+		if user, ok := users[id]; ok {
+			return user, nil
+		}
+		row, err := querier.UserGetById(ctx, id)
+		if err != nil {
+			return db.User{}, fmt.Errorf("failed to retrieve user: %w", err)
+		}
+		users[id] = row.User
+		return row.User, nil
+	}
+
+	accounts := make(map[ledgerKey]*ledgerAccount)
+	accountOf := func(key ledgerKey) (*ledgerAccount, error) {
+		if account, ok := accounts[key]; ok {
+			return account, nil
+		}
+		payer, err := userByID(key.payerID)
+		if err != nil {
+			return nil, err
+		}
+		payee, err := userByID(key.payeeID)
+		if err != nil {
+			return nil, err
+		}
+		account := &ledgerAccount{payer: payer, payee: payee}
+		accounts[key] = account
+		return account, nil
+	}
+
+	rows, err := querier.LedgerParticipantList(ctx, db.LedgerParticipantListParams{PayeeID: payeeID, ParticipantID: payerID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list participants: %w", err)
+	}
+
+	// the participants of a game are listed together, in the order they queued
+	var games []db.LedgerParticipantListRow
+	participants := make(map[string][]db.ParticipantsListRow)
+	for _, row := range rows {
+		users[row.User.ID] = row.User
+		if _, ok := participants[row.Game.ID]; !ok {
+			games = append(games, row)
+		}
+		participants[row.Game.ID] = append(participants[row.Game.ID], db.ParticipantsListRow{
+			IsOrganizer:     row.IsOrganizer,
+			IsRegular:       row.IsRegular,
+			GameParticipant: row.GameParticipant,
+			User:            row.User,
+		})
+	}
+
+	shares := make(map[ledgerKey]map[string]*ledgerShare)
+	for _, gameRow := range games {
+		game := gameRow.Game
+		if game.FrozenAt.Time.After(now) {
+			continue
+		}
+
+		// shares are ordered by when the games took place, the oldest are settled first
+		at := game.FrozenAt.Time
+		if game.StartsAt.Valid {
+			at = game.StartsAt.Time
+		}
+		for _, billed := range billedParticipants(game, participants[game.ID]) {
+			participantID := billed.row.User.ID
+			if billed.amountOwedCents <= 0 || participantID == game.OrganizerID || participantID == gameRow.PayeeID || (payerID.Valid && participantID != payerID.Int64) {
+				continue
+			}
+
+			key := ledgerKey{payerID: participantID, payeeID: gameRow.PayeeID}
+			account, err := accountOf(key)
+			if err != nil {
+				return nil, err
+			}
+			share := &ledgerShare{
+				game:            game,
+				at:              at,
+				amountOwedCents: billed.amountOwedCents,
+				receivedAt:      billed.row.GameParticipant.ReimbursementReceivedAt,
+			}
+			account.shares = append(account.shares, share)
+			if shares[key] == nil {
+				shares[key] = make(map[string]*ledgerShare)
+			}
+			shares[key][game.ID] = share
+		}
+	}
+
+	payments, err := querier.LedgerPaymentList(ctx, db.LedgerPaymentListParams{PayerID: payerID, PayeeID: payeeID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list payments: %w", err)
+	}
+	paymentsByID := make(map[int64]*ledgerPayment, len(payments))
+	for _, payment := range payments {
+		account, err := accountOf(ledgerKey{payerID: payment.PayerID, payeeID: payment.PayeeID})
+		if err != nil {
+			return nil, err
+		}
+		paymentsByID[payment.ID] = &ledgerPayment{LedgerPayment: payment}
+		account.payments = append(account.payments, paymentsByID[payment.ID])
+	}
+
+	allocations, err := querier.LedgerAllocationList(ctx, db.LedgerAllocationListParams{PayerID: payerID, PayeeID: payeeID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list allocations: %w", err)
+	}
+	for _, allocation := range allocations {
+		share, ok := shares[ledgerKey{payerID: allocation.PayerID, payeeID: allocation.PayeeID}][allocation.GameID]
+		if !ok {
+			// the game stopped billing the participant, e.g. it was cancelled, what was allocated to it is credit again
+			continue
+		}
+		share.allocatedCents += allocation.AmountCents
+		paymentsByID[allocation.PaymentID].allocatedCents += allocation.AmountCents
+	}
+
+	for _, account := range accounts {
+		slices.SortStableFunc(account.shares, func(a, b *ledgerShare) int {
+			return cmp.Or(a.at.Compare(b.at), strings.Compare(a.game.ID, b.game.ID))
+		})
+		slices.SortStableFunc(account.payments, func(a, b *ledgerPayment) int {
+			return cmp.Or(a.ReceivedAt.Compare(b.ReceivedAt), cmp.Compare(a.ID, b.ID))
+		})
+	}
+	return accounts, nil
+}
+
+// ledgerAccountBetween returns the account between the participant and the payee, which is empty when nothing was billed or paid yet.
+func ledgerAccountBetween(ctx context.Context, querier db.Querier, now time.Time, payerID, payeeID int64) (*ledgerAccount, error) {
+	accounts, err := loadLedger(ctx, querier, now, sql.NullInt64{Int64: payerID, Valid: true}, sql.NullInt64{Int64: payeeID, Valid: true})
+	if err != nil {
+		return nil, err
+	}
+	if account, ok := accounts[ledgerKey{payerID: payerID, payeeID: payeeID}]; ok {
+		return account, nil
+	}
+
+	payer, err := querier.UserGetById(ctx, payerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve payer: %w", err)
+	}
+	payee, err := querier.UserGetById(ctx, payeeID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve payee: %w", err)
+	}
+	return &ledgerAccount{payer: payer.User, payee: payee.User}, nil
+}
+
+// allocate settles the oldest outstanding shares of the account with what is left of its payments, oldest first.
+// The shares fully paid are marked as received on their games, when the payment that completed them was received.
+func allocate(ctx context.Context, querier db.Querier, account *ledgerAccount) error {
+	payments := account.payments
+	for _, share := range account.shares {
+		if share.outstandingCents() == 0 {
+			continue
+		}
+
+		var completedAt time.Time
+		for share.outstandingCents() > 0 && len(payments) > 0 {
+			payment := payments[0]
+			amount := min(share.outstandingCents(), payment.unallocatedCents())
+			if amount <= 0 {
+				payments = payments[1:]
+				continue
+			}
+
+			if err := querier.LedgerAllocationAdd(ctx, db.LedgerAllocationAddParams{
+				PaymentID:   payment.ID,
+				GameID:      share.game.ID,
+				AmountCents: amount,
+			}); err != nil {
+				return fmt.Errorf("failed to allocate payment: %w", err)
+			}
+			payment.allocatedCents += amount
+			share.allocatedCents += amount
+			completedAt = payment.ReceivedAt
+		}
+		if share.outstandingCents() > 0 {
+			return nil
+		}
+
+		share.receivedAt = sql.NullTime{Time: completedAt, Valid: true}
+		if err := setReimbursementReceivedAt(ctx, querier, share.game.ID, account.payer.ID, share.receivedAt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (account *ledgerAccount) balance(counterparty db.User) api.LedgerBalance {
+	balance := api.LedgerBalance{
+		OutstandingCents: account.outstandingCents(),
+		CreditCents:      account.creditCents(),
+	}
+	balance.User.FromDb(counterparty)
+	balance.BalanceCents = balance.CreditCents - balance.OutstandingCents
+	return balance
+}
+
+func (account *ledgerAccount) statement() api.LedgerStatement {
+	statement := api.LedgerStatement{
+		OutstandingCents: account.outstandingCents(),
+		CreditCents:      account.creditCents(),
+		Entries:          []api.LedgerEntry{},
+	}
+	statement.Payer.FromDb(account.payer)
+	statement.Payee.FromDb(account.payee)
+	statement.BalanceCents = statement.CreditCents - statement.OutstandingCents
+
+	for _, share := range account.shares {
+		statement.Entries = append(statement.Entries, api.LedgerEntry{
+			Type:             api.Share,
+			At:               share.at,
+			AmountCents:      -share.amountOwedCents,
+			GameId:           ptr.Ptr(share.game.ID),
+			GameName:         ptr.Ptr(share.game.Name),
+			OutstandingCents: ptr.Ptr(share.outstandingCents()),
+		})
+		// what the payments didn't cover was received outside the ledger, e.g. marked as received on the game
+		if share.receivedAt.Valid && share.amountOwedCents > share.allocatedCents {
+			statement.Entries = append(statement.Entries, api.LedgerEntry{
+				Type:        api.Reimbursement,
+				At:          share.receivedAt.Time,
+				AmountCents: share.amountOwedCents - share.allocatedCents,
+				GameId:      ptr.Ptr(share.game.ID),
+				GameName:    ptr.Ptr(share.game.Name),
+			})
+		}
+	}
+	for _, payment := range account.payments {
+		entry := api.LedgerEntry{
+			Type:             api.Payment,
+			At:               payment.ReceivedAt,
+			AmountCents:      payment.AmountCents,
+			PaymentId:        ptr.Ptr(payment.ID),
+			UnallocatedCents: ptr.Ptr(payment.unallocatedCents()),
+		}
+		if payment.Note.Valid {
+			entry.Note = ptr.Ptr(payment.Note.String)
+		}
+		statement.Entries = append(statement.Entries, entry)
+	}
+
+	slices.SortStableFunc(statement.Entries, func(a, b api.LedgerEntry) int {
+		return a.At.Compare(b.At)
+	})
+	var balance int64
+	for i := range statement.Entries {
+		balance += statement.Entries[i].AmountCents
+		statement.Entries[i].BalanceCents = balance
+	}
+	return statement
+}
+
+func (s *server) GetApiUsersMeBalances(w http.ResponseWriter, r *http.Request) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	accounts, err := loadLedger(r.Context(), s.querier, s.clock.Now(), sql.NullInt64{Int64: int64(authInfo.UserId), Valid: true}, sql.NullInt64{})
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to load ledger: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	resp := make([]api.LedgerBalance, 0, len(accounts))
+	for _, account := range sortedLedgerAccounts(accounts) {
+		resp = append(resp, account.balance(account.payee))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		http.Error(w, fmt.Sprintf("failed to encode response: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (s *server) GetApiUsersMeOrganizerBalances(w http.ResponseWriter, r *http.Request) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	accounts, err := loadLedger(r.Context(), s.querier, s.clock.Now(), sql.NullInt64{}, sql.NullInt64{Int64: int64(authInfo.UserId), Valid: true})
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to load ledger: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	resp := make([]api.LedgerBalance, 0, len(accounts))
+	for _, account := range sortedLedgerAccounts(accounts) {
+		resp = append(resp, account.balance(account.payer))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		http.Error(w, fmt.Sprintf("failed to encode response: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+}
+
+// sortedLedgerAccounts lists the accounts in a stable order, by payer then payee.
+func sortedLedgerAccounts(accounts map[ledgerKey]*ledgerAccount) []*ledgerAccount {
+	sorted := make([]*ledgerAccount, 0, len(accounts))
+	for _, account := range accounts {
+		sorted = append(sorted, account)
+	}
+	slices.SortFunc(sorted, func(a, b *ledgerAccount) int {
+		return cmp.Or(cmp.Compare(a.payer.ID, b.payer.ID), cmp.Compare(a.payee.ID, b.payee.ID))
+	})
+	return sorted
+}
+
+func (s *server) GetApiLedger(w http.ResponseWriter, r *http.Request, params api.GetApiLedgerParams) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	payerID, err := strconv.ParseInt(params.PayerId, 10, 64)
+	if err != nil {
+		http.Error(w, "invalid payerId", http.StatusBadRequest)
+		return
+	}
+	payeeID, err := strconv.ParseInt(params.PayeeId, 10, 64)
+	if err != nil {
+		http.Error(w, "invalid payeeId", http.StatusBadRequest)
+		return
+	}
+
+	userID := int64(authInfo.UserId)
+	if userID != payerID && userID != payeeID {
+		http.Error(w, "forbidden: only the participant and the organizer can access their ledger", http.StatusForbidden)
+		return
+	}
+
+	account, err := ledgerAccountBetween(r.Context(), s.querier, s.clock.Now(), payerID, payeeID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			http.Error(w, "user not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("failed to load ledger: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(account.statement()); err != nil {
+		http.Error(w, fmt.Sprintf("failed to encode response: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (s *server) PostApiLedgerPayments(w http.ResponseWriter, r *http.Request) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req api.RecordLedgerPaymentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("invalid request body: %s", err.Error()), http.StatusBadRequest)
+		return
+	}
+
+	payeeID := int64(authInfo.UserId)
+	payerID, err := strconv.ParseInt(req.PayerId, 10, 64)
+	if err != nil {
+		http.Error(w, "invalid payerId", http.StatusBadRequest)
+		return
+	}
+	if payerID == payeeID {
+		http.Error(w, "you cannot record a payment from yourself", http.StatusBadRequest)
+		return
+	}
+	if req.AmountCents <= 0 {
+		http.Error(w, "amountCents must be positive", http.StatusBadRequest)
+		return
+	}
+
+	params := db.LedgerPaymentCreateParams{
+		PayerID:     payerID,
+		PayeeID:     payeeID,
+		AmountCents: req.AmountCents,
+		ReceivedAt:  s.clock.Now(),
+	}
+	if req.ReceivedAt != nil {
+		params.ReceivedAt = *req.ReceivedAt
+	}
+	if note := strings.TrimSpace(ptr.Value(req.Note)); note != "" {
+		if utf8.RuneCountInString(note) > maxLedgerNoteLength {
+			http.Error(w, fmt.Sprintf("note cannot exceed %d characters", maxLedgerNoteLength), http.StatusBadRequest)
+			return
+		}
+		params.Note = sql.NullString{String: note, Valid: true}
+	}
+
+	tx, err := s.dbConn.BeginTx(r.Context(), nil)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to begin transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	querierWithTx := s.querier.WithTx(tx)
+
+	account, err := ledgerAccountBetween(r.Context(), querierWithTx, s.clock.Now(), payerID, payeeID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			http.Error(w, "participant not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("failed to load ledger: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+	// Payments settle the shares the participant owes you, there must be one left to pay
+	if account.outstandingCents() == 0 {
+		http.Error(w, "the participant doesn't owe you anything", http.StatusConflict)
+		return
+	}
+
+	if _, err := querierWithTx.LedgerPaymentCreate(r.Context(), params); err != nil {
+		http.Error(w, fmt.Sprintf("failed to record payment: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	// the account is loaded again with the payment
+	account, err = ledgerAccountBetween(r.Context(), querierWithTx, s.clock.Now(), payerID, payeeID)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to load ledger: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+	if err := allocate(r.Context(), querierWithTx, account); err != nil {
+		http.Error(w, fmt.Sprintf("failed to allocate payment: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		http.Error(w, fmt.Sprintf("failed to commit transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(account.statement()); err != nil {
+		http.Error(w, fmt.Sprintf("failed to encode response: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+}
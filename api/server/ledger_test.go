@@ -0,0 +1,301 @@
+package server_test
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/dmateusp/opengym/api"
+	"github.com/dmateusp/opengym/api/server"
+	"github.com/dmateusp/opengym/auth"
+	"github.com/dmateusp/opengym/clock"
+	"github.com/dmateusp/opengym/db"
+	dbtesting "github.com/dmateusp/opengym/db/testing"
+	"github.com/dmateusp/opengym/ptr"
+)
+
+func recordLedgerPayment(t *testing.T, srv api.ServerInterface, userID int64, req api.RecordLedgerPaymentRequest) *httptest.ResponseRecorder {
+	t.Helper()
+
+	body, _ := json.Marshal(req)
+	r := httptest.NewRequest(http.MethodPost, "/api/ledger/payments", bytes.NewReader(body))
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(userID)}))
+	w := httptest.NewRecorder()
+	srv.PostApiLedgerPayments(w, r)
+	return w
+}
+
+func getLedgerBalances(t *testing.T, srv api.ServerInterface, userID int64, asOrganizer bool) []api.LedgerBalance {
+	t.Helper()
+
+	r := httptest.NewRequest(http.MethodGet, "/api/users/me/balances", nil)
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(userID)}))
+	w := httptest.NewRecorder()
+	if asOrganizer {
+		srv.GetApiUsersMeOrganizerBalances(w, r)
+	} else {
+		srv.GetApiUsersMeBalances(w, r)
+	}
+	if w.Code != http.StatusOK {
+		t.Fatalf("failed to list balances: status %d, body %s", w.Code, w.Body.String())
+	}
+
+	var balances []api.LedgerBalance
+	if err := json.NewDecoder(w.Body).Decode(&balances); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	return balances
+}
+
+// setupLedgerGames creates two frozen games of the organizer costing 2000 cents each, with the participant going,
+// g2 took place before g1.
+func setupLedgerGames(t *testing.T, sqlDB *sql.DB, now time.Time) (api.ServerInterface, int64, int64) {
+	t.Helper()
+
+	organizerID := dbtesting.UpsertTestUser(t, sqlDB, "organizer@example.com")
+	participantID := dbtesting.UpsertTestUser(t, sqlDB, "participant@example.com")
+
+	querier := db.New(sqlDB)
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), clock.StaticClock{Time: now}, sqlDB)
+	for i, gameID := range []string{"g1", "g2"} {
+		createGame(t, querier, gameID, organizerID, sql.NullTime{Time: now.Add(-72 * time.Hour), Valid: true})
+		if code, _ := patchGame(t, srv, gameID, organizerID, api.UpdateGameRequest{TotalPriceCents: ptr.Ptr(int64(2000))}); code != http.StatusOK {
+			t.Fatalf("failed to set the price: status %d", code)
+		}
+		updateParticipation(t, srv, gameID, organizerID, api.Going)
+		updateParticipation(t, srv, gameID, participantID, api.Going)
+		startsAt := now.Add(-time.Duration(i+1) * 24 * time.Hour)
+		if _, err := sqlDB.Exec(`update games set starts_at = ? where id = ?`, startsAt, gameID); err != nil {
+			t.Fatalf("failed to set starts_at: %v", err)
+		}
+		freezeGameForReimbursements(t, sqlDB, now, gameID)
+	}
+	return srv, organizerID, participantID
+}
+
+func TestPostApiLedgerPayments(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	now := time.Now()
+
+	srv, organizerID, participantID := setupLedgerGames(t, sqlDB, now)
+	payerID := strconv.FormatInt(participantID, 10)
+
+	balances := getLedgerBalances(t, srv, participantID, false)
+	if len(balances) != 1 || balances[0].User.Id != strconv.FormatInt(organizerID, 10) || balances[0].OutstandingCents != 2000 || balances[0].BalanceCents != -2000 {
+		t.Fatalf("expected the participant to owe the organizer 2000 cents, got %+v", balances)
+	}
+
+	invalid := map[string]api.RecordLedgerPaymentRequest{
+		"payment from yourself": {PayerId: strconv.FormatInt(organizerID, 10), AmountCents: 1000},
+		"no amount":             {PayerId: payerID},
+		"invalid payer":         {PayerId: "abc", AmountCents: 1000},
+	}
+	for name, req := range invalid {
+		if w := recordLedgerPayment(t, srv, organizerID, req); w.Code != http.StatusBadRequest {
+			t.Errorf("%s: expected status %d, got %d", name, http.StatusBadRequest, w.Code)
+		}
+	}
+	if w := recordLedgerPayment(t, srv, organizerID, api.RecordLedgerPaymentRequest{PayerId: "999", AmountCents: 1000}); w.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d for an unknown participant, got %d", http.StatusNotFound, w.Code)
+	}
+
+	// the oldest game is settled first, the rest of the payment goes to the next one
+	receivedAt := now.Add(-time.Hour).Truncate(time.Second)
+	w := recordLedgerPayment(t, srv, organizerID, api.RecordLedgerPaymentRequest{PayerId: payerID, AmountCents: 1500, ReceivedAt: &receivedAt, Note: ptr.Ptr("cash")})
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusCreated, w.Code, w.Body.String())
+	}
+	var statement api.LedgerStatement
+	if err := json.NewDecoder(w.Body).Decode(&statement); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if statement.OutstandingCents != 500 || statement.CreditCents != 0 || statement.BalanceCents != -500 {
+		t.Fatalf("expected 500 cents to be outstanding, got %+v", statement)
+	}
+
+	g2ReceivedAt, err := listReimbursements(t, srv, "g2", organizerID)[payerID].ReimbursementReceivedAt.Get()
+	if err != nil || !g2ReceivedAt.Equal(receivedAt) {
+		t.Fatalf("expected the reimbursement of the oldest game received with the payment, got %v, %v", g2ReceivedAt, err)
+	}
+	if entry := listReimbursements(t, srv, "g1", organizerID)[payerID]; entry.ReimbursementReceivedAt.IsSpecified() && !entry.ReimbursementReceivedAt.IsNull() {
+		t.Fatalf("expected the reimbursement of the newest game not to be received yet, got %+v", entry)
+	}
+
+	// paying more than what is owed leaves credit for the next games
+	w = recordLedgerPayment(t, srv, organizerID, api.RecordLedgerPaymentRequest{PayerId: payerID, AmountCents: 800})
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusCreated, w.Code, w.Body.String())
+	}
+	if entry := listReimbursements(t, srv, "g1", organizerID)[payerID]; !entry.ReimbursementReceivedAt.IsSpecified() || entry.ReimbursementReceivedAt.IsNull() {
+		t.Fatalf("expected the reimbursement of the newest game to be received, got %+v", entry)
+	}
+
+	balances = getLedgerBalances(t, srv, organizerID, true)
+	if len(balances) != 1 || balances[0].User.Id != payerID || balances[0].OutstandingCents != 0 || balances[0].CreditCents != 300 || balances[0].BalanceCents != 300 {
+		t.Fatalf("expected the participant to have 300 cents of credit, got %+v", balances)
+	}
+
+	// nothing is owed anymore, neither by the participant nor by someone who never played
+	strangerID := dbtesting.UpsertTestUser(t, sqlDB, "stranger@example.com")
+	for _, id := range []string{payerID, strconv.FormatInt(strangerID, 10)} {
+		if w := recordLedgerPayment(t, srv, organizerID, api.RecordLedgerPaymentRequest{PayerId: id, AmountCents: 1000}); w.Code != http.StatusConflict {
+			t.Fatalf("expected status %d for a payment from %s who owes nothing, got %d", http.StatusConflict, id, w.Code)
+		}
+	}
+}
+
+func TestGetApiLedger(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	now := time.Now()
+
+	srv, organizerID, participantID := setupLedgerGames(t, sqlDB, now)
+	otherID := dbtesting.UpsertTestUser(t, sqlDB, "other@example.com")
+
+	getLedger := func(userID int64) *httptest.ResponseRecorder {
+		t.Helper()
+		r := httptest.NewRequest(http.MethodGet, "/api/ledger", nil)
+		r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(userID)}))
+		w := httptest.NewRecorder()
+		srv.GetApiLedger(w, r, api.GetApiLedgerParams{PayerId: strconv.FormatInt(participantID, 10), PayeeId: strconv.FormatInt(organizerID, 10)})
+		return w
+	}
+
+	if w := getLedger(otherID); w.Code != http.StatusForbidden {
+		t.Fatalf("expected status %d for another user, got %d", http.StatusForbidden, w.Code)
+	}
+
+	// a reimbursement marked as received on the game settles its share without a payment
+	if _, err := sqlDB.Exec(`update game_participants set reimbursement_received_at = ? where game_id = ? and user_id = ?`, now.Add(-2*time.Hour), "g2", participantID); err != nil {
+		t.Fatalf("failed to mark reimbursement received: %v", err)
+	}
+	if w := recordLedgerPayment(t, srv, organizerID, api.RecordLedgerPaymentRequest{PayerId: strconv.FormatInt(participantID, 10), AmountCents: 1200}); w.Code != http.StatusCreated {
+		t.Fatalf("failed to record payment: status %d, body %s", w.Code, w.Body.String())
+	}
+
+	w := getLedger(participantID)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	var statement api.LedgerStatement
+	if err := json.NewDecoder(w.Body).Decode(&statement); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	want := []struct {
+		entryType api.LedgerEntryType
+		gameID    string
+		amount    int64
+		balance   int64
+	}{
+		{api.Share, "g2", -1000, -1000},
+		{api.Share, "g1", -1000, -2000},
+		{api.Reimbursement, "g2", 1000, -1000},
+		{api.Payment, "", 1200, 200},
+	}
+	if len(statement.Entries) != len(want) {
+		t.Fatalf("expected %d entries, got %+v", len(want), statement.Entries)
+	}
+	for i, entry := range statement.Entries {
+		if entry.Type != want[i].entryType || ptr.Value(entry.GameId) != want[i].gameID || entry.AmountCents != want[i].amount || entry.BalanceCents != want[i].balance {
+			t.Fatalf("expected entry %d to be %+v, got %+v", i, want[i], entry)
+		}
+	}
+	if statement.BalanceCents != 200 || statement.CreditCents != 200 || statement.OutstandingCents != 0 {
+		t.Fatalf("expected 200 cents of credit, got %+v", statement)
+	}
+}
+
+func TestPostApiPlaceholdersClaim_MovesLedgerPayments(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	now := time.Now()
+
+	organizerID := dbtesting.UpsertTestUser(t, sqlDB, "organizer@example.com")
+	userID := dbtesting.UpsertTestUser(t, sqlDB, "user@example.com")
+
+	querier := db.New(sqlDB)
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), clock.StaticClock{Time: now}, sqlDB)
+	createGame(t, querier, "g1", organizerID, sql.NullTime{Time: now.Add(-72 * time.Hour), Valid: true})
+	if code, _ := patchGame(t, srv, "g1", organizerID, api.UpdateGameRequest{TotalPriceCents: ptr.Ptr(int64(2000))}); code != http.StatusOK {
+		t.Fatalf("failed to set the price: status %d", code)
+	}
+	updateParticipation(t, srv, "g1", organizerID, api.Going)
+
+	w := postPlaceholder(t, srv, "g1", organizerID, api.CreatePlaceholderRequest{Name: "Alice"})
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusCreated, w.Code, w.Body.String())
+	}
+	var placeholder api.PlaceholderParticipant
+	if err := json.NewDecoder(w.Body).Decode(&placeholder); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if _, err := sqlDB.Exec(`update games set starts_at = ? where id = ?`, now.Add(-24*time.Hour), "g1"); err != nil {
+		t.Fatalf("failed to set starts_at: %v", err)
+	}
+	freezeGameForReimbursements(t, sqlDB, now, "g1")
+
+	// Alice paid in cash before claiming her place
+	if w := recordLedgerPayment(t, srv, organizerID, api.RecordLedgerPaymentRequest{PayerId: placeholder.User.Id, AmountCents: 600}); w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusCreated, w.Code, w.Body.String())
+	}
+
+	if w := claimPlaceholder(t, srv, placeholder.ClaimUrl, userID); w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+
+	balances := getLedgerBalances(t, srv, organizerID, true)
+	if len(balances) != 1 || balances[0].User.Id != strconv.FormatInt(userID, 10) || balances[0].OutstandingCents != 400 {
+		t.Fatalf("expected the payment of the placeholder to count for the user, got %+v", balances)
+	}
+	balances = getLedgerBalances(t, srv, userID, false)
+	if len(balances) != 1 || balances[0].OutstandingCents != 400 {
+		t.Fatalf("expected the user to owe the rest of the share, got %+v", balances)
+	}
+}
+
+func TestLedger_TreasurerCollectsTheMoney(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	now := time.Now()
+
+	srv, organizerID, participantID := setupLedgerGames(t, sqlDB, now)
+	treasurerID := dbtesting.UpsertTestUser(t, sqlDB, "treasurer@example.com")
+	if w := inviteToRole(t, srv, "g1", organizerID, "treasurer@example.com", api.Treasurer); w.Code != http.StatusCreated {
+		t.Fatalf("failed to appoint the treasurer: status %d, body %s", w.Code, w.Body.String())
+	}
+
+	balances := getLedgerBalances(t, srv, participantID, false)
+	outstanding := make(map[string]int64)
+	for _, balance := range balances {
+		outstanding[balance.User.Id] = balance.OutstandingCents
+	}
+	if len(outstanding) != 2 || outstanding[strconv.FormatInt(organizerID, 10)] != 1000 || outstanding[strconv.FormatInt(treasurerID, 10)] != 1000 {
+		t.Fatalf("expected the participant to owe the treasurer for g1 and the owner for g2, got %+v", balances)
+	}
+
+	w := recordLedgerPayment(t, srv, treasurerID, api.RecordLedgerPaymentRequest{PayerId: strconv.FormatInt(participantID, 10), AmountCents: 1000})
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusCreated, w.Code, w.Body.String())
+	}
+	if entry := listReimbursements(t, srv, "g1", treasurerID)[strconv.FormatInt(participantID, 10)]; !entry.ReimbursementReceivedAt.IsSpecified() || entry.ReimbursementReceivedAt.IsNull() {
+		t.Fatalf("expected the payment to the treasurer to settle g1, got %+v", entry)
+	}
+
+	balances = getLedgerBalances(t, srv, treasurerID, true)
+	if len(balances) != 1 || balances[0].User.Id != strconv.FormatInt(participantID, 10) || balances[0].OutstandingCents != 0 {
+		t.Fatalf("expected the participant to owe the treasurer nothing, got %+v", balances)
+	}
+	balances = getLedgerBalances(t, srv, organizerID, true)
+	if len(balances) != 1 || balances[0].OutstandingCents != 1000 {
+		t.Fatalf("expected the participant to still owe the owner for g2, got %+v", balances)
+	}
+}
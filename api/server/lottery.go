@@ -0,0 +1,121 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+
+	"github.com/dmateusp/opengym/db"
+	"github.com/dmateusp/opengym/outbox"
+	"github.com/dmateusp/opengym/queue"
+	"github.com/dmateusp/opengym/scheduler"
+)
+
+const allocationLottery = "lottery"
+
+// lotteryPending reports whether the game is a lottery game that wasn't drawn yet,
+// the order of its participants is only provisional until then.
+func lotteryPending(game db.Game) bool {
+	return game.AllocationMode == allocationLottery && !game.LotteryDrawnAt.Valid
+}
+
+// spotsQueue computes the queue the spots of the game are allocated from. Before the draw of lottery games, only the organizer
+// holds a spot, the other participants are registered for the draw and the spots stay unallocated.
+func spotsQueue(game db.Game, rows []db.ParticipantsListRow) queue.Queue {
+	if lotteryPending(game) {
+		rows = slices.DeleteFunc(slices.Clone(rows), func(row db.ParticipantsListRow) bool {
+			return !row.IsOrganizer
+		})
+	}
+	return queueOf(rows, game.MaxPlayers)
+}
+
+// lotteryOrder returns the order in which the participants are drawn with the seed.
+// The participants are sorted by ID, then shuffled with a PCG generator seeded with (seed, 0),
+// so anyone knowing the seed and the participants can reproduce the draw.
+func lotteryOrder(userIDs []int64, seed int64) []int64 {
+	order := slices.Clone(userIDs)
+	slices.Sort(order)
+	rand.New(rand.NewPCG(uint64(seed), 0)).Shuffle(len(order), func(i, j int) {
+		order[i], order[j] = order[j], order[i]
+	})
+	return order
+}
+
+// DrawLottery is a [scheduler.Handler] drawing the lottery games once their registration closes.
+func (s *server) DrawLottery(ctx context.Context, querier db.QuerierWithTxSupport, event scheduler.Event) error {
+	return s.drawClosedLottery(ctx, querier, event.Game.ID)
+}
+
+// drawClosedLottery draws the game if it's a lottery game whose registration closed, without waiting for the scheduler.
+func (s *server) drawClosedLottery(ctx context.Context, querier db.Querier, gameID string) error {
+	game, err := querier.GameGetById(ctx, gameID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		return fmt.Errorf("failed to retrieve game: %w", err)
+	}
+
+	if !lotteryPending(game) || !game.RegistrationClosesAt.Valid || game.RegistrationClosesAt.Time.After(s.clock.Now()) || game.CancelledAt.Valid {
+		return nil
+	}
+	return s.drawLottery(ctx, querier, game, rand.Int64())
+}
+
+// drawLottery orders the participants going to the game by drawing them with the seed, the organizer keeps their priority.
+func (s *server) drawLottery(ctx context.Context, querier db.Querier, game db.Game, seed int64) error {
+	drawnAt := sql.NullTime{Time: s.clock.Now(), Valid: true}
+	drawn, err := querier.GameSetLotteryDrawn(ctx, db.GameSetLotteryDrawnParams{
+		ID:             game.ID,
+		LotterySeed:    sql.NullInt64{Int64: seed, Valid: true},
+		LotteryDrawnAt: drawnAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record draw: %w", err)
+	}
+	if drawn == 0 {
+		// drawn in the meantime
+		return nil
+	}
+	game.LotteryDrawnAt = drawnAt
+
+	participants, err := querier.ParticipantsList(ctx, db.ParticipantsListParams{
+		OrganizerID: game.OrganizerID,
+		GameID:      game.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list participants: %w", err)
+	}
+
+	registered := make([]int64, 0, len(participants))
+	for _, participant := range participants {
+		if participant.IsOrganizer || !participant.GameParticipant.Going.Valid || !participant.GameParticipant.Going.Bool {
+			continue
+		}
+		registered = append(registered, participant.User.ID)
+	}
+
+	order := lotteryOrder(registered, seed)
+	for i, userID := range order {
+		if err := querier.ParticipantSetDrawPosition(ctx, db.ParticipantSetDrawPositionParams{
+			GameID:       game.ID,
+			UserID:       userID,
+			DrawPosition: sql.NullInt64{Int64: int64(i + 1), Valid: true},
+		}); err != nil {
+			return fmt.Errorf("failed to record draw position: %w", err)
+		}
+	}
+
+	if _, err := updateGameSpotsLeft(ctx, querier, game); err != nil {
+		return err
+	}
+
+	if err := outbox.Publish(ctx, querier, game.ID, outbox.LotteryDrawn{Seed: seed, UserIDs: order}); err != nil {
+		return err
+	}
+
+	return nil
+}
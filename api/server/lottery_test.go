@@ -0,0 +1,201 @@
+package server_test
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"math/rand/v2"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/dmateusp/opengym/api"
+	"github.com/dmateusp/opengym/api/server"
+	"github.com/dmateusp/opengym/auth"
+	"github.com/dmateusp/opengym/clock"
+	"github.com/dmateusp/opengym/db"
+	dbtesting "github.com/dmateusp/opengym/db/testing"
+	"github.com/dmateusp/opengym/ptr"
+	"github.com/dmateusp/opengym/scheduler"
+)
+
+func patchGame(t *testing.T, srv api.ServerInterface, gameID string, userID int64, req api.UpdateGameRequest) (int, api.GameDetail) {
+	t.Helper()
+
+	body, _ := json.Marshal(req)
+	r := httptest.NewRequest(http.MethodPatch, "/api/games/"+gameID, bytes.NewReader(body))
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(userID)}))
+	w := httptest.NewRecorder()
+	srv.PatchApiGamesId(w, r, gameID)
+
+	var game api.GameDetail
+	if w.Code == http.StatusOK {
+		if err := json.NewDecoder(w.Body).Decode(&game); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+	}
+	return w.Code, game
+}
+
+func TestLottery_DrawOrdersParticipants(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	now := time.Now()
+
+	organizerID := dbtesting.UpsertTestUser(t, sqlDB, "organizer@example.com")
+
+	querier := db.New(sqlDB)
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), clock.StaticClock{Time: now}, sqlDB)
+	createGame(t, querier, "g1", organizerID, sql.NullTime{Time: now.Add(-time.Hour), Valid: true})
+	setMaxPlayers(t, querier, "g1", 3)
+
+	if code, _ := patchGame(t, srv, "g1", organizerID, api.UpdateGameRequest{AllocationMode: ptr.Ptr(api.Lottery)}); code != http.StatusBadRequest {
+		t.Fatalf("expected status %d without registrationClosesAt, got %d", http.StatusBadRequest, code)
+	}
+	code, game := patchGame(t, srv, "g1", organizerID, api.UpdateGameRequest{
+		AllocationMode:       ptr.Ptr(api.Lottery),
+		RegistrationClosesAt: ptr.Ptr(now.Add(time.Hour)),
+	})
+	if code != http.StatusOK || *game.Game.AllocationMode != api.Lottery {
+		t.Fatalf("expected the game to be a lottery game, got status %d and %+v", code, game.Game.AllocationMode)
+	}
+
+	var registered []int64
+	for i := range 5 {
+		userID := dbtesting.UpsertTestUser(t, sqlDB, "player"+strconv.Itoa(i)+"@example.com")
+		updateParticipation(t, srv, "g1", userID, api.Going)
+		registered = append(registered, userID)
+	}
+	updateParticipation(t, srv, "g1", organizerID, api.Going)
+
+	// the registration is still open
+	fireScheduledEvents(t, sqlDB, querier, clock.StaticClock{Time: now}, scheduler.EventRegistrationClosed, srv.DrawLottery)
+	drawnGame, err := querier.GameGetById(t.Context(), "g1")
+	if err != nil {
+		t.Fatalf("failed to retrieve game: %v", err)
+	}
+	if drawnGame.LotteryDrawnAt.Valid {
+		t.Fatalf("expected the lottery not to be drawn before the registration closes")
+	}
+	// until the draw, the players are only registered and the spots stay unallocated, but the organizer keeps their spot
+	if drawnGame.GameSpotsLeft != 2 {
+		t.Fatalf("expected 2 spots left before the draw, got %d", drawnGame.GameSpotsLeft)
+	}
+	statuses := participantStatuses(t, listParticipants(t, srv, "g1", organizerID))
+	for _, userID := range registered {
+		if got := statuses[strconv.FormatInt(userID, 10)]; got != "registered" {
+			t.Fatalf("expected participant %d to be registered before the draw, got %s", userID, got)
+		}
+	}
+	if got := statuses[strconv.FormatInt(organizerID, 10)]; got != "going" {
+		t.Fatalf("expected the organizer to be going before the draw, got %s", got)
+	}
+
+	laterClock := clock.StaticClock{Time: now.Add(2 * time.Hour)}
+	later := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), laterClock, sqlDB)
+	fireScheduledEvents(t, sqlDB, querier, laterClock, scheduler.EventRegistrationClosed, later.DrawLottery)
+	drawnGame, err = querier.GameGetById(t.Context(), "g1")
+	if err != nil {
+		t.Fatalf("failed to retrieve game: %v", err)
+	}
+	if !drawnGame.LotteryDrawnAt.Valid || !drawnGame.LotterySeed.Valid {
+		t.Fatalf("expected the lottery to be drawn, got %+v", drawnGame)
+	}
+
+	// anyone can reproduce the draw from the seed
+	expectedOrder := slices.Clone(registered)
+	slices.Sort(expectedOrder)
+	rand.New(rand.NewPCG(uint64(drawnGame.LotterySeed.Int64), 0)).Shuffle(len(expectedOrder), func(i, j int) {
+		expectedOrder[i], expectedOrder[j] = expectedOrder[j], expectedOrder[i]
+	})
+
+	lateID := dbtesting.UpsertTestUser(t, sqlDB, "late@example.com")
+	updateParticipation(t, later, "g1", lateID, api.Going)
+
+	participants := listParticipants(t, later, "g1", organizerID)
+	var order []int64
+	for _, participant := range participants {
+		order = append(order, mustParseInt(t, participant.User.Id))
+	}
+	wantOrder := append(append([]int64{organizerID}, expectedOrder...), lateID)
+	if !slices.Equal(order, wantOrder) {
+		t.Fatalf("expected the participants in the order %v, got %v", wantOrder, order)
+	}
+
+	statuses = participantStatuses(t, participants)
+	for i, userID := range expectedOrder {
+		want := "waitlisted"
+		if i < 2 {
+			want = "going"
+		}
+		if got := statuses[strconv.FormatInt(userID, 10)]; got != want {
+			t.Fatalf("expected participant drawn at position %d to be %s, got %s", i+1, want, got)
+		}
+		if participants[i+1].DrawPosition == nil || *participants[i+1].DrawPosition != int64(i+1) {
+			t.Fatalf("expected draw position %d, got %v", i+1, participants[i+1].DrawPosition)
+		}
+	}
+	if participants[len(participants)-1].DrawPosition != nil {
+		t.Fatalf("expected participants joining after the draw to have no draw position")
+	}
+	if drawnGame, err = querier.GameGetById(t.Context(), "g1"); err != nil || drawnGame.GameSpotsLeft != 0 {
+		t.Fatalf("expected the spots to be allocated once drawn, got %d, %v", drawnGame.GameSpotsLeft, err)
+	}
+
+	if code, _ := patchGame(t, later, "g1", organizerID, api.UpdateGameRequest{AllocationMode: ptr.Ptr(api.FirstComeFirstServed)}); code != http.StatusBadRequest {
+		t.Fatalf("expected status %d once drawn, got %d", http.StatusBadRequest, code)
+	}
+}
+
+func TestLottery_LateJoinIsQueuedAfterTheDraw(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	now := time.Now()
+
+	organizerID := dbtesting.UpsertTestUser(t, sqlDB, "organizer@example.com")
+
+	querier := db.New(sqlDB)
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), clock.StaticClock{Time: now}, sqlDB)
+	createGame(t, querier, "g1", organizerID, sql.NullTime{Time: now.Add(-time.Hour), Valid: true})
+	setMaxPlayers(t, querier, "g1", 1)
+	if code, _ := patchGame(t, srv, "g1", organizerID, api.UpdateGameRequest{
+		AllocationMode:       ptr.Ptr(api.Lottery),
+		RegistrationClosesAt: ptr.Ptr(now.Add(time.Hour)),
+	}); code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, code)
+	}
+
+	playerID := dbtesting.UpsertTestUser(t, sqlDB, "player@example.com")
+	updateParticipation(t, srv, "g1", playerID, api.Going)
+
+	// the registration closed, but the drawer didn't run yet
+	later := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), clock.StaticClock{Time: now.Add(time.Hour + time.Minute)}, sqlDB)
+	lateID := dbtesting.UpsertTestUser(t, sqlDB, "late@example.com")
+	// the late participant joins first, and is still queued after the registered participant
+	updateParticipation(t, later, "g1", lateID, api.Going)
+
+	game, err := querier.GameGetById(t.Context(), "g1")
+	if err != nil {
+		t.Fatalf("failed to retrieve game: %v", err)
+	}
+	if !game.LotteryDrawnAt.Valid {
+		t.Fatalf("expected the late join to draw the lottery first")
+	}
+
+	participants := listParticipants(t, later, "g1", organizerID)
+	if len(participants) != 2 || participants[0].User.Id != strconv.FormatInt(playerID, 10) || participants[1].User.Id != strconv.FormatInt(lateID, 10) {
+		t.Fatalf("expected the late participant after the drawn one, got %+v", participants)
+	}
+	if participants[1].DrawPosition != nil {
+		t.Fatalf("expected the late participant not to be part of the draw")
+	}
+	statuses := participantStatuses(t, participants)
+	if statuses[strconv.FormatInt(playerID, 10)] != "going" || statuses[strconv.FormatInt(lateID, 10)] != "waitlisted" {
+		t.Fatalf("expected the late participant to be waitlisted behind the drawn one, got %v", statuses)
+	}
+}
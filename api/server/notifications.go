@@ -0,0 +1,309 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/dmateusp/opengym/api"
+	"github.com/dmateusp/opengym/auth"
+	"github.com/dmateusp/opengym/db"
+	"github.com/dmateusp/opengym/notify"
+	"github.com/dmateusp/opengym/outbox"
+)
+
+func (srv *server) GetApiUsersMeNotificationPreferences(w http.ResponseWriter, r *http.Request) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	preferences, err := srv.notificationPreferences(r.Context(), srv.querier, int64(authInfo.UserId))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to retrieve notification preferences: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(preferences); err != nil {
+		http.Error(w, fmt.Sprintf("failed to encode response: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (srv *server) PutApiUsersMeNotificationPreferences(w http.ResponseWriter, r *http.Request) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req []api.NotificationPreference
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("invalid request body: %s", err.Error()), http.StatusBadRequest)
+		return
+	}
+
+	for _, preference := range req {
+		if !notify.Type(preference.Type).Valid() {
+			http.Error(w, fmt.Sprintf("invalid notification type: %s", preference.Type), http.StatusBadRequest)
+			return
+		}
+	}
+
+	tx, err := srv.dbConn.BeginTx(r.Context(), nil)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to begin transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	querierWithTx := srv.querier.WithTx(tx)
+
+	for _, preference := range req {
+		if err := querierWithTx.NotificationPreferenceUpsert(r.Context(), db.NotificationPreferenceUpsertParams{
+			UserID:           int64(authInfo.UserId),
+			NotificationType: string(preference.Type),
+			Enabled:          preference.Enabled,
+		}); err != nil {
+			http.Error(w, fmt.Sprintf("failed to update notification preference: %s", err.Error()), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	preferences, err := srv.notificationPreferences(r.Context(), querierWithTx, int64(authInfo.UserId))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to retrieve notification preferences: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		http.Error(w, fmt.Sprintf("failed to commit transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(preferences); err != nil {
+		http.Error(w, fmt.Sprintf("failed to encode response: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+}
+
+// notificationPreferences returns a preference for every notification type, including the ones the user never changed.
+func (srv *server) notificationPreferences(ctx context.Context, querier db.Querier, userID int64) ([]api.NotificationPreference, error) {
+	rows, err := querier.NotificationPreferencesListByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	enabledByType := make(map[string]bool, len(rows))
+	for _, row := range rows {
+		enabledByType[row.NotificationType] = row.Enabled
+	}
+
+	preferences := make([]api.NotificationPreference, 0, len(notify.Types))
+	for _, notificationType := range notify.Types {
+		enabled, ok := enabledByType[string(notificationType)]
+		preferences = append(preferences, api.NotificationPreference{
+			Type:    api.NotificationType(notificationType),
+			Enabled: !ok || enabled,
+		})
+	}
+	return preferences, nil
+}
+
+// NotifyOnEvent is an [outbox.Consumer] sending the notifications triggered by domain events.
+func (srv *server) NotifyOnEvent(ctx context.Context, event outbox.Event) error {
+	game, err := srv.querier.GameGetById(ctx, event.GameID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		return fmt.Errorf("failed to retrieve game: %w", err)
+	}
+
+	switch event.Type {
+	case outbox.EventParticipantPromoted:
+		var payload outbox.ParticipantPromoted
+		if err := event.Decode(&payload); err != nil {
+			return err
+		}
+		return srv.notify(ctx, []int64{payload.UserID}, notify.TypeWaitlistPromoted,
+			fmt.Sprintf("You're in: %s", game.Name),
+			fmt.Sprintf("A spot opened up in %s, you moved from the waitlist to the list of players.\n\n%s", game.Name, gameUrl(game.ID)),
+		)
+
+	case outbox.EventWaitlistOffered:
+		var payload outbox.WaitlistOffered
+		if err := event.Decode(&payload); err != nil {
+			return err
+		}
+		return srv.notify(ctx, []int64{payload.UserID}, notify.TypeWaitlistPromoted,
+			fmt.Sprintf("A spot opened up: %s", game.Name),
+			fmt.Sprintf("A spot opened up in %s. Accept it before %s, otherwise it will be offered to the next person on the waitlist.\n\n%s",
+				game.Name, payload.ExpiresAt.Format(time.RFC1123), gameUrl(game.ID)),
+		)
+
+	case outbox.EventGamePublished:
+		userIDs, err := goingUserIDs(ctx, srv.querier, game)
+		if err != nil {
+			return err
+		}
+		return srv.notify(ctx, userIDs, notify.TypeGamePublished,
+			fmt.Sprintf("%s is published", game.Name),
+			fmt.Sprintf("%s is now published.\n\n%s", game.Name, gameUrl(game.ID)),
+		)
+
+	case outbox.EventGameUpdated:
+		var payload outbox.GameUpdated
+		if err := event.Decode(&payload); err != nil {
+			return err
+		}
+		changes := describeGameChanges(game, payload.ChangedFields)
+		if len(changes) == 0 {
+			return nil
+		}
+		if !game.PublishedAt.Valid || game.PublishedAt.Time.After(srv.clock.Now()) {
+			return nil
+		}
+
+		if payload.Reconfirmation != nil {
+			ask := "Please confirm you're still coming, or leave the game if you can't make it anymore."
+			if payload.Reconfirmation.ReleaseAt != nil {
+				ask = fmt.Sprintf("Please confirm you're still coming before %s, otherwise your spot will be released. Leave the game if you can't make it anymore.",
+					payload.Reconfirmation.ReleaseAt.Format(time.RFC1123))
+			}
+			return srv.notify(ctx, payload.Reconfirmation.UserIDs, notify.TypeGameUpdated,
+				fmt.Sprintf("%s changed, are you still coming?", game.Name),
+				fmt.Sprintf("The organizer changed the details of %s:\n\n%s\n\n%s\n\n%s", game.Name, strings.Join(changes, "\n"), ask, gameUrl(game.ID)),
+			)
+		}
+
+		userIDs, err := goingUserIDs(ctx, srv.querier, game)
+		if err != nil {
+			return err
+		}
+		// the organizer made the change
+		userIDs = removeUserID(userIDs, game.OrganizerID)
+
+		return srv.notify(ctx, userIDs, notify.TypeGameUpdated,
+			fmt.Sprintf("%s changed", game.Name),
+			fmt.Sprintf("The organizer changed the details of %s:\n\n%s\n\n%s", game.Name, strings.Join(changes, "\n"), gameUrl(game.ID)),
+		)
+
+	case outbox.EventGameCancelled:
+		var payload outbox.GameCancelled
+		if err := event.Decode(&payload); err != nil {
+			return err
+		}
+		userIDs, err := goingUserIDs(ctx, srv.querier, game)
+		if err != nil {
+			return err
+		}
+		// the organizer cancelled it
+		userIDs = removeUserID(userIDs, game.OrganizerID)
+
+		return srv.notify(ctx, userIDs, notify.TypeGameUpdated,
+			fmt.Sprintf("%s is cancelled", game.Name),
+			fmt.Sprintf("The organizer cancelled %s:\n\n%s\n\n%s", game.Name, payload.Reason, gameUrl(game.ID)),
+		)
+
+	case outbox.EventReimbursementMarked:
+		var payload outbox.ReimbursementMarked
+		if err := event.Decode(&payload); err != nil {
+			return err
+		}
+		if !payload.ByOrganizer || payload.ReimbursementReceivedAt == nil {
+			return nil
+		}
+		return srv.notify(ctx, []int64{payload.UserID}, notify.TypeReimbursementReceived,
+			fmt.Sprintf("Reimbursement received: %s", game.Name),
+			fmt.Sprintf("The organizer of %s confirmed receiving your reimbursement.\n\n%s", game.Name, gameUrl(game.ID)),
+		)
+	}
+
+	return nil
+}
+
+// notify sends the notification to every user. The event is redelivered if any of them fails,
+// so the other users may receive it twice, which is better than not receiving it at all.
+func (srv *server) notify(ctx context.Context, userIDs []int64, notificationType notify.Type, subject, body string) error {
+	var errs []error
+	for _, userID := range userIDs {
+		if err := srv.notifier.Notify(ctx, srv.querier, userID, notificationType, subject, body); err != nil {
+			errs = append(errs, fmt.Errorf("user %d: %w", userID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// describeGameChanges describes the changed fields that matter to players, using the current values of the game.
+func describeGameChanges(game db.Game, changedFields []string) []string {
+	var changes []string
+	for _, field := range changedFields {
+		switch field {
+		case "name":
+			changes = append(changes, fmt.Sprintf("- Name: %s", game.Name))
+		case "startsAt":
+			changes = append(changes, fmt.Sprintf("- Starts at: %s", game.StartsAt.Time.Format(time.RFC1123)))
+		case "durationMinutes":
+			changes = append(changes, fmt.Sprintf("- Duration: %d minutes", game.DurationMinutes))
+		case "location":
+			changes = append(changes, fmt.Sprintf("- Location: %s", game.Location.String))
+		case "totalPriceCents":
+			changes = append(changes, fmt.Sprintf("- Total price: %d.%02d", game.TotalPriceCents/100, game.TotalPriceCents%100))
+		case "splitStrategy":
+			changes = append(changes, fmt.Sprintf("- Price split: %s", strings.ReplaceAll(game.SplitStrategy, "_", " ")))
+		case "pricePerPlayerCents":
+			changes = append(changes, fmt.Sprintf("- Price per player: %d.%02d", game.PricePerPlayerCents/100, game.PricePerPlayerCents%100))
+		case "guestPriceCents":
+			changes = append(changes, fmt.Sprintf("- Price per guest: %d.%02d", game.GuestPriceCents/100, game.GuestPriceCents%100))
+		}
+	}
+	return changes
+}
+
+func goingUserIDs(ctx context.Context, querier db.Querier, game db.Game) ([]int64, error) {
+	rows, err := querier.ParticipantsList(ctx, db.ParticipantsListParams{
+		OrganizerID: game.OrganizerID,
+		GameID:      game.ID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list participants: %w", err)
+	}
+
+	var userIDs []int64
+	for _, row := range rows {
+		if row.GameParticipant.Going.Valid && row.GameParticipant.Going.Bool {
+			userIDs = append(userIDs, row.User.ID)
+		}
+	}
+	return userIDs, nil
+}
+
+func removeUserID(userIDs []int64, userID int64) []int64 {
+	filtered := make([]int64, 0, len(userIDs))
+	for _, id := range userIDs {
+		if id != userID {
+			filtered = append(filtered, id)
+		}
+	}
+	return filtered
+}
+
+func gameUrl(gameID string) string {
+	gameUrl, err := url.JoinPath(*frontendBaseUrl, "games", gameID)
+	if err != nil {
+		return *frontendBaseUrl
+	}
+	return gameUrl
+}
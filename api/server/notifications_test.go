@@ -0,0 +1,338 @@
+package server_test
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/dmateusp/opengym/api"
+	"github.com/dmateusp/opengym/api/server"
+	"github.com/dmateusp/opengym/auth"
+	"github.com/dmateusp/opengym/clock"
+	"github.com/dmateusp/opengym/db"
+	dbtesting "github.com/dmateusp/opengym/db/testing"
+	"github.com/dmateusp/opengym/notify"
+	"github.com/dmateusp/opengym/outbox"
+	"github.com/dmateusp/opengym/ptr"
+	"github.com/dmateusp/opengym/scheduler"
+)
+
+func updateParticipation(t *testing.T, srv api.ServerInterface, gameID string, userID int64, status api.ParticipationStatusUpdate) {
+	t.Helper()
+
+	body, _ := json.Marshal(api.UpdateGameParticipationRequest{Status: status})
+	r := httptest.NewRequest(http.MethodPut, "/api/games/"+gameID+"/participants", bytes.NewReader(body))
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(userID)}))
+	w := httptest.NewRecorder()
+	srv.PutApiGamesIdParticipants(w, r, gameID)
+	if w.Code != http.StatusOK {
+		t.Fatalf("failed to update participation of user %d: status %d, body %s", userID, w.Code, w.Body.String())
+	}
+}
+
+func dispatchEvents(t *testing.T, querier *db.Queries, clock clock.Clock, consumer outbox.Consumer) {
+	t.Helper()
+
+	dispatcher := outbox.NewDispatcher(db.NewQuerierWrapper(querier), clock)
+	dispatcher.Register("test", consumer)
+	if err := dispatcher.Dispatch(t.Context()); err != nil {
+		t.Fatalf("failed to dispatch events: %v", err)
+	}
+}
+
+// fireScheduledEvents runs the handler for the events of the type that are due at the clock.
+func fireScheduledEvents(t *testing.T, sqlDB *sql.DB, querier *db.Queries, clock clock.Clock, eventType scheduler.EventType, handler scheduler.Handler) {
+	t.Helper()
+
+	gameScheduler := scheduler.New(db.NewQuerierWrapper(querier), sqlDB, clock)
+	gameScheduler.On(eventType, handler)
+	if err := gameScheduler.Tick(t.Context()); err != nil {
+		t.Fatalf("failed to fire scheduled events: %v", err)
+	}
+}
+
+func TestPutApiGamesIdParticipants_NotifiesPromotedParticipants(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	staticClock := clock.StaticClock{Time: time.Now()}
+
+	organizerID := dbtesting.UpsertTestUser(t, sqlDB, "organizer@example.com")
+	user1 := dbtesting.UpsertTestUser(t, sqlDB, "user1@example.com")
+	user2 := dbtesting.UpsertTestUser(t, sqlDB, "user2@example.com")
+	user3 := dbtesting.UpsertTestUser(t, sqlDB, "user3@example.com")
+
+	querier := db.New(sqlDB)
+	sender := &notify.MemorySender{}
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), staticClock, sqlDB,
+		server.WithNotifier(notify.NewNotifier(sender)))
+
+	createGame(t, querier, "g1", organizerID, sql.NullTime{Time: staticClock.Time.Add(-time.Hour), Valid: true})
+	if err := querier.GameUpdate(t.Context(), db.GameUpdateParams{
+		ID:            "g1",
+		MaxPlayers:    sql.NullInt64{Int64: 1, Valid: true},
+		GameSpotsLeft: sql.NullInt64{Int64: 1, Valid: true},
+	}); err != nil {
+		t.Fatalf("failed to update game: %v", err)
+	}
+
+	updateParticipation(t, srv, "g1", user1, api.Going)
+	updateParticipation(t, srv, "g1", user2, api.Going)
+	updateParticipation(t, srv, "g1", user3, api.Going)
+	dispatchEvents(t, querier, staticClock, srv.NotifyOnEvent)
+	if len(sender.Messages()) != 0 {
+		t.Fatalf("expected no notifications yet, got %v", sender.Messages())
+	}
+
+	updateParticipation(t, srv, "g1", user1, api.NotGoing)
+	dispatchEvents(t, querier, staticClock, srv.NotifyOnEvent)
+
+	messages := sender.Messages()
+	if len(messages) != 1 {
+		t.Fatalf("expected 1 notification, got %v", messages)
+	}
+	if messages[0].To != "user2@example.com" {
+		t.Errorf("expected user2 to be notified, got %s", messages[0].To)
+	}
+	if !strings.Contains(messages[0].Body, "/games/g1") {
+		t.Errorf("expected the notification to link to the game, got %q", messages[0].Body)
+	}
+}
+
+func TestPutApiGamesIdParticipants_PromotionRespectsOptOut(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	staticClock := clock.StaticClock{Time: time.Now()}
+
+	organizerID := dbtesting.UpsertTestUser(t, sqlDB, "organizer@example.com")
+	user1 := dbtesting.UpsertTestUser(t, sqlDB, "user1@example.com")
+	user2 := dbtesting.UpsertTestUser(t, sqlDB, "user2@example.com")
+
+	querier := db.New(sqlDB)
+	sender := &notify.MemorySender{}
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), staticClock, sqlDB,
+		server.WithNotifier(notify.NewNotifier(sender)))
+
+	createGame(t, querier, "g1", organizerID, sql.NullTime{Time: staticClock.Time.Add(-time.Hour), Valid: true})
+	if err := querier.GameUpdate(t.Context(), db.GameUpdateParams{
+		ID:            "g1",
+		MaxPlayers:    sql.NullInt64{Int64: 1, Valid: true},
+		GameSpotsLeft: sql.NullInt64{Int64: 1, Valid: true},
+	}); err != nil {
+		t.Fatalf("failed to update game: %v", err)
+	}
+
+	body, _ := json.Marshal([]api.NotificationPreference{{Type: api.NotificationType(notify.TypeWaitlistPromoted), Enabled: false}})
+	r := httptest.NewRequest(http.MethodPut, "/api/users/me/notification-preferences", bytes.NewReader(body))
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(user2)}))
+	w := httptest.NewRecorder()
+	srv.PutApiUsersMeNotificationPreferences(w, r)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+
+	updateParticipation(t, srv, "g1", user1, api.Going)
+	updateParticipation(t, srv, "g1", user2, api.Going)
+	updateParticipation(t, srv, "g1", user1, api.NotGoing)
+	dispatchEvents(t, querier, staticClock, srv.NotifyOnEvent)
+
+	if messages := sender.Messages(); len(messages) != 0 {
+		t.Fatalf("expected no notifications, got %v", messages)
+	}
+}
+
+func TestPatchApiGamesId_NotifiesParticipantsOfChanges(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	staticClock := clock.StaticClock{Time: time.Now()}
+
+	organizerID := dbtesting.UpsertTestUser(t, sqlDB, "organizer@example.com")
+	user1 := dbtesting.UpsertTestUser(t, sqlDB, "user1@example.com")
+
+	querier := db.New(sqlDB)
+	sender := &notify.MemorySender{}
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), staticClock, sqlDB,
+		server.WithNotifier(notify.NewNotifier(sender)))
+
+	createGame(t, querier, "g1", organizerID, sql.NullTime{Time: staticClock.Time.Add(-time.Hour), Valid: true})
+	updateParticipation(t, srv, "g1", organizerID, api.Going)
+	updateParticipation(t, srv, "g1", user1, api.Going)
+
+	patch := func(req api.UpdateGameRequest) {
+		t.Helper()
+		body, _ := json.Marshal(req)
+		r := httptest.NewRequest(http.MethodPatch, "/api/games/g1", bytes.NewReader(body))
+		r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(organizerID)}))
+		w := httptest.NewRecorder()
+		srv.PatchApiGamesId(w, r, "g1")
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+	}
+
+	// the description isn't important enough to notify
+	patch(api.UpdateGameRequest{Description: ptr.Ptr("bring water")})
+	dispatchEvents(t, querier, staticClock, srv.NotifyOnEvent)
+	if messages := sender.Messages(); len(messages) != 0 {
+		t.Fatalf("expected no notifications, got %v", messages)
+	}
+
+	patch(api.UpdateGameRequest{Location: ptr.Ptr("Court 2")})
+	dispatchEvents(t, querier, staticClock, srv.NotifyOnEvent)
+	messages := sender.Messages()
+	if len(messages) != 1 {
+		t.Fatalf("expected 1 notification, got %v", messages)
+	}
+	if messages[0].To != "user1@example.com" {
+		t.Errorf("expected user1 to be notified, got %s", messages[0].To)
+	}
+	if !strings.Contains(messages[0].Body, "Location: Court 2") {
+		t.Errorf("expected the notification to describe the change, got %q", messages[0].Body)
+	}
+}
+
+func TestPutApiGamesIdReimbursements_NotifiesParticipantWhenReceived(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	staticClock := clock.StaticClock{Time: time.Now()}
+
+	organizerID := dbtesting.UpsertTestUser(t, sqlDB, "organizer@example.com")
+	user1 := dbtesting.UpsertTestUser(t, sqlDB, "user1@example.com")
+
+	querier := db.New(sqlDB)
+	sender := &notify.MemorySender{}
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), staticClock, sqlDB,
+		server.WithNotifier(notify.NewNotifier(sender)))
+
+	createGame(t, querier, "g1", organizerID, sql.NullTime{Time: staticClock.Time.Add(-time.Hour), Valid: true})
+	updateParticipation(t, srv, "g1", user1, api.Going)
+	freezeGameForReimbursements(t, sqlDB, staticClock.Time, "g1")
+
+	body := []byte(`{"participantId":"` + strconv.FormatInt(user1, 10) + `","reimbursementReceivedAt":"` + staticClock.Time.Format(time.RFC3339) + `"}`)
+	r := httptest.NewRequest(http.MethodPut, "/api/games/g1/reimbursements", bytes.NewReader(body))
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(organizerID)}))
+	w := httptest.NewRecorder()
+	srv.PutApiGamesIdReimbursements(w, r, "g1")
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	dispatchEvents(t, querier, staticClock, srv.NotifyOnEvent)
+
+	messages := sender.Messages()
+	if len(messages) != 1 || messages[0].To != "user1@example.com" {
+		t.Fatalf("expected user1 to be notified, got %v", messages)
+	}
+}
+
+func TestGetApiUsersMeNotificationPreferences(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	staticClock := clock.StaticClock{Time: time.Now()}
+
+	userID := dbtesting.UpsertTestUser(t, sqlDB, "user@example.com")
+	querier := db.New(sqlDB)
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), staticClock, sqlDB)
+
+	get := func() map[api.NotificationType]bool {
+		t.Helper()
+		r := httptest.NewRequest(http.MethodGet, "/api/users/me/notification-preferences", nil)
+		r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(userID)}))
+		w := httptest.NewRecorder()
+		srv.GetApiUsersMeNotificationPreferences(w, r)
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+		}
+		var preferences []api.NotificationPreference
+		if err := json.NewDecoder(w.Body).Decode(&preferences); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		enabled := make(map[api.NotificationType]bool)
+		for _, preference := range preferences {
+			enabled[preference.Type] = preference.Enabled
+		}
+		return enabled
+	}
+
+	preferences := get()
+	if len(preferences) != len(notify.Types) {
+		t.Fatalf("expected a preference for every notification type, got %v", preferences)
+	}
+	for notificationType, enabled := range preferences {
+		if !enabled {
+			t.Errorf("expected %s to be enabled by default", notificationType)
+		}
+	}
+
+	body, _ := json.Marshal([]api.NotificationPreference{{Type: api.NotificationType(notify.TypeGameUpdated), Enabled: false}})
+	r := httptest.NewRequest(http.MethodPut, "/api/users/me/notification-preferences", bytes.NewReader(body))
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(userID)}))
+	w := httptest.NewRecorder()
+	srv.PutApiUsersMeNotificationPreferences(w, r)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+
+	preferences = get()
+	if preferences[api.NotificationType(notify.TypeGameUpdated)] {
+		t.Errorf("expected %s to be disabled", notify.TypeGameUpdated)
+	}
+	if !preferences[api.NotificationType(notify.TypeWaitlistPromoted)] {
+		t.Errorf("expected %s to stay enabled", notify.TypeWaitlistPromoted)
+	}
+}
+
+func TestPutApiUsersMeNotificationPreferences_InvalidType(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	staticClock := clock.StaticClock{Time: time.Now()}
+
+	userID := dbtesting.UpsertTestUser(t, sqlDB, "user@example.com")
+	querier := db.New(sqlDB)
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), staticClock, sqlDB)
+
+	r := httptest.NewRequest(http.MethodPut, "/api/users/me/notification-preferences", strings.NewReader(`[{"type":"newsletter","enabled":false}]`))
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(userID)}))
+	w := httptest.NewRecorder()
+	srv.PutApiUsersMeNotificationPreferences(w, r)
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
+
+func TestNotifyOnEvent_GamePublished(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	staticClock := &clock.StaticClock{Time: time.Now()}
+
+	organizerID := dbtesting.UpsertTestUser(t, sqlDB, "organizer@example.com")
+
+	querier := db.New(sqlDB)
+	sender := &notify.MemorySender{}
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), staticClock, sqlDB,
+		server.WithNotifier(notify.NewNotifier(sender)))
+
+	// the organizer joins before scheduling the publication
+	createGame(t, querier, "g1", organizerID, sql.NullTime{Time: staticClock.Time.Add(time.Hour), Valid: true})
+	updateParticipation(t, srv, "g1", organizerID, api.Going)
+
+	gameScheduler := scheduler.New(db.NewQuerierWrapper(querier), sqlDB, staticClock)
+	gameScheduler.On(scheduler.EventGamePublished, srv.RecordLifecycleEvent)
+
+	staticClock.Time = staticClock.Time.Add(2 * time.Hour)
+	if err := gameScheduler.Tick(t.Context()); err != nil {
+		t.Fatalf("failed to fire lifecycle events: %v", err)
+	}
+	dispatchEvents(t, querier, staticClock, srv.NotifyOnEvent)
+
+	messages := sender.Messages()
+	if len(messages) != 1 || messages[0].To != "organizer@example.com" {
+		t.Fatalf("expected the organizer to be notified, got %v", messages)
+	}
+}
@@ -1,17 +1,22 @@
 package server
 
 import (
+	"context"
 	"database/sql"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/dmateusp/opengym/api"
 	"github.com/dmateusp/opengym/auth"
 	"github.com/dmateusp/opengym/db"
+	"github.com/dmateusp/opengym/outbox"
 	"github.com/dmateusp/opengym/ptr"
+	"github.com/dmateusp/opengym/queue"
 )
 
 const reimbursementReferenceLength = 4
@@ -35,60 +40,75 @@ func (s *server) GetApiGamesIdParticipants(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	// Non-organizers cannot view participants of unpublished or future games
-	if game.OrganizerID != int64(authInfo.UserId) {
-		if !game.PublishedAt.Valid || game.PublishedAt.Time.After(s.clock.Now()) {
-			http.Error(w, "game not found", http.StatusNotFound)
-			return
-		}
+	// Users without a role in the game cannot view participants of unpublished or future games
+	hidden, err := s.isHidden(r.Context(), s.querier, game, int64(authInfo.UserId), false)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
 	}
+	if hidden {
+		http.Error(w, "game not found", http.StatusNotFound)
+		return
+	}
+
+	participants, err := participantsWithStatus(r.Context(), s.querier, game)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(participants); err != nil {
+		http.Error(w, fmt.Sprintf("failed to encode response: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+}
 
-	// Get participants list
-	rows, err := s.querier.ParticipantsList(r.Context(), db.ParticipantsListParams{
+// participantsWithStatus lists the participants of the game with their computed participation status.
+func participantsWithStatus(ctx context.Context, querier db.Querier, game db.Game) ([]api.ParticipantWithUser, error) {
+	rows, err := querier.ParticipantsList(ctx, db.ParticipantsListParams{
 		OrganizerID: game.OrganizerID,
 		GameID:      game.ID,
 	})
 	if err != nil {
-		http.Error(w, fmt.Sprintf("failed to retrieve participants: %s", err.Error()), http.StatusInternalServerError)
-		return
+		return nil, fmt.Errorf("failed to retrieve participants: %w", err)
 	}
 
-	// Compute participation status for each participant; waitlist status doesn't enforce capacity except organizer overflow
-	participants := make([]api.ParticipantWithUser, 0, len(rows))
-	goingCount := int64(0)
-	waitlistCount := int64(0)
+	offers, err := querier.WaitlistOfferListPendingByGame(ctx, game.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve waitlist offers: %w", err)
+	}
+	offersByUser := make(map[int64]db.WaitlistOffer, len(offers))
+	for _, offer := range offers {
+		offersByUser[offer.UserID] = offer
+	}
 
-	for _, row := range rows {
+	participants := make([]api.ParticipantWithUser, 0, len(rows))
+	for i, entry := range queueOf(rows, game.MaxPlayers).Entries {
+		row := rows[i]
 		var status api.ParticipationStatus
-
-		// Determine participation status based on going flag and position
-		if row.GameParticipant.Going.Valid && row.GameParticipant.Going.Bool {
-			// User marked as going - compute segment based on capacities
-			participantCount := int64(1)
-			if row.GameParticipant.Guests.Valid {
-				participantCount += row.GameParticipant.Guests.Int64
-			}
-
-			if goingCount+participantCount <= game.MaxPlayers {
-				if err := status.FromParticipationStatusUpdate(api.Going); err != nil {
-					http.Error(w, fmt.Sprintf("failed to encode status: %s", err.Error()), http.StatusInternalServerError)
-					return
-				}
-				goingCount += participantCount
-			} else {
-				// Waitlisted regardless of waitlist capacity; we track count for organizer overflow handling
-				if err := status.FromParticipationStatus1(api.Waitlisted); err != nil {
-					http.Error(w, fmt.Sprintf("failed to encode status: %s", err.Error()), http.StatusInternalServerError)
-					return
-				}
-				waitlistCount += participantCount
-			}
-		} else {
-			// User marked as not going or hasn't set status
-			if err := status.FromParticipationStatusUpdate(api.NotGoing); err != nil {
-				http.Error(w, fmt.Sprintf("failed to encode status: %s", err.Error()), http.StatusInternalServerError)
-				return
-			}
+		var offerExpiresAt *time.Time
+		var err error
+
+		offer, offered := offersByUser[entry.UserID]
+		switch {
+		case entry.Status != queue.StatusNotGoing && lotteryPending(game) && !row.IsOrganizer:
+			// Their spot is decided by the draw
+			err = status.FromParticipationStatus1(api.Registered)
+		case entry.Status == queue.StatusGoing && offered:
+			// Holding the spot until they accept it
+			err = status.FromParticipationStatus1(api.Offered)
+			offerExpiresAt = ptr.Ptr(offer.ExpiresAt)
+		case entry.Status == queue.StatusGoing:
+			err = status.FromParticipationStatusUpdate(api.Going)
+		case entry.Status == queue.StatusWaitlisted:
+			err = status.FromParticipationStatus1(api.Waitlisted)
+		default:
+			err = status.FromParticipationStatusUpdate(api.NotGoing)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode status: %w", err)
 		}
 
 		// Convert user from DB model to API model
@@ -100,21 +120,36 @@ func (s *server) GetApiGamesIdParticipants(w http.ResponseWriter, r *http.Reques
 			guests = int(row.GameParticipant.Guests.Int64)
 		}
 
+		var drawPosition *int64
+		if row.GameParticipant.DrawPosition.Valid {
+			drawPosition = ptr.Ptr(row.GameParticipant.DrawPosition.Int64)
+		}
+
+		var reconfirmationRequestedAt *time.Time
+		if row.GameParticipant.ReconfirmRequestedAt.Valid {
+			reconfirmationRequestedAt = ptr.Ptr(row.GameParticipant.ReconfirmRequestedAt.Time)
+		}
+
+		var lateCancelledAt *time.Time
+		if row.GameParticipant.LateCancelledAt.Valid {
+			lateCancelledAt = ptr.Ptr(row.GameParticipant.LateCancelledAt.Time)
+		}
+
 		participants = append(participants, api.ParticipantWithUser{
-			Status:    status,
-			User:      user,
-			CreatedAt: ptr.Ptr(row.GameParticipant.CreatedAt),
-			UpdatedAt: ptr.Ptr(row.GameParticipant.GoingUpdatedAt),
-			Guests:    guests,
+			Status:                    status,
+			User:                      user,
+			CreatedAt:                 ptr.Ptr(row.GameParticipant.CreatedAt),
+			UpdatedAt:                 ptr.Ptr(row.GameParticipant.GoingUpdatedAt),
+			Guests:                    guests,
+			OfferExpiresAt:            offerExpiresAt,
+			Regular:                   ptr.Ptr(row.IsRegular),
+			DrawPosition:              drawPosition,
+			ReconfirmationRequestedAt: reconfirmationRequestedAt,
+			LateCancelledAt:           lateCancelledAt,
 		})
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	if err := json.NewEncoder(w).Encode(participants); err != nil {
-		http.Error(w, fmt.Sprintf("failed to encode response: %s", err.Error()), http.StatusInternalServerError)
-		return
-	}
+	return participants, nil
 }
 
 func (s *server) PutApiGamesIdParticipants(w http.ResponseWriter, r *http.Request, id string) {
@@ -143,6 +178,16 @@ func (s *server) PutApiGamesIdParticipants(w http.ResponseWriter, r *http.Reques
 	defer tx.Rollback()
 
 	querierWithTx := s.querier.WithTx(tx)
+
+	// Participants joining a lottery game after its registration closed are queued after the drawn participants,
+	// so the game is drawn first if the scheduler didn't get to it yet
+	if req.Status == api.Going {
+		if err := s.drawClosedLottery(r.Context(), querierWithTx, id); err != nil {
+			http.Error(w, fmt.Sprintf("failed to draw lottery: %s", err.Error()), http.StatusInternalServerError)
+			return
+		}
+	}
+
 	game, err := querierWithTx.GameGetById(r.Context(), id)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -153,12 +198,22 @@ func (s *server) PutApiGamesIdParticipants(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	// Non-organizers cannot interact with unpublished or future games.
-	if game.OrganizerID != int64(authInfo.UserId) {
-		if !game.PublishedAt.Valid || game.PublishedAt.Time.After(s.clock.Now()) {
-			http.Error(w, "game not found", http.StatusNotFound)
-			return
-		}
+	inviteToken, invited, err := activeInviteToken(r.Context(), querierWithTx, game.ID, req.InviteToken)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// Users without a role in the game cannot interact with unpublished or future games,
+	// and need an invite token to join private games.
+	hidden, err := s.isHidden(r.Context(), querierWithTx, game, int64(authInfo.UserId), invited)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if hidden {
+		http.Error(w, "game not found", http.StatusNotFound)
+		return
 	}
 
 	if game.FrozenAt.Valid && !game.FrozenAt.Time.After(s.clock.Now()) {
@@ -166,6 +221,29 @@ func (s *server) PutApiGamesIdParticipants(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
+	if game.CancelledAt.Valid {
+		http.Error(w, "game is cancelled", http.StatusBadRequest)
+		return
+	}
+
+	regular, err := isGroupRegular(r.Context(), querierWithTx, game, int64(authInfo.UserId))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if req.Status == api.Going && !s.checkHeadStart(w, r, querierWithTx, game, int64(authInfo.UserId), regular) {
+		return
+	}
+
+	participantsBefore, err := querierWithTx.ParticipantsList(r.Context(), db.ParticipantsListParams{
+		OrganizerID: game.OrganizerID,
+		GameID:      id,
+	})
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to list participants: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
 	going := sql.NullBool{Bool: req.Status == api.Going, Valid: true}
 
 	confirmedAt := sql.NullTime{
@@ -173,9 +251,6 @@ func (s *server) PutApiGamesIdParticipants(w http.ResponseWriter, r *http.Reques
 		Valid: req.Confirmed != nil,
 	}
 
-	// Participant and his/her potential guests
-	participantsGroup := 1
-
 	guests := sql.NullInt64{}
 	if req.Guests != nil {
 		if *req.Guests < 0 {
@@ -189,188 +264,104 @@ func (s *server) PutApiGamesIdParticipants(w http.ResponseWriter, r *http.Reques
 			http.Error(w, "this game doesn't allow that many guests", http.StatusBadRequest)
 			return
 		}
-
-		participantsGroup += int(*req.Guests)
 	}
 
-	var gameSpotsLeft sql.NullInt64
-	var computedStatus api.ParticipationStatus
+	if req.Status == api.NotGoing {
+		// Clear guests when someone is not going
+		guests.Int64 = 0
+		guests.Valid = true
+	}
 
-	switch req.Status {
-	case api.Going:
-		// Determine if organizer or if there's space in the main list
-		isOrganizer := game.OrganizerID == int64(authInfo.UserId)
-		if isOrganizer || participantsGroup <= int(game.GameSpotsLeft) {
-			// Organizer has priority, or user fits in main list - they're going
-			if err := computedStatus.FromParticipationStatusUpdate(api.Going); err != nil {
-				http.Error(w, fmt.Sprintf("failed to encode status: %s", err.Error()), http.StatusInternalServerError)
-				return
-			}
-			if participantsGroup <= int(game.GameSpotsLeft) {
-				// Decrement game spots
-				gameSpotsLeft.Int64 = game.GameSpotsLeft - int64(participantsGroup)
-				gameSpotsLeft.Valid = true
-			} else if isOrganizer {
-				// Organizer joining without space: recalculate GameSpotsLeft
-				// because the organizer takes spots and may push others to waitlist
-				participants, err := querierWithTx.ParticipantsList(r.Context(), db.ParticipantsListParams{
-					OrganizerID: game.OrganizerID,
-					GameID:      id,
-				})
-				if err != nil {
-					http.Error(w, fmt.Sprintf("failed to list participants: %s", err.Error()), http.StatusInternalServerError)
-					return
-				}
-
-				// Calculate how many of the existing going participants fit in the main list
-				// with the organizer now having priority and taking spots
-				mainListCount := int64(participantsGroup) // Start with the organizer's group size
-
-				for _, participant := range participants {
-					if !participant.GameParticipant.Going.Valid || !participant.GameParticipant.Going.Bool {
-						continue
-					}
-
-					pc := int64(1)
-					if participant.GameParticipant.Guests.Valid {
-						pc += participant.GameParticipant.Guests.Int64
-					}
-
-					// Check if this participant fits in the main list with the organizer
-					if mainListCount+pc <= int64(game.MaxPlayers) {
-						mainListCount += pc
-					}
-					// Otherwise they stay/go to waitlist
-				}
-
-				// GameSpotsLeft = total capacity minus those who fit in the main list
-				gameSpotsLeft.Int64 = int64(game.MaxPlayers) - mainListCount
-				gameSpotsLeft.Valid = true
-			}
-		} else {
-			// Non-organizer, not enough space: they're waitlisted
-			if err := computedStatus.FromParticipationStatus1(api.Waitlisted); err != nil {
-				http.Error(w, fmt.Sprintf("failed to encode status: %s", err.Error()), http.StatusInternalServerError)
-				return
-			}
-		}
+	if err := s.upsertParticipant(r.Context(), querierWithTx, db.ParticipantsUpsertParams{
+		UserID:         int64(authInfo.UserId),
+		GameID:         id,
+		Going:          going,
+		ConfirmedAt:    confirmedAt,
+		GoingUpdatedAt: s.clock.Now(),
+		Guests:         guests,
+	}); err != nil {
+		http.Error(w, fmt.Sprintf("failed to update participation: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
 
-	case api.NotGoing:
-		if err := computedStatus.FromParticipationStatusUpdate(api.NotGoing); err != nil {
-			http.Error(w, fmt.Sprintf("failed to encode status: %s", err.Error()), http.StatusInternalServerError)
-			return
-		}
-		// We need to determine if they were in the main list to free up spots
-		participants, err := querierWithTx.ParticipantsList(r.Context(), db.ParticipantsListParams{
-			OrganizerID: game.OrganizerID,
-			GameID:      id,
-		})
-		if err != nil {
-			http.Error(w, fmt.Sprintf("failed to list participants: %s", err.Error()), http.StatusInternalServerError)
+	// Participants dropping out of the list of players after the cancellation deadline cancel late
+	queueBefore := queueOf(participantsBefore, game.MaxPlayers)
+	entryBefore, _ := queueBefore.Entry(int64(authInfo.UserId))
+	lateCancellation := req.Status == api.NotGoing && entryBefore.Status == queue.StatusGoing && isLateCancellation(game, s.clock.Now())
+	if lateCancellation {
+		if err := querierWithTx.ParticipantSetLateCancellation(r.Context(), db.ParticipantSetLateCancellationParams{
+			LateCancelledAt:    sql.NullTime{Time: s.clock.Now(), Valid: true},
+			LateCancelledSpots: entryBefore.Size(),
+			GameID:             id,
+			UserID:             int64(authInfo.UserId),
+		}); err != nil {
+			http.Error(w, fmt.Sprintf("failed to record late cancellation: %s", err.Error()), http.StatusInternalServerError)
 			return
 		}
-		// Determine current participant segment by simulating read-time status
-		goingCount := 0
-		spotsFreed := int64(0)
-		for _, participant := range participants {
-			pc := 1
-			if participant.GameParticipant.Guests.Valid {
-				pc += int(participant.GameParticipant.Guests.Int64)
-			}
+	}
 
-			if participant.GameParticipant.Going.Valid && participant.GameParticipant.Going.Bool {
-				if goingCount+pc <= int(game.MaxPlayers) {
-					// main list - free up spots when this user leaves
-					if participant.User.ID == int64(authInfo.UserId) {
-						spotsFreed = int64(pc)
-						break
-					}
-					goingCount += pc
-				} else {
-					// waitlist (unlimited) - no counter to update
-					if participant.User.ID == int64(authInfo.UserId) {
-						// no counters to update
-						break
-					}
-				}
-			} else {
-				if participant.User.ID == int64(authInfo.UserId) {
-					// already not going
-					break
-				}
-			}
+	if game.IsPrivate && invited {
+		if err := querierWithTx.ParticipantSetInviteToken(r.Context(), db.ParticipantSetInviteTokenParams{
+			GameID:        id,
+			UserID:        int64(authInfo.UserId),
+			InviteTokenID: sql.NullInt64{Int64: inviteToken.ID, Valid: true},
+		}); err != nil {
+			http.Error(w, fmt.Sprintf("failed to record invite token: %s", err.Error()), http.StatusInternalServerError)
+			return
 		}
+	}
 
-		// If spots were freed, calculate how the remaining participants reorder
-		if spotsFreed > 0 {
-			// Calculate how many of the remaining going participants fit in the main list
-			mainListCount := int64(0)
-			for _, participant := range participants {
-				// Skip the user who is leaving
-				if participant.User.ID == int64(authInfo.UserId) {
-					continue
-				}
-
-				if !participant.GameParticipant.Going.Valid || !participant.GameParticipant.Going.Bool {
-					continue
-				}
-
-				pc := int64(1)
-				if participant.GameParticipant.Guests.Valid {
-					pc += participant.GameParticipant.Guests.Int64
-				}
-
-				// Count how many people fit in the main list
-				if mainListCount+pc <= int64(game.MaxPlayers) {
-					mainListCount += pc
-				}
-				// Note: we don't count waitlisted people towards spots available
-			}
+	participantsAfter, err := updateGameSpotsLeft(r.Context(), querierWithTx, game)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
 
-			// GameSpotsLeft = total capacity minus those who fit in the main list
-			gameSpotsLeft.Int64 = int64(game.MaxPlayers) - mainListCount
-			gameSpotsLeft.Valid = true
-		}
+	queueAfter := queueOf(participantsAfter, game.MaxPlayers)
+	status := queueAfter.Status(int64(authInfo.UserId))
+	// the order of lottery games is only provisional until the draw
+	waitlisted := status == queue.StatusWaitlisted && !lotteryPending(game)
 
-		// Clear guests when someone is not going
-		guests.Int64 = 0
-		guests.Valid = true
-		participantsGroup = 1
+	// Participants can't join the waitlist once it's full, lottery games accept every registration until the draw
+	if waitlisted && waitlistOverflows(game, queueAfter) && queueAfter.Waitlisted > queueBefore.Waitlisted {
+		http.Error(w, "the game and its waitlist are full", http.StatusConflict)
+		return
+	}
 
+	var computedStatus api.ParticipationStatus
+	switch {
+	case status != queue.StatusNotGoing && lotteryPending(game) && int64(authInfo.UserId) != game.OrganizerID:
+		err = computedStatus.FromParticipationStatus1(api.Registered)
+	case status == queue.StatusGoing:
+		err = computedStatus.FromParticipationStatusUpdate(api.Going)
+	case status == queue.StatusWaitlisted:
+		err = computedStatus.FromParticipationStatus1(api.Waitlisted)
 	default:
-		http.Error(w, "invalid status: "+string(req.Status), http.StatusBadRequest)
+		err = computedStatus.FromParticipationStatusUpdate(api.NotGoing)
+	}
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to encode status: %s", err.Error()), http.StatusInternalServerError)
 		return
 	}
 
-	if gameSpotsLeft.Valid {
-		if err := querierWithTx.GameUpdate(r.Context(), db.GameUpdateParams{
-			ID:            id,
-			GameSpotsLeft: gameSpotsLeft,
-		}); err != nil {
-			http.Error(w, fmt.Sprintf("failed to update game spots left: %s", err.Error()), http.StatusInternalServerError)
-			return
+	var event outbox.Payload = outbox.ParticipantLeft{UserID: int64(authInfo.UserId), Late: lateCancellation}
+	if req.Status == api.Going {
+		joined := outbox.ParticipantJoined{UserID: int64(authInfo.UserId), Waitlisted: waitlisted}
+		// guests are only part of the request when they change
+		for _, participant := range participantsAfter {
+			if participant.User.ID == int64(authInfo.UserId) && participant.GameParticipant.Guests.Valid {
+				joined.Guests = participant.GameParticipant.Guests.Int64
+			}
 		}
+		event = joined
+	}
+	if err := outbox.Publish(r.Context(), querierWithTx, id, event); err != nil {
+		http.Error(w, fmt.Sprintf("failed to publish event: %s", err.Error()), http.StatusInternalServerError)
+		return
 	}
 
-	for attempt := range maxReimbursementReferenceAttempts {
-		err := querierWithTx.ParticipantsUpsert(r.Context(), db.ParticipantsUpsertParams{
-			UserID:                 int64(authInfo.UserId),
-			GameID:                 id,
-			Going:                  going,
-			ConfirmedAt:            confirmedAt,
-			GoingUpdatedAt:         s.clock.Now(),
-			Guests:                 guests,
-			ReimbursementReference: s.randomAlphanumericGenerator.Generate(reimbursementReferenceLength),
-		})
-		if err == nil {
-			break
-		}
-
-		if attempt < maxReimbursementReferenceAttempts-1 && isReimbursementReferenceConstraintError(err) {
-			continue
-		}
-
-		http.Error(w, fmt.Sprintf("failed to update participation: %s", err.Error()), http.StatusInternalServerError)
+	if err := publishPromotions(r.Context(), querierWithTx, game, s.clock.Now(), participantsBefore, game.MaxPlayers, participantsAfter, game.MaxPlayers, int64(authInfo.UserId)); err != nil {
+		http.Error(w, fmt.Sprintf("failed to publish event: %s", err.Error()), http.StatusInternalServerError)
 		return
 	}
 
@@ -393,6 +384,286 @@ func (s *server) PutApiGamesIdParticipants(w http.ResponseWriter, r *http.Reques
 	}
 }
 
+func (s *server) DeleteApiGamesIdParticipantsUserId(w http.ResponseWriter, r *http.Request, id string, userId string) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	participantID, err := strconv.ParseInt(userId, 10, 64)
+	if err != nil {
+		http.Error(w, "participant not found", http.StatusNotFound)
+		return
+	}
+
+	tx, err := s.dbConn.BeginTx(r.Context(), nil)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to begin transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	querierWithTx := s.querier.WithTx(tx)
+
+	game, ok := s.gameForQueueUpdate(w, r, querierWithTx, id, int64(authInfo.UserId))
+	if !ok {
+		return
+	}
+
+	participantsBefore, err := querierWithTx.ParticipantsList(r.Context(), db.ParticipantsListParams{
+		OrganizerID: game.OrganizerID,
+		GameID:      id,
+	})
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to list participants: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	rowsAffected, err := querierWithTx.ParticipantDelete(r.Context(), db.ParticipantDeleteParams{
+		GameID: id,
+		UserID: participantID,
+	})
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to remove participant: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+	if rowsAffected == 0 {
+		http.Error(w, "participant not found", http.StatusNotFound)
+		return
+	}
+
+	// placeholders only participate in one game
+	if err := querierWithTx.PlaceholderClaimDeleteByUser(r.Context(), participantID); err != nil {
+		http.Error(w, fmt.Sprintf("failed to remove placeholder: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+	if err := querierWithTx.UserDeletePlaceholder(r.Context(), participantID); err != nil {
+		http.Error(w, fmt.Sprintf("failed to remove placeholder: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	participantsAfter, err := updateGameSpotsLeft(r.Context(), querierWithTx, game)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := outbox.Publish(r.Context(), querierWithTx, id, outbox.ParticipantLeft{UserID: participantID, ByOrganizer: true}); err != nil {
+		http.Error(w, fmt.Sprintf("failed to publish event: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+	if err := publishPromotions(r.Context(), querierWithTx, game, s.clock.Now(), participantsBefore, game.MaxPlayers, participantsAfter, game.MaxPlayers, participantID); err != nil {
+		http.Error(w, fmt.Sprintf("failed to publish event: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		http.Error(w, fmt.Sprintf("failed to commit transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *server) PutApiGamesIdParticipantsOrder(w http.ResponseWriter, r *http.Request, id string) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req api.ReorderParticipantsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("invalid request body: %s", err.Error()), http.StatusBadRequest)
+		return
+	}
+
+	tx, err := s.dbConn.BeginTx(r.Context(), nil)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to begin transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	querierWithTx := s.querier.WithTx(tx)
+
+	game, ok := s.gameForQueueUpdate(w, r, querierWithTx, id, int64(authInfo.UserId))
+	if !ok {
+		return
+	}
+
+	participantsBefore, err := querierWithTx.ParticipantsList(r.Context(), db.ParticipantsListParams{
+		OrganizerID: game.OrganizerID,
+		GameID:      id,
+	})
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to list participants: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	// The queue is ordered by going_updated_at, the participants swap their timestamps to take their new place
+	var queueTimes []time.Time
+	inQueue := make(map[int64]bool)
+	for _, participant := range participantsBefore {
+		if participant.IsOrganizer || !participant.GameParticipant.Going.Valid || !participant.GameParticipant.Going.Bool {
+			continue
+		}
+		queueTimes = append(queueTimes, participant.GameParticipant.GoingUpdatedAt)
+		inQueue[participant.User.ID] = true
+	}
+	slices.SortFunc(queueTimes, func(a, b time.Time) int { return a.Compare(b) })
+
+	const invalidOrder = "userIds must list every participant going to the game once, except the organizer"
+	if len(req.UserIds) != len(inQueue) {
+		http.Error(w, invalidOrder, http.StatusBadRequest)
+		return
+	}
+	userIDs := make([]int64, 0, len(req.UserIds))
+	for _, rawUserID := range req.UserIds {
+		userID, err := strconv.ParseInt(rawUserID, 10, 64)
+		if err != nil || !inQueue[userID] || slices.Contains(userIDs, userID) {
+			http.Error(w, invalidOrder, http.StatusBadRequest)
+			return
+		}
+		userIDs = append(userIDs, userID)
+	}
+
+	var previous time.Time
+	for i, userID := range userIDs {
+		goingUpdatedAt := queueTimes[i]
+		// participants who joined at the same time are ordered by when they first joined, which the new order may contradict
+		if i > 0 && !goingUpdatedAt.After(previous) {
+			goingUpdatedAt = previous.Add(time.Millisecond)
+		}
+		previous = goingUpdatedAt
+
+		if err := querierWithTx.ParticipantUpdateGoingUpdatedAt(r.Context(), db.ParticipantUpdateGoingUpdatedAtParams{
+			GameID:         id,
+			UserID:         userID,
+			GoingUpdatedAt: goingUpdatedAt,
+		}); err != nil {
+			http.Error(w, fmt.Sprintf("failed to reorder participants: %s", err.Error()), http.StatusInternalServerError)
+			return
+		}
+
+		// once drawn, lottery games are ordered by draw position first
+		if game.LotteryDrawnAt.Valid {
+			if err := querierWithTx.ParticipantSetDrawPosition(r.Context(), db.ParticipantSetDrawPositionParams{
+				GameID:       id,
+				UserID:       userID,
+				DrawPosition: sql.NullInt64{Int64: int64(i + 1), Valid: true},
+			}); err != nil {
+				http.Error(w, fmt.Sprintf("failed to reorder participants: %s", err.Error()), http.StatusInternalServerError)
+				return
+			}
+		}
+	}
+
+	participantsAfter, err := updateGameSpotsLeft(r.Context(), querierWithTx, game)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := outbox.Publish(r.Context(), querierWithTx, id, outbox.ParticipantsReordered{UserIDs: userIDs}); err != nil {
+		http.Error(w, fmt.Sprintf("failed to publish event: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+	if err := publishPromotions(r.Context(), querierWithTx, game, s.clock.Now(), participantsBefore, game.MaxPlayers, participantsAfter, game.MaxPlayers, 0); err != nil {
+		http.Error(w, fmt.Sprintf("failed to publish event: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	participants, err := participantsWithStatus(r.Context(), querierWithTx, game)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		http.Error(w, fmt.Sprintf("failed to commit transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(participants); err != nil {
+		http.Error(w, fmt.Sprintf("failed to encode response: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+}
+
+// gameForQueueUpdate retrieves a game whose queue the user wants to manage, writing the error response
+// if the user isn't an organizer of the game or the game is frozen.
+func (s *server) gameForQueueUpdate(w http.ResponseWriter, r *http.Request, querier db.Querier, id string, userID int64) (db.Game, bool) {
+	game, err := querier.GameGetById(r.Context(), id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			http.Error(w, "game not found", http.StatusNotFound)
+			return db.Game{}, false
+		}
+		http.Error(w, fmt.Sprintf("failed to retrieve game: %s", err.Error()), http.StatusInternalServerError)
+		return db.Game{}, false
+	}
+
+	if !authorize(w, r, querier, game, userID, permissionManage) {
+		return db.Game{}, false
+	}
+
+	if game.FrozenAt.Valid && !game.FrozenAt.Time.After(s.clock.Now()) {
+		http.Error(w, "game is frozen", http.StatusBadRequest)
+		return db.Game{}, false
+	}
+
+	if game.CancelledAt.Valid {
+		http.Error(w, "game is cancelled", http.StatusBadRequest)
+		return db.Game{}, false
+	}
+
+	return game, true
+}
+
+// updateGameSpotsLeft computes the spots left in the game and on its waitlist from the participants, after the queue changed.
+// It returns the participants, as they are now.
+func updateGameSpotsLeft(ctx context.Context, querier db.Querier, game db.Game) ([]db.ParticipantsListRow, error) {
+	participants, err := querier.ParticipantsList(ctx, db.ParticipantsListParams{
+		OrganizerID: game.OrganizerID,
+		GameID:      game.ID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list participants: %w", err)
+	}
+
+	q := spotsQueue(game, participants)
+	if err := querier.GameUpdate(ctx, db.GameUpdateParams{
+		ID:                game.ID,
+		GameSpotsLeft:     sql.NullInt64{Int64: q.SpotsLeft, Valid: true},
+		WaitlistSpotsLeft: sql.NullInt64{Int64: waitlistSpotsLeft(game, q), Valid: true},
+	}); err != nil {
+		return nil, fmt.Errorf("failed to update game spots left: %w", err)
+	}
+
+	return participants, nil
+}
+
+// upsertParticipant creates or updates a participation, generating a reimbursement reference that's unique in the game.
+func (s *server) upsertParticipant(ctx context.Context, querier db.Querier, params db.ParticipantsUpsertParams) error {
+	for attempt := range maxReimbursementReferenceAttempts {
+		params.ReimbursementReference = s.randomAlphanumericGenerator.Generate(reimbursementReferenceLength)
+		err := querier.ParticipantsUpsert(ctx, params)
+		if err == nil {
+			return nil
+		}
+
+		if attempt < maxReimbursementReferenceAttempts-1 && isReimbursementReferenceConstraintError(err) {
+			continue
+		}
+		return err
+	}
+	return nil
+}
+
 func isReimbursementReferenceConstraintError(err error) bool {
 	if err == nil {
 		return false
@@ -0,0 +1,206 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/dmateusp/opengym/api"
+	"github.com/dmateusp/opengym/auth"
+	"github.com/dmateusp/opengym/db"
+	"github.com/dmateusp/opengym/ptr"
+	"github.com/dmateusp/opengym/sepa"
+)
+
+var (
+	mbwayPhonePattern     = regexp.MustCompile(`^\+?[0-9]{9,15}$`)
+	paypalMeHandlePattern = regexp.MustCompile(`^[A-Za-z0-9]{1,20}$`)
+)
+
+func (s *server) GetApiUsersMePayoutDetails(w http.ResponseWriter, r *http.Request) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var resp api.PayoutDetails
+	details, err := s.querier.PayoutDetailsGetByUser(r.Context(), int64(authInfo.UserId))
+	if err != nil && err != sql.ErrNoRows {
+		http.Error(w, fmt.Sprintf("failed to retrieve payout details: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+	if err == nil {
+		resp.FromDb(details)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		http.Error(w, fmt.Sprintf("failed to encode response: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (s *server) PutApiUsersMePayoutDetails(w http.ResponseWriter, r *http.Request) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req api.PayoutDetails
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("invalid request body: %s", err.Error()), http.StatusBadRequest)
+		return
+	}
+
+	params := db.PayoutDetailsUpsertParams{UserID: int64(authInfo.UserId)}
+
+	if iban := sepa.NormalizeIBAN(ptr.Value(req.Iban)); iban != "" {
+		if err := sepa.ValidateIBAN(iban); err != nil {
+			http.Error(w, fmt.Sprintf("invalid iban: %s", err.Error()), http.StatusBadRequest)
+			return
+		}
+		params.Iban = sql.NullString{String: iban, Valid: true}
+	}
+
+	if bic := strings.ToUpper(strings.TrimSpace(ptr.Value(req.Bic))); bic != "" {
+		if !params.Iban.Valid {
+			http.Error(w, "bic can only be set with an iban", http.StatusBadRequest)
+			return
+		}
+		if err := sepa.ValidateBIC(bic); err != nil {
+			http.Error(w, fmt.Sprintf("invalid bic: %s", err.Error()), http.StatusBadRequest)
+			return
+		}
+		params.Bic = sql.NullString{String: bic, Valid: true}
+	}
+
+	beneficiaryName := strings.TrimSpace(ptr.Value(req.BeneficiaryName))
+	if utf8.RuneCountInString(beneficiaryName) > 70 {
+		http.Error(w, "beneficiaryName cannot exceed 70 characters", http.StatusBadRequest)
+		return
+	}
+	if params.Iban.Valid && beneficiaryName == "" {
+		http.Error(w, "beneficiaryName is required with an iban", http.StatusBadRequest)
+		return
+	}
+	if beneficiaryName != "" {
+		params.BeneficiaryName = sql.NullString{String: beneficiaryName, Valid: true}
+	}
+
+	if phone := strings.Join(strings.Fields(ptr.Value(req.MbwayPhone)), ""); phone != "" {
+		if !mbwayPhonePattern.MatchString(phone) {
+			http.Error(w, "mbwayPhone must be a phone number", http.StatusBadRequest)
+			return
+		}
+		params.MbwayPhone = sql.NullString{String: phone, Valid: true}
+	}
+
+	if handle := strings.TrimSpace(ptr.Value(req.PaypalMeHandle)); handle != "" {
+		if !paypalMeHandlePattern.MatchString(handle) {
+			http.Error(w, "paypalMeHandle must be up to 20 letters and digits", http.StatusBadRequest)
+			return
+		}
+		params.PaypalMeHandle = sql.NullString{String: handle, Valid: true}
+	}
+
+	details, err := s.querier.PayoutDetailsUpsert(r.Context(), params)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to update payout details: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	var resp api.PayoutDetails
+	resp.FromDb(details)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		http.Error(w, fmt.Sprintf("failed to encode response: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+}
+
+// paymentInstructions returns how the participant can pay what they owe for the game to whoever collects its money,
+// or nil when they aren't billed for it or the payee didn't say how they want to be paid.
+func paymentInstructions(ctx context.Context, querier db.Querier, game db.Game, participant db.GameParticipant) (*api.PaymentInstructions, error) {
+	rows, err := querier.ParticipantsList(ctx, db.ParticipantsListParams{
+		OrganizerID: game.OrganizerID,
+		GameID:      game.ID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve participants: %w", err)
+	}
+
+	var billed *billedParticipant
+	for _, candidate := range billedParticipants(game, rows) {
+		if candidate.row.User.ID == participant.UserID {
+			billed = &candidate
+			break
+		}
+	}
+	if billed == nil {
+		return nil, nil
+	}
+
+	payeeID, err := gamePayee(ctx, querier, game)
+	if err != nil {
+		return nil, err
+	}
+
+	details, err := querier.PayoutDetailsGetByUser(ctx, payeeID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to retrieve payout details: %w", err)
+	}
+
+	var payout api.PayoutDetails
+	payout.FromDb(details)
+	if payout.Iban == nil && payout.MbwayPhone == nil && payout.PaypalMeHandle == nil {
+		return nil, nil
+	}
+
+	amount := billed.amountOwedCents
+	instructions := &api.PaymentInstructions{
+		AmountOwedCents: amount,
+		// the game ID and the reference let the organizer match the transfer on their bank statement
+		RemittanceInformation: fmt.Sprintf("%s %s", game.ID, participant.ReimbursementReference),
+		BeneficiaryName:       payout.BeneficiaryName,
+		Iban:                  payout.Iban,
+		Bic:                   payout.Bic,
+		MbwayPhone:            payout.MbwayPhone,
+	}
+
+	if payout.Iban != nil && amount > 0 {
+		payload, err := sepa.CreditTransfer{
+			BIC:         ptr.Value(payout.Bic),
+			Name:        ptr.Value(payout.BeneficiaryName),
+			IBAN:        *payout.Iban,
+			AmountCents: amount,
+			Remittance:  instructions.RemittanceInformation,
+		}.EPCQRPayload()
+		if err != nil {
+			return nil, fmt.Errorf("failed to build the QR code payload: %w", err)
+		}
+		instructions.EpcQrPayload = &payload
+	}
+
+	if payout.PaypalMeHandle != nil {
+		url := fmt.Sprintf("https://paypal.me/%s", *payout.PaypalMeHandle)
+		if amount > 0 {
+			url += fmt.Sprintf("/%d.%02d", amount/100, amount%100)
+		}
+		instructions.PaypalMeUrl = &url
+	}
+
+	return instructions, nil
+}
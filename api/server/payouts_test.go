@@ -0,0 +1,162 @@
+package server_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/dmateusp/opengym/api"
+	"github.com/dmateusp/opengym/api/server"
+	"github.com/dmateusp/opengym/auth"
+	"github.com/dmateusp/opengym/clock"
+	"github.com/dmateusp/opengym/db"
+	dbtesting "github.com/dmateusp/opengym/db/testing"
+	"github.com/dmateusp/opengym/ptr"
+)
+
+func putPayoutDetails(t *testing.T, srv api.ServerInterface, userID int64, req api.PayoutDetails) *httptest.ResponseRecorder {
+	t.Helper()
+
+	body, _ := json.Marshal(req)
+	r := httptest.NewRequest(http.MethodPut, "/api/users/me/payout-details", bytes.NewReader(body))
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(userID)}))
+	w := httptest.NewRecorder()
+	srv.PutApiUsersMePayoutDetails(w, r)
+	return w
+}
+
+func TestPayoutDetails(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+
+	userID := dbtesting.UpsertTestUser(t, sqlDB, "organizer@example.com")
+	querier := db.New(sqlDB)
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), clock.StaticClock{Time: time.Now()}, sqlDB)
+
+	invalid := map[string]api.PayoutDetails{
+		"wrong check digits":        {Iban: ptr.Ptr("DE89 3704 0044 0532 0130 01"), BeneficiaryName: ptr.Ptr("Jane Doe")},
+		"iban without a name":       {Iban: ptr.Ptr("DE89 3704 0044 0532 0130 00")},
+		"bic without an iban":       {Bic: ptr.Ptr("DEUTDEFF")},
+		"phone number with letters": {MbwayPhone: ptr.Ptr("call me")},
+		"paypal.me link":            {PaypalMeHandle: ptr.Ptr("paypal.me/janedoe")},
+	}
+	for name, req := range invalid {
+		if w := putPayoutDetails(t, srv, userID, req); w.Code != http.StatusBadRequest {
+			t.Errorf("%s: expected status %d, got %d", name, http.StatusBadRequest, w.Code)
+		}
+	}
+
+	w := putPayoutDetails(t, srv, userID, api.PayoutDetails{
+		Iban:            ptr.Ptr("de89 3704 0044 0532 0130 00"),
+		Bic:             ptr.Ptr("deutdeff"),
+		BeneficiaryName: ptr.Ptr(" Jane Doe "),
+		MbwayPhone:      ptr.Ptr("+351 912 345 678"),
+	})
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+
+	r := httptest.NewRequest(http.MethodGet, "/api/users/me/payout-details", nil)
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(userID)}))
+	w = httptest.NewRecorder()
+	srv.GetApiUsersMePayoutDetails(w, r)
+	var details api.PayoutDetails
+	if err := json.NewDecoder(w.Body).Decode(&details); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if ptr.Value(details.Iban) != "DE89370400440532013000" || ptr.Value(details.Bic) != "DEUTDEFF" || ptr.Value(details.BeneficiaryName) != "Jane Doe" || ptr.Value(details.MbwayPhone) != "+351912345678" || details.PaypalMeHandle != nil {
+		t.Fatalf("expected the normalized payout details, got %+v", details)
+	}
+
+	// details that aren't provided are cleared
+	w = putPayoutDetails(t, srv, userID, api.PayoutDetails{PaypalMeHandle: ptr.Ptr("janedoe")})
+	var updated api.PayoutDetails
+	if err := json.NewDecoder(w.Body).Decode(&updated); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if updated.Iban != nil || updated.MbwayPhone != nil || ptr.Value(updated.PaypalMeHandle) != "janedoe" {
+		t.Fatalf("expected only the PayPal.me handle to be kept, got %+v", updated)
+	}
+}
+
+func TestGetApiGamesIdReimbursementsParticipantId_PaymentInstructions(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	now := time.Now()
+
+	srv, userIDs := setupBilledGame(t, sqlDB, now)
+	organizerID, participantID := userIDs[0], userIDs[1]
+
+	getRecord := func() api.ReimbursementRecord {
+		t.Helper()
+		r := httptest.NewRequest(http.MethodGet, "/api/games/g1/reimbursements/"+strconv.FormatInt(participantID, 10), nil)
+		r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(participantID)}))
+		w := httptest.NewRecorder()
+		srv.GetApiGamesIdReimbursementsParticipantId(w, r, "g1", strconv.FormatInt(participantID, 10))
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+		var record api.ReimbursementRecord
+		if err := json.NewDecoder(w.Body).Decode(&record); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		return record
+	}
+
+	if record := getRecord(); record.PaymentInstructions != nil {
+		t.Fatalf("expected no payment instructions before the organizer sets their payout details, got %+v", record.PaymentInstructions)
+	}
+
+	if w := putPayoutDetails(t, srv, organizerID, api.PayoutDetails{
+		Iban:            ptr.Ptr("DE89370400440532013000"),
+		BeneficiaryName: ptr.Ptr("Jane Doe"),
+		PaypalMeHandle:  ptr.Ptr("janedoe"),
+	}); w.Code != http.StatusOK {
+		t.Fatalf("failed to set payout details: status %d, body %s", w.Code, w.Body.String())
+	}
+
+	instructions := getRecord().PaymentInstructions
+	if instructions == nil {
+		t.Fatalf("expected payment instructions")
+	}
+	if instructions.AmountOwedCents != 1000 || instructions.RemittanceInformation != "g1 Bb22" || ptr.Value(instructions.Iban) != "DE89370400440532013000" {
+		t.Fatalf("expected instructions to pay the amount owed with the reference, got %+v", instructions)
+	}
+	wantPayload := "BCD\n002\n1\nSCT\n\nJane Doe\nDE89370400440532013000\nEUR10.00\n\n\ng1 Bb22"
+	if ptr.Value(instructions.EpcQrPayload) != wantPayload {
+		t.Fatalf("expected QR code payload %q, got %q", wantPayload, ptr.Value(instructions.EpcQrPayload))
+	}
+	if ptr.Value(instructions.PaypalMeUrl) != "https://paypal.me/janedoe/10.00" {
+		t.Fatalf("expected a prefilled PayPal.me link, got %q", ptr.Value(instructions.PaypalMeUrl))
+	}
+
+	// once the owner appoints a treasurer, the money goes to the treasurer
+	treasurerID := dbtesting.UpsertTestUser(t, sqlDB, "treasurer@example.com")
+	if w := inviteToRole(t, srv, "g1", organizerID, "treasurer@example.com", api.Treasurer); w.Code != http.StatusCreated {
+		t.Fatalf("failed to appoint the treasurer: status %d, body %s", w.Code, w.Body.String())
+	}
+	if record := getRecord(); record.PaymentInstructions != nil {
+		t.Fatalf("expected no payment instructions before the treasurer sets their payout details, got %+v", record.PaymentInstructions)
+	}
+	if w := putPayoutDetails(t, srv, treasurerID, api.PayoutDetails{MbwayPhone: ptr.Ptr("+351912345678")}); w.Code != http.StatusOK {
+		t.Fatalf("failed to set payout details: status %d, body %s", w.Code, w.Body.String())
+	}
+	instructions = getRecord().PaymentInstructions
+	if instructions == nil || instructions.Iban != nil || ptr.Value(instructions.MbwayPhone) != "+351912345678" {
+		t.Fatalf("expected instructions to pay the treasurer, got %+v", instructions)
+	}
+
+	// what participants owe can still change until the game is frozen
+	if _, err := sqlDB.Exec(`update games set frozen_at = null where id = ?`, "g1"); err != nil {
+		t.Fatalf("failed to unfreeze game: %v", err)
+	}
+	if record := getRecord(); record.PaymentInstructions != nil {
+		t.Fatalf("expected no payment instructions before the game is frozen, got %+v", record.PaymentInstructions)
+	}
+}
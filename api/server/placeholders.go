@@ -0,0 +1,344 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/dmateusp/opengym/api"
+	"github.com/dmateusp/opengym/auth"
+	"github.com/dmateusp/opengym/db"
+	"github.com/dmateusp/opengym/outbox"
+	"github.com/dmateusp/opengym/queue"
+)
+
+const maxPlaceholderNameLength = 100
+
+func (s *server) PostApiGamesIdPlaceholders(w http.ResponseWriter, r *http.Request, id string) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req api.CreatePlaceholderRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("invalid request body: %s", err.Error()), http.StatusBadRequest)
+		return
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" || len(name) > maxPlaceholderNameLength {
+		http.Error(w, fmt.Sprintf("name must be between 1 and %d characters", maxPlaceholderNameLength), http.StatusBadRequest)
+		return
+	}
+
+	tx, err := s.dbConn.BeginTx(r.Context(), nil)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to begin transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	querierWithTx := s.querier.WithTx(tx)
+
+	game, ok := s.gameForQueueUpdate(w, r, querierWithTx, id, int64(authInfo.UserId))
+	if !ok {
+		return
+	}
+
+	guests := sql.NullInt64{}
+	if req.Guests != nil {
+		if *req.Guests < 0 {
+			http.Error(w, "invalid number of guests", http.StatusBadRequest)
+			return
+		}
+		if int64(*req.Guests) > game.MaxGuestsPerPlayer {
+			http.Error(w, "this game doesn't allow that many guests", http.StatusBadRequest)
+			return
+		}
+		guests = sql.NullInt64{Int64: int64(*req.Guests), Valid: true}
+	}
+
+	// Placeholders are users that can't sign in, emails must be unique so they get one that can't be delivered to.
+	userID, err := querierWithTx.UserCreatePlaceholder(r.Context(), db.UserCreatePlaceholderParams{
+		Name:  sql.NullString{String: name, Valid: true},
+		Email: fmt.Sprintf("placeholder-%s@placeholder.invalid", uuid.NewString()),
+	})
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to create placeholder: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	if err := s.upsertParticipant(r.Context(), querierWithTx, db.ParticipantsUpsertParams{
+		UserID:         userID,
+		GameID:         id,
+		Going:          sql.NullBool{Bool: true, Valid: true},
+		GoingUpdatedAt: s.clock.Now(),
+		Guests:         guests,
+	}); err != nil {
+		http.Error(w, fmt.Sprintf("failed to update participation: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	participants, err := updateGameSpotsLeft(r.Context(), querierWithTx, game)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := outbox.Publish(r.Context(), querierWithTx, id, outbox.ParticipantJoined{
+		UserID:      userID,
+		Guests:      guests.Int64,
+		Waitlisted:  queueOf(participants, game.MaxPlayers).Status(userID) == queue.StatusWaitlisted,
+		ByOrganizer: true,
+	}); err != nil {
+		http.Error(w, fmt.Sprintf("failed to publish event: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	claimUrl, err := createPlaceholderClaim(r.Context(), querierWithTx, id, userID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	dbUser, err := querierWithTx.UserGetById(r.Context(), userID)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to retrieve placeholder: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		http.Error(w, fmt.Sprintf("failed to commit transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	resp := api.PlaceholderParticipant{ClaimUrl: claimUrl}
+	resp.User.FromDb(dbUser.User)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		http.Error(w, fmt.Sprintf("failed to encode response: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (s *server) PostApiGamesIdPlaceholdersUserIdClaimLink(w http.ResponseWriter, r *http.Request, id string, userId string) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	tx, err := s.dbConn.BeginTx(r.Context(), nil)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to begin transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	querierWithTx := s.querier.WithTx(tx)
+
+	game, err := querierWithTx.GameGetById(r.Context(), id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			http.Error(w, "game not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("failed to retrieve game: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	if !authorize(w, r, querierWithTx, game, int64(authInfo.UserId), permissionManage) {
+		return
+	}
+
+	placeholderID, err := strconv.ParseInt(userId, 10, 64)
+	if err != nil {
+		http.Error(w, "placeholder not found", http.StatusNotFound)
+		return
+	}
+
+	dbUser, err := querierWithTx.UserGetById(r.Context(), placeholderID)
+	if err != nil && err != sql.ErrNoRows {
+		http.Error(w, fmt.Sprintf("failed to retrieve placeholder: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+	if err == sql.ErrNoRows || !dbUser.User.IsPlaceholder {
+		http.Error(w, "placeholder not found", http.StatusNotFound)
+		return
+	}
+
+	if _, err := querierWithTx.ParticipantGetByGameAndUser(r.Context(), db.ParticipantGetByGameAndUserParams{
+		GameID: id,
+		UserID: placeholderID,
+	}); err != nil {
+		if err == sql.ErrNoRows {
+			http.Error(w, "placeholder not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("failed to retrieve participation: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	// Only the latest link can be used, in case a previous one was shared with the wrong person
+	if err := querierWithTx.PlaceholderClaimDeleteByUser(r.Context(), placeholderID); err != nil {
+		http.Error(w, fmt.Sprintf("failed to revoke previous claim links: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	claimUrl, err := createPlaceholderClaim(r.Context(), querierWithTx, id, placeholderID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		http.Error(w, fmt.Sprintf("failed to commit transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	resp := api.PlaceholderParticipant{ClaimUrl: claimUrl}
+	resp.User.FromDb(dbUser.User)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		http.Error(w, fmt.Sprintf("failed to encode response: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (s *server) PostApiPlaceholdersClaim(w http.ResponseWriter, r *http.Request) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req api.ClaimPlaceholderRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("invalid request body: %s", err.Error()), http.StatusBadRequest)
+		return
+	}
+
+	tx, err := s.dbConn.BeginTx(r.Context(), nil)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to begin transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	querierWithTx := s.querier.WithTx(tx)
+
+	claim, err := querierWithTx.PlaceholderClaimGetByTokenHash(r.Context(), hashClaimToken(req.Token))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			http.Error(w, "claim link not found or already used", http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("failed to retrieve claim link: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	if _, err := querierWithTx.ParticipantGetByGameAndUser(r.Context(), db.ParticipantGetByGameAndUserParams{
+		GameID: claim.GameID,
+		UserID: int64(authInfo.UserId),
+	}); err == nil {
+		http.Error(w, "you already have a participation in this game", http.StatusConflict)
+		return
+	} else if err != sql.ErrNoRows {
+		http.Error(w, fmt.Sprintf("failed to retrieve participation: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	// The user takes over the place of the placeholder in the queue, along with its guests and reimbursement reference
+	if err := querierWithTx.ParticipantTransfer(r.Context(), db.ParticipantTransferParams{
+		NewUserID: int64(authInfo.UserId),
+		GameID:    claim.GameID,
+		UserID:    claim.UserID,
+	}); err != nil {
+		http.Error(w, fmt.Sprintf("failed to transfer participation: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	// Payments recorded for the placeholder settle what the user owes
+	if err := querierWithTx.LedgerPaymentTransferPayer(r.Context(), db.LedgerPaymentTransferPayerParams{
+		NewPayerID: int64(authInfo.UserId),
+		PayerID:    claim.UserID,
+	}); err != nil {
+		http.Error(w, fmt.Sprintf("failed to transfer payments: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	if err := querierWithTx.PlaceholderClaimDeleteByUser(r.Context(), claim.UserID); err != nil {
+		http.Error(w, fmt.Sprintf("failed to remove claim links: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+	if err := querierWithTx.UserDeletePlaceholder(r.Context(), claim.UserID); err != nil {
+		http.Error(w, fmt.Sprintf("failed to remove placeholder: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	if err := outbox.Publish(r.Context(), querierWithTx, claim.GameID, outbox.PlaceholderClaimed{
+		PlaceholderUserID: claim.UserID,
+		UserID:            int64(authInfo.UserId),
+	}); err != nil {
+		http.Error(w, fmt.Sprintf("failed to publish event: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		http.Error(w, fmt.Sprintf("failed to commit transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(api.ClaimedPlaceholder{GameId: claim.GameID}); err != nil {
+		http.Error(w, fmt.Sprintf("failed to encode response: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+}
+
+// createPlaceholderClaim creates a one-time link letting a user claim the placeholder.
+// Only a hash of the token is stored, the link can't be retrieved afterwards.
+func createPlaceholderClaim(ctx context.Context, querier db.Querier, gameID string, userID int64) (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate claim token: %w", err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+
+	if err := querier.PlaceholderClaimCreate(ctx, db.PlaceholderClaimCreateParams{
+		TokenHash: hashClaimToken(token),
+		UserID:    userID,
+		GameID:    gameID,
+	}); err != nil {
+		return "", fmt.Errorf("failed to create claim link: %w", err)
+	}
+
+	claimUrl, err := url.JoinPath(*frontendBaseUrl, "placeholders", "claim")
+	if err != nil {
+		return "", fmt.Errorf("failed to build claim link: %w", err)
+	}
+	return claimUrl + "?" + url.Values{"token": {token}}.Encode(), nil
+}
+
+func hashClaimToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
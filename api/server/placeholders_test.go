@@ -0,0 +1,330 @@
+package server_test
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/dmateusp/opengym/api"
+	"github.com/dmateusp/opengym/api/server"
+	"github.com/dmateusp/opengym/auth"
+	"github.com/dmateusp/opengym/clock"
+	"github.com/dmateusp/opengym/db"
+	dbtesting "github.com/dmateusp/opengym/db/testing"
+	"github.com/dmateusp/opengym/ptr"
+)
+
+func setMaxPlayers(t *testing.T, querier *db.Queries, gameID string, maxPlayers int64) {
+	t.Helper()
+
+	if err := querier.GameUpdate(t.Context(), db.GameUpdateParams{
+		ID:            gameID,
+		MaxPlayers:    sql.NullInt64{Int64: maxPlayers, Valid: true},
+		GameSpotsLeft: sql.NullInt64{Int64: maxPlayers, Valid: true},
+	}); err != nil {
+		t.Fatalf("failed to update game: %v", err)
+	}
+}
+
+func listParticipants(t *testing.T, srv api.ServerInterface, gameID string, userID int64) []api.ParticipantWithUser {
+	t.Helper()
+
+	r := httptest.NewRequest(http.MethodGet, "/api/games/"+gameID+"/participants", nil)
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(userID)}))
+	w := httptest.NewRecorder()
+	srv.GetApiGamesIdParticipants(w, r, gameID)
+	if w.Code != http.StatusOK {
+		t.Fatalf("failed to list participants: status %d, body %s", w.Code, w.Body.String())
+	}
+
+	var participants []api.ParticipantWithUser
+	if err := json.NewDecoder(w.Body).Decode(&participants); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	return participants
+}
+
+func participantStatuses(t *testing.T, participants []api.ParticipantWithUser) map[string]string {
+	t.Helper()
+
+	statuses := make(map[string]string)
+	for _, participant := range participants {
+		raw, err := participant.Status.MarshalJSON()
+		if err != nil {
+			t.Fatalf("failed to encode status: %v", err)
+		}
+		var status string
+		if err := json.Unmarshal(raw, &status); err != nil {
+			t.Fatalf("failed to decode status: %v", err)
+		}
+		statuses[participant.User.Id] = status
+	}
+	return statuses
+}
+
+func postPlaceholder(t *testing.T, srv api.ServerInterface, gameID string, userID int64, req api.CreatePlaceholderRequest) *httptest.ResponseRecorder {
+	t.Helper()
+
+	body, _ := json.Marshal(req)
+	r := httptest.NewRequest(http.MethodPost, "/api/games/"+gameID+"/placeholders", bytes.NewReader(body))
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(userID)}))
+	w := httptest.NewRecorder()
+	srv.PostApiGamesIdPlaceholders(w, r, gameID)
+	return w
+}
+
+func claimPlaceholder(t *testing.T, srv api.ServerInterface, claimUrl string, userID int64) *httptest.ResponseRecorder {
+	t.Helper()
+
+	parsedUrl, err := url.Parse(claimUrl)
+	if err != nil {
+		t.Fatalf("invalid claim url: %v", err)
+	}
+	body, _ := json.Marshal(api.ClaimPlaceholderRequest{Token: parsedUrl.Query().Get("token")})
+	r := httptest.NewRequest(http.MethodPost, "/api/placeholders/claim", bytes.NewReader(body))
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(userID)}))
+	w := httptest.NewRecorder()
+	srv.PostApiPlaceholdersClaim(w, r)
+	return w
+}
+
+func TestPostApiGamesIdPlaceholders_CountsTowardsSpotsAndCanBeClaimed(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	staticClock := clock.StaticClock{Time: time.Now()}
+
+	organizerID := dbtesting.UpsertTestUser(t, sqlDB, "organizer@example.com")
+	userID := dbtesting.UpsertTestUser(t, sqlDB, "user@example.com")
+
+	querier := db.New(sqlDB)
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), staticClock, sqlDB)
+	createGame(t, querier, "g1", organizerID, sql.NullTime{Time: staticClock.Time.Add(-time.Hour), Valid: true})
+	setMaxPlayers(t, querier, "g1", 3)
+
+	if w := postPlaceholder(t, srv, "g1", userID, api.CreatePlaceholderRequest{Name: "Alice"}); w.Code != http.StatusForbidden {
+		t.Fatalf("expected status %d for a non-organizer, got %d", http.StatusForbidden, w.Code)
+	}
+	if w := postPlaceholder(t, srv, "g1", organizerID, api.CreatePlaceholderRequest{Name: "  "}); w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d for an empty name, got %d", http.StatusBadRequest, w.Code)
+	}
+
+	w := postPlaceholder(t, srv, "g1", organizerID, api.CreatePlaceholderRequest{Name: "Alice", Guests: ptr.Ptr(1)})
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusCreated, w.Code, w.Body.String())
+	}
+	var placeholder api.PlaceholderParticipant
+	if err := json.NewDecoder(w.Body).Decode(&placeholder); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if !placeholder.User.IsPlaceholder || placeholder.User.Name == nil || *placeholder.User.Name != "Alice" || placeholder.ClaimUrl == "" {
+		t.Fatalf("unexpected placeholder %+v", placeholder)
+	}
+
+	game, err := querier.GameGetById(t.Context(), "g1")
+	if err != nil {
+		t.Fatalf("failed to retrieve game: %v", err)
+	}
+	if game.GameSpotsLeft != 1 {
+		t.Fatalf("expected the placeholder and its guest to take 2 spots, got %d spots left", game.GameSpotsLeft)
+	}
+
+	participants := listParticipants(t, srv, "g1", organizerID)
+	if len(participants) != 1 || participants[0].User.Id != placeholder.User.Id || participants[0].Guests != 1 {
+		t.Fatalf("expected the placeholder in the participants, got %+v", participants)
+	}
+
+	// the user takes over the participation of the placeholder
+	w = claimPlaceholder(t, srv, placeholder.ClaimUrl, userID)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	var claimed api.ClaimedPlaceholder
+	if err := json.NewDecoder(w.Body).Decode(&claimed); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if claimed.GameId != "g1" {
+		t.Fatalf("expected game g1, got %s", claimed.GameId)
+	}
+
+	participants = listParticipants(t, srv, "g1", organizerID)
+	if len(participants) != 1 || participants[0].User.Id != strconv.FormatInt(userID, 10) || participants[0].Guests != 1 {
+		t.Fatalf("expected the user to replace the placeholder, got %+v", participants)
+	}
+	if _, err := querier.UserGetById(t.Context(), mustParseInt(t, placeholder.User.Id)); err != sql.ErrNoRows {
+		t.Fatalf("expected the placeholder to be removed, got %v", err)
+	}
+
+	// claim links can only be used once
+	otherID := dbtesting.UpsertTestUser(t, sqlDB, "other@example.com")
+	if w := claimPlaceholder(t, srv, placeholder.ClaimUrl, otherID); w.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
+
+func TestPostApiPlaceholdersClaim_ConflictsWithExistingParticipation(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	staticClock := clock.StaticClock{Time: time.Now()}
+
+	organizerID := dbtesting.UpsertTestUser(t, sqlDB, "organizer@example.com")
+	userID := dbtesting.UpsertTestUser(t, sqlDB, "user@example.com")
+
+	querier := db.New(sqlDB)
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), staticClock, sqlDB)
+	createGame(t, querier, "g1", organizerID, sql.NullTime{Time: staticClock.Time.Add(-time.Hour), Valid: true})
+
+	w := postPlaceholder(t, srv, "g1", organizerID, api.CreatePlaceholderRequest{Name: "Alice"})
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusCreated, w.Code, w.Body.String())
+	}
+	var placeholder api.PlaceholderParticipant
+	if err := json.NewDecoder(w.Body).Decode(&placeholder); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	updateParticipation(t, srv, "g1", userID, api.NotGoing)
+	if w := claimPlaceholder(t, srv, placeholder.ClaimUrl, userID); w.Code != http.StatusConflict {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusConflict, w.Code, w.Body.String())
+	}
+
+	// regenerating the link revokes the previous one
+	r := httptest.NewRequest(http.MethodPost, "/", nil)
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(organizerID)}))
+	w = httptest.NewRecorder()
+	srv.PostApiGamesIdPlaceholdersUserIdClaimLink(w, r, "g1", placeholder.User.Id)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	var regenerated api.PlaceholderParticipant
+	if err := json.NewDecoder(w.Body).Decode(&regenerated); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	otherID := dbtesting.UpsertTestUser(t, sqlDB, "other@example.com")
+	if w := claimPlaceholder(t, srv, placeholder.ClaimUrl, otherID); w.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d for a revoked link, got %d", http.StatusNotFound, w.Code)
+	}
+	if w := claimPlaceholder(t, srv, regenerated.ClaimUrl, otherID); w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+}
+
+func TestDeleteApiGamesIdParticipantsUserId_PromotesWaitlisted(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	staticClock := clock.StaticClock{Time: time.Now()}
+
+	organizerID := dbtesting.UpsertTestUser(t, sqlDB, "organizer@example.com")
+	user1 := dbtesting.UpsertTestUser(t, sqlDB, "user1@example.com")
+	user2 := dbtesting.UpsertTestUser(t, sqlDB, "user2@example.com")
+
+	querier := db.New(sqlDB)
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), staticClock, sqlDB)
+	createGame(t, querier, "g1", organizerID, sql.NullTime{Time: staticClock.Time.Add(-time.Hour), Valid: true})
+	setMaxPlayers(t, querier, "g1", 1)
+
+	updateParticipation(t, srv, "g1", user1, api.Going)
+	updateParticipation(t, srv, "g1", user2, api.Going)
+
+	remove := func(userID int64, participantID int64) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodDelete, "/", nil)
+		r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(userID)}))
+		w := httptest.NewRecorder()
+		srv.DeleteApiGamesIdParticipantsUserId(w, r, "g1", strconv.FormatInt(participantID, 10))
+		return w
+	}
+
+	if w := remove(user2, user1); w.Code != http.StatusForbidden {
+		t.Fatalf("expected status %d for a non-organizer, got %d", http.StatusForbidden, w.Code)
+	}
+	if w := remove(organizerID, organizerID); w.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d for a user not participating, got %d", http.StatusNotFound, w.Code)
+	}
+
+	if w := remove(organizerID, user1); w.Code != http.StatusNoContent {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusNoContent, w.Code, w.Body.String())
+	}
+
+	statuses := participantStatuses(t, listParticipants(t, srv, "g1", organizerID))
+	if len(statuses) != 1 || statuses[strconv.FormatInt(user2, 10)] != string(api.Going) {
+		t.Fatalf("expected user2 to be promoted, got %v", statuses)
+	}
+	game, err := querier.GameGetById(t.Context(), "g1")
+	if err != nil {
+		t.Fatalf("failed to retrieve game: %v", err)
+	}
+	if game.GameSpotsLeft != 0 {
+		t.Fatalf("expected no spots left, got %d", game.GameSpotsLeft)
+	}
+}
+
+func TestPutApiGamesIdParticipantsOrder(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	staticClock := clock.StaticClock{Time: time.Now()}
+
+	organizerID := dbtesting.UpsertTestUser(t, sqlDB, "organizer@example.com")
+	user1 := dbtesting.UpsertTestUser(t, sqlDB, "user1@example.com")
+	user2 := dbtesting.UpsertTestUser(t, sqlDB, "user2@example.com")
+
+	querier := db.New(sqlDB)
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), staticClock, sqlDB)
+	createGame(t, querier, "g1", organizerID, sql.NullTime{Time: staticClock.Time.Add(-time.Hour), Valid: true})
+	setMaxPlayers(t, querier, "g1", 1)
+
+	updateParticipation(t, srv, "g1", user1, api.Going)
+	updateParticipation(t, srv, "g1", user2, api.Going)
+
+	reorder := func(userIDs ...int64) *httptest.ResponseRecorder {
+		req := api.ReorderParticipantsRequest{UserIds: []string{}}
+		for _, userID := range userIDs {
+			req.UserIds = append(req.UserIds, strconv.FormatInt(userID, 10))
+		}
+		body, _ := json.Marshal(req)
+		r := httptest.NewRequest(http.MethodPut, "/api/games/g1/participants/order", bytes.NewReader(body))
+		r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(organizerID)}))
+		w := httptest.NewRecorder()
+		srv.PutApiGamesIdParticipantsOrder(w, r, "g1")
+		return w
+	}
+
+	for _, invalid := range [][]int64{{user2}, {user2, user2}, {user2, organizerID}} {
+		if w := reorder(invalid...); w.Code != http.StatusBadRequest {
+			t.Fatalf("expected status %d for order %v, got %d", http.StatusBadRequest, invalid, w.Code)
+		}
+	}
+
+	w := reorder(user2, user1)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	var participants []api.ParticipantWithUser
+	if err := json.NewDecoder(w.Body).Decode(&participants); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(participants) != 2 || participants[0].User.Id != strconv.FormatInt(user2, 10) {
+		t.Fatalf("expected user2 to be first, got %+v", participants)
+	}
+
+	statuses := participantStatuses(t, listParticipants(t, srv, "g1", organizerID))
+	if statuses[strconv.FormatInt(user2, 10)] != string(api.Going) || statuses[strconv.FormatInt(user1, 10)] != string(api.Waitlisted) {
+		t.Fatalf("expected user2 going and user1 waitlisted, got %v", statuses)
+	}
+}
+
+func mustParseInt(t *testing.T, s string) int64 {
+	t.Helper()
+
+	i, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		t.Fatalf("invalid integer %q: %v", s, err)
+	}
+	return i
+}
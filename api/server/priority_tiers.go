@@ -0,0 +1,61 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/dmateusp/opengym/db"
+)
+
+// hasPriorityTiers reports whether the settings give the regulars of a group priority over drop-ins.
+func hasPriorityTiers(regularsHeadStartMinutes *int64, regularsFirst *bool) bool {
+	return (regularsHeadStartMinutes != nil && *regularsHeadStartMinutes > 0) || (regularsFirst != nil && *regularsFirst)
+}
+
+// regularsOnlyUntil returns until when only the regulars of the group can join the game,
+// it reports false when the game doesn't give them a head start.
+func regularsOnlyUntil(game db.Game) (time.Time, bool) {
+	if !game.GroupID.Valid || !game.PublishedAt.Valid || game.RegularsHeadStartMinutes <= 0 {
+		return time.Time{}, false
+	}
+	return game.PublishedAt.Time.Add(time.Duration(game.RegularsHeadStartMinutes) * time.Minute), true
+}
+
+// isGroupRegular reports whether the user is a regular of the group of the game.
+func isGroupRegular(ctx context.Context, querier db.Querier, game db.Game, userID int64) (bool, error) {
+	if !game.GroupID.Valid {
+		return false, nil
+	}
+
+	regular, err := querier.GroupMemberIsRegular(ctx, db.GroupMemberIsRegularParams{
+		GroupID: game.GroupID.String,
+		UserID:  userID,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to retrieve group tier: %w", err)
+	}
+	return regular, nil
+}
+
+// checkHeadStart writes the error response if the user can't join the game yet, because only the regulars can join it for now.
+// Users with a role in the game aren't held back.
+func (s *server) checkHeadStart(w http.ResponseWriter, r *http.Request, querier db.Querier, game db.Game, userID int64, regular bool) bool {
+	until, ok := regularsOnlyUntil(game)
+	if !ok || regular || !s.clock.Now().Before(until) {
+		return true
+	}
+
+	role, err := gameRole(r.Context(), querier, game, userID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return false
+	}
+	if role != "" {
+		return true
+	}
+
+	http.Error(w, fmt.Sprintf("only regulars can join this game until %s", until.Format(time.RFC3339)), http.StatusForbidden)
+	return false
+}
@@ -0,0 +1,130 @@
+package server_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/dmateusp/opengym/api"
+	"github.com/dmateusp/opengym/api/server"
+	"github.com/dmateusp/opengym/auth"
+	"github.com/dmateusp/opengym/clock"
+	"github.com/dmateusp/opengym/db"
+	dbtesting "github.com/dmateusp/opengym/db/testing"
+	"github.com/dmateusp/opengym/ptr"
+)
+
+func TestPriorityTiers_RegularsJoinFirstAndSortAhead(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	now := time.Now()
+
+	adminID := dbtesting.UpsertTestUser(t, sqlDB, "admin@example.com")
+	regularID := dbtesting.UpsertTestUser(t, sqlDB, "regular@example.com")
+	dropInID := dbtesting.UpsertTestUser(t, sqlDB, "drop-in@example.com")
+
+	querier := db.New(sqlDB)
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), clock.StaticClock{Time: now}, sqlDB)
+
+	group := createGroup(t, srv, adminID, "Tuesday Volleyball")
+	for _, userID := range []int64{regularID, dropInID} {
+		if w := joinGroup(t, srv, userID, inviteCode(t, group)); w.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+		}
+	}
+
+	body, _ := json.Marshal(api.UpdateGroupRequest{RegularsHeadStartMinutes: ptr.Ptr(int64(60)), RegularsFirst: ptr.Ptr(true)})
+	r := httptest.NewRequest(http.MethodPatch, "/api/groups/"+group.Id, bytes.NewReader(body))
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(adminID)}))
+	w := httptest.NewRecorder()
+	srv.PatchApiGroupsId(w, r, group.Id)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+
+	body, _ = json.Marshal(api.UpdateGroupMemberRequest{Tier: ptr.Ptr(api.Regular)})
+	r = httptest.NewRequest(http.MethodPut, "/", bytes.NewReader(body))
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(adminID)}))
+	w = httptest.NewRecorder()
+	srv.PutApiGroupsIdMembersUserId(w, r, group.Id, strconv.FormatInt(regularID, 10))
+	var member api.GroupMember
+	if err := json.NewDecoder(w.Body).Decode(&member); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if w.Code != http.StatusOK || member.Tier != api.Regular || member.Role != api.Member {
+		t.Fatalf("expected the member to become a regular, got status %d and %+v", w.Code, member)
+	}
+
+	createGame := func(req api.CreateGameRequest) *httptest.ResponseRecorder {
+		body, _ := json.Marshal(req)
+		r := httptest.NewRequest(http.MethodPost, "/api/games", bytes.NewReader(body))
+		r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(adminID)}))
+		w := httptest.NewRecorder()
+		srv.PostApiGames(w, r)
+		return w
+	}
+
+	if w := createGame(api.CreateGameRequest{Name: "Open game", RegularsFirst: ptr.Ptr(true)}); w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d for a game outside of a group, got %d", http.StatusBadRequest, w.Code)
+	}
+
+	w = createGame(api.CreateGameRequest{Name: "Group game", GroupId: ptr.Ptr(group.Id), MaxPlayers: ptr.Ptr(int64(1))})
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusCreated, w.Code, w.Body.String())
+	}
+	var game api.GameDetail
+	if err := json.NewDecoder(w.Body).Decode(&game); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if *game.Game.RegularsHeadStartMinutes != 60 || !*game.Game.RegularsFirst {
+		t.Fatalf("expected the game to follow the priority tiers of the group, got %+v", game.Game)
+	}
+	gameID := game.Game.Id
+	if _, err := sqlDB.Exec(`update games set published_at = ? where id = ?`, now, gameID); err != nil {
+		t.Fatalf("failed to publish game: %v", err)
+	}
+
+	join := func(srv api.ServerInterface, userID int64) int {
+		body, _ := json.Marshal(api.UpdateGameParticipationRequest{Status: api.Going})
+		r := httptest.NewRequest(http.MethodPut, "/", bytes.NewReader(body))
+		r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(userID)}))
+		w := httptest.NewRecorder()
+		srv.PutApiGamesIdParticipants(w, r, gameID)
+		return w.Code
+	}
+
+	if code := join(srv, dropInID); code != http.StatusForbidden {
+		t.Fatalf("expected status %d during the head start of the regulars, got %d", http.StatusForbidden, code)
+	}
+
+	later := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), clock.StaticClock{Time: now.Add(time.Hour)}, sqlDB)
+	if code := join(later, dropInID); code != http.StatusOK {
+		t.Fatalf("expected status %d after the head start, got %d", http.StatusOK, code)
+	}
+	if code := join(later, regularID); code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, code)
+	}
+
+	participants := listParticipants(t, later, gameID, adminID)
+	statuses := participantStatuses(t, participants)
+	if statuses[strconv.FormatInt(regularID, 10)] != "going" || statuses[strconv.FormatInt(dropInID, 10)] != "waitlisted" {
+		t.Fatalf("expected the regular to take the spot of the drop-in, got %v", statuses)
+	}
+	if participants[0].Regular == nil || !*participants[0].Regular {
+		t.Fatalf("expected the regular first, got %+v", participants[0])
+	}
+
+	updatedGame, err := querier.GameGetById(t.Context(), gameID)
+	if err != nil {
+		t.Fatalf("failed to retrieve game: %v", err)
+	}
+	if updatedGame.GameSpotsLeft != 0 {
+		t.Fatalf("expected no spots left, got %d", updatedGame.GameSpotsLeft)
+	}
+}
@@ -0,0 +1,174 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/dmateusp/opengym/api"
+	"github.com/dmateusp/opengym/auth"
+	"github.com/dmateusp/opengym/db"
+	"github.com/dmateusp/opengym/outbox"
+	"github.com/dmateusp/opengym/ptr"
+	"github.com/dmateusp/opengym/scheduler"
+)
+
+// reconfirmationFields are the details of a game that participants have to reconfirm when they change.
+var reconfirmationFields = []string{"startsAt", "location", "totalPriceCents", "splitStrategy", "pricePerPlayerCents", "guestPriceCents", "durationMinutes"}
+
+// reconfirmationReleaseAt returns when the spot of a participant asked to reconfirm at requestedAt is released,
+// it reports false when the game doesn't release spots.
+func reconfirmationReleaseAt(game db.Game, requestedAt time.Time) (time.Time, bool) {
+	if game.ReconfirmWithinMinutes <= 0 {
+		return time.Time{}, false
+	}
+	return requestedAt.Add(time.Duration(game.ReconfirmWithinMinutes) * time.Minute), true
+}
+
+// requestReconfirmations resets the confirmations of the participants going to a published game whose important details changed,
+// so they confirm they're still coming. It returns nil when nobody has to reconfirm.
+func requestReconfirmations(ctx context.Context, querier db.Querier, before, after db.Game, now time.Time) (*outbox.Reconfirmation, error) {
+	if !after.PublishedAt.Valid || after.PublishedAt.Time.After(now) {
+		return nil, nil
+	}
+	if !slices.ContainsFunc(changedGameFields(before, after), func(field string) bool {
+		return slices.Contains(reconfirmationFields, field)
+	}) {
+		return nil, nil
+	}
+
+	userIDs, err := querier.ParticipantsRequestReconfirmation(ctx, db.ParticipantsRequestReconfirmationParams{
+		ReconfirmRequestedAt: sql.NullTime{Time: now.UTC(), Valid: true},
+		GameID:               after.ID,
+		OrganizerID:          after.OrganizerID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to reset confirmations: %w", err)
+	}
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+
+	reconfirmation := &outbox.Reconfirmation{UserIDs: userIDs}
+	if releaseAt, ok := reconfirmationReleaseAt(after, now); ok {
+		reconfirmation.ReleaseAt = &releaseAt
+	}
+	return reconfirmation, nil
+}
+
+func (s *server) GetApiGamesIdReconfirmations(w http.ResponseWriter, r *http.Request, id string) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	game, err := s.querier.GameGetById(r.Context(), id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			http.Error(w, "game not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("failed to retrieve game: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	if !authorize(w, r, s.querier, game, int64(authInfo.UserId), permissionManage) {
+		return
+	}
+
+	rows, err := s.querier.ParticipantsListPendingReconfirmation(r.Context(), id)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to list pending reconfirmations: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	resp := make([]api.PendingReconfirmation, 0, len(rows))
+	for _, row := range rows {
+		reconfirmation := api.PendingReconfirmation{RequestedAt: row.GameParticipant.ReconfirmRequestedAt.Time}
+		reconfirmation.User.FromDb(row.User)
+		if releaseAt, ok := reconfirmationReleaseAt(game, row.GameParticipant.ReconfirmRequestedAt.Time); ok {
+			reconfirmation.ReleaseAt = ptr.Ptr(releaseAt)
+		}
+		resp = append(resp, reconfirmation)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		http.Error(w, fmt.Sprintf("failed to encode response: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+}
+
+// ReleaseUnconfirmedSpots is a [scheduler.Handler] moving the participants of the game who didn't reconfirm in time out of it,
+// their spots go to the waitlist.
+func (s *server) ReleaseUnconfirmedSpots(ctx context.Context, querier db.QuerierWithTxSupport, event scheduler.Event) error {
+	game, err := querier.GameGetById(ctx, event.Game.ID)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve game: %w", err)
+	}
+
+	rows, err := querier.ParticipantsListPendingReconfirmation(ctx, game.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list pending reconfirmations: %w", err)
+	}
+
+	for _, row := range rows {
+		releaseAt, ok := reconfirmationReleaseAt(game, row.GameParticipant.ReconfirmRequestedAt.Time)
+		if !ok || releaseAt.After(event.At) {
+			continue
+		}
+
+		if err := s.releaseUnconfirmedSpot(ctx, querier, game, row.User.ID); err != nil {
+			return fmt.Errorf("user %d: %w", row.User.ID, err)
+		}
+	}
+	return nil
+}
+
+func (s *server) releaseUnconfirmedSpot(ctx context.Context, querier db.Querier, game db.Game, userID int64) error {
+	participantsBefore, err := querier.ParticipantsList(ctx, db.ParticipantsListParams{
+		OrganizerID: game.OrganizerID,
+		GameID:      game.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list participants: %w", err)
+	}
+
+	// Participants who reconfirmed or left in the meantime keep their participation
+	if !slices.ContainsFunc(participantsBefore, func(row db.ParticipantsListRow) bool {
+		return row.User.ID == userID && row.GameParticipant.ReconfirmRequestedAt.Valid
+	}) {
+		return nil
+	}
+
+	now := s.clock.Now()
+	if err := s.upsertParticipant(ctx, querier, db.ParticipantsUpsertParams{
+		UserID:         userID,
+		GameID:         game.ID,
+		Going:          sql.NullBool{Bool: false, Valid: true},
+		GoingUpdatedAt: now,
+		Guests:         sql.NullInt64{Int64: 0, Valid: true},
+	}); err != nil {
+		return fmt.Errorf("failed to update participation: %w", err)
+	}
+
+	participantsAfter, err := updateGameSpotsLeft(ctx, querier, game)
+	if err != nil {
+		return err
+	}
+
+	if err := outbox.Publish(ctx, querier, game.ID, outbox.ParticipantLeft{UserID: userID, Unconfirmed: true}); err != nil {
+		return err
+	}
+	if err := publishPromotions(ctx, querier, game, now, participantsBefore, game.MaxPlayers, participantsAfter, game.MaxPlayers, userID); err != nil {
+		return err
+	}
+
+	return nil
+}
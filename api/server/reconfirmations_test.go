@@ -0,0 +1,115 @@
+package server_test
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/dmateusp/opengym/api"
+	"github.com/dmateusp/opengym/api/server"
+	"github.com/dmateusp/opengym/auth"
+	"github.com/dmateusp/opengym/clock"
+	"github.com/dmateusp/opengym/db"
+	dbtesting "github.com/dmateusp/opengym/db/testing"
+	"github.com/dmateusp/opengym/ptr"
+	"github.com/dmateusp/opengym/scheduler"
+)
+
+func listReconfirmations(t *testing.T, srv api.ServerInterface, gameID string, userID int64) []api.PendingReconfirmation {
+	t.Helper()
+
+	r := httptest.NewRequest(http.MethodGet, "/api/games/"+gameID+"/reconfirmations", nil)
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(userID)}))
+	w := httptest.NewRecorder()
+	srv.GetApiGamesIdReconfirmations(w, r, gameID)
+	if w.Code != http.StatusOK {
+		t.Fatalf("failed to list reconfirmations: status %d, body %s", w.Code, w.Body.String())
+	}
+
+	var reconfirmations []api.PendingReconfirmation
+	if err := json.NewDecoder(w.Body).Decode(&reconfirmations); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	return reconfirmations
+}
+
+func TestReconfirmations_ConfirmOrLoseSpot(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	now := time.Now()
+
+	organizerID := dbtesting.UpsertTestUser(t, sqlDB, "organizer@example.com")
+	confirmingID := dbtesting.UpsertTestUser(t, sqlDB, "confirming@example.com")
+	silentID := dbtesting.UpsertTestUser(t, sqlDB, "silent@example.com")
+	waitlistedID := dbtesting.UpsertTestUser(t, sqlDB, "waitlisted@example.com")
+
+	querier := db.New(sqlDB)
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), clock.StaticClock{Time: now}, sqlDB)
+	createGame(t, querier, "g1", organizerID, sql.NullTime{Time: now.Add(-time.Hour), Valid: true})
+	setMaxPlayers(t, querier, "g1", 3)
+	for _, userID := range []int64{organizerID, confirmingID, silentID, waitlistedID} {
+		updateParticipation(t, srv, "g1", userID, api.Going)
+	}
+
+	// settings don't need a reconfirmation
+	if code, _ := patchGame(t, srv, "g1", organizerID, api.UpdateGameRequest{ReconfirmWithinMinutes: ptr.Ptr(int64(60))}); code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, code)
+	}
+	if reconfirmations := listReconfirmations(t, srv, "g1", organizerID); len(reconfirmations) != 0 {
+		t.Fatalf("expected no pending reconfirmations, got %+v", reconfirmations)
+	}
+
+	if code, _ := patchGame(t, srv, "g1", organizerID, api.UpdateGameRequest{Location: ptr.Ptr("Another gym")}); code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, code)
+	}
+
+	reconfirmations := listReconfirmations(t, srv, "g1", organizerID)
+	if len(reconfirmations) != 3 {
+		t.Fatalf("expected every participant going but the organizer to reconfirm, got %+v", reconfirmations)
+	}
+	if reconfirmations[0].ReleaseAt == nil || !reconfirmations[0].ReleaseAt.Equal(reconfirmations[0].RequestedAt.Add(time.Hour)) {
+		t.Fatalf("expected the spot to be released an hour after the request, got %+v", reconfirmations[0])
+	}
+
+	body, _ := json.Marshal(api.UpdateGameParticipationRequest{Status: api.Going, Confirmed: ptr.Ptr(api.True)})
+	r := httptest.NewRequest(http.MethodPut, "/", bytes.NewReader(body))
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(confirmingID)}))
+	w := httptest.NewRecorder()
+	srv.PutApiGamesIdParticipants(w, r, "g1")
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	// leaving the game is an answer too
+	updateParticipation(t, srv, "g1", waitlistedID, api.NotGoing)
+	updateParticipation(t, srv, "g1", waitlistedID, api.Going)
+
+	reconfirmations = listReconfirmations(t, srv, "g1", organizerID)
+	if len(reconfirmations) != 1 || reconfirmations[0].User.Id != strconv.FormatInt(silentID, 10) {
+		t.Fatalf("expected only the silent participant to still have to reconfirm, got %+v", reconfirmations)
+	}
+
+	// the deadline didn't pass yet
+	fireScheduledEvents(t, sqlDB, querier, clock.StaticClock{Time: now}, scheduler.EventReconfirmationDue, srv.ReleaseUnconfirmedSpots)
+	if statuses := participantStatuses(t, listParticipants(t, srv, "g1", organizerID)); statuses[strconv.FormatInt(silentID, 10)] != "going" {
+		t.Fatalf("expected the silent participant to keep their spot until the deadline, got %v", statuses)
+	}
+
+	laterClock := clock.StaticClock{Time: now.Add(2 * time.Hour)}
+	later := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), laterClock, sqlDB)
+	fireScheduledEvents(t, sqlDB, querier, laterClock, scheduler.EventReconfirmationDue, later.ReleaseUnconfirmedSpots)
+
+	statuses := participantStatuses(t, listParticipants(t, later, "g1", organizerID))
+	if statuses[strconv.FormatInt(silentID, 10)] != "not_going" || statuses[strconv.FormatInt(waitlistedID, 10)] != "going" {
+		t.Fatalf("expected the spot of the silent participant to go to the waitlist, got %v", statuses)
+	}
+	if reconfirmations := listReconfirmations(t, later, "g1", organizerID); len(reconfirmations) != 0 {
+		t.Fatalf("expected no pending reconfirmations, got %+v", reconfirmations)
+	}
+}
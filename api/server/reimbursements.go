@@ -1,8 +1,10 @@
 package server
 
 import (
+	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -10,8 +12,11 @@ import (
 
 	"github.com/dmateusp/opengym/api"
 	"github.com/dmateusp/opengym/auth"
+	"github.com/dmateusp/opengym/costsplit"
 	"github.com/dmateusp/opengym/db"
+	"github.com/dmateusp/opengym/outbox"
 	"github.com/dmateusp/opengym/ptr"
+	"github.com/dmateusp/opengym/queue"
 	"github.com/oapi-codegen/nullable"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
@@ -34,7 +39,11 @@ func (s *server) GetApiGamesIdReimbursementsParticipantId(w http.ResponseWriter,
 	}
 
 	userID := int64(authInfo.UserId)
-	isOrganizer := game.OrganizerID == userID
+	isTreasurer, err := can(r.Context(), s.querier, game, userID, permissionManageReimbursements)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
 
 	targetParticipantID, err := strconv.ParseInt(participantId, 10, 64)
 	if err != nil {
@@ -42,8 +51,8 @@ func (s *server) GetApiGamesIdReimbursementsParticipantId(w http.ResponseWriter,
 		return
 	}
 
-	if !isOrganizer && userID != targetParticipantID {
-		http.Error(w, "forbidden: only the participant or the organizer can access this record", http.StatusForbidden)
+	if !isTreasurer && userID != targetParticipantID {
+		http.Error(w, "forbidden: only the participant or the treasurer can access this record", http.StatusForbidden)
 		return
 	}
 
@@ -70,6 +79,15 @@ func (s *server) GetApiGamesIdReimbursementsParticipantId(w http.ResponseWriter,
 		ReimbursementReceivedAt: sqlNullTimeToNullable(participant.ReimbursementReceivedAt),
 	}
 
+	// what participants owe is only settled once the game is frozen
+	if !game.CancelledAt.Valid && game.FrozenAt.Valid && !game.FrozenAt.Time.After(s.clock.Now()) {
+		response.PaymentInstructions, err = paymentInstructions(r.Context(), s.querier, game, participant)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	if err := json.NewEncoder(w).Encode(response); err != nil {
@@ -94,9 +112,12 @@ func (s *server) GetApiGamesIdReimbursements(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	userID := int64(authInfo.UserId)
-	if game.OrganizerID != userID {
-		http.Error(w, "forbidden: only the organizer can view reimbursements", http.StatusForbidden)
+	if !authorize(w, r, s.querier, game, int64(authInfo.UserId), permissionManageReimbursements) {
+		return
+	}
+
+	if game.CancelledAt.Valid {
+		http.Error(w, "cancelled games aren't billed", http.StatusBadRequest)
 		return
 	}
 
@@ -114,66 +135,24 @@ func (s *server) GetApiGamesIdReimbursements(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	type reimbursementParticipant struct {
-		row       db.ParticipantsListRow
-		groupSize int64
-	}
-
-	billableParticipants := make([]reimbursementParticipant, 0, len(rows))
-	totalBillableCount := int64(0)
-
-	for _, row := range rows {
-		if !row.GameParticipant.Going.Valid || !row.GameParticipant.Going.Bool {
-			continue
-		}
-
-		groupSize := int64(1)
-		if row.GameParticipant.Guests.Valid {
-			groupSize += row.GameParticipant.Guests.Int64
-		}
-
-		// Reimbursements apply only to participants that fit in the main list.
-		if totalBillableCount+groupSize > game.MaxPlayers {
-			continue
-		}
-
-		totalBillableCount += groupSize
-		billableParticipants = append(billableParticipants, reimbursementParticipant{
-			row:       row,
-			groupSize: groupSize,
-		})
-	}
-
-	entries := make([]api.GameReimbursementEntry, 0, len(billableParticipants))
-	for _, participant := range billableParticipants {
+	billed := billedParticipants(game, rows)
+	entries := make([]api.GameReimbursementEntry, 0, len(billed))
+	for _, participant := range billed {
 		row := participant.row
-		guests := 0
-		if row.GameParticipant.Guests.Valid {
-			guests = int(row.GameParticipant.Guests.Int64)
-		}
-		var name *string
-		if row.User.Name.Valid {
-			name = &row.User.Name.String
-		}
-		var picture *string
-		if row.User.Photo.Valid {
-			picture = &row.User.Photo.String
-		}
 
-		amountOwedCents := ceilDiv(game.TotalPriceCents*participant.groupSize, totalBillableCount)
+		var lateCancelledAt *time.Time
+		if participant.lateCancelled {
+			lateCancelledAt = ptr.Ptr(row.GameParticipant.LateCancelledAt.Time)
+		}
 
 		entries = append(entries, api.GameReimbursementEntry{
-			ReimbursementReference: row.GameParticipant.ReimbursementReference,
-			AmountOwedCents:        amountOwedCents,
-			Guests:                 guests,
-			Participant: api.User{
-				Id:      strconv.FormatInt(row.User.ID, 10),
-				Email:   openapi_types.Email(row.User.Email),
-				Name:    name,
-				Picture: picture,
-			},
+			ReimbursementReference:  row.GameParticipant.ReimbursementReference,
+			AmountOwedCents:         participant.amountOwedCents,
+			Guests:                  int(participant.guests),
+			Participant:             reimbursementUser(row.User),
 			ReimbursedAt:            sqlNullTimeToNullable(row.GameParticipant.ReimbursedAt),
 			ReimbursementReceivedAt: sqlNullTimeToNullable(row.GameParticipant.ReimbursementReceivedAt),
+			LateCancelledAt:         lateCancelledAt,
 		})
 	}
 
@@ -201,6 +180,11 @@ func (s *server) PutApiGamesIdReimbursements(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	if game.CancelledAt.Valid {
+		http.Error(w, "cancelled games aren't billed", http.StatusBadRequest)
+		return
+	}
+
 	if !game.FrozenAt.Valid || game.FrozenAt.Time.After(s.clock.Now()) {
 		http.Error(w, "reimbursements are only available for frozen games", http.StatusBadRequest)
 		return
@@ -213,10 +197,24 @@ func (s *server) PutApiGamesIdReimbursements(w http.ResponseWriter, r *http.Requ
 	}
 
 	userID := int64(authInfo.UserId)
-	isOrganizer := game.OrganizerID == userID
 	participantID := userID
 
-	if isOrganizer {
+	tx, err := s.dbConn.BeginTx(r.Context(), nil)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to begin transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	querierWithTx := s.querier.WithTx(tx)
+
+	isTreasurer, err := can(r.Context(), querierWithTx, game, userID, permissionManageReimbursements)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if isTreasurer {
 		organizerReq, err := req.AsUpdateReimbursementRequest0()
 		if err != nil {
 			http.Error(w, fmt.Sprintf("invalid request body: %s", err.Error()), http.StatusBadRequest)
@@ -234,18 +232,12 @@ func (s *server) PutApiGamesIdReimbursements(w http.ResponseWriter, r *http.Requ
 			return
 		}
 
-		reimbursementReceivedAt := nullableToNullTime(organizerReq.ReimbursementReceivedAt)
-		rowsAffected, err := s.querier.ParticipantUpdateReimbursementReceivedAt(r.Context(), db.ParticipantUpdateReimbursementReceivedAtParams{
-			GameID:                  id,
-			UserID:                  participantID,
-			ReimbursementReceivedAt: reimbursementReceivedAt,
-		})
-		if err != nil {
-			http.Error(w, fmt.Sprintf("failed to update reimbursement status: %s", err.Error()), http.StatusInternalServerError)
-			return
-		}
-		if rowsAffected == 0 {
-			http.Error(w, "participant not found", http.StatusNotFound)
+		if err := setReimbursementReceivedAt(r.Context(), querierWithTx, id, participantID, nullableToNullTime(organizerReq.ReimbursementReceivedAt)); err != nil {
+			if errors.Is(err, errParticipantNotFound) {
+				http.Error(w, "participant not found", http.StatusNotFound)
+				return
+			}
+			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
 		}
 	} else {
@@ -269,7 +261,7 @@ func (s *server) PutApiGamesIdReimbursements(w http.ResponseWriter, r *http.Requ
 			return
 		}
 
-		if _, err := s.querier.ParticipantGetByGameAndUser(r.Context(), db.ParticipantGetByGameAndUserParams{
+		if _, err := querierWithTx.ParticipantGetByGameAndUser(r.Context(), db.ParticipantGetByGameAndUserParams{
 			GameID: id,
 			UserID: userID,
 		}); err != nil {
@@ -282,7 +274,7 @@ func (s *server) PutApiGamesIdReimbursements(w http.ResponseWriter, r *http.Requ
 		}
 
 		reimbursedAt := nullableToNullTime(participantReq.ReimbursedAt)
-		rowsAffected, err := s.querier.ParticipantUpdateReimbursedAt(r.Context(), db.ParticipantUpdateReimbursedAtParams{
+		rowsAffected, err := querierWithTx.ParticipantUpdateReimbursedAt(r.Context(), db.ParticipantUpdateReimbursedAtParams{
 			GameID:       id,
 			UserID:       userID,
 			ReimbursedAt: reimbursedAt,
@@ -295,9 +287,17 @@ func (s *server) PutApiGamesIdReimbursements(w http.ResponseWriter, r *http.Requ
 			http.Error(w, "forbidden: you are not a participant of this game", http.StatusForbidden)
 			return
 		}
+
+		if err := outbox.Publish(r.Context(), querierWithTx, id, outbox.ReimbursementMarked{
+			UserID:       userID,
+			ReimbursedAt: nullTimePtr(reimbursedAt.Time, reimbursedAt.Valid),
+		}); err != nil {
+			http.Error(w, fmt.Sprintf("failed to publish event: %s", err.Error()), http.StatusInternalServerError)
+			return
+		}
 	}
 
-	participant, err := s.querier.ParticipantGetByGameAndUser(r.Context(), db.ParticipantGetByGameAndUserParams{
+	participant, err := querierWithTx.ParticipantGetByGameAndUser(r.Context(), db.ParticipantGetByGameAndUserParams{
 		GameID: id,
 		UserID: participantID,
 	})
@@ -310,16 +310,13 @@ func (s *server) PutApiGamesIdReimbursements(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	response := api.ReimbursementRecord{
-		ParticipantId:           strconv.FormatInt(participantID, 10),
-		GameId:                  id,
-		ReimbursementReference:  participant.ReimbursementReference,
-		CreatedAt:               ptr.Ptr(participant.CreatedAt),
-		UpdatedAt:               ptr.Ptr(participant.UpdatedAt),
-		ReimbursedAt:            sqlNullTimeToNullable(participant.ReimbursedAt),
-		ReimbursementReceivedAt: sqlNullTimeToNullable(participant.ReimbursementReceivedAt),
+	if err := tx.Commit(); err != nil {
+		http.Error(w, fmt.Sprintf("failed to commit transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
 	}
 
+	response := reimbursementRecord(participant)
+
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	if err := json.NewEncoder(w).Encode(response); err != nil {
@@ -327,6 +324,212 @@ func (s *server) PutApiGamesIdReimbursements(w http.ResponseWriter, r *http.Requ
 	}
 }
 
+const maxBulkReimbursements = 500
+
+func (s *server) PostApiGamesIdReimbursementsBulk(w http.ResponseWriter, r *http.Request, id string) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req api.BulkReimbursementRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("invalid request body: %s", err.Error()), http.StatusBadRequest)
+		return
+	}
+
+	markAllOutstanding := ptr.Value(req.MarkAllOutstanding)
+	updates := ptr.Value(req.Updates)
+	switch {
+	case markAllOutstanding && len(updates) > 0:
+		http.Error(w, "updates cannot be combined with markAllOutstanding", http.StatusBadRequest)
+		return
+	case !markAllOutstanding && len(updates) == 0:
+		http.Error(w, "updates or markAllOutstanding are required", http.StatusBadRequest)
+		return
+	case !markAllOutstanding && req.ReceivedAt != nil:
+		http.Error(w, "receivedAt can only be set with markAllOutstanding", http.StatusBadRequest)
+		return
+	case len(updates) > maxBulkReimbursements:
+		http.Error(w, fmt.Sprintf("cannot update more than %d reimbursements at once", maxBulkReimbursements), http.StatusBadRequest)
+		return
+	}
+
+	seen := make(map[string]bool, len(updates))
+	for _, update := range updates {
+		if !update.ReimbursementReceivedAt.IsSpecified() {
+			http.Error(w, fmt.Sprintf("reimbursementReceivedAt is required for participant %s", update.ParticipantId), http.StatusBadRequest)
+			return
+		}
+		if seen[update.ParticipantId] {
+			http.Error(w, fmt.Sprintf("participant %s is updated more than once", update.ParticipantId), http.StatusBadRequest)
+			return
+		}
+		seen[update.ParticipantId] = true
+	}
+
+	now := s.clock.Now()
+
+	tx, err := s.dbConn.BeginTx(r.Context(), nil)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to begin transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	querierWithTx := s.querier.WithTx(tx)
+
+	game, err := querierWithTx.GameGetById(r.Context(), id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			http.Error(w, "game not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("failed to retrieve game: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	if !authorize(w, r, querierWithTx, game, int64(authInfo.UserId), permissionManageReimbursements) {
+		return
+	}
+
+	if game.CancelledAt.Valid {
+		http.Error(w, "cancelled games aren't billed", http.StatusBadRequest)
+		return
+	}
+
+	if !game.FrozenAt.Valid || game.FrozenAt.Time.After(now) {
+		http.Error(w, "reimbursements are only available for frozen games", http.StatusBadRequest)
+		return
+	}
+
+	if markAllOutstanding {
+		rows, err := querierWithTx.ParticipantsList(r.Context(), db.ParticipantsListParams{
+			OrganizerID: game.OrganizerID,
+			GameID:      id,
+		})
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed to retrieve reimbursements: %s", err.Error()), http.StatusInternalServerError)
+			return
+		}
+
+		receivedAt := now
+		if req.ReceivedAt != nil {
+			receivedAt = *req.ReceivedAt
+		}
+		for _, billed := range billedParticipants(game, rows) {
+			if billed.row.GameParticipant.ReimbursementReceivedAt.Valid {
+				continue
+			}
+			updates = append(updates, api.BulkReimbursementUpdate{
+				ParticipantId:           strconv.FormatInt(billed.row.User.ID, 10),
+				ReimbursementReceivedAt: nullable.NewNullableWithValue(receivedAt),
+			})
+		}
+	}
+
+	resp, err := updateReimbursements(r.Context(), querierWithTx, id, updates)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		http.Error(w, fmt.Sprintf("failed to commit transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		http.Error(w, fmt.Sprintf("failed to encode response: %s", err.Error()), http.StatusInternalServerError)
+	}
+}
+
+// updateReimbursements applies the updates in order. A participant that can't be updated doesn't prevent updating the others,
+// it's reported in its result.
+func updateReimbursements(ctx context.Context, querier db.Querier, gameID string, updates []api.BulkReimbursementUpdate) (api.BulkReimbursementResponse, error) {
+	resp := api.BulkReimbursementResponse{Results: make([]api.BulkReimbursementResult, 0, len(updates))}
+	for _, update := range updates {
+		result := api.BulkReimbursementResult{ParticipantId: update.ParticipantId}
+
+		participantID, err := strconv.ParseInt(update.ParticipantId, 10, 64)
+		if err != nil {
+			result.Status = api.InvalidParticipantId
+			result.Error = ptr.Ptr("invalid participantId")
+			resp.Results = append(resp.Results, result)
+			resp.Failed++
+			continue
+		}
+
+		if err := setReimbursementReceivedAt(ctx, querier, gameID, participantID, nullableToNullTime(update.ReimbursementReceivedAt)); err != nil {
+			if errors.Is(err, errParticipantNotFound) {
+				result.Status = api.ParticipantNotFound
+				result.Error = ptr.Ptr(err.Error())
+				resp.Results = append(resp.Results, result)
+				resp.Failed++
+				continue
+			}
+			return api.BulkReimbursementResponse{}, err
+		}
+
+		participant, err := querier.ParticipantGetByGameAndUser(ctx, db.ParticipantGetByGameAndUserParams{
+			GameID: gameID,
+			UserID: participantID,
+		})
+		if err != nil {
+			return api.BulkReimbursementResponse{}, fmt.Errorf("failed to retrieve participant: %w", err)
+		}
+
+		result.Status = api.Updated
+		result.Record = ptr.Ptr(reimbursementRecord(participant))
+		resp.Results = append(resp.Results, result)
+		resp.Updated++
+	}
+	return resp, nil
+}
+
+// errParticipantNotFound is returned when the user whose reimbursement is updated isn't a participant of the game.
+var errParticipantNotFound = errors.New("participant not found")
+
+// setReimbursementReceivedAt records when the treasurer received the participant's reimbursement, or clears it, and publishes the change.
+// It returns errParticipantNotFound when the user isn't a participant of the game.
+func setReimbursementReceivedAt(ctx context.Context, querier db.Querier, gameID string, participantID int64, receivedAt sql.NullTime) error {
+	rowsAffected, err := querier.ParticipantUpdateReimbursementReceivedAt(ctx, db.ParticipantUpdateReimbursementReceivedAtParams{
+		GameID:                  gameID,
+		UserID:                  participantID,
+		ReimbursementReceivedAt: receivedAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update reimbursement status: %w", err)
+	}
+	if rowsAffected == 0 {
+		return errParticipantNotFound
+	}
+
+	if err := outbox.Publish(ctx, querier, gameID, outbox.ReimbursementMarked{
+		UserID:                  participantID,
+		ReimbursementReceivedAt: nullTimePtr(receivedAt.Time, receivedAt.Valid),
+		ByOrganizer:             true,
+	}); err != nil {
+		return fmt.Errorf("failed to publish event: %w", err)
+	}
+	return nil
+}
+
+func reimbursementRecord(participant db.GameParticipant) api.ReimbursementRecord {
+	return api.ReimbursementRecord{
+		ParticipantId:           strconv.FormatInt(participant.UserID, 10),
+		GameId:                  participant.GameID,
+		ReimbursementReference:  participant.ReimbursementReference,
+		CreatedAt:               ptr.Ptr(participant.CreatedAt),
+		UpdatedAt:               ptr.Ptr(participant.UpdatedAt),
+		ReimbursedAt:            sqlNullTimeToNullable(participant.ReimbursedAt),
+		ReimbursementReceivedAt: sqlNullTimeToNullable(participant.ReimbursementReceivedAt),
+	}
+}
+
 func nullableToNullTime(value nullable.Nullable[time.Time]) sql.NullTime {
 	if value.IsNull() || !value.IsSpecified() {
 		return sql.NullTime{}
@@ -341,9 +544,78 @@ func sqlNullTimeToNullable(value sql.NullTime) nullable.Nullable[time.Time] {
 	return nullable.NewNullableWithValue(value.Time)
 }
 
-func ceilDiv(numerator int64, denominator int64) int64 {
-	if denominator <= 0 {
-		return 0
+// billedParticipant is a participant billed for a game, with the amount they owe.
+type billedParticipant struct {
+	row             db.ParticipantsListRow
+	guests          int64
+	amountOwedCents int64
+	lateCancelled   bool
+}
+
+// billedParticipants returns the participants billed for the game, in the order they queued.
+// Reimbursements apply only to participants that fit in the main list,
+// and to the participants who cancelled late when the game bills them and nobody took their spot.
+func billedParticipants(game db.Game, rows []db.ParticipantsListRow) []billedParticipant {
+	q := queueOf(rows, game.MaxPlayers)
+	var billedLate map[int]int64
+	if game.BillLateCancellations {
+		billedLate = billedLateCancellations(rows, q)
+	}
+
+	var billed []billedParticipant
+	var shares []costsplit.Share
+	for i, entry := range q.Entries {
+		spots, lateCancelled := billedLate[i]
+		if entry.Status != queue.StatusGoing && !lateCancelled {
+			continue
+		}
+		if !lateCancelled {
+			spots = entry.Size()
+		}
+		share := costsplit.Share{Spots: spots, Organizer: rows[i].IsOrganizer}
+		billed = append(billed, billedParticipant{row: rows[i], guests: share.Guests(), lateCancelled: lateCancelled})
+		shares = append(shares, share)
+	}
+
+	for i, amount := range splitStrategy(game).Split(shares) {
+		billed[i].amountOwedCents = amount
+	}
+	return billed
+}
+
+func reimbursementUser(user db.User) api.User {
+	var name *string
+	if user.Name.Valid {
+		name = &user.Name.String
+	}
+	var picture *string
+	if user.Photo.Valid {
+		picture = &user.Photo.String
+	}
+	return api.User{
+		Id:      strconv.FormatInt(user.ID, 10),
+		Email:   openapi_types.Email(user.Email),
+		Name:    name,
+		Picture: picture,
+	}
+}
+
+const (
+	splitPerPlayer          = "per_player"
+	splitPerPlayerGuestRate = "per_player_guest_rate"
+	splitOrganizerExempt    = "organizer_exempt"
+)
+
+// splitStrategy returns how the price of the game is split between the participants billed for it.
+func splitStrategy(game db.Game) costsplit.Strategy {
+	switch game.SplitStrategy {
+	case splitPerPlayer:
+		return costsplit.PerPlayer{PriceCents: game.PricePerPlayerCents}
+	case splitPerPlayerGuestRate:
+		return costsplit.PerPlayerWithGuestRate{PriceCents: game.PricePerPlayerCents, GuestPriceCents: game.GuestPriceCents}
+	case splitOrganizerExempt:
+		return costsplit.OrganizerExempt{TotalCents: game.TotalPriceCents}
+	default:
+		return costsplit.Even{TotalCents: game.TotalPriceCents}
 	}
-	return (numerator + denominator - 1) / denominator
 }
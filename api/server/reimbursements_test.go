@@ -304,8 +304,9 @@ func TestGetApiGamesIdReimbursements_AmountOwedExcludesWaitlistAndIncludesGuests
 	if got := guestsByParticipant[strconv.FormatInt(p1ID, 10)]; got != 1 {
 		t.Fatalf("expected participant 1 guests to be 1, got %d", got)
 	}
-	if got := amountByParticipant[strconv.FormatInt(p2ID, 10)]; got != 334 {
-		t.Fatalf("expected participant 2 amount owed to be 334, got %d", got)
+	// the cent left over goes to the first participant, the amounts owed add up to the total price
+	if got := amountByParticipant[strconv.FormatInt(p2ID, 10)]; got != 333 {
+		t.Fatalf("expected participant 2 amount owed to be 333, got %d", got)
 	}
 	if got := guestsByParticipant[strconv.FormatInt(p2ID, 10)]; got != 0 {
 		t.Fatalf("expected participant 2 guests to be 0, got %d", got)
@@ -598,3 +599,86 @@ func freezeGameForReimbursements(t *testing.T, sqlDB *sql.DB, now time.Time, gam
 		t.Fatalf("failed to freeze game: %v", err)
 	}
 }
+
+func TestPostApiGamesIdReimbursementsBulk(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	now := time.Now()
+
+	srv, userIDs := setupBilledGame(t, sqlDB, now)
+	organizerID, firstID, secondID := userIDs[0], userIDs[1], userIDs[2]
+
+	bulk := func(userID int64, body string) *httptest.ResponseRecorder {
+		t.Helper()
+		r := httptest.NewRequest(http.MethodPost, "/api/games/g1/reimbursements/bulk", bytes.NewReader([]byte(body)))
+		r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(userID)}))
+		w := httptest.NewRecorder()
+		srv.PostApiGamesIdReimbursementsBulk(w, r, "g1")
+		return w
+	}
+
+	if w := bulk(firstID, `{"markAllOutstanding": true}`); w.Code != http.StatusForbidden {
+		t.Fatalf("expected status %d for a participant, got %d", http.StatusForbidden, w.Code)
+	}
+
+	invalid := map[string]string{
+		"nothing to update":               `{}`,
+		"both updates and markAll":        `{"markAllOutstanding": true, "updates": [{"participantId": "1", "reimbursementReceivedAt": null}]}`,
+		"receivedAt without markAll":      `{"receivedAt": "2026-10-12T10:00:00Z", "updates": [{"participantId": "1", "reimbursementReceivedAt": null}]}`,
+		"participant updated twice":       `{"updates": [{"participantId": "1", "reimbursementReceivedAt": null}, {"participantId": "1", "reimbursementReceivedAt": null}]}`,
+		"missing reimbursementReceivedAt": `{"updates": [{"participantId": "1"}]}`,
+	}
+	for name, body := range invalid {
+		if w := bulk(organizerID, body); w.Code != http.StatusBadRequest {
+			t.Errorf("%s: expected status %d, got %d", name, http.StatusBadRequest, w.Code)
+		}
+	}
+
+	// the participants that can't be updated are reported, the others are still updated
+	receivedAt := now.Add(-24 * time.Hour).Truncate(time.Second)
+	body, _ := json.Marshal(api.BulkReimbursementRequest{Updates: &[]api.BulkReimbursementUpdate{
+		{ParticipantId: strconv.FormatInt(firstID, 10), ReimbursementReceivedAt: nullable.NewNullableWithValue(receivedAt)},
+		{ParticipantId: "abc", ReimbursementReceivedAt: nullable.NewNullableWithValue(receivedAt)},
+		{ParticipantId: "999", ReimbursementReceivedAt: nullable.NewNullableWithValue(receivedAt)},
+	}})
+	w := bulk(organizerID, string(body))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	var resp api.BulkReimbursementResponse
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if resp.Updated != 1 || resp.Failed != 2 || len(resp.Results) != 3 {
+		t.Fatalf("expected 1 reimbursement updated and 2 failures, got %+v", resp)
+	}
+	wantStatuses := []api.BulkReimbursementStatus{api.Updated, api.InvalidParticipantId, api.ParticipantNotFound}
+	for i, result := range resp.Results {
+		if result.Status != wantStatuses[i] {
+			t.Fatalf("expected result %d to be %q, got %+v", i, wantStatuses[i], result)
+		}
+	}
+	if resp.Results[0].Record == nil || resp.Results[1].Error == nil {
+		t.Fatalf("expected the record of the update and the error of the failure, got %+v", resp.Results)
+	}
+
+	// marking every outstanding reimbursement leaves the ones already received untouched
+	w = bulk(organizerID, `{"markAllOutstanding": true}`)
+	var markAll api.BulkReimbursementResponse
+	if err := json.NewDecoder(w.Body).Decode(&markAll); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if markAll.Updated != 2 || markAll.Failed != 0 {
+		t.Fatalf("expected the 2 outstanding reimbursements to be updated, got %+v", markAll)
+	}
+
+	reimbursements := listReimbursements(t, srv, "g1", organizerID)
+	firstReceivedAt, err := reimbursements[strconv.FormatInt(firstID, 10)].ReimbursementReceivedAt.Get()
+	if err != nil || !firstReceivedAt.Equal(receivedAt) {
+		t.Fatalf("expected the first reimbursement to keep when it was received, got %v, %v", firstReceivedAt, err)
+	}
+	secondReceivedAt, err := reimbursements[strconv.FormatInt(secondID, 10)].ReimbursementReceivedAt.Get()
+	if err != nil || !secondReceivedAt.Equal(now) {
+		t.Fatalf("expected the second reimbursement received now, got %v, %v", secondReceivedAt, err)
+	}
+}
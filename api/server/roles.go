@@ -0,0 +1,491 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/dmateusp/opengym/api"
+	"github.com/dmateusp/opengym/auth"
+	"github.com/dmateusp/opengym/db"
+)
+
+// permission is something users can do in a game, depending on their role.
+type permission int
+
+const (
+	// permissionView lets users see the game and its participants before it's published.
+	permissionView permission = iota
+	// permissionManage lets users update the game and manage its participants.
+	permissionManage
+	// permissionManageReimbursements lets users see and confirm the reimbursements of the participants.
+	permissionManageReimbursements
+	// permissionManageRoles lets users give roles in the game to other users.
+	permissionManageRoles
+)
+
+var forbiddenMessages = map[permission]string{
+	permissionView:                 "forbidden: you have no role in this game",
+	permissionManage:               "forbidden: you are not an organizer of this game",
+	permissionManageReimbursements: "forbidden: only the treasurer can manage reimbursements",
+	permissionManageRoles:          "forbidden: only the owner can manage roles",
+}
+
+// gameRole returns the role of the user in the game, it's empty if they have none.
+// The owner of the game is its organizer, other roles are given by the owner.
+func gameRole(ctx context.Context, querier db.Querier, game db.Game, userID int64) (api.GameRoleName, error) {
+	if game.OrganizerID == userID {
+		return api.Owner, nil
+	}
+
+	role, err := querier.GameRoleGet(ctx, db.GameRoleGetParams{
+		GameID: game.ID,
+		UserID: userID,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to retrieve role: %w", err)
+	}
+	return api.GameRoleName(role), nil
+}
+
+// gamePayee returns the user collecting the money of the game: the treasurer appointed first, or the owner until they appoint one.
+func gamePayee(ctx context.Context, querier db.Querier, game db.Game) (int64, error) {
+	treasurerID, err := querier.GameRoleFirstUserByRole(ctx, db.GameRoleFirstUserByRoleParams{
+		GameID: game.ID,
+		Role:   string(api.Treasurer),
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return game.OrganizerID, nil
+		}
+		return 0, fmt.Errorf("failed to retrieve treasurer: %w", err)
+	}
+	return treasurerID, nil
+}
+
+// can reports whether the user has the permission in the game.
+func can(ctx context.Context, querier db.Querier, game db.Game, userID int64, p permission) (bool, error) {
+	role, err := gameRole(ctx, querier, game, userID)
+	if err != nil {
+		return false, err
+	}
+
+	switch p {
+	case permissionView:
+		return role != "", nil
+	case permissionManage:
+		return role == api.Owner || role == api.CoOrganizer, nil
+	case permissionManageRoles:
+		return role == api.Owner, nil
+	case permissionManageReimbursements:
+		if role == api.Treasurer {
+			return true, nil
+		}
+		if role != api.Owner {
+			return false, nil
+		}
+		// The owner acts as the treasurer until they appoint one
+		treasurers, err := querier.GameRoleCountByRole(ctx, db.GameRoleCountByRoleParams{
+			GameID: game.ID,
+			Role:   string(api.Treasurer),
+		})
+		if err != nil {
+			return false, fmt.Errorf("failed to count treasurers: %w", err)
+		}
+		return treasurers == 0, nil
+	default:
+		return false, fmt.Errorf("unknown permission %d", p)
+	}
+}
+
+// authorize checks that the user has the permission in the game, writing the error response otherwise.
+func authorize(w http.ResponseWriter, r *http.Request, querier db.Querier, game db.Game, userID int64, p permission) bool {
+	allowed, err := can(r.Context(), querier, game, userID, p)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return false
+	}
+	if !allowed {
+		http.Error(w, forbiddenMessages[p], http.StatusForbidden)
+		return false
+	}
+	return true
+}
+
+// isHidden reports whether the game can't be seen by the user, unpublished games are only visible to users with a role.
+// Games of a group are only visible to its members, and private games to the users invited with a valid invite token.
+// Participants keep seeing the game after leaving the group or when their invite token is revoked.
+func (s *server) isHidden(ctx context.Context, querier db.Querier, game db.Game, userID int64, invited bool) (bool, error) {
+	canView, err := can(ctx, querier, game, userID, permissionView)
+	if err != nil || canView {
+		return false, err
+	}
+
+	if game.GroupID.Valid || game.IsPrivate {
+		participant, err := isParticipant(ctx, querier, game.ID, userID)
+		if err != nil {
+			return true, err
+		}
+
+		if !participant {
+			if game.IsPrivate && !invited {
+				return true, nil
+			}
+			if game.GroupID.Valid {
+				role, err := groupRole(ctx, querier, game.GroupID.String, userID)
+				if err != nil || role == "" {
+					return true, err
+				}
+			}
+		}
+	}
+
+	return !game.PublishedAt.Valid || game.PublishedAt.Time.After(s.clock.Now()), nil
+}
+
+func isParticipant(ctx context.Context, querier db.Querier, gameID string, userID int64) (bool, error) {
+	_, err := querier.ParticipantGetByGameAndUser(ctx, db.ParticipantGetByGameAndUserParams{
+		GameID: gameID,
+		UserID: userID,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to retrieve participation: %w", err)
+	}
+	return true, nil
+}
+
+func (s *server) GetApiGamesIdRoles(w http.ResponseWriter, r *http.Request, id string) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	game, err := s.querier.GameGetByIdWithOrganizer(r.Context(), id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			http.Error(w, "game not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("failed to retrieve game: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	if !authorize(w, r, s.querier, game.Game, int64(authInfo.UserId), permissionView) {
+		return
+	}
+
+	dbRoles, err := s.querier.GameRoleListByGame(r.Context(), id)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to list roles: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	resp := make([]api.GameRole, 0, len(dbRoles)+1)
+	var owner api.GameRole
+	owner.FromDb(db.GameRole{
+		GameID:    game.Game.ID,
+		UserID:    game.User.ID,
+		Role:      string(api.Owner),
+		CreatedAt: game.Game.CreatedAt,
+		UpdatedAt: game.Game.CreatedAt,
+	}, game.User)
+	resp = append(resp, owner)
+	for _, dbRole := range dbRoles {
+		var role api.GameRole
+		role.FromDb(dbRole.GameRole, dbRole.User)
+		resp = append(resp, role)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		http.Error(w, fmt.Sprintf("failed to encode response: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (s *server) PostApiGamesIdRoles(w http.ResponseWriter, r *http.Request, id string) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req api.InviteGameRoleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("invalid request body: %s", err.Error()), http.StatusBadRequest)
+		return
+	}
+
+	if !isAssignableRole(req.Role) {
+		http.Error(w, "role must be co_organizer or treasurer", http.StatusBadRequest)
+		return
+	}
+
+	tx, err := s.dbConn.BeginTx(r.Context(), nil)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to begin transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	querierWithTx := s.querier.WithTx(tx)
+
+	game, err := querierWithTx.GameGetById(r.Context(), id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			http.Error(w, "game not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("failed to retrieve game: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	if !authorize(w, r, querierWithTx, game, int64(authInfo.UserId), permissionManageRoles) {
+		return
+	}
+
+	invitee, err := querierWithTx.UserGetByEmail(r.Context(), strings.TrimSpace(string(req.Email)))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			http.Error(w, "user not found, they must sign in to opengym before being invited", http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("failed to retrieve user: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	if invitee.User.IsPlaceholder {
+		http.Error(w, "user not found, they must sign in to opengym before being invited", http.StatusNotFound)
+		return
+	}
+
+	role, err := gameRole(r.Context(), querierWithTx, game, invitee.User.ID)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if role != "" {
+		http.Error(w, "the user already has a role in this game", http.StatusConflict)
+		return
+	}
+
+	if err := querierWithTx.GameRoleUpsert(r.Context(), db.GameRoleUpsertParams{
+		GameID: id,
+		UserID: invitee.User.ID,
+		Role:   string(req.Role),
+	}); err != nil {
+		http.Error(w, fmt.Sprintf("failed to give role: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	dbRole, err := querierWithTx.GameRoleGetWithUser(r.Context(), db.GameRoleGetWithUserParams{
+		GameID: id,
+		UserID: invitee.User.ID,
+	})
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to retrieve role: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		http.Error(w, fmt.Sprintf("failed to commit transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	var resp api.GameRole
+	resp.FromDb(dbRole.GameRole, dbRole.User)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		http.Error(w, fmt.Sprintf("failed to encode response: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (s *server) PutApiGamesIdRolesUserId(w http.ResponseWriter, r *http.Request, id string, userId string) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req api.UpdateGameRoleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("invalid request body: %s", err.Error()), http.StatusBadRequest)
+		return
+	}
+
+	if !isAssignableRole(req.Role) {
+		http.Error(w, "role must be co_organizer or treasurer", http.StatusBadRequest)
+		return
+	}
+
+	tx, err := s.dbConn.BeginTx(r.Context(), nil)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to begin transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	querierWithTx := s.querier.WithTx(tx)
+
+	game, err := querierWithTx.GameGetById(r.Context(), id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			http.Error(w, "game not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("failed to retrieve game: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	if !authorize(w, r, querierWithTx, game, int64(authInfo.UserId), permissionManageRoles) {
+		return
+	}
+
+	targetUserID, err := strconv.ParseInt(userId, 10, 64)
+	if err != nil {
+		http.Error(w, "role not found", http.StatusNotFound)
+		return
+	}
+
+	if targetUserID == game.OrganizerID {
+		http.Error(w, "the role of the owner can't be changed", http.StatusBadRequest)
+		return
+	}
+
+	if _, err := querierWithTx.GameRoleGet(r.Context(), db.GameRoleGetParams{GameID: id, UserID: targetUserID}); err != nil {
+		if err == sql.ErrNoRows {
+			http.Error(w, "role not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("failed to retrieve role: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	if err := querierWithTx.GameRoleUpsert(r.Context(), db.GameRoleUpsertParams{
+		GameID: id,
+		UserID: targetUserID,
+		Role:   string(req.Role),
+	}); err != nil {
+		http.Error(w, fmt.Sprintf("failed to change role: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	if req.Role != api.CoOrganizer {
+		if err := deleteGameWebhooksOf(r.Context(), querierWithTx, id, targetUserID); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	dbRole, err := querierWithTx.GameRoleGetWithUser(r.Context(), db.GameRoleGetWithUserParams{
+		GameID: id,
+		UserID: targetUserID,
+	})
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to retrieve role: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		http.Error(w, fmt.Sprintf("failed to commit transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	var resp api.GameRole
+	resp.FromDb(dbRole.GameRole, dbRole.User)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		http.Error(w, fmt.Sprintf("failed to encode response: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (s *server) DeleteApiGamesIdRolesUserId(w http.ResponseWriter, r *http.Request, id string, userId string) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	tx, err := s.dbConn.BeginTx(r.Context(), nil)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to begin transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	querierWithTx := s.querier.WithTx(tx)
+
+	game, err := querierWithTx.GameGetById(r.Context(), id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			http.Error(w, "game not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("failed to retrieve game: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	targetUserID, err := strconv.ParseInt(userId, 10, 64)
+	if err != nil {
+		http.Error(w, "role not found", http.StatusNotFound)
+		return
+	}
+
+	// Users can give up their own role
+	if targetUserID != int64(authInfo.UserId) && !authorize(w, r, querierWithTx, game, int64(authInfo.UserId), permissionManageRoles) {
+		return
+	}
+
+	if targetUserID == game.OrganizerID {
+		http.Error(w, "the role of the owner can't be revoked", http.StatusBadRequest)
+		return
+	}
+
+	rowsAffected, err := querierWithTx.GameRoleDelete(r.Context(), db.GameRoleDeleteParams{
+		GameID: id,
+		UserID: targetUserID,
+	})
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to revoke role: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+	if rowsAffected == 0 {
+		http.Error(w, "role not found", http.StatusNotFound)
+		return
+	}
+
+	if err := deleteGameWebhooksOf(r.Context(), querierWithTx, id, targetUserID); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		http.Error(w, fmt.Sprintf("failed to commit transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// isAssignableRole reports whether the role can be given by the owner, there's only one owner per game.
+func isAssignableRole(role api.GameRoleName) bool {
+	return role == api.CoOrganizer || role == api.Treasurer
+}
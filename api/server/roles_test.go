@@ -0,0 +1,221 @@
+package server_test
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/dmateusp/opengym/api"
+	"github.com/dmateusp/opengym/api/server"
+	"github.com/dmateusp/opengym/auth"
+	"github.com/dmateusp/opengym/clock"
+	"github.com/dmateusp/opengym/db"
+	dbtesting "github.com/dmateusp/opengym/db/testing"
+	"github.com/dmateusp/opengym/ptr"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+func inviteToRole(t *testing.T, srv api.ServerInterface, gameID string, userID int64, email string, role api.GameRoleName) *httptest.ResponseRecorder {
+	t.Helper()
+
+	body, _ := json.Marshal(api.InviteGameRoleRequest{Email: openapi_types.Email(email), Role: role})
+	r := httptest.NewRequest(http.MethodPost, "/api/games/"+gameID+"/roles", bytes.NewReader(body))
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(userID)}))
+	w := httptest.NewRecorder()
+	srv.PostApiGamesIdRoles(w, r, gameID)
+	return w
+}
+
+func TestGameRoles_CoOrganizerManagesTheGame(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	staticClock := clock.StaticClock{Time: time.Now()}
+
+	ownerID := dbtesting.UpsertTestUser(t, sqlDB, "owner@example.com")
+	coOrganizerID := dbtesting.UpsertTestUser(t, sqlDB, "co-organizer@example.com")
+	otherID := dbtesting.UpsertTestUser(t, sqlDB, "other@example.com")
+
+	querier := db.New(sqlDB)
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), staticClock, sqlDB)
+	createGame(t, querier, "g1", ownerID, sql.NullTime{})
+
+	patch := func(userID int64) *httptest.ResponseRecorder {
+		body, _ := json.Marshal(api.UpdateGameRequest{Name: ptr.Ptr("Renamed")})
+		r := httptest.NewRequest(http.MethodPatch, "/api/games/g1", bytes.NewReader(body))
+		r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(userID)}))
+		w := httptest.NewRecorder()
+		srv.PatchApiGamesId(w, r, "g1")
+		return w
+	}
+
+	if w := patch(coOrganizerID); w.Code != http.StatusForbidden {
+		t.Fatalf("expected status %d before the invite, got %d", http.StatusForbidden, w.Code)
+	}
+
+	if w := inviteToRole(t, srv, "g1", ownerID, "co-organizer@example.com", api.CoOrganizer); w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusCreated, w.Code, w.Body.String())
+	}
+
+	if w := patch(coOrganizerID); w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	if w := patch(otherID); w.Code != http.StatusForbidden {
+		t.Fatalf("expected status %d, got %d", http.StatusForbidden, w.Code)
+	}
+
+	// co-organizers see the draft and find it in their games
+	r := httptest.NewRequest(http.MethodGet, "/api/games/g1", nil)
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(coOrganizerID)}))
+	w := httptest.NewRecorder()
+	srv.GetApiGamesId(w, r, "g1", api.GetApiGamesIdParams{})
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+
+	r = httptest.NewRequest(http.MethodGet, "/api/games", nil)
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(coOrganizerID)}))
+	w = httptest.NewRecorder()
+	srv.GetApiGames(w, r, api.GetApiGamesParams{})
+	var games api.GameListResponse
+	if err := json.NewDecoder(w.Body).Decode(&games); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if games.Total != 1 || games.Items[0].Id != "g1" {
+		t.Fatalf("expected the game in the co-organizer's games, got %+v", games)
+	}
+
+	// but they can't give roles
+	if w := inviteToRole(t, srv, "g1", coOrganizerID, "other@example.com", api.Treasurer); w.Code != http.StatusForbidden {
+		t.Fatalf("expected status %d, got %d", http.StatusForbidden, w.Code)
+	}
+}
+
+func TestGameRoles_OnlyTheTreasurerManagesReimbursements(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	staticClock := clock.StaticClock{Time: time.Now()}
+
+	ownerID := dbtesting.UpsertTestUser(t, sqlDB, "owner@example.com")
+	coOrganizerID := dbtesting.UpsertTestUser(t, sqlDB, "co-organizer@example.com")
+	treasurerID := dbtesting.UpsertTestUser(t, sqlDB, "treasurer@example.com")
+
+	querier := db.New(sqlDB)
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), staticClock, sqlDB)
+	createGame(t, querier, "g1", ownerID, sql.NullTime{Time: staticClock.Time.Add(-time.Hour), Valid: true})
+	freezeGameForReimbursements(t, sqlDB, staticClock.Time, "g1")
+
+	listReimbursements := func(userID int64) int {
+		r := httptest.NewRequest(http.MethodGet, "/api/games/g1/reimbursements", nil)
+		r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(userID)}))
+		w := httptest.NewRecorder()
+		srv.GetApiGamesIdReimbursements(w, r, "g1")
+		return w.Code
+	}
+
+	if code := listReimbursements(ownerID); code != http.StatusOK {
+		t.Fatalf("expected the owner to act as the treasurer, got status %d", code)
+	}
+
+	for email, role := range map[string]api.GameRoleName{"co-organizer@example.com": api.CoOrganizer, "treasurer@example.com": api.Treasurer} {
+		if w := inviteToRole(t, srv, "g1", ownerID, email, role); w.Code != http.StatusCreated {
+			t.Fatalf("expected status %d, got %d: %s", http.StatusCreated, w.Code, w.Body.String())
+		}
+	}
+
+	for _, tt := range []struct {
+		name     string
+		userID   int64
+		wantCode int
+	}{
+		{"owner", ownerID, http.StatusForbidden},
+		{"co-organizer", coOrganizerID, http.StatusForbidden},
+		{"treasurer", treasurerID, http.StatusOK},
+	} {
+		if code := listReimbursements(tt.userID); code != tt.wantCode {
+			t.Errorf("%s: expected status %d, got %d", tt.name, tt.wantCode, code)
+		}
+	}
+}
+
+func TestGameRoles_Management(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	staticClock := clock.StaticClock{Time: time.Now()}
+
+	ownerID := dbtesting.UpsertTestUser(t, sqlDB, "owner@example.com")
+	userID := dbtesting.UpsertTestUser(t, sqlDB, "user@example.com")
+
+	querier := db.New(sqlDB)
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), staticClock, sqlDB)
+	createGame(t, querier, "g1", ownerID, sql.NullTime{})
+
+	for _, tt := range []struct {
+		name     string
+		email    string
+		role     api.GameRoleName
+		wantCode int
+	}{
+		{"unknown user", "nobody@example.com", api.CoOrganizer, http.StatusNotFound},
+		{"owner role", "user@example.com", api.Owner, http.StatusBadRequest},
+		{"the owner", "owner@example.com", api.Treasurer, http.StatusConflict},
+		{"valid", "user@example.com", api.CoOrganizer, http.StatusCreated},
+		{"already invited", "user@example.com", api.Treasurer, http.StatusConflict},
+	} {
+		if w := inviteToRole(t, srv, "g1", ownerID, tt.email, tt.role); w.Code != tt.wantCode {
+			t.Fatalf("%s: expected status %d, got %d: %s", tt.name, tt.wantCode, w.Code, w.Body.String())
+		}
+	}
+
+	body, _ := json.Marshal(api.UpdateGameRoleRequest{Role: api.Treasurer})
+	r := httptest.NewRequest(http.MethodPut, "/", bytes.NewReader(body))
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(ownerID)}))
+	w := httptest.NewRecorder()
+	srv.PutApiGamesIdRolesUserId(w, r, "g1", strconv.FormatInt(userID, 10))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+
+	r = httptest.NewRequest(http.MethodGet, "/api/games/g1/roles", nil)
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(userID)}))
+	w = httptest.NewRecorder()
+	srv.GetApiGamesIdRoles(w, r, "g1")
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	var roles []api.GameRole
+	if err := json.NewDecoder(w.Body).Decode(&roles); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(roles) != 2 || roles[0].Role != api.Owner || roles[1].Role != api.Treasurer || roles[1].User.Id != strconv.FormatInt(userID, 10) {
+		t.Fatalf("unexpected roles %+v", roles)
+	}
+
+	revoke := func(userID, targetUserID int64) int {
+		r := httptest.NewRequest(http.MethodDelete, "/", nil)
+		r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(userID)}))
+		w := httptest.NewRecorder()
+		srv.DeleteApiGamesIdRolesUserId(w, r, "g1", strconv.FormatInt(targetUserID, 10))
+		return w.Code
+	}
+
+	if code := revoke(userID, ownerID); code != http.StatusForbidden {
+		t.Fatalf("expected status %d, got %d", http.StatusForbidden, code)
+	}
+	if code := revoke(ownerID, ownerID); code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, code)
+	}
+	// users can give up their own role
+	if code := revoke(userID, userID); code != http.StatusNoContent {
+		t.Fatalf("expected status %d, got %d", http.StatusNoContent, code)
+	}
+	if code := revoke(ownerID, userID); code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, code)
+	}
+}
@@ -0,0 +1,750 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/dmateusp/opengym/api"
+	"github.com/dmateusp/opengym/auth"
+	"github.com/dmateusp/opengym/db"
+	"github.com/dmateusp/opengym/log"
+	"github.com/dmateusp/opengym/outbox"
+	"github.com/dmateusp/opengym/recurrence"
+)
+
+var (
+	seriesMaterializeInterval = flag.Duration("series.materialize-interval", 15*time.Minute, "How often games are generated for the upcoming occurrences of series")
+	seriesMaterializeAhead    = flag.Duration("series.materialize-ahead", 14*24*time.Hour, "How far ahead games are generated for series")
+)
+
+// seriesRescheduledReason is the cancellation reason of the games dropped when the schedule of their series changes.
+const seriesRescheduledReason = "The schedule of the series changed"
+
+func (srv *server) PostApiSeries(w http.ResponseWriter, r *http.Request) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req api.CreateSeriesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("invalid request body: %s", err.Error()), http.StatusBadRequest)
+		return
+	}
+
+	if req.GameDefaults.Name == nil {
+		http.Error(w, "name cannot be empty", http.StatusBadRequest)
+		return
+	}
+	if err := validateSeriesGameDefaults(req.GameDefaults); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	rule, err := recurrenceRuleFromApi(req.Recurrence)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("invalid recurrence: %s", err.Error()), http.StatusBadRequest)
+		return
+	}
+
+	if req.PublishMinutesBefore.IsSpecified() && !req.PublishMinutesBefore.IsNull() && req.PublishMinutesBefore.MustGet() < 0 {
+		http.Error(w, "publishMinutesBefore cannot be negative", http.StatusBadRequest)
+		return
+	}
+
+	params := db.SeriesCreateParams{
+		OrganizerID:     int64(authInfo.UserId),
+		Name:            *req.GameDefaults.Name,
+		DurationMinutes: 60,
+		MaxPlayers:      100, // Default to 100, like games
+		StartsAt:        rule.Start,
+		Timezone:        rule.Start.Location().String(),
+		IntervalWeeks:   int64(rule.IntervalWeeks),
+		Weekdays:        recurrence.FormatWeekdays(rule.Weekdays),
+	}
+	if req.GameDefaults.Description != nil {
+		params.Description = sql.NullString{String: *req.GameDefaults.Description, Valid: true}
+	}
+	if req.GameDefaults.TotalPriceCents != nil {
+		params.TotalPriceCents = *req.GameDefaults.TotalPriceCents
+	}
+	if req.GameDefaults.Location != nil {
+		params.Location = sql.NullString{String: *req.GameDefaults.Location, Valid: true}
+	}
+	if req.GameDefaults.DurationMinutes != nil {
+		params.DurationMinutes = *req.GameDefaults.DurationMinutes
+	}
+	if req.GameDefaults.MaxPlayers != nil {
+		params.MaxPlayers = *req.GameDefaults.MaxPlayers
+	}
+	if req.GameDefaults.MaxGuestsPerPlayer != nil {
+		params.MaxGuestsPerPlayer = *req.GameDefaults.MaxGuestsPerPlayer
+	}
+	if !rule.Until.IsZero() {
+		params.EndsAt = sql.NullTime{Time: rule.Until, Valid: true}
+	}
+	if rule.Count > 0 {
+		params.OccurrenceCount = sql.NullInt64{Int64: int64(rule.Count), Valid: true}
+	}
+	if req.PublishMinutesBefore.IsSpecified() && !req.PublishMinutesBefore.IsNull() {
+		params.PublishMinutesBefore = sql.NullInt64{Int64: int64(req.PublishMinutesBefore.MustGet()), Valid: true}
+	}
+
+	tx, err := srv.dbConn.BeginTx(r.Context(), nil)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to begin transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	querierWithTx := srv.querier.WithTx(tx)
+
+	var series db.GameSeries
+	for attempt := range maxGameIDAttempts {
+		params.ID = srv.randomAlphanumericGenerator.Generate(*gameIDLength)
+
+		series, err = querierWithTx.SeriesCreate(r.Context(), params)
+		if err == nil {
+			break
+		}
+
+		if attempt < maxGameIDAttempts-1 && isSeriesIDConstraintError(err) {
+			continue
+		}
+
+		http.Error(w, fmt.Sprintf("failed to create series: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	// Generate the first games right away so the organizer doesn't have to wait for the background process
+	if err := srv.materializeSeries(r.Context(), querierWithTx, series); err != nil {
+		http.Error(w, fmt.Sprintf("failed to generate games: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		http.Error(w, fmt.Sprintf("failed to commit transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	var apiSeries api.Series
+	apiSeries.FromDb(series)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(apiSeries); err != nil {
+		http.Error(w, fmt.Sprintf("failed to encode response: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (srv *server) GetApiSeries(w http.ResponseWriter, r *http.Request) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	rows, err := srv.querier.SeriesListByOrganizer(r.Context(), int64(authInfo.UserId))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to list series: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	items := make([]api.Series, 0, len(rows))
+	for _, row := range rows {
+		var item api.Series
+		item.FromDb(row)
+		items = append(items, item)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(items); err != nil {
+		http.Error(w, fmt.Sprintf("failed to encode response: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (srv *server) GetApiSeriesId(w http.ResponseWriter, r *http.Request, id string) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	series, err := srv.querier.SeriesGetById(r.Context(), id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			http.Error(w, "series not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("failed to retrieve series: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	// Participants interact with the generated games, only organizers can see the series
+	if series.OrganizerID != int64(authInfo.UserId) {
+		http.Error(w, "series not found", http.StatusNotFound)
+		return
+	}
+
+	var apiSeries api.Series
+	apiSeries.FromDb(series)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(apiSeries); err != nil {
+		http.Error(w, fmt.Sprintf("failed to encode response: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (srv *server) PatchApiSeriesId(w http.ResponseWriter, r *http.Request, id string) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	tx, err := srv.dbConn.BeginTx(r.Context(), nil)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to begin transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	querierWithTx := srv.querier.WithTx(tx)
+
+	series, err := querierWithTx.SeriesGetById(r.Context(), id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			http.Error(w, "series not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("failed to retrieve series: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	if series.OrganizerID != int64(authInfo.UserId) {
+		http.Error(w, "forbidden: you are not the organizer of this series", http.StatusForbidden)
+		return
+	}
+
+	var req api.UpdateSeriesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("invalid request body: %s", err.Error()), http.StatusBadRequest)
+		return
+	}
+
+	params := db.SeriesUpdateParams{ID: id}
+
+	if req.GameDefaults != nil {
+		if err := validateSeriesGameDefaults(*req.GameDefaults); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if req.GameDefaults.Name != nil {
+			params.Name = sql.NullString{String: *req.GameDefaults.Name, Valid: true}
+		}
+		if req.GameDefaults.Description != nil {
+			params.Description = sql.NullString{String: *req.GameDefaults.Description, Valid: true}
+		}
+		if req.GameDefaults.TotalPriceCents != nil {
+			params.TotalPriceCents = sql.NullInt64{Int64: *req.GameDefaults.TotalPriceCents, Valid: true}
+		}
+		if req.GameDefaults.Location != nil {
+			params.Location = sql.NullString{String: *req.GameDefaults.Location, Valid: true}
+		}
+		if req.GameDefaults.DurationMinutes != nil {
+			params.DurationMinutes = sql.NullInt64{Int64: *req.GameDefaults.DurationMinutes, Valid: true}
+		}
+		if req.GameDefaults.MaxPlayers != nil {
+			params.MaxPlayers = sql.NullInt64{Int64: *req.GameDefaults.MaxPlayers, Valid: true}
+		}
+		if req.GameDefaults.MaxGuestsPerPlayer != nil {
+			params.MaxGuestsPerPlayer = sql.NullInt64{Int64: *req.GameDefaults.MaxGuestsPerPlayer, Valid: true}
+		}
+	}
+
+	// The recurrence rule is always replaced as a whole
+	if req.Recurrence != nil {
+		rule, err := recurrenceRuleFromApi(*req.Recurrence)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid recurrence: %s", err.Error()), http.StatusBadRequest)
+			return
+		}
+
+		params.StartsAt = sql.NullTime{Time: rule.Start, Valid: true}
+		params.Timezone = sql.NullString{String: rule.Start.Location().String(), Valid: true}
+		params.IntervalWeeks = sql.NullInt64{Int64: int64(rule.IntervalWeeks), Valid: true}
+		params.Weekdays = sql.NullString{String: recurrence.FormatWeekdays(rule.Weekdays), Valid: true}
+		if rule.Until.IsZero() {
+			params.ClearEndsAt = true
+		} else {
+			params.EndsAt = sql.NullTime{Time: rule.Until, Valid: true}
+		}
+		if rule.Count == 0 {
+			params.ClearOccurrenceCount = true
+		} else {
+			params.OccurrenceCount = sql.NullInt64{Int64: int64(rule.Count), Valid: true}
+		}
+	}
+
+	if req.PublishMinutesBefore.IsNull() {
+		params.ClearPublishMinutesBefore = true
+	} else if req.PublishMinutesBefore.IsSpecified() {
+		if req.PublishMinutesBefore.MustGet() < 0 {
+			http.Error(w, "publishMinutesBefore cannot be negative", http.StatusBadRequest)
+			return
+		}
+		params.PublishMinutesBefore = sql.NullInt64{Int64: int64(req.PublishMinutesBefore.MustGet()), Valid: true}
+	}
+
+	if err := querierWithTx.SeriesUpdate(r.Context(), params); err != nil {
+		http.Error(w, fmt.Sprintf("failed to update series: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	updatedSeries, err := querierWithTx.SeriesGetById(r.Context(), id)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to retrieve updated series: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	copyDefaults := req.ApplyToFutureGames != nil && *req.ApplyToFutureGames
+	reschedule := req.Recurrence != nil
+	if copyDefaults || reschedule {
+		if err := applySeriesToFutureGames(r.Context(), querierWithTx, updatedSeries, srv.clock.Now(), copyDefaults, reschedule); err != nil {
+			http.Error(w, fmt.Sprintf("failed to update future games: %s", err.Error()), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	if err := srv.materializeSeries(r.Context(), querierWithTx, updatedSeries); err != nil {
+		http.Error(w, fmt.Sprintf("failed to generate games: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		http.Error(w, fmt.Sprintf("failed to commit transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	var apiSeries api.Series
+	apiSeries.FromDb(updatedSeries)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(apiSeries); err != nil {
+		http.Error(w, fmt.Sprintf("failed to encode response: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+}
+
+// RunSeriesMaterializer generates games for the upcoming occurrences of every series until ctx is cancelled.
+func (srv *server) RunSeriesMaterializer(ctx context.Context) {
+	ticker := time.NewTicker(*seriesMaterializeInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := srv.MaterializeSeries(ctx); err != nil {
+			log.FromCtx(ctx).ErrorContext(ctx, "Failed to materialize series", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// MaterializeSeries creates the missing games for the occurrences of every active series
+// starting within the materialization window.
+func (srv *server) MaterializeSeries(ctx context.Context) error {
+	allSeries, err := srv.querier.SeriesList(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list series: %w", err)
+	}
+
+	now := srv.clock.Now()
+	var errs []error
+	for _, series := range allSeries {
+		if series.EndsAt.Valid && series.EndsAt.Time.Before(now) {
+			continue
+		}
+
+		if err := srv.materializeSeries(ctx, srv.querier, series); err != nil {
+			errs = append(errs, fmt.Errorf("series %s: %w", series.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (srv *server) materializeSeries(ctx context.Context, querier db.QuerierWithTxSupport, series db.GameSeries) error {
+	rule, err := seriesRecurrenceRule(series)
+	if err != nil {
+		return err
+	}
+
+	now := srv.clock.Now()
+	occurrences := rule.Occurrences(now, now.Add(*seriesMaterializeAhead))
+	if len(occurrences) == 0 {
+		return nil
+	}
+
+	existingOccurrences, err := querier.SeriesListOccurrences(ctx, sql.NullString{String: series.ID, Valid: true})
+	if err != nil {
+		return fmt.Errorf("failed to list existing games: %w", err)
+	}
+	materialized := make(map[int64]bool, len(existingOccurrences))
+	for _, occurrence := range existingOccurrences {
+		materialized[occurrence.Time.Unix()] = true
+	}
+
+	for _, occurrence := range occurrences {
+		if materialized[occurrence.Unix()] {
+			continue
+		}
+
+		params := db.GameCreateParams{
+			OrganizerID:        series.OrganizerID,
+			Name:               series.Name,
+			Description:        series.Description,
+			TotalPriceCents:    series.TotalPriceCents,
+			Location:           series.Location,
+			StartsAt:           sql.NullTime{Time: occurrence.UTC(), Valid: true},
+			DurationMinutes:    series.DurationMinutes,
+			MaxPlayers:         series.MaxPlayers,
+			MaxGuestsPerPlayer: series.MaxGuestsPerPlayer,
+			GameSpotsLeft:      series.MaxPlayers,
+			SeriesID:           sql.NullString{String: series.ID, Valid: true},
+			SeriesOccurrenceAt: sql.NullTime{Time: occurrence.UTC(), Valid: true},
+		}
+
+		if series.PublishMinutesBefore.Valid {
+			publishAt := occurrence.Add(-time.Duration(series.PublishMinutesBefore.Int64) * time.Minute)
+			if publishAt.Before(now) {
+				publishAt = now
+			}
+			params.PublishedAt = sql.NullTime{Time: publishAt.UTC(), Valid: true}
+		}
+
+		for attempt := range maxGameIDAttempts {
+			params.ID = srv.randomAlphanumericGenerator.Generate(*gameIDLength)
+
+			_, err = querier.GameCreate(ctx, params)
+			if err == nil {
+				break
+			}
+
+			if attempt < maxGameIDAttempts-1 && isConstraintError(err) {
+				continue
+			}
+
+			// Generated concurrently by another process
+			if isSeriesOccurrenceConstraintError(err) {
+				break
+			}
+
+			return fmt.Errorf("failed to create game for occurrence %s: %w", occurrence, err)
+		}
+	}
+
+	return nil
+}
+
+// applySeriesToFutureGames updates the games of the series that haven't started and aren't frozen.
+// When copyDefaults is set, the defaults of the series are copied to the games.
+// When reschedule is set, the games that no longer match the recurrence rule are moved in order to the occurrences
+// of the rule that don't have a game yet, and the games left over are cancelled, or deleted if they aren't published.
+// Every update goes through [applyGameUpdate], so the participants hear about the changes.
+func applySeriesToFutureGames(ctx context.Context, querier db.QuerierWithTxSupport, series db.GameSeries, now time.Time, copyDefaults, reschedule bool) error {
+	games, err := querier.GameListBySeries(ctx, sql.NullString{String: series.ID, Valid: true})
+	if err != nil {
+		return fmt.Errorf("failed to list games: %w", err)
+	}
+
+	var futureGames []db.Game
+	for _, game := range games {
+		if !game.StartsAt.Valid || !game.StartsAt.Time.After(now) {
+			continue
+		}
+		if game.FrozenAt.Valid && !game.FrozenAt.Time.After(now) {
+			continue
+		}
+		futureGames = append(futureGames, game)
+	}
+	if len(futureGames) == 0 {
+		return nil
+	}
+
+	var moveTo map[string]time.Time
+	if reschedule {
+		moveTo, err = seriesReschedule(ctx, querier, series, futureGames, now)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, game := range futureGames {
+		params := db.GameUpdateParams{ID: game.ID}
+		updated := false
+
+		if copyDefaults {
+			params.Name = sql.NullString{String: series.Name, Valid: true}
+			params.Description = series.Description
+			params.TotalPriceCents = sql.NullInt64{Int64: series.TotalPriceCents, Valid: true}
+			params.Location = series.Location
+			params.DurationMinutes = series.DurationMinutes
+			params.MaxPlayers = sql.NullInt64{Int64: series.MaxPlayers, Valid: true}
+			params.MaxGuestsPerPlayer = sql.NullInt64{Int64: series.MaxGuestsPerPlayer, Valid: true}
+			updated = true
+		}
+
+		if reschedule && game.SeriesOccurrenceAt.Valid {
+			occurrence, ok := moveTo[game.ID]
+			if !ok {
+				if err := dropSeriesGame(ctx, querier, game, now); err != nil {
+					return fmt.Errorf("game %s: %w", game.ID, err)
+				}
+				continue
+			}
+			if !occurrence.Equal(game.SeriesOccurrenceAt.Time) {
+				if err := querier.GameSetSeriesOccurrence(ctx, db.GameSetSeriesOccurrenceParams{
+					SeriesOccurrenceAt: sql.NullTime{Time: occurrence.UTC(), Valid: true},
+					ID:                 game.ID,
+				}); err != nil {
+					return fmt.Errorf("failed to move game %s: %w", game.ID, err)
+				}
+				params.StartsAt = sql.NullTime{Time: occurrence.UTC(), Valid: true}
+				// Games that aren't published yet are published as long before the new occurrence
+				if series.PublishMinutesBefore.Valid && game.PublishedAt.Valid && game.PublishedAt.Time.After(now) {
+					publishAt := occurrence.Add(-time.Duration(series.PublishMinutesBefore.Int64) * time.Minute)
+					if publishAt.Before(now) {
+						publishAt = now
+					}
+					params.PublishedAt = sql.NullTime{Time: publishAt.UTC(), Valid: true}
+				}
+				updated = true
+			}
+		}
+
+		if !updated {
+			continue
+		}
+		if _, err := applyGameUpdate(ctx, querier, game, params, now); err != nil {
+			return fmt.Errorf("game %s: %w", game.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// seriesReschedule returns the occurrence each of the future games of the series is at under its recurrence rule.
+// The games at an occurrence of the rule stay there, the others take the next occurrences that don't have a game yet, in order.
+// Games that don't get an occurrence are left out.
+func seriesReschedule(ctx context.Context, querier db.Querier, series db.GameSeries, futureGames []db.Game, now time.Time) (map[string]time.Time, error) {
+	rule, err := seriesRecurrenceRule(series)
+	if err != nil {
+		return nil, err
+	}
+
+	until := now.Add(*seriesMaterializeAhead)
+	if last := futureGames[len(futureGames)-1].StartsAt.Time; last.After(until) {
+		until = last
+	}
+	occurrences := rule.Occurrences(now, until)
+
+	existingOccurrences, err := querier.SeriesListOccurrences(ctx, sql.NullString{String: series.ID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list existing games: %w", err)
+	}
+	taken := make(map[int64]bool, len(existingOccurrences))
+	for _, occurrence := range existingOccurrences {
+		taken[occurrence.Time.Unix()] = true
+	}
+
+	matching := make(map[int64]bool, len(occurrences))
+	for _, occurrence := range occurrences {
+		matching[occurrence.Unix()] = true
+	}
+
+	var free []time.Time
+	for _, occurrence := range occurrences {
+		if !taken[occurrence.Unix()] {
+			free = append(free, occurrence)
+		}
+	}
+
+	moveTo := make(map[string]time.Time, len(futureGames))
+	for _, game := range futureGames {
+		if !game.SeriesOccurrenceAt.Valid {
+			continue
+		}
+		if matching[game.SeriesOccurrenceAt.Time.Unix()] {
+			moveTo[game.ID] = game.SeriesOccurrenceAt.Time
+			continue
+		}
+		if len(free) > 0 {
+			moveTo[game.ID] = free[0]
+			free = free[1:]
+		}
+	}
+	return moveTo, nil
+}
+
+// dropSeriesGame removes a game that no longer has an occurrence in its series.
+// Participants may have joined published games, they're cancelled instead so they know what happened.
+func dropSeriesGame(ctx context.Context, querier db.Querier, game db.Game, now time.Time) error {
+	// The occurrence is free again, a later change of the rule can generate a game for it
+	if err := querier.GameSetSeriesOccurrence(ctx, db.GameSetSeriesOccurrenceParams{ID: game.ID}); err != nil {
+		return fmt.Errorf("failed to free the occurrence of the game: %w", err)
+	}
+
+	if !game.PublishedAt.Valid || game.PublishedAt.Time.After(now) {
+		if _, err := querier.GameDelete(ctx, db.GameDeleteParams{
+			DeletedAt: sql.NullTime{Time: now, Valid: true},
+			ID:        game.ID,
+		}); err != nil {
+			return fmt.Errorf("failed to delete game: %w", err)
+		}
+		return nil
+	}
+
+	if _, err := querier.GameCancel(ctx, db.GameCancelParams{
+		CancelledAt:        sql.NullTime{Time: now, Valid: true},
+		CancellationReason: sql.NullString{String: seriesRescheduledReason, Valid: true},
+		ID:                 game.ID,
+	}); err != nil {
+		return fmt.Errorf("failed to cancel game: %w", err)
+	}
+	if err := outbox.Publish(ctx, querier, game.ID, outbox.GameCancelled{CancelledAt: now, Reason: seriesRescheduledReason}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func recurrenceRuleFromApi(apiRule api.RecurrenceRule) (recurrence.Rule, error) {
+	timezone := "UTC"
+	if apiRule.Timezone != nil {
+		timezone = *apiRule.Timezone
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return recurrence.Rule{}, fmt.Errorf("unknown timezone %q", timezone)
+	}
+
+	rule := recurrence.Rule{
+		Start:         apiRule.StartsAt.In(loc),
+		IntervalWeeks: 1,
+	}
+
+	if apiRule.IntervalWeeks != nil {
+		rule.IntervalWeeks = *apiRule.IntervalWeeks
+	}
+
+	for _, weekday := range apiRule.Weekdays {
+		t, ok := weekday.ToTime()
+		if !ok {
+			return recurrence.Rule{}, fmt.Errorf("invalid weekday %q", weekday)
+		}
+		rule.Weekdays = append(rule.Weekdays, t)
+	}
+
+	if apiRule.EndsAt.IsSpecified() && !apiRule.EndsAt.IsNull() {
+		rule.Until = apiRule.EndsAt.MustGet()
+	}
+
+	if apiRule.OccurrenceCount.IsSpecified() && !apiRule.OccurrenceCount.IsNull() {
+		rule.Count = apiRule.OccurrenceCount.MustGet()
+		if rule.Count < 1 {
+			return recurrence.Rule{}, fmt.Errorf("occurrenceCount must be at least 1")
+		}
+	}
+
+	if err := rule.Validate(); err != nil {
+		return recurrence.Rule{}, err
+	}
+
+	return rule, nil
+}
+
+func seriesRecurrenceRule(series db.GameSeries) (recurrence.Rule, error) {
+	loc, err := time.LoadLocation(series.Timezone)
+	if err != nil {
+		return recurrence.Rule{}, fmt.Errorf("failed to load timezone %q: %w", series.Timezone, err)
+	}
+
+	weekdays, err := recurrence.ParseWeekdays(series.Weekdays)
+	if err != nil {
+		return recurrence.Rule{}, err
+	}
+
+	rule := recurrence.Rule{
+		Start:         series.StartsAt.In(loc),
+		IntervalWeeks: int(series.IntervalWeeks),
+		Weekdays:      weekdays,
+	}
+	if series.EndsAt.Valid {
+		rule.Until = series.EndsAt.Time
+	}
+	if series.OccurrenceCount.Valid {
+		rule.Count = int(series.OccurrenceCount.Int64)
+	}
+
+	return rule, nil
+}
+
+func validateSeriesGameDefaults(fields api.GameFields) error {
+	if fields.Name != nil {
+		if len(*fields.Name) == 0 {
+			return fmt.Errorf("name cannot be empty")
+		}
+		if len(*fields.Name) > 100 {
+			return fmt.Errorf("name cannot exceed 100 characters")
+		}
+	}
+
+	if fields.Description != nil && len(*fields.Description) > 1000 {
+		return fmt.Errorf("description cannot exceed 1000 characters")
+	}
+
+	if fields.DurationMinutes != nil && *fields.DurationMinutes <= 0 {
+		return fmt.Errorf("duration must be positive")
+	}
+
+	if fields.MaxPlayers != nil && *fields.MaxPlayers < 1 {
+		return fmt.Errorf("maxPlayers must be at least 1")
+	}
+
+	if fields.MaxGuestsPerPlayer != nil && *fields.MaxGuestsPerPlayer < 0 {
+		return fmt.Errorf("maxGuestsPerPlayer cannot be negative")
+	}
+
+	return nil
+}
+
+func isSeriesIDConstraintError(err error) bool {
+	if err == nil {
+		return false
+	}
+	return strings.Contains(err.Error(), "UNIQUE constraint failed: game_series.id")
+}
+
+func isSeriesOccurrenceConstraintError(err error) bool {
+	if err == nil {
+		return false
+	}
+	return strings.Contains(err.Error(), "UNIQUE constraint failed: games.series_id, games.series_occurrence_at")
+}
@@ -0,0 +1,520 @@
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/dmateusp/opengym/api"
+	"github.com/dmateusp/opengym/api/server"
+	"github.com/dmateusp/opengym/auth"
+	"github.com/dmateusp/opengym/clock"
+	"github.com/dmateusp/opengym/db"
+	dbtesting "github.com/dmateusp/opengym/db/testing"
+	"github.com/dmateusp/opengym/ptr"
+	"github.com/oapi-codegen/nullable"
+)
+
+// Monday
+var seriesTestNow = time.Date(2026, time.March, 2, 12, 0, 0, 0, time.UTC)
+
+func createTestSeries(t *testing.T, srv api.ServerInterface, userID int64, req api.CreateSeriesRequest) api.Series {
+	t.Helper()
+
+	body, _ := json.Marshal(req)
+	r := httptest.NewRequest(http.MethodPost, "/api/series", bytes.NewReader(body))
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(userID)}))
+	w := httptest.NewRecorder()
+
+	srv.PostApiSeries(w, r)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusCreated, w.Code, w.Body.String())
+	}
+
+	var series api.Series
+	if err := json.NewDecoder(w.Body).Decode(&series); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	return series
+}
+
+func listSeriesGames(t *testing.T, sqlDB *sql.DB, seriesID string) []db.Game {
+	t.Helper()
+
+	rows, err := sqlDB.Query(`select id from games where series_id = ? and deleted_at is null order by starts_at`, seriesID)
+	if err != nil {
+		t.Fatalf("failed to list series games: %v", err)
+	}
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			t.Fatalf("failed to scan game id: %v", err)
+		}
+		ids = append(ids, id)
+	}
+	rows.Close()
+
+	querier := db.New(sqlDB)
+	var games []db.Game
+	for _, id := range ids {
+		game, err := querier.GameGetById(context.Background(), id)
+		if err != nil {
+			t.Fatalf("failed to get game: %v", err)
+		}
+		games = append(games, game)
+	}
+	return games
+}
+
+func TestPostApiSeries_MaterializesUpcomingGames(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+
+	staticClock := clock.StaticClock{Time: seriesTestNow}
+	organizerID := dbtesting.UpsertTestUser(t, sqlDB, "organizer@example.com")
+	querier := db.New(sqlDB)
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), staticClock, sqlDB)
+
+	series := createTestSeries(t, srv, organizerID, api.CreateSeriesRequest{
+		GameDefaults: api.GameFields{
+			Name:            ptr.Ptr("Volleyball"),
+			Location:        ptr.Ptr("Gym"),
+			TotalPriceCents: ptr.Ptr(int64(3000)),
+			MaxPlayers:      ptr.Ptr(int64(12)),
+		},
+		Recurrence: api.RecurrenceRule{
+			StartsAt: time.Date(2026, time.March, 3, 19, 0, 0, 0, time.UTC),
+			Weekdays: []api.Weekday{api.Tuesday, api.Thursday},
+		},
+		PublishMinutesBefore: nullable.NewNullableWithValue(36 * 60),
+	})
+
+	if series.Recurrence.Timezone == nil || *series.Recurrence.Timezone != "UTC" {
+		t.Fatalf("expected timezone to default to UTC, got %v", series.Recurrence.Timezone)
+	}
+
+	games := listSeriesGames(t, sqlDB, series.Id)
+	// 14 days ahead: 3, 5, 10, 12 March
+	if len(games) != 4 {
+		t.Fatalf("expected 4 games, got %d", len(games))
+	}
+	for _, game := range games {
+		if game.Name != "Volleyball" || game.Location.String != "Gym" || game.TotalPriceCents != 3000 || game.MaxPlayers != 12 || game.GameSpotsLeft != 12 {
+			t.Errorf("expected game to use the series defaults, got %+v", game)
+		}
+		if game.OrganizerID != organizerID {
+			t.Errorf("expected organizer %d, got %d", organizerID, game.OrganizerID)
+		}
+	}
+	if !games[0].StartsAt.Time.Equal(time.Date(2026, time.March, 3, 19, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected start of first game: %v", games[0].StartsAt.Time)
+	}
+	// the first game is less than 36h away, so it gets published right away
+	if !games[0].PublishedAt.Time.Equal(seriesTestNow) {
+		t.Errorf("expected first game to be published now, got %v", games[0].PublishedAt.Time)
+	}
+	if !games[1].PublishedAt.Time.Equal(time.Date(2026, time.March, 4, 7, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected second game to be published 36h before it starts, got %v", games[1].PublishedAt.Time)
+	}
+
+	// Running the materializer again doesn't duplicate games
+	if err := srv.MaterializeSeries(context.Background()); err != nil {
+		t.Fatalf("failed to materialize series: %v", err)
+	}
+	if games := listSeriesGames(t, sqlDB, series.Id); len(games) != 4 {
+		t.Fatalf("expected 4 games after materializing again, got %d", len(games))
+	}
+
+	// A week later, the next occurrences are generated
+	laterSrv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), clock.StaticClock{Time: seriesTestNow.AddDate(0, 0, 7)}, sqlDB)
+	if err := laterSrv.MaterializeSeries(context.Background()); err != nil {
+		t.Fatalf("failed to materialize series: %v", err)
+	}
+	if games := listSeriesGames(t, sqlDB, series.Id); len(games) != 6 {
+		t.Fatalf("expected 6 games a week later, got %d", len(games))
+	}
+}
+
+func TestPostApiSeries_OccurrenceCountLimitsGames(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+
+	staticClock := clock.StaticClock{Time: seriesTestNow}
+	organizerID := dbtesting.UpsertTestUser(t, sqlDB, "organizer@example.com")
+	querier := db.New(sqlDB)
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), staticClock, sqlDB)
+
+	series := createTestSeries(t, srv, organizerID, api.CreateSeriesRequest{
+		GameDefaults: api.GameFields{Name: ptr.Ptr("Volleyball")},
+		Recurrence: api.RecurrenceRule{
+			StartsAt:        time.Date(2026, time.March, 3, 19, 0, 0, 0, time.UTC),
+			Weekdays:        []api.Weekday{api.Tuesday, api.Thursday},
+			OccurrenceCount: nullable.NewNullableWithValue(3),
+		},
+	})
+
+	games := listSeriesGames(t, sqlDB, series.Id)
+	if len(games) != 3 {
+		t.Fatalf("expected 3 games, got %d", len(games))
+	}
+	for _, game := range games {
+		if game.PublishedAt.Valid {
+			t.Errorf("expected generated games to be drafts, got published at %v", game.PublishedAt.Time)
+		}
+	}
+}
+
+func TestPostApiSeries_Validation(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+
+	staticClock := clock.StaticClock{Time: seriesTestNow}
+	organizerID := dbtesting.UpsertTestUser(t, sqlDB, "organizer@example.com")
+	querier := db.New(sqlDB)
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), staticClock, sqlDB)
+
+	tests := []struct {
+		name string
+		req  api.CreateSeriesRequest
+	}{
+		{
+			name: "missing name",
+			req: api.CreateSeriesRequest{
+				Recurrence: api.RecurrenceRule{StartsAt: seriesTestNow, Weekdays: []api.Weekday{api.Monday}},
+			},
+		},
+		{
+			name: "no weekdays",
+			req: api.CreateSeriesRequest{
+				GameDefaults: api.GameFields{Name: ptr.Ptr("Volleyball")},
+				Recurrence:   api.RecurrenceRule{StartsAt: seriesTestNow},
+			},
+		},
+		{
+			name: "invalid weekday",
+			req: api.CreateSeriesRequest{
+				GameDefaults: api.GameFields{Name: ptr.Ptr("Volleyball")},
+				Recurrence:   api.RecurrenceRule{StartsAt: seriesTestNow, Weekdays: []api.Weekday{"someday"}},
+			},
+		},
+		{
+			name: "unknown timezone",
+			req: api.CreateSeriesRequest{
+				GameDefaults: api.GameFields{Name: ptr.Ptr("Volleyball")},
+				Recurrence:   api.RecurrenceRule{StartsAt: seriesTestNow, Weekdays: []api.Weekday{api.Monday}, Timezone: ptr.Ptr("Mars/Olympus")},
+			},
+		},
+		{
+			name: "invalid max players",
+			req: api.CreateSeriesRequest{
+				GameDefaults: api.GameFields{Name: ptr.Ptr("Volleyball"), MaxPlayers: ptr.Ptr(int64(0))},
+				Recurrence:   api.RecurrenceRule{StartsAt: seriesTestNow, Weekdays: []api.Weekday{api.Monday}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, _ := json.Marshal(tt.req)
+			r := httptest.NewRequest(http.MethodPost, "/api/series", bytes.NewReader(body))
+			r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(organizerID)}))
+			w := httptest.NewRecorder()
+
+			srv.PostApiSeries(w, r)
+
+			if w.Code != http.StatusBadRequest {
+				t.Fatalf("expected status %d, got %d, body=%s", http.StatusBadRequest, w.Code, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestGetApiSeriesId_HiddenFromNonOrganizer(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+
+	staticClock := clock.StaticClock{Time: seriesTestNow}
+	organizerID := dbtesting.UpsertTestUser(t, sqlDB, "organizer@example.com")
+	otherID := dbtesting.UpsertTestUser(t, sqlDB, "other@example.com")
+	querier := db.New(sqlDB)
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), staticClock, sqlDB)
+
+	series := createTestSeries(t, srv, organizerID, api.CreateSeriesRequest{
+		GameDefaults: api.GameFields{Name: ptr.Ptr("Volleyball")},
+		Recurrence:   api.RecurrenceRule{StartsAt: seriesTestNow, Weekdays: []api.Weekday{api.Monday}},
+	})
+
+	r := httptest.NewRequest(http.MethodGet, "/api/series/"+series.Id, nil)
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(otherID)}))
+	w := httptest.NewRecorder()
+
+	srv.GetApiSeriesId(w, r, series.Id)
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+
+	r = httptest.NewRequest(http.MethodGet, "/api/series/"+series.Id, nil)
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(organizerID)}))
+	w = httptest.NewRecorder()
+
+	srv.GetApiSeriesId(w, r, series.Id)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, w.Code)
+	}
+}
+
+func TestPatchApiSeriesId_Forbidden(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+
+	staticClock := clock.StaticClock{Time: seriesTestNow}
+	organizerID := dbtesting.UpsertTestUser(t, sqlDB, "organizer@example.com")
+	otherID := dbtesting.UpsertTestUser(t, sqlDB, "other@example.com")
+	querier := db.New(sqlDB)
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), staticClock, sqlDB)
+
+	series := createTestSeries(t, srv, organizerID, api.CreateSeriesRequest{
+		GameDefaults: api.GameFields{Name: ptr.Ptr("Volleyball")},
+		Recurrence:   api.RecurrenceRule{StartsAt: seriesTestNow, Weekdays: []api.Weekday{api.Monday}},
+	})
+
+	body, _ := json.Marshal(api.UpdateSeriesRequest{GameDefaults: &api.GameFields{Name: ptr.Ptr("Hijacked")}})
+	r := httptest.NewRequest(http.MethodPatch, "/api/series/"+series.Id, bytes.NewReader(body))
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(otherID)}))
+	w := httptest.NewRecorder()
+
+	srv.PatchApiSeriesId(w, r, series.Id)
+
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("expected status %d, got %d", http.StatusForbidden, w.Code)
+	}
+}
+
+func TestPatchApiSeriesId_ApplyToFutureGames(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+
+	staticClock := clock.StaticClock{Time: seriesTestNow}
+	organizerID := dbtesting.UpsertTestUser(t, sqlDB, "organizer@example.com")
+	querier := db.New(sqlDB)
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), staticClock, sqlDB)
+
+	series := createTestSeries(t, srv, organizerID, api.CreateSeriesRequest{
+		GameDefaults: api.GameFields{Name: ptr.Ptr("Volleyball"), MaxPlayers: ptr.Ptr(int64(12))},
+		Recurrence: api.RecurrenceRule{
+			StartsAt: time.Date(2026, time.March, 3, 19, 0, 0, 0, time.UTC),
+			Weekdays: []api.Weekday{api.Tuesday},
+		},
+	})
+
+	games := listSeriesGames(t, sqlDB, series.Id)
+	if len(games) != 2 {
+		t.Fatalf("expected 2 games, got %d", len(games))
+	}
+
+	// The first game is frozen, and the second one already has 2 players
+	if _, err := sqlDB.Exec(`update games set frozen_at = ? where id = ?`, seriesTestNow.Add(-time.Minute), games[0].ID); err != nil {
+		t.Fatalf("failed to freeze game: %v", err)
+	}
+	if err := querier.ParticipantsUpsert(t.Context(), db.ParticipantsUpsertParams{
+		UserID:         organizerID,
+		GameID:         games[1].ID,
+		Going:          sql.NullBool{Bool: true, Valid: true},
+		GoingUpdatedAt: seriesTestNow,
+		Guests:         sql.NullInt64{Int64: 1, Valid: true},
+	}); err != nil {
+		t.Fatalf("failed to add participant: %v", err)
+	}
+
+	t.Run("without applying to future games", func(t *testing.T) {
+		body, _ := json.Marshal(api.UpdateSeriesRequest{GameDefaults: &api.GameFields{Name: ptr.Ptr("Beach Volleyball")}})
+		r := httptest.NewRequest(http.MethodPatch, "/api/series/"+series.Id, bytes.NewReader(body))
+		r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(organizerID)}))
+		w := httptest.NewRecorder()
+
+		srv.PatchApiSeriesId(w, r, series.Id)
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d, body=%s", http.StatusOK, w.Code, w.Body.String())
+		}
+
+		for _, game := range listSeriesGames(t, sqlDB, series.Id) {
+			if game.Name != "Volleyball" {
+				t.Errorf("expected game %s to keep its name, got %q", game.ID, game.Name)
+			}
+		}
+	})
+
+	t.Run("applying to future games", func(t *testing.T) {
+		body, _ := json.Marshal(api.UpdateSeriesRequest{
+			GameDefaults:       &api.GameFields{MaxPlayers: ptr.Ptr(int64(14))},
+			ApplyToFutureGames: ptr.Ptr(true),
+		})
+		r := httptest.NewRequest(http.MethodPatch, "/api/series/"+series.Id, bytes.NewReader(body))
+		r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(organizerID)}))
+		w := httptest.NewRecorder()
+
+		srv.PatchApiSeriesId(w, r, series.Id)
+
+		if w.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d, body=%s", http.StatusOK, w.Code, w.Body.String())
+		}
+
+		var updated api.Series
+		if err := json.NewDecoder(w.Body).Decode(&updated); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if *updated.GameDefaults.Name != "Beach Volleyball" || *updated.GameDefaults.MaxPlayers != 14 {
+			t.Fatalf("unexpected series defaults: %+v", updated.GameDefaults)
+		}
+
+		games := listSeriesGames(t, sqlDB, series.Id)
+		if games[0].Name != "Volleyball" || games[0].MaxPlayers != 12 {
+			t.Errorf("expected frozen game to be left untouched, got %+v", games[0])
+		}
+		if games[1].Name != "Beach Volleyball" || games[1].MaxPlayers != 14 {
+			t.Errorf("expected future game to be updated, got %+v", games[1])
+		}
+		if games[1].GameSpotsLeft != 12 {
+			t.Errorf("expected game spots left to grow with max players, got %d", games[1].GameSpotsLeft)
+		}
+	})
+}
+
+func TestPatchApiSeriesId_RescheduleFutureGames(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+
+	staticClock := clock.StaticClock{Time: seriesTestNow}
+	organizerID := dbtesting.UpsertTestUser(t, sqlDB, "organizer@example.com")
+	participantID := dbtesting.UpsertTestUser(t, sqlDB, "participant@example.com")
+	querier := db.New(sqlDB)
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), staticClock, sqlDB)
+
+	series := createTestSeries(t, srv, organizerID, api.CreateSeriesRequest{
+		GameDefaults: api.GameFields{Name: ptr.Ptr("Volleyball"), MaxPlayers: ptr.Ptr(int64(12))},
+		Recurrence: api.RecurrenceRule{
+			StartsAt: time.Date(2026, time.March, 3, 19, 0, 0, 0, time.UTC),
+			Weekdays: []api.Weekday{api.Tuesday, api.Thursday},
+		},
+		PublishMinutesBefore: nullable.NewNullableWithValue(36 * 60),
+	})
+
+	// 3, 5, 10 and 12 March, the first one is published and the participant is going
+	games := listSeriesGames(t, sqlDB, series.Id)
+	if len(games) != 4 {
+		t.Fatalf("expected 4 games, got %d", len(games))
+	}
+	if err := querier.ParticipantsUpsert(t.Context(), db.ParticipantsUpsertParams{
+		UserID:         participantID,
+		GameID:         games[0].ID,
+		Going:          sql.NullBool{Bool: true, Valid: true},
+		GoingUpdatedAt: seriesTestNow,
+	}); err != nil {
+		t.Fatalf("failed to add participant: %v", err)
+	}
+	// the game of 10 March was published early by the organizer
+	if _, err := sqlDB.Exec(`update games set published_at = ? where id = ?`, seriesTestNow.Add(-time.Minute), games[2].ID); err != nil {
+		t.Fatalf("failed to publish game: %v", err)
+	}
+
+	// The series moves to Wednesdays, which only leaves room for two games in the next 14 days
+	body, _ := json.Marshal(api.UpdateSeriesRequest{
+		Recurrence: &api.RecurrenceRule{
+			StartsAt: time.Date(2026, time.March, 4, 19, 0, 0, 0, time.UTC),
+			Weekdays: []api.Weekday{api.Wednesday},
+		},
+	})
+	r := httptest.NewRequest(http.MethodPatch, "/api/series/"+series.Id, bytes.NewReader(body))
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(organizerID)}))
+	w := httptest.NewRecorder()
+
+	srv.PatchApiSeriesId(w, r, series.Id)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusOK, w.Code, w.Body.String())
+	}
+
+	var count int
+	if err := sqlDB.QueryRow(`select count(*) from games where series_id = ?`, series.Id).Scan(&count); err != nil {
+		t.Fatalf("failed to count games: %v", err)
+	}
+	if count != 4 {
+		t.Fatalf("expected the games to be moved instead of generating new ones, got %d games", count)
+	}
+	moved := make(map[string]db.Game)
+	for _, game := range listSeriesGames(t, sqlDB, series.Id) {
+		moved[game.ID] = game
+	}
+
+	first := moved[games[0].ID]
+	if !first.StartsAt.Time.Equal(time.Date(2026, time.March, 4, 19, 0, 0, 0, time.UTC)) || !first.SeriesOccurrenceAt.Time.Equal(first.StartsAt.Time) {
+		t.Errorf("expected the first game to move to 4 March, got %+v", first)
+	}
+	if reconfirmations := listReconfirmations(t, srv, games[0].ID, organizerID); len(reconfirmations) != 1 || reconfirmations[0].User.Id != strconv.FormatInt(participantID, 10) {
+		t.Errorf("expected the participant to reconfirm the new time, got %+v", reconfirmations)
+	}
+
+	second := moved[games[1].ID]
+	if !second.StartsAt.Time.Equal(time.Date(2026, time.March, 11, 19, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected the second game to move to 11 March, got %v", second.StartsAt.Time)
+	}
+	if !second.PublishedAt.Time.Equal(time.Date(2026, time.March, 10, 7, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected the second game to be published 36h before its new time, got %v", second.PublishedAt.Time)
+	}
+
+	if third := moved[games[2].ID]; !third.CancelledAt.Valid {
+		t.Errorf("expected the published game left over to be cancelled, got %+v", third)
+	}
+	var deleted bool
+	if err := sqlDB.QueryRow(`select deleted_at is not null from games where id = ?`, games[3].ID).Scan(&deleted); err != nil {
+		t.Fatalf("failed to get game: %v", err)
+	}
+	if !deleted {
+		t.Errorf("expected the draft left over to be deleted")
+	}
+
+	// Back to Tuesdays and Thursdays, the occurrences of the dropped games get new games
+	body, _ = json.Marshal(api.UpdateSeriesRequest{
+		Recurrence: &api.RecurrenceRule{
+			StartsAt: time.Date(2026, time.March, 3, 19, 0, 0, 0, time.UTC),
+			Weekdays: []api.Weekday{api.Tuesday, api.Thursday},
+		},
+	})
+	r = httptest.NewRequest(http.MethodPatch, "/api/series/"+series.Id, bytes.NewReader(body))
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(organizerID)}))
+	w = httptest.NewRecorder()
+
+	srv.PatchApiSeriesId(w, r, series.Id)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d, body=%s", http.StatusOK, w.Code, w.Body.String())
+	}
+
+	var startsAt []time.Time
+	for _, game := range listSeriesGames(t, sqlDB, series.Id) {
+		if !game.CancelledAt.Valid {
+			startsAt = append(startsAt, game.StartsAt.Time)
+		}
+	}
+	want := []time.Time{
+		time.Date(2026, time.March, 3, 19, 0, 0, 0, time.UTC),
+		time.Date(2026, time.March, 5, 19, 0, 0, 0, time.UTC),
+		time.Date(2026, time.March, 10, 19, 0, 0, 0, time.UTC),
+		time.Date(2026, time.March, 12, 19, 0, 0, 0, time.UTC),
+	}
+	if !slices.EqualFunc(startsAt, want, time.Time.Equal) {
+		t.Errorf("expected a game on every occurrence again, got %v", startsAt)
+	}
+}
@@ -9,6 +9,8 @@ import (
 	"github.com/dmateusp/opengym/clock"
 	"github.com/dmateusp/opengym/db"
 	"github.com/dmateusp/opengym/demo"
+	"github.com/dmateusp/opengym/hub"
+	"github.com/dmateusp/opengym/notify"
 )
 
 var (
@@ -38,6 +40,24 @@ type server struct {
 	randomAlphanumericGenerator RandomAlphanumericGenerator
 	clock                       clock.Clock
 	dbConn                      *sql.DB
+	notifier                    *notify.Notifier
+	hub                         *hub.Hub
+}
+
+type Option func(*server)
+
+// WithNotifier sets how users are notified, notifications are discarded by default.
+func WithNotifier(notifier *notify.Notifier) Option {
+	return func(srv *server) {
+		srv.notifier = notifier
+	}
+}
+
+// WithHub sets the hub streaming game updates, it should be registered as a consumer of the outbox.
+func WithHub(hub *hub.Hub) Option {
+	return func(srv *server) {
+		srv.hub = hub
+	}
 }
 
 func NewServer(
@@ -45,13 +65,20 @@ func NewServer(
 	randomAlphanumericGenerator RandomAlphanumericGenerator,
 	clock clock.Clock,
 	dbConn *sql.DB,
+	opts ...Option,
 ) *server {
-	return &server{
+	srv := &server{
 		querier:                     querier,
 		randomAlphanumericGenerator: randomAlphanumericGenerator,
 		clock:                       clock,
 		dbConn:                      dbConn,
+		notifier:                    notify.NewNotifier(notify.DiscardSender{}),
+		hub:                         hub.New(),
+	}
+	for _, opt := range opts {
+		opt(srv)
 	}
+	return srv
 }
 
 var _ api.ServerInterface = (*server)(nil)
@@ -0,0 +1,112 @@
+package server_test
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/dmateusp/opengym/api"
+	"github.com/dmateusp/opengym/api/server"
+	"github.com/dmateusp/opengym/auth"
+	"github.com/dmateusp/opengym/clock"
+	"github.com/dmateusp/opengym/db"
+	dbtesting "github.com/dmateusp/opengym/db/testing"
+	"github.com/dmateusp/opengym/ptr"
+)
+
+func TestSplitStrategies(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	now := time.Now()
+
+	organizerID := dbtesting.UpsertTestUser(t, sqlDB, "organizer@example.com")
+	hostID := dbtesting.UpsertTestUser(t, sqlDB, "host@example.com")
+	playerID := dbtesting.UpsertTestUser(t, sqlDB, "player@example.com")
+
+	querier := db.New(sqlDB)
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), clock.StaticClock{Time: now}, sqlDB)
+	createGame(t, querier, "g1", organizerID, sql.NullTime{Time: now.Add(-time.Hour), Valid: true})
+
+	updateParticipation(t, srv, "g1", organizerID, api.Going)
+	body, _ := json.Marshal(api.UpdateGameParticipationRequest{Status: api.Going, Guests: ptr.Ptr(2)})
+	r := httptest.NewRequest(http.MethodPut, "/api/games/g1/participants", bytes.NewReader(body))
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(hostID)}))
+	w := httptest.NewRecorder()
+	srv.PutApiGamesIdParticipants(w, r, "g1")
+	if w.Code != http.StatusOK {
+		t.Fatalf("failed to join with guests: status %d, body %s", w.Code, w.Body.String())
+	}
+	updateParticipation(t, srv, "g1", playerID, api.Going)
+
+	if code, _ := patchGame(t, srv, "g1", organizerID, api.UpdateGameRequest{SplitStrategy: ptr.Ptr(api.GameSplitStrategy("by_skill"))}); code != http.StatusBadRequest {
+		t.Fatalf("expected status %d for an unknown split strategy, got %d", http.StatusBadRequest, code)
+	}
+	if code, _ := patchGame(t, srv, "g1", organizerID, api.UpdateGameRequest{PricePerPlayerCents: ptr.Ptr(int64(-1))}); code != http.StatusBadRequest {
+		t.Fatalf("expected status %d for a negative price, got %d", http.StatusBadRequest, code)
+	}
+
+	tests := []struct {
+		name string
+		req  api.UpdateGameRequest
+		// amounts owed by the organizer, the participant bringing 2 guests and the other participant
+		want [3]int64
+	}{
+		{
+			name: "even, the cent left over goes to the first participant",
+			req:  api.UpdateGameRequest{SplitStrategy: ptr.Ptr(api.Even), TotalPriceCents: ptr.Ptr(int64(1001))},
+			want: [3]int64{201, 600, 200},
+		},
+		{
+			name: "per player",
+			req:  api.UpdateGameRequest{SplitStrategy: ptr.Ptr(api.PerPlayer), PricePerPlayerCents: ptr.Ptr(int64(300))},
+			want: [3]int64{300, 900, 300},
+		},
+		{
+			name: "per player with a guest rate",
+			req:  api.UpdateGameRequest{SplitStrategy: ptr.Ptr(api.PerPlayerGuestRate), GuestPriceCents: ptr.Ptr(int64(100))},
+			want: [3]int64{300, 500, 300},
+		},
+		{
+			name: "organizer exempt",
+			req:  api.UpdateGameRequest{SplitStrategy: ptr.Ptr(api.OrganizerExempt), TotalPriceCents: ptr.Ptr(int64(1000))},
+			want: [3]int64{0, 750, 250},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := sqlDB.Exec(`update games set frozen_at = null where id = ?`, "g1"); err != nil {
+				t.Fatalf("failed to unfreeze game: %v", err)
+			}
+			code, detail := patchGame(t, srv, "g1", organizerID, test.req)
+			if code != http.StatusOK {
+				t.Fatalf("expected status %d, got %d", http.StatusOK, code)
+			}
+			if detail.Game.SplitStrategy == nil || *detail.Game.SplitStrategy != *test.req.SplitStrategy {
+				t.Fatalf("expected split strategy %s, got %v", *test.req.SplitStrategy, detail.Game.SplitStrategy)
+			}
+			freezeGameForReimbursements(t, sqlDB, now, "g1")
+
+			reimbursements := listReimbursements(t, srv, "g1", organizerID)
+			got := [3]int64{
+				reimbursements[strconv.FormatInt(organizerID, 10)].AmountOwedCents,
+				reimbursements[strconv.FormatInt(hostID, 10)].AmountOwedCents,
+				reimbursements[strconv.FormatInt(playerID, 10)].AmountOwedCents,
+			}
+			if got != test.want {
+				t.Fatalf("expected amounts owed %v, got %v", test.want, got)
+			}
+		})
+	}
+
+	if code, _ := patchGame(t, srv, "g1", organizerID, api.UpdateGameRequest{SplitStrategy: ptr.Ptr(api.Even)}); code != http.StatusBadRequest {
+		t.Fatalf("expected status %d when changing the split strategy of a frozen game, got %d", http.StatusBadRequest, code)
+	}
+}
@@ -0,0 +1,84 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/dmateusp/opengym/db"
+	"github.com/dmateusp/opengym/log"
+)
+
+// SpotsMismatch is a game whose spots left, stored at write time, disagree with its queue.
+type SpotsMismatch struct {
+	GameID           string
+	Stored           int64
+	Computed         int64
+	StoredWaitlist   int64
+	ComputedWaitlist int64
+}
+
+// CheckSpotsLeft recomputes the spots left of every game from its participants and reports the games where they drifted.
+// When repair is set, the spots left of these games are fixed, all in one transaction.
+func (s *server) CheckSpotsLeft(ctx context.Context, repair bool) ([]SpotsMismatch, error) {
+	tx, err := s.dbConn.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	querierWithTx := s.querier.WithTx(tx)
+
+	games, err := querierWithTx.GameListAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list games: %w", err)
+	}
+
+	var mismatches []SpotsMismatch
+	for _, game := range games {
+		participants, err := querierWithTx.ParticipantsList(ctx, db.ParticipantsListParams{
+			OrganizerID: game.OrganizerID,
+			GameID:      game.ID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("game %s: failed to list participants: %w", game.ID, err)
+		}
+
+		q := spotsQueue(game, participants)
+		computed, computedWaitlist := q.SpotsLeft, waitlistSpotsLeft(game, q)
+		if computed == game.GameSpotsLeft && computedWaitlist == game.WaitlistSpotsLeft {
+			continue
+		}
+
+		mismatches = append(mismatches, SpotsMismatch{
+			GameID:           game.ID,
+			Stored:           game.GameSpotsLeft,
+			Computed:         computed,
+			StoredWaitlist:   game.WaitlistSpotsLeft,
+			ComputedWaitlist: computedWaitlist,
+		})
+		log.FromCtx(ctx).WarnContext(ctx, "Game spots left drifted from its participants",
+			"game_id", game.ID, "stored", game.GameSpotsLeft, "computed", computed,
+			"stored_waitlist", game.WaitlistSpotsLeft, "computed_waitlist", computedWaitlist, "repaired", repair)
+
+		if repair {
+			if err := querierWithTx.GameUpdate(ctx, db.GameUpdateParams{
+				ID:                game.ID,
+				GameSpotsLeft:     sql.NullInt64{Int64: computed, Valid: true},
+				WaitlistSpotsLeft: sql.NullInt64{Int64: computedWaitlist, Valid: true},
+			}); err != nil {
+				return nil, fmt.Errorf("game %s: failed to update game spots left: %w", game.ID, err)
+			}
+		}
+	}
+
+	if repair {
+		if err := tx.Commit(); err != nil {
+			return nil, fmt.Errorf("failed to commit transaction: %w", err)
+		}
+	}
+
+	// spots_drift is the number of games whose spots left drifted, to be picked up by log-based metrics
+	log.FromCtx(ctx).InfoContext(ctx, "Checked game spots left", "games", len(games), "spots_drift", len(mismatches), "repaired", repair)
+	return mismatches, nil
+}
@@ -0,0 +1,72 @@
+package server_test
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/dmateusp/opengym/api"
+	"github.com/dmateusp/opengym/api/server"
+	"github.com/dmateusp/opengym/clock"
+	"github.com/dmateusp/opengym/db"
+	dbtesting "github.com/dmateusp/opengym/db/testing"
+)
+
+func TestCheckSpotsLeft(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	now := time.Now()
+
+	organizerID := dbtesting.UpsertTestUser(t, sqlDB, "organizer@example.com")
+	playerID := dbtesting.UpsertTestUser(t, sqlDB, "player@example.com")
+
+	querier := db.New(sqlDB)
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), clock.StaticClock{Time: now}, sqlDB)
+	createGame(t, querier, "g1", organizerID, sql.NullTime{Time: now.Add(-time.Hour), Valid: true})
+	createGame(t, querier, "g2", organizerID, sql.NullTime{Time: now.Add(-time.Hour), Valid: true})
+	setMaxPlayers(t, querier, "g1", 4)
+	setMaxPlayers(t, querier, "g2", 4)
+	updateParticipation(t, srv, "g1", playerID, api.Going)
+	updateParticipation(t, srv, "g2", playerID, api.Going)
+
+	if _, err := sqlDB.Exec(`update games set game_spots_left = 4 where id = 'g2'`); err != nil {
+		t.Fatalf("failed to update game spots left: %v", err)
+	}
+
+	mismatches, err := srv.CheckSpotsLeft(t.Context(), false)
+	if err != nil {
+		t.Fatalf("failed to check game spots left: %v", err)
+	}
+	want := []server.SpotsMismatch{{GameID: "g2", Stored: 4, Computed: 3}}
+	if len(mismatches) != 1 || mismatches[0] != want[0] {
+		t.Fatalf("expected %+v, got %+v", want, mismatches)
+	}
+	game, err := querier.GameGetById(t.Context(), "g2")
+	if err != nil {
+		t.Fatalf("failed to retrieve game: %v", err)
+	}
+	if game.GameSpotsLeft != 4 {
+		t.Fatalf("expected the check to leave the game untouched, got %d spots left", game.GameSpotsLeft)
+	}
+
+	if _, err := srv.CheckSpotsLeft(t.Context(), true); err != nil {
+		t.Fatalf("failed to repair game spots left: %v", err)
+	}
+	game, err = querier.GameGetById(t.Context(), "g2")
+	if err != nil {
+		t.Fatalf("failed to retrieve game: %v", err)
+	}
+	if game.GameSpotsLeft != 3 {
+		t.Fatalf("expected the game to be repaired, got %d spots left", game.GameSpotsLeft)
+	}
+
+	mismatches, err = srv.CheckSpotsLeft(t.Context(), false)
+	if err != nil {
+		t.Fatalf("failed to check game spots left: %v", err)
+	}
+	if len(mismatches) != 0 {
+		t.Fatalf("expected no mismatches after the repair, got %+v", mismatches)
+	}
+}
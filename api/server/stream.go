@@ -0,0 +1,223 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/dmateusp/opengym/api"
+	"github.com/dmateusp/opengym/auth"
+	"github.com/dmateusp/opengym/log"
+	"github.com/dmateusp/opengym/outbox"
+	"github.com/dmateusp/opengym/ptr"
+)
+
+var heartbeatInterval = flag.Duration("sse.heartbeat-interval", 15*time.Second, "How often a comment is sent on game update streams to keep the connection open")
+
+// Kinds of messages sent on the game update streams, every message carries the current state.
+const (
+	streamMessageParticipants = "participants"
+	streamMessageSpots        = "spots"
+	streamMessageGame         = "game"
+)
+
+var allStreamMessages = []string{streamMessageParticipants, streamMessageSpots, streamMessageGame}
+
+// errGameHidden is returned when the game stopped being visible to the user of the stream.
+var errGameHidden = errors.New("game not found")
+
+func (srv *server) GetApiGamesIdEvents(w http.ResponseWriter, r *http.Request, id string, params api.GetApiGamesIdEventsParams) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	game, err := srv.querier.GameGetById(r.Context(), id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			http.Error(w, "game not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, fmt.Sprintf("failed to retrieve game: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	// Users without a role in the game cannot follow unpublished or future games
+	hidden, err := srv.isHidden(r.Context(), srv.querier, game, int64(authInfo.UserId), false)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if hidden {
+		http.Error(w, "game not found", http.StatusNotFound)
+		return
+	}
+
+	var lastEventID int64
+	if params.LastEventID != nil {
+		lastEventID, err = strconv.ParseInt(*params.LastEventID, 10, 64)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid Last-Event-ID: %s", err.Error()), http.StatusBadRequest)
+			return
+		}
+	}
+
+	// Subscribe before reading the latest event, so that nothing published in between is missed
+	subscription := srv.hub.Subscribe(id)
+	defer subscription.Close()
+
+	latestEventID, err := srv.querier.EventMaxIdByGame(r.Context(), id)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to retrieve latest event: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	stream := &gameStream{
+		srv:        srv,
+		w:          w,
+		controller: http.NewResponseController(w),
+		userID:     int64(authInfo.UserId),
+		gameID:     id,
+	}
+	ctx := r.Context()
+
+	// Send the current state, unless the client resumes and nothing happened since
+	lastSentID := lastEventID
+	if params.LastEventID == nil || latestEventID > lastEventID {
+		lastSentID = max(latestEventID, lastEventID)
+		if err := stream.send(ctx, lastSentID, allStreamMessages); err != nil {
+			stream.close(ctx, err)
+			return
+		}
+	} else if err := stream.controller.Flush(); err != nil {
+		return
+	}
+
+	heartbeat := time.NewTicker(*heartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-heartbeat.C:
+			if err := stream.write(": heartbeat\n\n"); err != nil {
+				return
+			}
+
+		case event, ok := <-subscription.Events():
+			if !ok {
+				// the stream fell behind, the client reconnects and resumes from the last event it received
+				return
+			}
+			if event.ID <= lastSentID {
+				continue
+			}
+			if err := stream.send(ctx, event.ID, streamMessagesFor(event.Type)); err != nil {
+				stream.close(ctx, err)
+				return
+			}
+			lastSentID = event.ID
+		}
+	}
+}
+
+// streamMessagesFor returns the messages refreshing what an event may have changed.
+func streamMessagesFor(eventType outbox.EventType) []string {
+	switch eventType {
+	case outbox.EventParticipantJoined, outbox.EventParticipantLeft, outbox.EventParticipantPromoted, outbox.EventReimbursementMarked,
+		outbox.EventParticipantsReordered, outbox.EventPlaceholderClaimed,
+		outbox.EventWaitlistOffered, outbox.EventWaitlistOfferAccepted, outbox.EventWaitlistOfferDeclined:
+		return []string{streamMessageParticipants, streamMessageSpots}
+	default:
+		// changing the max players of a game changes the status of the participants
+		return allStreamMessages
+	}
+}
+
+type gameStream struct {
+	srv        *server
+	w          http.ResponseWriter
+	controller *http.ResponseController
+	userID     int64
+	gameID     string
+}
+
+// send writes the current state of the game for each kind of message, identified by the ID of the last event they include.
+func (s *gameStream) send(ctx context.Context, eventID int64, messages []string) error {
+	game, err := s.srv.querier.GameGetByIdWithOrganizer(ctx, s.gameID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return errGameHidden
+		}
+		return fmt.Errorf("failed to retrieve game: %w", err)
+	}
+	hidden, err := s.srv.isHidden(ctx, s.srv.querier, game.Game, s.userID, false)
+	if err != nil {
+		return err
+	}
+	if hidden {
+		return errGameHidden
+	}
+
+	for _, message := range messages {
+		var data any
+		switch message {
+		case streamMessageParticipants:
+			participants, err := participantsWithStatus(ctx, s.srv.querier, game.Game)
+			if err != nil {
+				return err
+			}
+			data = participants
+		case streamMessageSpots:
+			spots := api.GameSpots{GameSpotsLeft: game.Game.GameSpotsLeft}
+			if game.Game.WaitlistMode != waitlistUnlimited {
+				spots.WaitlistSpotsLeft = ptr.Ptr(game.Game.WaitlistSpotsLeft)
+			}
+			data = spots
+		case streamMessageGame:
+			var apiGame api.GameDetail
+			apiGame.FromDb(game)
+			data = apiGame
+		}
+
+		b, err := json.Marshal(data)
+		if err != nil {
+			return fmt.Errorf("failed to encode %s: %w", message, err)
+		}
+		if err := s.write(fmt.Sprintf("id: %d\nevent: %s\ndata: %s\n\n", eventID, message, b)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *gameStream) write(message string) error {
+	if _, err := s.w.Write([]byte(message)); err != nil {
+		return err
+	}
+	return s.controller.Flush()
+}
+
+// close ends the stream after a failure. When the game isn't visible anymore,
+// the client reconnects and receives a 404, which stops it from reconnecting again.
+func (s *gameStream) close(ctx context.Context, err error) {
+	if errors.Is(err, errGameHidden) || ctx.Err() != nil {
+		return
+	}
+	log.FromCtx(ctx).ErrorContext(ctx, "Failed to stream game updates", "game_id", s.gameID, "error", err)
+}
@@ -0,0 +1,222 @@
+package server_test
+
+import (
+	"bufio"
+	"database/sql"
+	"encoding/json"
+	"flag"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/dmateusp/opengym/api"
+	"github.com/dmateusp/opengym/api/server"
+	"github.com/dmateusp/opengym/auth"
+	"github.com/dmateusp/opengym/clock"
+	"github.com/dmateusp/opengym/db"
+	dbtesting "github.com/dmateusp/opengym/db/testing"
+	"github.com/dmateusp/opengym/hub"
+	"github.com/dmateusp/opengym/ptr"
+)
+
+type streamMessage struct {
+	id    string
+	event string
+	data  string
+}
+
+// readStreamMessage reads the next message of an event stream, comments are returned as messages with no event.
+func readStreamMessage(t *testing.T, reader *bufio.Reader) streamMessage {
+	t.Helper()
+
+	var message streamMessage
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			t.Fatalf("failed to read stream: %v", err)
+		}
+		line = strings.TrimSuffix(line, "\n")
+		if line == "" {
+			return message
+		}
+
+		field, value, _ := strings.Cut(line, ": ")
+		switch field {
+		case "id":
+			message.id = value
+		case "event":
+			message.event = value
+		case "data":
+			message.data = value
+		}
+	}
+}
+
+func openStream(t *testing.T, url string, lastEventID string) *bufio.Reader {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, url, nil)
+	if err != nil {
+		t.Fatalf("failed to create request: %v", err)
+	}
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("failed to open stream: %v", err)
+	}
+	t.Cleanup(func() { resp.Body.Close() })
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, resp.StatusCode)
+	}
+	if contentType := resp.Header.Get("Content-Type"); contentType != "text/event-stream" {
+		t.Fatalf("expected an event stream, got %s", contentType)
+	}
+	return bufio.NewReader(resp.Body)
+}
+
+func TestGetApiGamesIdEvents_StreamsUpdates(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	// the handler queries the database from another goroutine, it must use the same in-memory database
+	sqlDB.SetMaxOpenConns(1)
+
+	if err := flag.Set("sse.heartbeat-interval", "10ms"); err != nil {
+		t.Fatalf("failed to set flag: %v", err)
+	}
+	t.Cleanup(func() { _ = flag.Set("sse.heartbeat-interval", "15s") })
+
+	staticClock := clock.StaticClock{Time: time.Now()}
+	organizerID := dbtesting.UpsertTestUser(t, sqlDB, "organizer@example.com")
+	userID := dbtesting.UpsertTestUser(t, sqlDB, "user@example.com")
+
+	querier := db.New(sqlDB)
+	liveUpdates := hub.New()
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), staticClock, sqlDB,
+		server.WithHub(liveUpdates))
+
+	createGame(t, querier, "g1", organizerID, sql.NullTime{Time: staticClock.Time.Add(-time.Hour), Valid: true})
+	if err := querier.GameUpdate(t.Context(), db.GameUpdateParams{
+		ID:                "g1",
+		MaxPlayers:        sql.NullInt64{Int64: 1, Valid: true},
+		GameSpotsLeft:     sql.NullInt64{Int64: 1, Valid: true},
+		WaitlistMode:      sql.NullString{String: "fixed", Valid: true},
+		MaxWaitlistSize:   sql.NullInt64{Int64: 3, Valid: true},
+		WaitlistSpotsLeft: sql.NullInt64{Int64: 3, Valid: true},
+	}); err != nil {
+		t.Fatalf("failed to update game: %v", err)
+	}
+
+	ts := httptest.NewServer(api.HandlerWithOptions(srv, api.StdHTTPServerOptions{
+		Middlewares: []api.MiddlewareFunc{func(next http.Handler) http.Handler {
+			return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				next.ServeHTTP(w, r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(userID)})))
+			})
+		}},
+	}))
+	t.Cleanup(ts.Close)
+	url := ts.URL + "/api/games/g1/events"
+
+	// the current state is sent when connecting
+	stream := openStream(t, url, "")
+	for _, event := range []string{"participants", "spots", "game"} {
+		message := readStreamMessage(t, stream)
+		if message.event != event || message.id != "0" {
+			t.Fatalf("expected %s message with id 0, got %+v", event, message)
+		}
+	}
+
+	updateParticipation(t, srv, "g1", userID, api.Going)
+	dispatchEvents(t, querier, staticClock, liveUpdates.Publish)
+
+	var eventID string
+	for {
+		message := readStreamMessage(t, stream)
+		if message.event == "" {
+			continue // heartbeat
+		}
+		if message.event != "participants" {
+			t.Fatalf("expected a participants message, got %+v", message)
+		}
+		var participants []api.ParticipantWithUser
+		if err := json.Unmarshal([]byte(message.data), &participants); err != nil {
+			t.Fatalf("failed to decode participants: %v", err)
+		}
+		if len(participants) != 1 || participants[0].User.Id != strconv.FormatInt(userID, 10) {
+			t.Fatalf("expected the user to be in the participants, got %s", message.data)
+		}
+		eventID = message.id
+		break
+	}
+
+	message := readStreamMessage(t, stream)
+	var spots api.GameSpots
+	if err := json.Unmarshal([]byte(message.data), &spots); err != nil || message.event != "spots" {
+		t.Fatalf("expected a spots message, got %+v", message)
+	}
+	if spots.GameSpotsLeft != 0 || spots.WaitlistSpotsLeft == nil || *spots.WaitlistSpotsLeft != 3 || message.id != eventID {
+		t.Fatalf("expected no spots left and 3 on the waitlist as of event %s, got %+v", eventID, message)
+	}
+
+	// resuming from the last event only sends heartbeats
+	if message := readStreamMessage(t, openStream(t, url, eventID)); message.event != "" {
+		t.Fatalf("expected a heartbeat, got %+v", message)
+	}
+
+	// resuming from an older event sends the current state
+	if message := readStreamMessage(t, openStream(t, url, "0")); message.event != "participants" || message.id != eventID {
+		t.Fatalf("expected a participants message with id %s, got %+v", eventID, message)
+	}
+}
+
+func TestGetApiGamesIdEvents_HidesUnpublishedGames(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	staticClock := clock.StaticClock{Time: time.Now()}
+
+	organizerID := dbtesting.UpsertTestUser(t, sqlDB, "organizer@example.com")
+	userID := dbtesting.UpsertTestUser(t, sqlDB, "user@example.com")
+
+	querier := db.New(sqlDB)
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), staticClock, sqlDB)
+
+	createGame(t, querier, "g1", organizerID, sql.NullTime{Time: staticClock.Time.Add(time.Hour), Valid: true})
+
+	r := httptest.NewRequest(http.MethodGet, "/api/games/g1/events", nil)
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(userID)}))
+	w := httptest.NewRecorder()
+	srv.GetApiGamesIdEvents(w, r, "g1", api.GetApiGamesIdEventsParams{})
+
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+}
+
+func TestGetApiGamesIdEvents_InvalidLastEventID(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	staticClock := clock.StaticClock{Time: time.Now()}
+
+	organizerID := dbtesting.UpsertTestUser(t, sqlDB, "organizer@example.com")
+
+	querier := db.New(sqlDB)
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), staticClock, sqlDB)
+
+	createGame(t, querier, "g1", organizerID, sql.NullTime{})
+
+	r := httptest.NewRequest(http.MethodGet, "/api/games/g1/events", nil)
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(organizerID)}))
+	w := httptest.NewRecorder()
+	srv.GetApiGamesIdEvents(w, r, "g1", api.GetApiGamesIdEventsParams{LastEventID: ptr.Ptr("abc")})
+
+	if w.Code != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, w.Code)
+	}
+}
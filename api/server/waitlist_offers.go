@@ -0,0 +1,264 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/dmateusp/opengym/auth"
+	"github.com/dmateusp/opengym/db"
+	"github.com/dmateusp/opengym/outbox"
+	"github.com/dmateusp/opengym/queue"
+	"github.com/dmateusp/opengym/scheduler"
+)
+
+const (
+	waitlistOfferAccepted = "accepted"
+	waitlistOfferDeclined = "declined"
+	waitlistOfferExpired  = "expired"
+)
+
+// offerSpot offers the spot freed in the game to the waitlisted participant, they must accept it before the offer expires.
+func offerSpot(ctx context.Context, querier db.Querier, game db.Game, userID int64, now time.Time) error {
+	expiresAt := now.UTC().Add(time.Duration(game.WaitlistOfferMinutes) * time.Minute)
+	created, err := querier.WaitlistOfferCreate(ctx, db.WaitlistOfferCreateParams{
+		GameID:    game.ID,
+		UserID:    userID,
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create waitlist offer: %w", err)
+	}
+	if created == 0 {
+		// they were already offered a spot
+		return nil
+	}
+	return outbox.Publish(ctx, querier, game.ID, outbox.WaitlistOffered{UserID: userID, ExpiresAt: expiresAt})
+}
+
+// releaseOfferedSpot closes the offer when it's declined or expired. If the participant was holding the spot they leave the game,
+// and the spot is offered to the next waitlisted participant.
+func (s *server) releaseOfferedSpot(ctx context.Context, querier db.Querier, game db.Game, offer db.WaitlistOffer, status string) error {
+	now := s.clock.Now()
+
+	responded, err := querier.WaitlistOfferRespond(ctx, db.WaitlistOfferRespondParams{
+		ID:          offer.ID,
+		Status:      status,
+		RespondedAt: sql.NullTime{Time: now, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to close waitlist offer: %w", err)
+	}
+	if responded == 0 {
+		// closed in the meantime
+		return nil
+	}
+
+	participantsBefore, err := querier.ParticipantsList(ctx, db.ParticipantsListParams{
+		OrganizerID: game.OrganizerID,
+		GameID:      game.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list participants: %w", err)
+	}
+
+	// Participants moved back to the waitlist, or who left, aren't holding the spot anymore
+	if queueOf(participantsBefore, game.MaxPlayers).Status(offer.UserID) != queue.StatusGoing {
+		return nil
+	}
+
+	if err := s.upsertParticipant(ctx, querier, db.ParticipantsUpsertParams{
+		UserID:         offer.UserID,
+		GameID:         game.ID,
+		Going:          sql.NullBool{Bool: false, Valid: true},
+		GoingUpdatedAt: now,
+	}); err != nil {
+		return fmt.Errorf("failed to update participation: %w", err)
+	}
+
+	participantsAfter, err := updateGameSpotsLeft(ctx, querier, game)
+	if err != nil {
+		return err
+	}
+
+	if err := outbox.Publish(ctx, querier, game.ID, outbox.ParticipantLeft{UserID: offer.UserID}); err != nil {
+		return err
+	}
+	if err := outbox.Publish(ctx, querier, game.ID, outbox.WaitlistOfferDeclined{
+		UserID:  offer.UserID,
+		Expired: status == waitlistOfferExpired,
+	}); err != nil {
+		return err
+	}
+	return publishPromotions(ctx, querier, game, now, participantsBefore, game.MaxPlayers, participantsAfter, game.MaxPlayers, offer.UserID)
+}
+
+func (s *server) PostApiGamesIdWaitlistOfferAccept(w http.ResponseWriter, r *http.Request, id string) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	tx, err := s.dbConn.BeginTx(r.Context(), nil)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to begin transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	querierWithTx := s.querier.WithTx(tx)
+
+	game, offer, ok := s.pendingOffer(w, r, querierWithTx, id, int64(authInfo.UserId))
+	if !ok {
+		return
+	}
+
+	participants, err := querierWithTx.ParticipantsList(r.Context(), db.ParticipantsListParams{
+		OrganizerID: game.OrganizerID,
+		GameID:      id,
+	})
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to list participants: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+	if queueOf(participants, game.MaxPlayers).Status(offer.UserID) != queue.StatusGoing {
+		http.Error(w, "the spot is no longer available", http.StatusConflict)
+		return
+	}
+
+	if _, err := querierWithTx.WaitlistOfferRespond(r.Context(), db.WaitlistOfferRespondParams{
+		ID:          offer.ID,
+		Status:      waitlistOfferAccepted,
+		RespondedAt: sql.NullTime{Time: s.clock.Now(), Valid: true},
+	}); err != nil {
+		http.Error(w, fmt.Sprintf("failed to accept offer: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	if err := outbox.Publish(r.Context(), querierWithTx, id, outbox.WaitlistOfferAccepted{UserID: offer.UserID}); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		http.Error(w, fmt.Sprintf("failed to commit transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *server) PostApiGamesIdWaitlistOfferDecline(w http.ResponseWriter, r *http.Request, id string) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	tx, err := s.dbConn.BeginTx(r.Context(), nil)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to begin transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	querierWithTx := s.querier.WithTx(tx)
+
+	game, offer, ok := s.pendingOffer(w, r, querierWithTx, id, int64(authInfo.UserId))
+	if !ok {
+		return
+	}
+
+	if game.FrozenAt.Valid && !game.FrozenAt.Time.After(s.clock.Now()) {
+		http.Error(w, "game is frozen", http.StatusBadRequest)
+		return
+	}
+
+	if err := s.releaseOfferedSpot(r.Context(), querierWithTx, game, offer, waitlistOfferDeclined); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		http.Error(w, fmt.Sprintf("failed to commit transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// pendingOffer retrieves the game and the offer the user can still respond to, writing the error response otherwise.
+func (s *server) pendingOffer(w http.ResponseWriter, r *http.Request, querier db.Querier, id string, userID int64) (db.Game, db.WaitlistOffer, bool) {
+	game, err := querier.GameGetById(r.Context(), id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			http.Error(w, "game not found", http.StatusNotFound)
+			return db.Game{}, db.WaitlistOffer{}, false
+		}
+		http.Error(w, fmt.Sprintf("failed to retrieve game: %s", err.Error()), http.StatusInternalServerError)
+		return db.Game{}, db.WaitlistOffer{}, false
+	}
+
+	if game.CancelledAt.Valid {
+		http.Error(w, "game is cancelled", http.StatusBadRequest)
+		return db.Game{}, db.WaitlistOffer{}, false
+	}
+
+	offer, err := querier.WaitlistOfferGetPending(r.Context(), db.WaitlistOfferGetPendingParams{
+		GameID: id,
+		UserID: userID,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			http.Error(w, "you have no pending offer in this game", http.StatusNotFound)
+			return db.Game{}, db.WaitlistOffer{}, false
+		}
+		http.Error(w, fmt.Sprintf("failed to retrieve offer: %s", err.Error()), http.StatusInternalServerError)
+		return db.Game{}, db.WaitlistOffer{}, false
+	}
+
+	if !offer.ExpiresAt.After(s.clock.Now()) {
+		http.Error(w, "the offer expired", http.StatusConflict)
+		return db.Game{}, db.WaitlistOffer{}, false
+	}
+
+	return game, offer, true
+}
+
+// ExpireWaitlistOffers is a [scheduler.Handler] closing the offers of the game that weren't accepted in time,
+// offering the spots to the next waitlisted participants.
+func (s *server) ExpireWaitlistOffers(ctx context.Context, querier db.QuerierWithTxSupport, event scheduler.Event) error {
+	game, err := querier.GameGetById(ctx, event.Game.ID)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve game: %w", err)
+	}
+
+	offers, err := querier.WaitlistOfferListPendingByGame(ctx, game.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list pending waitlist offers: %w", err)
+	}
+
+	now := s.clock.Now()
+	for _, offer := range offers {
+		if offer.ExpiresAt.After(event.At) {
+			continue
+		}
+
+		if (game.FrozenAt.Valid && !game.FrozenAt.Time.After(now)) || gameStarted(game, now) {
+			// The participants of the game are final once it's frozen or started, the participant keeps the spot they were offered
+			if _, err := querier.WaitlistOfferRespond(ctx, db.WaitlistOfferRespondParams{
+				ID:          offer.ID,
+				Status:      waitlistOfferExpired,
+				RespondedAt: sql.NullTime{Time: now, Valid: true},
+			}); err != nil {
+				return fmt.Errorf("failed to close waitlist offer: %w", err)
+			}
+		} else if err := s.releaseOfferedSpot(ctx, querier, game, offer, waitlistOfferExpired); err != nil {
+			return fmt.Errorf("offer %d: %w", offer.ID, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+package server_test
+
+import (
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/dmateusp/opengym/api"
+	"github.com/dmateusp/opengym/api/server"
+	"github.com/dmateusp/opengym/auth"
+	"github.com/dmateusp/opengym/clock"
+	"github.com/dmateusp/opengym/db"
+	dbtesting "github.com/dmateusp/opengym/db/testing"
+	"github.com/dmateusp/opengym/scheduler"
+)
+
+func respondToOffer(t *testing.T, srv api.ServerInterface, gameID string, userID int64, accept bool) int {
+	t.Helper()
+
+	r := httptest.NewRequest(http.MethodPost, "/", nil)
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(userID)}))
+	w := httptest.NewRecorder()
+	if accept {
+		srv.PostApiGamesIdWaitlistOfferAccept(w, r, gameID)
+	} else {
+		srv.PostApiGamesIdWaitlistOfferDecline(w, r, gameID)
+	}
+	return w.Code
+}
+
+// setUpWaitlistOffers creates a game with a single spot where freed spots are offered for 30 minutes,
+// and the users joining it in order.
+func setUpWaitlistOffers(t *testing.T, sqlDB *sql.DB, querier *db.Queries, srv api.ServerInterface, emails ...string) []int64 {
+	t.Helper()
+
+	organizerID := dbtesting.UpsertTestUser(t, sqlDB, "organizer@example.com")
+	createGame(t, querier, "g1", organizerID, sql.NullTime{Time: time.Now().Add(-time.Hour), Valid: true})
+	setMaxPlayers(t, querier, "g1", 1)
+	if err := querier.GameUpdate(t.Context(), db.GameUpdateParams{
+		ID:                   "g1",
+		WaitlistOfferMinutes: sql.NullInt64{Int64: 30, Valid: true},
+	}); err != nil {
+		t.Fatalf("failed to update game: %v", err)
+	}
+
+	userIDs := make([]int64, 0, len(emails))
+	for _, email := range emails {
+		userID := dbtesting.UpsertTestUser(t, sqlDB, email)
+		updateParticipation(t, srv, "g1", userID, api.Going)
+		userIDs = append(userIDs, userID)
+	}
+	return userIDs
+}
+
+func TestWaitlistOffers_Accept(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	staticClock := clock.StaticClock{Time: time.Now()}
+
+	querier := db.New(sqlDB)
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), staticClock, sqlDB)
+	userIDs := setUpWaitlistOffers(t, sqlDB, querier, srv, "first@example.com", "second@example.com", "third@example.com")
+	first, second, third := userIDs[0], userIDs[1], userIDs[2]
+
+	updateParticipation(t, srv, "g1", first, api.NotGoing)
+
+	participants := listParticipants(t, srv, "g1", second)
+	statuses := participantStatuses(t, participants)
+	if statuses[strconv.FormatInt(second, 10)] != "offered" || statuses[strconv.FormatInt(third, 10)] != "waitlisted" {
+		t.Fatalf("expected the spot to be offered to the second user, got %v", statuses)
+	}
+	for _, participant := range participants {
+		if participant.User.Id == strconv.FormatInt(second, 10) &&
+			(participant.OfferExpiresAt == nil || !participant.OfferExpiresAt.Equal(staticClock.Time.Add(30*time.Minute))) {
+			t.Fatalf("expected the offer to expire in 30 minutes, got %v", participant.OfferExpiresAt)
+		}
+	}
+
+	// the spot is held for the second user
+	if code := respondToOffer(t, srv, "g1", third, true); code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, code)
+	}
+
+	if code := respondToOffer(t, srv, "g1", second, true); code != http.StatusNoContent {
+		t.Fatalf("expected status %d, got %d", http.StatusNoContent, code)
+	}
+	statuses = participantStatuses(t, listParticipants(t, srv, "g1", second))
+	if statuses[strconv.FormatInt(second, 10)] != "going" || statuses[strconv.FormatInt(third, 10)] != "waitlisted" {
+		t.Fatalf("expected the second user to be going, got %v", statuses)
+	}
+
+	if code := respondToOffer(t, srv, "g1", second, true); code != http.StatusNotFound {
+		t.Fatalf("expected status %d once accepted, got %d", http.StatusNotFound, code)
+	}
+}
+
+func TestWaitlistOffers_DeclineAndExpiryCascade(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	now := time.Now()
+
+	querier := db.New(sqlDB)
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), clock.StaticClock{Time: now}, sqlDB)
+	userIDs := setUpWaitlistOffers(t, sqlDB, querier, srv, "first@example.com", "second@example.com", "third@example.com", "fourth@example.com")
+	first, second, third, fourth := userIDs[0], userIDs[1], userIDs[2], userIDs[3]
+
+	updateParticipation(t, srv, "g1", first, api.NotGoing)
+
+	if code := respondToOffer(t, srv, "g1", second, false); code != http.StatusNoContent {
+		t.Fatalf("expected status %d, got %d", http.StatusNoContent, code)
+	}
+	statuses := participantStatuses(t, listParticipants(t, srv, "g1", third))
+	if statuses[strconv.FormatInt(second, 10)] != "not_going" || statuses[strconv.FormatInt(third, 10)] != "offered" {
+		t.Fatalf("expected the offer to move on to the third user, got %v", statuses)
+	}
+
+	// nothing expires before the end of the window
+	fireScheduledEvents(t, sqlDB, querier, clock.StaticClock{Time: now}, scheduler.EventWaitlistOfferExpired, srv.ExpireWaitlistOffers)
+	if statuses := participantStatuses(t, listParticipants(t, srv, "g1", third)); statuses[strconv.FormatInt(third, 10)] != "offered" {
+		t.Fatalf("expected the offer to still be pending, got %v", statuses)
+	}
+
+	laterClock := clock.StaticClock{Time: now.Add(31 * time.Minute)}
+	later := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), laterClock, sqlDB)
+	if code := respondToOffer(t, later, "g1", third, true); code != http.StatusConflict {
+		t.Fatalf("expected status %d for an expired offer, got %d", http.StatusConflict, code)
+	}
+	fireScheduledEvents(t, sqlDB, querier, laterClock, scheduler.EventWaitlistOfferExpired, later.ExpireWaitlistOffers)
+	statuses = participantStatuses(t, listParticipants(t, later, "g1", fourth))
+	if statuses[strconv.FormatInt(third, 10)] != "not_going" || statuses[strconv.FormatInt(fourth, 10)] != "offered" {
+		t.Fatalf("expected the offer to move on to the fourth user, got %v", statuses)
+	}
+}
+
+func TestWaitlistOffers_ExpiryKeepsTheSpotOnceFrozen(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	now := time.Now()
+
+	querier := db.New(sqlDB)
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), clock.StaticClock{Time: now}, sqlDB)
+	userIDs := setUpWaitlistOffers(t, sqlDB, querier, srv, "first@example.com", "second@example.com", "third@example.com")
+	first, second, third := userIDs[0], userIDs[1], userIDs[2]
+
+	updateParticipation(t, srv, "g1", first, api.NotGoing)
+	if err := querier.GameUpdate(t.Context(), db.GameUpdateParams{
+		ID:       "g1",
+		FrozenAt: sql.NullTime{Time: now.Add(10 * time.Minute), Valid: true},
+	}); err != nil {
+		t.Fatalf("failed to freeze game: %v", err)
+	}
+
+	laterClock := clock.StaticClock{Time: now.Add(31 * time.Minute)}
+	later := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), laterClock, sqlDB)
+	fireScheduledEvents(t, sqlDB, querier, laterClock, scheduler.EventWaitlistOfferExpired, later.ExpireWaitlistOffers)
+	statuses := participantStatuses(t, listParticipants(t, later, "g1", second))
+	if statuses[strconv.FormatInt(second, 10)] != "going" || statuses[strconv.FormatInt(third, 10)] != "waitlisted" {
+		t.Fatalf("expected the second user to keep the spot of the frozen game, got %v", statuses)
+	}
+}
@@ -0,0 +1,34 @@
+package server
+
+import (
+	"github.com/dmateusp/opengym/db"
+	"github.com/dmateusp/opengym/queue"
+)
+
+const (
+	waitlistDisabled  = "disabled"
+	waitlistFixed     = "fixed"
+	waitlistUnlimited = "unlimited"
+)
+
+// waitlistSpotsLeft returns the spots left on the waitlist of the game, given its queue.
+// It's only meaningful for fixed waitlists, disabled waitlists have no spots and unlimited waitlists are never full.
+func waitlistSpotsLeft(game db.Game, q queue.Queue) int64 {
+	if game.WaitlistMode != waitlistFixed {
+		return 0
+	}
+	return max(game.MaxWaitlistSize-q.Waitlisted, 0)
+}
+
+// waitlistOverflows reports whether more participants are on the waitlist of the game than it allows.
+// This happens when the organizer shrinks the game or its waitlist, the participants already waitlisted keep their place.
+func waitlistOverflows(game db.Game, q queue.Queue) bool {
+	switch game.WaitlistMode {
+	case waitlistDisabled:
+		return q.Waitlisted > 0
+	case waitlistFixed:
+		return q.Waitlisted > game.MaxWaitlistSize
+	default:
+		return false
+	}
+}
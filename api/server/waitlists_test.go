@@ -0,0 +1,98 @@
+package server_test
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/dmateusp/opengym/api"
+	"github.com/dmateusp/opengym/api/server"
+	"github.com/dmateusp/opengym/auth"
+	"github.com/dmateusp/opengym/clock"
+	"github.com/dmateusp/opengym/db"
+	dbtesting "github.com/dmateusp/opengym/db/testing"
+	"github.com/dmateusp/opengym/ptr"
+)
+
+func TestWaitlists_RejectJoinsOnceFull(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	now := time.Now()
+
+	organizerID := dbtesting.UpsertTestUser(t, sqlDB, "organizer@example.com")
+	player1ID := dbtesting.UpsertTestUser(t, sqlDB, "player1@example.com")
+	player2ID := dbtesting.UpsertTestUser(t, sqlDB, "player2@example.com")
+	player3ID := dbtesting.UpsertTestUser(t, sqlDB, "player3@example.com")
+
+	querier := db.New(sqlDB)
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), clock.StaticClock{Time: now}, sqlDB)
+	createGame(t, querier, "g1", organizerID, sql.NullTime{Time: now.Add(-time.Hour), Valid: true})
+	setMaxPlayers(t, querier, "g1", 1)
+
+	if code, _ := patchGame(t, srv, "g1", organizerID, api.UpdateGameRequest{WaitlistMode: ptr.Ptr(api.GameWaitlistMode("short"))}); code != http.StatusBadRequest {
+		t.Fatalf("expected status %d for an unknown waitlist mode, got %d", http.StatusBadRequest, code)
+	}
+	code, game := patchGame(t, srv, "g1", organizerID, api.UpdateGameRequest{WaitlistMode: ptr.Ptr(api.Fixed), MaxWaitlistSize: ptr.Ptr(int64(1))})
+	if code != http.StatusOK || *game.Game.WaitlistSpotsLeft != 1 {
+		t.Fatalf("expected a waitlist with 1 spot, got status %d and %+v", code, game.Game)
+	}
+
+	join := func(userID int64) int {
+		body, _ := json.Marshal(api.UpdateGameParticipationRequest{Status: api.Going})
+		r := httptest.NewRequest(http.MethodPut, "/", bytes.NewReader(body))
+		r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(userID)}))
+		w := httptest.NewRecorder()
+		srv.PutApiGamesIdParticipants(w, r, "g1")
+		return w.Code
+	}
+
+	updateParticipation(t, srv, "g1", player1ID, api.Going)
+	updateParticipation(t, srv, "g1", player2ID, api.Going)
+	if code := join(player3ID); code != http.StatusConflict {
+		t.Fatalf("expected status %d once the game and its waitlist are full, got %d", http.StatusConflict, code)
+	}
+
+	r := httptest.NewRequest(http.MethodGet, "/public/api/games/g1", nil)
+	w := httptest.NewRecorder()
+	srv.GetPublicApiGamesId(w, r, "g1", api.GetPublicApiGamesIdParams{})
+	var resp api.PublicGameDetail
+	if err := json.NewDecoder(w.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	teaser, err := resp.AsPublicGameDetail0()
+	if err != nil {
+		t.Fatalf("failed to decode published game: %v", err)
+	}
+	if teaser.GameSpotsLeft != 0 || teaser.WaitlistSpotsLeft == nil || *teaser.WaitlistSpotsLeft != 0 {
+		t.Fatalf("expected no spots left in the game or on its waitlist, got %+v", teaser)
+	}
+
+	// leaving frees the spot on the waitlist
+	updateParticipation(t, srv, "g1", player2ID, api.NotGoing)
+	if code := join(player3ID); code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, code)
+	}
+
+	// without a waitlist, only the spots in the game are left
+	code, game = patchGame(t, srv, "g1", organizerID, api.UpdateGameRequest{WaitlistMode: ptr.Ptr(api.Disabled)})
+	if code != http.StatusOK || *game.Game.WaitlistSpotsLeft != 0 {
+		t.Fatalf("expected a disabled waitlist, got status %d and %+v", code, game.Game)
+	}
+	if code := join(player2ID); code != http.StatusConflict {
+		t.Fatalf("expected status %d without a waitlist, got %d", http.StatusConflict, code)
+	}
+
+	code, game = patchGame(t, srv, "g1", organizerID, api.UpdateGameRequest{WaitlistMode: ptr.Ptr(api.Unlimited)})
+	if code != http.StatusOK || game.Game.WaitlistSpotsLeft != nil {
+		t.Fatalf("expected an unlimited waitlist, got status %d and %+v", code, game.Game)
+	}
+	if code := join(player2ID); code != http.StatusOK {
+		t.Fatalf("expected status %d with an unlimited waitlist, got %d", http.StatusOK, code)
+	}
+}
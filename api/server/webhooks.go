@@ -0,0 +1,327 @@
+package server
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+
+	"github.com/dmateusp/opengym/api"
+	"github.com/dmateusp/opengym/auth"
+	"github.com/dmateusp/opengym/db"
+	"github.com/dmateusp/opengym/outbox"
+	"github.com/dmateusp/opengym/webhook"
+)
+
+// maxListedWebhookDeliveries is how many deliveries are returned in the delivery log of a webhook.
+const maxListedWebhookDeliveries = 100
+
+func (srv *server) GetApiWebhooks(w http.ResponseWriter, r *http.Request) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	webhooks, err := srv.querier.WebhookListByOrganizer(r.Context(), int64(authInfo.UserId))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to list webhooks: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	resp := make([]api.Webhook, 0, len(webhooks))
+	for _, dbWebhook := range webhooks {
+		var apiWebhook api.Webhook
+		apiWebhook.FromDb(dbWebhook)
+		resp = append(resp, apiWebhook)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		http.Error(w, fmt.Sprintf("failed to encode response: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (srv *server) PostApiWebhooks(w http.ResponseWriter, r *http.Request) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var req api.CreateWebhookRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, fmt.Sprintf("invalid request body: %s", err.Error()), http.StatusBadRequest)
+		return
+	}
+
+	parsedUrl, err := url.Parse(req.Url)
+	if err != nil || (parsedUrl.Scheme != "http" && parsedUrl.Scheme != "https") || parsedUrl.Host == "" {
+		http.Error(w, "url must be an absolute http or https URL", http.StatusBadRequest)
+		return
+	}
+	// hosts are checked again once resolved when delivering, this rejects the URLs that can never be delivered to
+	if addr, err := netip.ParseAddr(parsedUrl.Hostname()); (err == nil && !webhook.IsAllowedAddress(addr)) || strings.EqualFold(parsedUrl.Hostname(), "localhost") {
+		http.Error(w, "url cannot point to a loopback, private or link-local address", http.StatusBadRequest)
+		return
+	}
+
+	if (req.GameId == nil) == (req.SeriesId == nil) {
+		http.Error(w, "exactly one of gameId and seriesId must be set", http.StatusBadRequest)
+		return
+	}
+
+	if len(req.EventTypes) == 0 {
+		http.Error(w, "eventTypes cannot be empty", http.StatusBadRequest)
+		return
+	}
+	eventTypes := make([]outbox.EventType, 0, len(req.EventTypes))
+	for _, eventType := range req.EventTypes {
+		if !outbox.EventType(eventType).Valid() {
+			http.Error(w, fmt.Sprintf("invalid event type: %s", eventType), http.StatusBadRequest)
+			return
+		}
+		eventTypes = append(eventTypes, outbox.EventType(eventType))
+	}
+
+	params := db.WebhookCreateParams{
+		OrganizerID: int64(authInfo.UserId),
+		Url:         req.Url,
+		EventTypes:  webhook.FormatEventTypes(eventTypes),
+	}
+
+	if req.GameId != nil {
+		game, err := srv.querier.GameGetById(r.Context(), *req.GameId)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				http.Error(w, "game not found", http.StatusNotFound)
+				return
+			}
+			http.Error(w, fmt.Sprintf("failed to retrieve game: %s", err.Error()), http.StatusInternalServerError)
+			return
+		}
+		if !authorize(w, r, srv.querier, game, int64(authInfo.UserId), permissionManage) {
+			return
+		}
+		params.GameID = sql.NullString{String: game.ID, Valid: true}
+	} else {
+		series, err := srv.querier.SeriesGetById(r.Context(), *req.SeriesId)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				http.Error(w, "series not found", http.StatusNotFound)
+				return
+			}
+			http.Error(w, fmt.Sprintf("failed to retrieve series: %s", err.Error()), http.StatusInternalServerError)
+			return
+		}
+		if series.OrganizerID != int64(authInfo.UserId) {
+			http.Error(w, "forbidden: you are not the organizer of this series", http.StatusForbidden)
+			return
+		}
+		params.SeriesID = sql.NullString{String: series.ID, Valid: true}
+	}
+
+	params.Secret, err = webhook.NewSecret()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	dbWebhook, err := srv.querier.WebhookCreate(r.Context(), params)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to create webhook: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	var apiWebhook api.Webhook
+	apiWebhook.FromDb(dbWebhook)
+	resp := api.CreatedWebhook{
+		Id:         apiWebhook.Id,
+		Url:        apiWebhook.Url,
+		GameId:     apiWebhook.GameId,
+		SeriesId:   apiWebhook.SeriesId,
+		EventTypes: apiWebhook.EventTypes,
+		CreatedAt:  apiWebhook.CreatedAt,
+		Secret:     dbWebhook.Secret,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		http.Error(w, fmt.Sprintf("failed to encode response: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (srv *server) DeleteApiWebhooksId(w http.ResponseWriter, r *http.Request, id int) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	tx, err := srv.dbConn.BeginTx(r.Context(), nil)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to begin transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	querierWithTx := srv.querier.WithTx(tx)
+
+	if !authorizeWebhook(w, r, querierWithTx, int64(id), int64(authInfo.UserId)) {
+		return
+	}
+
+	if err := querierWithTx.WebhookDeliveryDeleteByWebhook(r.Context(), int64(id)); err != nil {
+		http.Error(w, fmt.Sprintf("failed to delete deliveries: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+	if err := querierWithTx.WebhookDelete(r.Context(), int64(id)); err != nil {
+		http.Error(w, fmt.Sprintf("failed to delete webhook: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		http.Error(w, fmt.Sprintf("failed to commit transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (srv *server) GetApiWebhooksIdDeliveries(w http.ResponseWriter, r *http.Request, id int) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	if !authorizeWebhook(w, r, srv.querier, int64(id), int64(authInfo.UserId)) {
+		return
+	}
+
+	deliveries, err := srv.querier.WebhookDeliveryListByWebhook(r.Context(), db.WebhookDeliveryListByWebhookParams{
+		WebhookID: int64(id),
+		Limit:     maxListedWebhookDeliveries,
+	})
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to list deliveries: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	resp := make([]api.WebhookDelivery, 0, len(deliveries))
+	for _, dbDelivery := range deliveries {
+		var apiDelivery api.WebhookDelivery
+		apiDelivery.FromDb(dbDelivery)
+		resp = append(resp, apiDelivery)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		http.Error(w, fmt.Sprintf("failed to encode response: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (srv *server) PostApiWebhooksIdDeliveriesDeliveryIdRedeliver(w http.ResponseWriter, r *http.Request, id int, deliveryId int) {
+	authInfo, ok := auth.FromCtx(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	tx, err := srv.dbConn.BeginTx(r.Context(), nil)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to begin transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+	defer tx.Rollback()
+
+	querierWithTx := srv.querier.WithTx(tx)
+
+	if !authorizeWebhook(w, r, querierWithTx, int64(id), int64(authInfo.UserId)) {
+		return
+	}
+
+	delivery, err := querierWithTx.WebhookDeliveryGetById(r.Context(), int64(deliveryId))
+	if err != nil && err != sql.ErrNoRows {
+		http.Error(w, fmt.Sprintf("failed to retrieve delivery: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+	if err == sql.ErrNoRows || delivery.WebhookID != int64(id) {
+		http.Error(w, "delivery not found", http.StatusNotFound)
+		return
+	}
+
+	if err := querierWithTx.WebhookDeliveryReset(r.Context(), delivery.ID); err != nil {
+		http.Error(w, fmt.Sprintf("failed to reset delivery: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	delivery, err = querierWithTx.WebhookDeliveryGetById(r.Context(), delivery.ID)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to retrieve delivery: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	if err := tx.Commit(); err != nil {
+		http.Error(w, fmt.Sprintf("failed to commit transaction: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+
+	var resp api.WebhookDelivery
+	resp.FromDb(delivery)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		http.Error(w, fmt.Sprintf("failed to encode response: %s", err.Error()), http.StatusInternalServerError)
+		return
+	}
+}
+
+// authorizeWebhook checks that the webhook exists and belongs to the user, writing the error response otherwise.
+func authorizeWebhook(w http.ResponseWriter, r *http.Request, querier db.Querier, webhookID, userID int64) bool {
+	dbWebhook, err := querier.WebhookGetById(r.Context(), webhookID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			http.Error(w, "webhook not found", http.StatusNotFound)
+			return false
+		}
+		http.Error(w, fmt.Sprintf("failed to retrieve webhook: %s", err.Error()), http.StatusInternalServerError)
+		return false
+	}
+
+	if dbWebhook.OrganizerID != userID {
+		http.Error(w, "forbidden: you are not the owner of this webhook", http.StatusForbidden)
+		return false
+	}
+
+	return true
+}
+
+// deleteGameWebhooksOf deletes the webhooks the user registered on the game, they stop receiving its data
+// once the user can no longer manage the game.
+func deleteGameWebhooksOf(ctx context.Context, querier db.Querier, gameID string, userID int64) error {
+	if err := querier.WebhookDeliveryDeleteByGameAndOrganizer(ctx, db.WebhookDeliveryDeleteByGameAndOrganizerParams{
+		GameID:      sql.NullString{String: gameID, Valid: true},
+		OrganizerID: userID,
+	}); err != nil {
+		return fmt.Errorf("failed to delete deliveries: %w", err)
+	}
+	if err := querier.WebhookDeleteByGameAndOrganizer(ctx, db.WebhookDeleteByGameAndOrganizerParams{
+		GameID:      sql.NullString{String: gameID, Valid: true},
+		OrganizerID: userID,
+	}); err != nil {
+		return fmt.Errorf("failed to delete webhooks: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,274 @@
+package server_test
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/dmateusp/opengym/api"
+	"github.com/dmateusp/opengym/api/server"
+	"github.com/dmateusp/opengym/auth"
+	"github.com/dmateusp/opengym/clock"
+	"github.com/dmateusp/opengym/db"
+	dbtesting "github.com/dmateusp/opengym/db/testing"
+	"github.com/dmateusp/opengym/ptr"
+	"github.com/dmateusp/opengym/webhook"
+)
+
+func postWebhook(t *testing.T, srv api.ServerInterface, userID int64, req api.CreateWebhookRequest) *httptest.ResponseRecorder {
+	t.Helper()
+
+	body, _ := json.Marshal(req)
+	r := httptest.NewRequest(http.MethodPost, "/api/webhooks", bytes.NewReader(body))
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(userID)}))
+	w := httptest.NewRecorder()
+	srv.PostApiWebhooks(w, r)
+	return w
+}
+
+func TestPostApiWebhooks_Validation(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	staticClock := clock.StaticClock{Time: time.Now()}
+
+	organizerID := dbtesting.UpsertTestUser(t, sqlDB, "organizer@example.com")
+	otherID := dbtesting.UpsertTestUser(t, sqlDB, "other@example.com")
+
+	querier := db.New(sqlDB)
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), staticClock, sqlDB)
+	createGame(t, querier, "g1", organizerID, sql.NullTime{})
+
+	eventTypes := []api.WebhookEventType{"participant_joined"}
+	tests := []struct {
+		name     string
+		userID   int64
+		req      api.CreateWebhookRequest
+		wantCode int
+	}{
+		{"relative url", organizerID, api.CreateWebhookRequest{Url: "/hook", GameId: ptr.Ptr("g1"), EventTypes: eventTypes}, http.StatusBadRequest},
+		{"unsupported scheme", organizerID, api.CreateWebhookRequest{Url: "ftp://example.com", GameId: ptr.Ptr("g1"), EventTypes: eventTypes}, http.StatusBadRequest},
+		{"loopback address", organizerID, api.CreateWebhookRequest{Url: "http://127.0.0.1:8080/hook", GameId: ptr.Ptr("g1"), EventTypes: eventTypes}, http.StatusBadRequest},
+		{"metadata address", organizerID, api.CreateWebhookRequest{Url: "http://169.254.169.254/latest", GameId: ptr.Ptr("g1"), EventTypes: eventTypes}, http.StatusBadRequest},
+		{"localhost", organizerID, api.CreateWebhookRequest{Url: "http://localhost/hook", GameId: ptr.Ptr("g1"), EventTypes: eventTypes}, http.StatusBadRequest},
+		{"no game or series", organizerID, api.CreateWebhookRequest{Url: "https://example.com", EventTypes: eventTypes}, http.StatusBadRequest},
+		{"game and series", organizerID, api.CreateWebhookRequest{Url: "https://example.com", GameId: ptr.Ptr("g1"), SeriesId: ptr.Ptr("s1"), EventTypes: eventTypes}, http.StatusBadRequest},
+		{"no event types", organizerID, api.CreateWebhookRequest{Url: "https://example.com", GameId: ptr.Ptr("g1")}, http.StatusBadRequest},
+		{"invalid event type", organizerID, api.CreateWebhookRequest{Url: "https://example.com", GameId: ptr.Ptr("g1"), EventTypes: []api.WebhookEventType{"unknown"}}, http.StatusBadRequest},
+		{"unknown game", organizerID, api.CreateWebhookRequest{Url: "https://example.com", GameId: ptr.Ptr("nope"), EventTypes: eventTypes}, http.StatusNotFound},
+		{"unknown series", organizerID, api.CreateWebhookRequest{Url: "https://example.com", SeriesId: ptr.Ptr("nope"), EventTypes: eventTypes}, http.StatusNotFound},
+		{"not the organizer", otherID, api.CreateWebhookRequest{Url: "https://example.com", GameId: ptr.Ptr("g1"), EventTypes: eventTypes}, http.StatusForbidden},
+		{"valid", organizerID, api.CreateWebhookRequest{Url: "https://example.com", GameId: ptr.Ptr("g1"), EventTypes: eventTypes}, http.StatusCreated},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := postWebhook(t, srv, tt.userID, tt.req)
+			if w.Code != tt.wantCode {
+				t.Fatalf("expected status %d, got %d: %s", tt.wantCode, w.Code, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestWebhooks_DeliveryLogAndRedelivery(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	staticClock := clock.StaticClock{Time: time.Now()}
+
+	organizerID := dbtesting.UpsertTestUser(t, sqlDB, "organizer@example.com")
+	userID := dbtesting.UpsertTestUser(t, sqlDB, "user@example.com")
+	otherID := dbtesting.UpsertTestUser(t, sqlDB, "other@example.com")
+
+	querier := db.New(sqlDB)
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), staticClock, sqlDB)
+	createGame(t, querier, "g1", organizerID, sql.NullTime{Time: staticClock.Time.Add(-time.Hour), Valid: true})
+
+	var received atomic.Int64
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received.Add(1)
+	}))
+	defer receiver.Close()
+
+	w := postWebhook(t, srv, organizerID, api.CreateWebhookRequest{
+		Url:        "https://hooks.example.com/opengym",
+		GameId:     ptr.Ptr("g1"),
+		EventTypes: []api.WebhookEventType{"participant_joined"},
+	})
+	if w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusCreated, w.Code, w.Body.String())
+	}
+	var created api.CreatedWebhook
+	if err := json.NewDecoder(w.Body).Decode(&created); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if created.Secret == "" {
+		t.Fatalf("expected the secret to be returned")
+	}
+	// the receiver listens on a loopback address, which the API refuses
+	if _, err := sqlDB.Exec(`update webhooks set url = ? where id = ?`, receiver.URL, created.Id); err != nil {
+		t.Fatalf("failed to point the webhook to the receiver: %v", err)
+	}
+
+	deliverer := webhook.NewDeliverer(db.NewQuerierWrapper(querier), staticClock, http.DefaultClient)
+	updateParticipation(t, srv, "g1", userID, api.Going)
+	dispatchEvents(t, querier, staticClock, deliverer.Enqueue)
+	if err := deliverer.Deliver(t.Context()); err != nil {
+		t.Fatalf("failed to deliver: %v", err)
+	}
+	if received.Load() != 1 {
+		t.Fatalf("expected 1 delivery, got %d", received.Load())
+	}
+
+	listDeliveries := func(userID int64) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/webhooks/%d/deliveries", created.Id), nil)
+		r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(userID)}))
+		w := httptest.NewRecorder()
+		srv.GetApiWebhooksIdDeliveries(w, r, int(created.Id))
+		return w
+	}
+
+	if w := listDeliveries(otherID); w.Code != http.StatusForbidden {
+		t.Fatalf("expected status %d, got %d", http.StatusForbidden, w.Code)
+	}
+
+	w = listDeliveries(organizerID)
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	var deliveries []api.WebhookDelivery
+	if err := json.NewDecoder(w.Body).Decode(&deliveries); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if len(deliveries) != 1 || deliveries[0].EventType != "participant_joined" || deliveries[0].DeliveredAt == nil || *deliveries[0].LastStatusCode != http.StatusOK {
+		t.Fatalf("expected a successful delivery in the log, got %+v", deliveries)
+	}
+
+	// redelivering an unknown delivery
+	r := httptest.NewRequest(http.MethodPost, "/", nil)
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(organizerID)}))
+	w = httptest.NewRecorder()
+	srv.PostApiWebhooksIdDeliveriesDeliveryIdRedeliver(w, r, int(created.Id), int(deliveries[0].Id)+1)
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expected status %d, got %d", http.StatusNotFound, w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	srv.PostApiWebhooksIdDeliveriesDeliveryIdRedeliver(w, r, int(created.Id), int(deliveries[0].Id))
+	if w.Code != http.StatusAccepted {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusAccepted, w.Code, w.Body.String())
+	}
+	if err := deliverer.Deliver(t.Context()); err != nil {
+		t.Fatalf("failed to deliver: %v", err)
+	}
+	if received.Load() != 2 {
+		t.Fatalf("expected the delivery to be sent again, got %d deliveries", received.Load())
+	}
+
+	// deleted webhooks stop receiving deliveries
+	r = httptest.NewRequest(http.MethodDelete, "/api/webhooks/"+strconv.FormatInt(created.Id, 10), nil)
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(organizerID)}))
+	w = httptest.NewRecorder()
+	srv.DeleteApiWebhooksId(w, r, int(created.Id))
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusNoContent, w.Code, w.Body.String())
+	}
+
+	updateParticipation(t, srv, "g1", userID, api.NotGoing)
+	updateParticipation(t, srv, "g1", userID, api.Going)
+	dispatchEvents(t, querier, staticClock, deliverer.Enqueue)
+	if err := deliverer.Deliver(t.Context()); err != nil {
+		t.Fatalf("failed to deliver: %v", err)
+	}
+	if received.Load() != 2 {
+		t.Fatalf("expected no deliveries after the webhook was deleted, got %d deliveries", received.Load())
+	}
+}
+
+func TestWebhooks_DeletedWhenTheCoOrganizerRoleIsRevoked(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+	staticClock := clock.StaticClock{Time: time.Now()}
+
+	ownerID := dbtesting.UpsertTestUser(t, sqlDB, "owner@example.com")
+	coOrganizerID := dbtesting.UpsertTestUser(t, sqlDB, "co-organizer@example.com")
+
+	querier := db.New(sqlDB)
+	srv := server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), staticClock, sqlDB)
+	createGame(t, querier, "g1", ownerID, sql.NullTime{})
+
+	if w := inviteToRole(t, srv, "g1", ownerID, "co-organizer@example.com", api.CoOrganizer); w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusCreated, w.Code, w.Body.String())
+	}
+
+	webhookCount := func(userID int64) int {
+		t.Helper()
+		var count int
+		if err := sqlDB.QueryRow(`select count(*) from webhooks where organizer_id = ?`, userID).Scan(&count); err != nil {
+			t.Fatalf("failed to count webhooks: %v", err)
+		}
+		return count
+	}
+
+	for _, userID := range []int64{ownerID, coOrganizerID} {
+		w := postWebhook(t, srv, userID, api.CreateWebhookRequest{
+			Url:        "https://hooks.example.com/opengym",
+			GameId:     ptr.Ptr("g1"),
+			EventTypes: []api.WebhookEventType{"participant_joined"},
+		})
+		if w.Code != http.StatusCreated {
+			t.Fatalf("expected status %d, got %d: %s", http.StatusCreated, w.Code, w.Body.String())
+		}
+	}
+
+	// a treasurer doesn't manage the game, so changing the role deletes the webhooks too
+	body, _ := json.Marshal(api.UpdateGameRoleRequest{Role: api.Treasurer})
+	r := httptest.NewRequest(http.MethodPut, "/", bytes.NewReader(body))
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(ownerID)}))
+	w := httptest.NewRecorder()
+	srv.PutApiGamesIdRolesUserId(w, r, "g1", strconv.FormatInt(coOrganizerID, 10))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	if count := webhookCount(coOrganizerID); count != 0 {
+		t.Fatalf("expected the webhooks of the former co-organizer to be deleted, got %d", count)
+	}
+
+	body, _ = json.Marshal(api.UpdateGameRoleRequest{Role: api.CoOrganizer})
+	r = httptest.NewRequest(http.MethodPut, "/", bytes.NewReader(body))
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(ownerID)}))
+	w = httptest.NewRecorder()
+	srv.PutApiGamesIdRolesUserId(w, r, "g1", strconv.FormatInt(coOrganizerID, 10))
+	if w.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusOK, w.Code, w.Body.String())
+	}
+	if w := postWebhook(t, srv, coOrganizerID, api.CreateWebhookRequest{
+		Url:        "https://hooks.example.com/opengym",
+		GameId:     ptr.Ptr("g1"),
+		EventTypes: []api.WebhookEventType{"participant_joined"},
+	}); w.Code != http.StatusCreated {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusCreated, w.Code, w.Body.String())
+	}
+
+	r = httptest.NewRequest(http.MethodDelete, "/", nil)
+	r = r.WithContext(auth.WithAuthInfo(r.Context(), auth.AuthInfo{UserId: int(ownerID)}))
+	w = httptest.NewRecorder()
+	srv.DeleteApiGamesIdRolesUserId(w, r, "g1", strconv.FormatInt(coOrganizerID, 10))
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("expected status %d, got %d: %s", http.StatusNoContent, w.Code, w.Body.String())
+	}
+	if count := webhookCount(coOrganizerID); count != 0 {
+		t.Fatalf("expected the webhooks of the revoked co-organizer to be deleted, got %d", count)
+	}
+	if count := webhookCount(ownerID); count != 1 {
+		t.Fatalf("expected the webhook of the owner to be kept, got %d", count)
+	}
+}
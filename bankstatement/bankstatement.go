@@ -0,0 +1,386 @@
+// Package bankstatement reads the transactions of bank exports, in CSV or ISO 20022 CAMT.053 XML.
+package bankstatement
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Transaction is a line of a bank statement.
+type Transaction struct {
+	// Line is the line of the transaction in a CSV file, or the number of its entry in a CAMT.053 statement.
+	Line int
+	// BookedAt is when the bank booked the transaction, zero when the statement doesn't say.
+	BookedAt time.Time
+	// AmountCents is positive for money received and negative for money sent.
+	AmountCents int64
+	// Description is the text the sender wrote on the transfer, with the other details the bank gives about it.
+	Description string
+}
+
+var errNoHeader = errors.New("no header with an amount and a description column")
+
+// ParseCSV reads the transactions of a CSV bank export. The header row names the columns, it can be preceded by
+// details about the account. Amounts are in a single amount column or split between credit and debit columns,
+// the descriptions are read from every column describing the transfer.
+func ParseCSV(r io.Reader) ([]Transaction, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read statement: %w", err)
+	}
+	data = bytes.TrimPrefix(data, []byte("\ufeff"))
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.Comma = detectDelimiter(data)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+
+	var cols *columns
+	var transactions []Transaction
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read statement: %w", err)
+		}
+		line, _ := reader.FieldPos(0)
+
+		if cols == nil {
+			cols = headerColumns(record)
+			continue
+		}
+		if isBlank(record) {
+			continue
+		}
+
+		transaction, err := cols.transaction(record)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		transaction.Line = line
+		transactions = append(transactions, transaction)
+	}
+	if cols == nil {
+		return nil, errNoHeader
+	}
+	return transactions, nil
+}
+
+// detectDelimiter returns the delimiter used the most on the first line among commas, semicolons and tabs.
+func detectDelimiter(data []byte) rune {
+	firstLine, _, _ := bytes.Cut(data, []byte("\n"))
+	delimiter, count := ',', bytes.Count(firstLine, []byte(","))
+	for _, candidate := range []rune{';', '\t'} {
+		if n := bytes.Count(firstLine, []byte(string(candidate))); n > count {
+			delimiter, count = candidate, n
+		}
+	}
+	return delimiter
+}
+
+// columns are the indexes of the columns of a CSV bank export, -1 when missing.
+type columns struct {
+	date         int
+	amount       int
+	credit       int
+	debit        int
+	descriptions []int
+}
+
+var descriptionColumns = []string{"description", "reference", "details", "memo", "narrative", "remittance", "purpose", "payee", "counterparty", "name"}
+
+// headerColumns returns the columns named by the record, or nil when it isn't the header.
+func headerColumns(record []string) *columns {
+	cols := &columns{date: -1, amount: -1, credit: -1, debit: -1}
+	for i, name := range record {
+		name = strings.ToLower(strings.TrimSpace(name))
+		switch {
+		case strings.Contains(name, "date") && cols.date < 0:
+			cols.date = i
+		case (strings.Contains(name, "credit") || strings.Contains(name, "paid in")) && cols.credit < 0:
+			cols.credit = i
+		case (strings.Contains(name, "debit") || strings.Contains(name, "paid out")) && cols.debit < 0:
+			cols.debit = i
+		case strings.Contains(name, "amount") && cols.amount < 0:
+			cols.amount = i
+		case containsAny(name, descriptionColumns):
+			cols.descriptions = append(cols.descriptions, i)
+		}
+	}
+	if (cols.amount < 0 && cols.credit < 0) || len(cols.descriptions) == 0 {
+		return nil
+	}
+	return cols
+}
+
+func (c *columns) transaction(record []string) (Transaction, error) {
+	var transaction Transaction
+
+	if value := field(record, c.date); value != "" {
+		transaction.BookedAt = parseDate(value)
+	}
+
+	if c.amount >= 0 {
+		amount, err := ParseAmount(field(record, c.amount))
+		if err != nil {
+			return Transaction{}, err
+		}
+		transaction.AmountCents = amount
+	} else {
+		if value := field(record, c.credit); value != "" {
+			credit, err := ParseAmount(value)
+			if err != nil {
+				return Transaction{}, err
+			}
+			transaction.AmountCents += abs(credit)
+		}
+		if value := field(record, c.debit); value != "" {
+			debit, err := ParseAmount(value)
+			if err != nil {
+				return Transaction{}, err
+			}
+			transaction.AmountCents -= abs(debit)
+		}
+	}
+
+	var descriptions []string
+	for _, i := range c.descriptions {
+		if value := field(record, i); value != "" {
+			descriptions = append(descriptions, value)
+		}
+	}
+	transaction.Description = strings.Join(descriptions, " ")
+	return transaction, nil
+}
+
+// ParseAmount parses an amount of money into cents, e.g. "12.50", "-1,234.56", "1.234,56 EUR" or "(5.00)".
+// The last dot or comma is the decimal separator when it's followed by up to 2 digits, the others separate thousands.
+func ParseAmount(value string) (int64, error) {
+	value = strings.TrimSpace(value)
+	negative := false
+	if strings.HasPrefix(value, "(") && strings.HasSuffix(value, ")") {
+		negative = true
+		value = value[1 : len(value)-1]
+	}
+
+	var digits strings.Builder
+	separator := -1
+	for _, r := range value {
+		switch {
+		case unicode.IsDigit(r):
+			digits.WriteRune(r)
+		case r == '.' || r == ',':
+			separator = digits.Len()
+		case r == '-':
+			negative = true
+		}
+	}
+	if digits.Len() == 0 {
+		return 0, fmt.Errorf("invalid amount %q", value)
+	}
+
+	whole, decimals := digits.String(), ""
+	if separator >= 0 && digits.Len()-separator <= 2 {
+		whole, decimals = whole[:separator], whole[separator:]
+	}
+	decimals = (decimals + "00")[:2]
+
+	cents, err := strconv.ParseInt(whole+decimals, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid amount %q", value)
+	}
+	if negative {
+		cents = -cents
+	}
+	return cents, nil
+}
+
+// dateLayouts are the layouts dates are parsed with, days come before months when it's ambiguous.
+var dateLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02",
+	"02.01.2006",
+	"02/01/2006",
+	"2/1/2006",
+	"02-01-2006",
+	"2006/01/02",
+}
+
+// parseDate returns the zero time when the date isn't in a known layout, the transaction can still be matched.
+func parseDate(value string) time.Time {
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// camtDocument is the part of a CAMT.053 statement needed to read its transactions.
+type camtDocument struct {
+	Statements []struct {
+		Entries []camtEntry `xml:"Ntry"`
+	} `xml:"BkToCstmrStmt>Stmt"`
+}
+
+type camtEntry struct {
+	Amount          string            `xml:"Amt"`
+	CreditDebit     string            `xml:"CdtDbtInd"`
+	BookingDate     string            `xml:"BookgDt>Dt"`
+	BookingDateTime string            `xml:"BookgDt>DtTm"`
+	AdditionalInfo  string            `xml:"AddtlNtryInf"`
+	Transactions    []camtTransaction `xml:"NtryDtls>TxDtls"`
+}
+
+type camtTransaction struct {
+	Amount         string   `xml:"Amt"`
+	DetailedAmount string   `xml:"AmtDtls>TxAmt>Amt"`
+	CreditDebit    string   `xml:"CdtDbtInd"`
+	Unstructured   []string `xml:"RmtInf>Ustrd"`
+	References     []string `xml:"RmtInf>Strd>CdtrRefInf>Ref"`
+	AdditionalInfo string   `xml:"AddtlTxInf"`
+}
+
+// ParseCAMT053 reads the transactions of an ISO 20022 CAMT.053 bank statement. Batched entries are split
+// into a transaction per transfer when the statement details their amounts.
+func ParseCAMT053(r io.Reader) ([]Transaction, error) {
+	var document camtDocument
+	if err := xml.NewDecoder(r).Decode(&document); err != nil {
+		return nil, fmt.Errorf("failed to read statement: %w", err)
+	}
+
+	var transactions []Transaction
+	line := 0
+	for _, statement := range document.Statements {
+		for _, entry := range statement.Entries {
+			line++
+
+			bookedAt := parseDate(strings.TrimSpace(entry.BookingDateTime))
+			if bookedAt.IsZero() {
+				bookedAt = parseDate(strings.TrimSpace(entry.BookingDate))
+			}
+
+			// a transfer per transaction detail when they all have an amount, otherwise the entry as a whole
+			split := len(entry.Transactions) > 1
+			for _, tx := range entry.Transactions {
+				if tx.amount() == "" {
+					split = false
+				}
+			}
+
+			if !split {
+				amount, err := camtAmount(entry.Amount, entry.CreditDebit)
+				if err != nil {
+					return nil, fmt.Errorf("entry %d: %w", line, err)
+				}
+				descriptions := []string{entry.AdditionalInfo}
+				for _, tx := range entry.Transactions {
+					descriptions = append(descriptions, tx.descriptions()...)
+				}
+				transactions = append(transactions, Transaction{
+					Line:        line,
+					BookedAt:    bookedAt,
+					AmountCents: amount,
+					Description: joinNonEmpty(descriptions),
+				})
+				continue
+			}
+
+			for _, tx := range entry.Transactions {
+				creditDebit := tx.CreditDebit
+				if creditDebit == "" {
+					creditDebit = entry.CreditDebit
+				}
+				amount, err := camtAmount(tx.amount(), creditDebit)
+				if err != nil {
+					return nil, fmt.Errorf("entry %d: %w", line, err)
+				}
+				transactions = append(transactions, Transaction{
+					Line:        line,
+					BookedAt:    bookedAt,
+					AmountCents: amount,
+					Description: joinNonEmpty(tx.descriptions()),
+				})
+			}
+		}
+	}
+	return transactions, nil
+}
+
+func (tx camtTransaction) amount() string {
+	if tx.Amount != "" {
+		return tx.Amount
+	}
+	return tx.DetailedAmount
+}
+
+func (tx camtTransaction) descriptions() []string {
+	descriptions := append([]string{}, tx.Unstructured...)
+	descriptions = append(descriptions, tx.References...)
+	return append(descriptions, tx.AdditionalInfo)
+}
+
+// camtAmount returns the amount in cents, negative for debits.
+func camtAmount(amount, creditDebit string) (int64, error) {
+	cents, err := ParseAmount(amount)
+	if err != nil {
+		return 0, err
+	}
+	if strings.TrimSpace(creditDebit) == "DBIT" {
+		return -abs(cents), nil
+	}
+	return abs(cents), nil
+}
+
+func field(record []string, i int) string {
+	if i < 0 || i >= len(record) {
+		return ""
+	}
+	return strings.TrimSpace(record[i])
+}
+
+func isBlank(record []string) bool {
+	for _, value := range record {
+		if strings.TrimSpace(value) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func containsAny(s string, substrings []string) bool {
+	for _, substring := range substrings {
+		if strings.Contains(s, substring) {
+			return true
+		}
+	}
+	return false
+}
+
+func joinNonEmpty(values []string) string {
+	var nonEmpty []string
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			nonEmpty = append(nonEmpty, value)
+		}
+	}
+	return strings.Join(nonEmpty, " ")
+}
+
+func abs(n int64) int64 {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,176 @@
+package bankstatement
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		value string
+		want  int64
+	}{
+		{"12.50", 1250},
+		{"12,50", 1250},
+		{"12.5", 1250},
+		{"12", 1200},
+		{"-7.25", -725},
+		{"7.25-", -725},
+		{"(5.00)", -500},
+		{"1,234.56", 123456},
+		{"1.234,56 EUR", 123456},
+		{"€1 234,56", 123456},
+		{"1.234", 123400},
+	}
+
+	for _, test := range tests {
+		got, err := ParseAmount(test.value)
+		if err != nil {
+			t.Errorf("ParseAmount(%q): unexpected error %v", test.value, err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("ParseAmount(%q): expected %d, got %d", test.value, test.want, got)
+		}
+	}
+
+	if _, err := ParseAmount("EUR"); err == nil {
+		t.Errorf("expected an error for an amount without digits")
+	}
+}
+
+func TestParseCSV(t *testing.T) {
+	tests := []struct {
+		name      string
+		statement string
+		want      []Transaction
+	}{
+		{
+			name: "amount column",
+			statement: "Date,Description,Amount\n" +
+				"2026-10-12,\"opengym g1 A1b2\",12.50\n" +
+				"2026-10-13,Groceries,-30.00\n",
+			want: []Transaction{
+				{Line: 2, BookedAt: time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC), AmountCents: 1250, Description: "opengym g1 A1b2"},
+				{Line: 3, BookedAt: time.Date(2026, 10, 13, 0, 0, 0, 0, time.UTC), AmountCents: -3000, Description: "Groceries"},
+			},
+		},
+		{
+			name: "account details before the header, semicolons and decimal commas",
+			statement: "Account;NL00BANK0123456789\n" +
+				"\n" +
+				"Buchungsdatum / Booking date;Name;Verwendungszweck / Purpose;Amount (EUR)\n" +
+				"12.10.2026;Jane Doe;g1 C3d4;1.012,50\n",
+			want: []Transaction{
+				{Line: 4, BookedAt: time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC), AmountCents: 101250, Description: "Jane Doe g1 C3d4"},
+			},
+		},
+		{
+			name: "credit and debit columns",
+			statement: "Date,Memo,Paid out,Paid in\n" +
+				"12/10/2026,g1 A1b2,,12.50\n" +
+				"13/10/2026,Court rental,40.00,\n",
+			want: []Transaction{
+				{Line: 2, BookedAt: time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC), AmountCents: 1250, Description: "g1 A1b2"},
+				{Line: 3, BookedAt: time.Date(2026, 10, 13, 0, 0, 0, 0, time.UTC), AmountCents: -4000, Description: "Court rental"},
+			},
+		},
+		{
+			name:      "unknown date layout",
+			statement: "Date,Description,Amount\nOct 12th,g1 A1b2,12.50\n",
+			want:      []Transaction{{Line: 2, AmountCents: 1250, Description: "g1 A1b2"}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ParseCSV(strings.NewReader(test.statement))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !slices.Equal(got, test.want) {
+				t.Errorf("expected %+v, got %+v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestParseCSV_Errors(t *testing.T) {
+	if _, err := ParseCSV(strings.NewReader("Date,Balance\n2026-10-12,100.00\n")); err == nil {
+		t.Errorf("expected an error without an amount and a description column")
+	}
+	if _, err := ParseCSV(strings.NewReader("Date,Description,Amount\n2026-10-12,g1 A1b2,twelve\n")); err == nil || !strings.Contains(err.Error(), "line 2") {
+		t.Errorf("expected an error pointing at the invalid line, got %v", err)
+	}
+}
+
+const camt053 = `<?xml version="1.0" encoding="UTF-8"?>
+<Document xmlns="urn:iso:std:iso:20022:tech:xsd:camt.053.001.02">
+  <BkToCstmrStmt>
+    <Stmt>
+      <Id>STMT-1</Id>
+      <Ntry>
+        <Amt Ccy="EUR">12.50</Amt>
+        <CdtDbtInd>CRDT</CdtDbtInd>
+        <BookgDt><Dt>2026-10-12</Dt></BookgDt>
+        <NtryDtls>
+          <TxDtls>
+            <RmtInf><Ustrd>opengym g1 A1b2</Ustrd></RmtInf>
+          </TxDtls>
+        </NtryDtls>
+      </Ntry>
+      <Ntry>
+        <Amt Ccy="EUR">40.00</Amt>
+        <CdtDbtInd>DBIT</CdtDbtInd>
+        <BookgDt><DtTm>2026-10-13T09:30:00+02:00</DtTm></BookgDt>
+        <AddtlNtryInf>Court rental</AddtlNtryInf>
+      </Ntry>
+      <Ntry>
+        <Amt Ccy="EUR">20.00</Amt>
+        <CdtDbtInd>CRDT</CdtDbtInd>
+        <BookgDt><Dt>2026-10-14</Dt></BookgDt>
+        <NtryDtls>
+          <TxDtls>
+            <AmtDtls><TxAmt><Amt Ccy="EUR">12.00</Amt></TxAmt></AmtDtls>
+            <RmtInf><Strd><CdtrRefInf><Ref>g1 C3d4</Ref></CdtrRefInf></Strd></RmtInf>
+          </TxDtls>
+          <TxDtls>
+            <AmtDtls><TxAmt><Amt Ccy="EUR">8.00</Amt></TxAmt></AmtDtls>
+            <RmtInf><Ustrd>g1</Ustrd><Ustrd>E5f6</Ustrd></RmtInf>
+          </TxDtls>
+        </NtryDtls>
+      </Ntry>
+    </Stmt>
+  </BkToCstmrStmt>
+</Document>`
+
+func TestParseCAMT053(t *testing.T) {
+	got, err := ParseCAMT053(strings.NewReader(camt053))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []Transaction{
+		{Line: 1, BookedAt: time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC), AmountCents: 1250, Description: "opengym g1 A1b2"},
+		{Line: 2, BookedAt: time.Date(2026, 10, 13, 9, 30, 0, 0, time.FixedZone("", 2*60*60)), AmountCents: -4000, Description: "Court rental"},
+		// batched transfers are split
+		{Line: 3, BookedAt: time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC), AmountCents: 1200, Description: "g1 C3d4"},
+		{Line: 3, BookedAt: time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC), AmountCents: 800, Description: "g1 E5f6"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("expected %+v, got %+v", want, got)
+	}
+	for i := range want {
+		if got[i].Line != want[i].Line || !got[i].BookedAt.Equal(want[i].BookedAt) || got[i].AmountCents != want[i].AmountCents || got[i].Description != want[i].Description {
+			t.Errorf("transaction %d: expected %+v, got %+v", i, want[i], got[i])
+		}
+	}
+}
+
+func TestParseCAMT053_Errors(t *testing.T) {
+	if _, err := ParseCAMT053(strings.NewReader("Date,Description,Amount\n")); err == nil {
+		t.Errorf("expected an error for a statement that isn't XML")
+	}
+}
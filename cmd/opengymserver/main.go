@@ -20,8 +20,13 @@ import (
 	"github.com/dmateusp/opengym/db"
 	"github.com/dmateusp/opengym/demo"
 	"github.com/dmateusp/opengym/flagfromenv"
+	"github.com/dmateusp/opengym/hub"
 	"github.com/dmateusp/opengym/log"
+	"github.com/dmateusp/opengym/notify"
+	"github.com/dmateusp/opengym/outbox"
 	"github.com/dmateusp/opengym/panics"
+	"github.com/dmateusp/opengym/scheduler"
+	"github.com/dmateusp/opengym/webhook"
 	"github.com/pressly/goose/v3"
 
 	"github.com/lmittmann/tint"
@@ -33,6 +38,9 @@ var (
 
 	dbPath          = flag.String("db.path", "./opengym.db", "database path")
 	dbRunMigrations = flag.Bool("db.run-migrations", false, "whether to run the database migrations on start")
+
+	spotsCheckOnStart  = flag.Bool("spots.check-on-start", false, "whether to check on start that the spots left of the games match their participants")
+	spotsRepairOnStart = flag.Bool("spots.repair-on-start", false, "whether to fix on start the spots left of the games that don't match their participants")
 )
 
 func main() {
@@ -117,8 +125,60 @@ func main() {
 		}
 	}
 
+	sender, err := notify.NewSenderFromFlags()
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to set up notifications", "error", err)
+		os.Exit(1)
+	}
+
+	liveUpdates := hub.New()
+
+	srv := server.NewServer(
+		db.NewQuerierWrapper(querier),
+		server.NewRandomAlphanumericGenerator(),
+		clock.RealClock{},
+		dbConn,
+		server.WithNotifier(notify.NewNotifier(sender)),
+		server.WithHub(liveUpdates),
+	)
+
+	if *spotsCheckOnStart || *spotsRepairOnStart {
+		if _, err := srv.CheckSpotsLeft(log.WithLogger(ctx, logger), *spotsRepairOnStart); err != nil {
+			logger.ErrorContext(ctx, "Failed to check game spots left", "error", err)
+			os.Exit(1)
+		}
+	}
+
+	// Generate the games of recurring series in the background
+	go srv.RunSeriesMaterializer(log.WithLogger(ctx, logger))
+
+	// Fire the lifecycle events of games (published, frozen, started, ended) and their deadlines in the background
+	gameScheduler := scheduler.New(db.NewQuerierWrapper(querier), dbConn, clock.RealClock{})
+	for _, eventType := range []scheduler.EventType{scheduler.EventGamePublished, scheduler.EventGameFrozen, scheduler.EventGameStarted, scheduler.EventGameEnded} {
+		gameScheduler.On(eventType, srv.RecordLifecycleEvent)
+	}
+	// Draw the lottery games once their registration closes
+	gameScheduler.On(scheduler.EventRegistrationClosed, srv.DrawLottery)
+	// Release the spots of participants who didn't reconfirm in time after important details of their game changed
+	gameScheduler.On(scheduler.EventReconfirmationDue, srv.ReleaseUnconfirmedSpots)
+	// Move the spots offered to waitlisted participants on once the offers expire
+	gameScheduler.On(scheduler.EventWaitlistOfferExpired, srv.ExpireWaitlistOffers)
+	go gameScheduler.Run(log.WithLogger(ctx, logger))
+
+	// Deliver the domain events recorded by the handlers and the scheduler
+	dispatcher := outbox.NewDispatcher(db.NewQuerierWrapper(querier), clock.RealClock{})
+	dispatcher.Register("notifications", srv.NotifyOnEvent)
+	dispatcher.Register("live-updates", liveUpdates.Publish)
+
+	// Send the events to the webhooks registered by organizers
+	webhookDeliverer := webhook.NewDeliverer(db.NewQuerierWrapper(querier), clock.RealClock{}, webhook.NewClient())
+	dispatcher.Register("webhooks", webhookDeliverer.Enqueue)
+	go webhookDeliverer.Run(log.WithLogger(ctx, logger))
+
+	go dispatcher.Run(log.WithLogger(ctx, logger))
+
 	// Create the API handler with auth and logging middleware
-	apiHandler := api.HandlerWithOptions(server.NewServer(db.NewQuerierWrapper(querier), server.NewRandomAlphanumericGenerator(), clock.RealClock{}, dbConn), api.StdHTTPServerOptions{
+	apiHandler := api.HandlerWithOptions(srv, api.StdHTTPServerOptions{
 		Middlewares: []api.MiddlewareFunc{ // Middleware is executed last to first
 			auth.AuthMiddleware,
 			panics.PanicsCatcherMiddleware,
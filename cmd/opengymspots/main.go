@@ -0,0 +1,62 @@
+// Command opengymspots checks that the spots left of the games, stored at write time, match their participants.
+// It exits with a non-zero status when some games drifted, unless they're repaired with -repair.
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/dmateusp/opengym/api/server"
+	"github.com/dmateusp/opengym/clock"
+	"github.com/dmateusp/opengym/db"
+	"github.com/dmateusp/opengym/flagfromenv"
+	"github.com/dmateusp/opengym/log"
+
+	"github.com/lmittmann/tint"
+)
+
+var (
+	dbPath = flag.String("db.path", "./opengym.db", "database path")
+	repair = flag.Bool("repair", false, "whether to fix the spots left of the games that drifted, in one transaction")
+)
+
+func main() {
+	ctx := context.Background()
+	logger := slog.New(tint.NewHandler(os.Stderr, &tint.Options{
+		Level:      slog.LevelInfo,
+		TimeFormat: time.Kitchen,
+	}))
+
+	flag.Parse()
+
+	if err := flagfromenv.Parse("OPENGYM"); err != nil {
+		logger.ErrorContext(ctx, "Failed to parse flags from environment", "error", err)
+		os.Exit(1)
+	}
+
+	dbConn, err := sql.Open("sqlite", *dbPath)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to open database", "error", err)
+		os.Exit(1)
+	}
+	defer dbConn.Close()
+
+	srv := server.NewServer(db.NewQuerierWrapper(db.New(dbConn)), server.NewRandomAlphanumericGenerator(), clock.RealClock{}, dbConn)
+	mismatches, err := srv.CheckSpotsLeft(log.WithLogger(ctx, logger), *repair)
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to check game spots left", "error", err)
+		os.Exit(1)
+	}
+
+	for _, mismatch := range mismatches {
+		fmt.Printf("%s\tstored=%d\tcomputed=%d\tstored_waitlist=%d\tcomputed_waitlist=%d\n", mismatch.GameID, mismatch.Stored, mismatch.Computed, mismatch.StoredWaitlist, mismatch.ComputedWaitlist)
+	}
+	if len(mismatches) > 0 && !*repair {
+		os.Exit(1)
+	}
+}
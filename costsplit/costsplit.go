@@ -0,0 +1,146 @@
+// Package costsplit splits the price of a game between the participants billed for it.
+package costsplit
+
+// Share is a participant billed for a game.
+type Share struct {
+	// Spots is the number of spots the participant is billed for: themselves and their guests.
+	Spots int64
+	// Organizer is set for the organizer of the game.
+	Organizer bool
+}
+
+// Guests is the number of guests the participant is billed for.
+func (s Share) Guests() int64 {
+	return max(s.Spots-1, 0)
+}
+
+// Strategy splits the price of a game between the participants billed for it.
+type Strategy interface {
+	// Split returns the amount owed by each share in cents, in the order of the shares.
+	Split(shares []Share) []int64
+	// Total returns the amount collected from the shares in cents, the amounts owed always add up to it.
+	Total(shares []Share) int64
+}
+
+// Even splits the total price evenly between the spots. The cents left over go one per spot to the first shares,
+// so the amounts owed add up to the total price exactly, unless nobody is billed.
+type Even struct {
+	TotalCents int64
+}
+
+func (s Even) Split(shares []Share) []int64 {
+	spots := make([]int64, len(shares))
+	for i, share := range shares {
+		spots[i] = max(share.Spots, 0)
+	}
+	return splitEvenly(s.TotalCents, spots)
+}
+
+func (s Even) Total(shares []Share) int64 {
+	if totalSpots(shares) == 0 {
+		return 0
+	}
+	return s.TotalCents
+}
+
+// PerPlayer charges a fixed price for every spot, guests included.
+type PerPlayer struct {
+	PriceCents int64
+}
+
+func (s PerPlayer) Split(shares []Share) []int64 {
+	amounts := make([]int64, len(shares))
+	for i, share := range shares {
+		amounts[i] = s.PriceCents * max(share.Spots, 0)
+	}
+	return amounts
+}
+
+func (s PerPlayer) Total(shares []Share) int64 {
+	return s.PriceCents * totalSpots(shares)
+}
+
+// PerPlayerWithGuestRate charges a fixed price for every participant, and a different one for each of their guests.
+type PerPlayerWithGuestRate struct {
+	PriceCents      int64
+	GuestPriceCents int64
+}
+
+func (s PerPlayerWithGuestRate) Split(shares []Share) []int64 {
+	amounts := make([]int64, len(shares))
+	for i, share := range shares {
+		if share.Spots > 0 {
+			amounts[i] = s.PriceCents + s.GuestPriceCents*share.Guests()
+		}
+	}
+	return amounts
+}
+
+func (s PerPlayerWithGuestRate) Total(shares []Share) int64 {
+	total := int64(0)
+	for _, share := range shares {
+		if share.Spots > 0 {
+			total += s.PriceCents + s.GuestPriceCents*share.Guests()
+		}
+	}
+	return total
+}
+
+// OrganizerExempt splits the total price evenly like [Even], between the spots of everyone but the organizer.
+// The guests of the organizer still pay, the organizer covers the total price when nobody else is billed.
+type OrganizerExempt struct {
+	TotalCents int64
+}
+
+func (s OrganizerExempt) Split(shares []Share) []int64 {
+	return splitEvenly(s.TotalCents, payingSpots(shares))
+}
+
+func (s OrganizerExempt) Total(shares []Share) int64 {
+	for _, spots := range payingSpots(shares) {
+		if spots > 0 {
+			return s.TotalCents
+		}
+	}
+	return 0
+}
+
+// payingSpots returns the number of spots each share pays for, the organizer doesn't pay for themselves.
+func payingSpots(shares []Share) []int64 {
+	spots := make([]int64, len(shares))
+	for i, share := range shares {
+		spots[i] = max(share.Spots, 0)
+		if share.Organizer && spots[i] > 0 {
+			spots[i]--
+		}
+	}
+	return spots
+}
+
+// splitEvenly splits total between the spots of each share, the remainder going one cent per spot to the first shares.
+func splitEvenly(total int64, spots []int64) []int64 {
+	amounts := make([]int64, len(spots))
+	sum := int64(0)
+	for _, s := range spots {
+		sum += s
+	}
+	if sum == 0 {
+		return amounts
+	}
+
+	perSpot, remainder := total/sum, total%sum
+	for i, s := range spots {
+		extra := min(s, remainder)
+		remainder -= extra
+		amounts[i] = perSpot*s + extra
+	}
+	return amounts
+}
+
+func totalSpots(shares []Share) int64 {
+	total := int64(0)
+	for _, share := range shares {
+		total += max(share.Spots, 0)
+	}
+	return total
+}
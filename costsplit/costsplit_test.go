@@ -0,0 +1,135 @@
+package costsplit
+
+import (
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+	"testing/quick"
+)
+
+func TestStrategies(t *testing.T) {
+	shares := []Share{
+		{Spots: 1, Organizer: true},
+		{Spots: 3}, // 2 guests
+		{Spots: 1},
+	}
+
+	tests := []struct {
+		name     string
+		strategy Strategy
+		want     []int64
+	}{
+		// 1000 / 5 spots, no remainder
+		{"even", Even{TotalCents: 1000}, []int64{200, 600, 200}},
+		// 1001 / 5 spots, the cent left over goes to the first spot
+		{"even with a remainder", Even{TotalCents: 1001}, []int64{201, 600, 200}},
+		// 1003 / 5 spots, the 3 cents left over go to the first 3 spots
+		{"even with a larger remainder", Even{TotalCents: 1003}, []int64{201, 602, 200}},
+		{"per player", PerPlayer{PriceCents: 300}, []int64{300, 900, 300}},
+		{"per player with a guest rate", PerPlayerWithGuestRate{PriceCents: 300, GuestPriceCents: 100}, []int64{300, 500, 300}},
+		// 1000 / 4 spots, the organizer doesn't pay
+		{"organizer exempt", OrganizerExempt{TotalCents: 1000}, []int64{0, 750, 250}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.strategy.Split(shares)
+			if !slices.Equal(got, test.want) {
+				t.Errorf("expected %v, got %v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestStrategies_NobodyBilled(t *testing.T) {
+	organizerAlone := []Share{{Spots: 1, Organizer: true}}
+	if total := (OrganizerExempt{TotalCents: 1000}).Total(organizerAlone); total != 0 {
+		t.Errorf("expected the organizer alone not to be billed, got %d", total)
+	}
+	if total := (Even{TotalCents: 1000}).Total(nil); total != 0 {
+		t.Errorf("expected nothing to be collected without participants, got %d", total)
+	}
+}
+
+// bill is a random bill for property-based tests.
+type bill struct {
+	Strategy Strategy
+	Shares   []Share
+}
+
+func (bill) Generate(r *rand.Rand, size int) reflect.Value {
+	strategies := []Strategy{
+		Even{TotalCents: r.Int63n(100_000)},
+		PerPlayer{PriceCents: r.Int63n(10_000)},
+		PerPlayerWithGuestRate{PriceCents: r.Int63n(10_000), GuestPriceCents: r.Int63n(10_000)},
+		OrganizerExempt{TotalCents: r.Int63n(100_000)},
+	}
+	b := bill{Strategy: strategies[r.Intn(len(strategies))]}
+	for i := range r.Intn(size + 1) {
+		b.Shares = append(b.Shares, Share{Spots: 1 + r.Int63n(4), Organizer: i == 0 && r.Intn(2) == 0})
+	}
+	return reflect.ValueOf(b)
+}
+
+func TestStrategies_Invariants(t *testing.T) {
+	properties := map[string]func(b bill) bool{
+		"the amounts owed add up to the total": func(b bill) bool {
+			sum := int64(0)
+			for _, amount := range b.Strategy.Split(b.Shares) {
+				sum += amount
+			}
+			return sum == b.Strategy.Total(b.Shares)
+		},
+		"an amount per share, never negative": func(b bill) bool {
+			amounts := b.Strategy.Split(b.Shares)
+			if len(amounts) != len(b.Shares) {
+				return false
+			}
+			for _, amount := range amounts {
+				if amount < 0 {
+					return false
+				}
+			}
+			return true
+		},
+		"spots split evenly cost the same, to the cent": func(b bill) bool {
+			var spots []int64
+			switch b.Strategy.(type) {
+			case Even:
+				for _, share := range b.Shares {
+					spots = append(spots, share.Spots)
+				}
+			case OrganizerExempt:
+				spots = payingSpots(b.Shares)
+			default:
+				return true
+			}
+
+			sum := int64(0)
+			for _, s := range spots {
+				sum += s
+			}
+			if sum == 0 {
+				return true
+			}
+
+			// every spot costs the total divided by the spots, rounded down or up
+			perSpot := b.Strategy.Total(b.Shares) / sum
+			for i, amount := range b.Strategy.Split(b.Shares) {
+				if amount < perSpot*spots[i] || amount > (perSpot+1)*spots[i] {
+					return false
+				}
+			}
+			return true
+		},
+	}
+
+	for name, property := range properties {
+		t.Run(name, func(t *testing.T) {
+			if err := quick.Check(property, &quick.Config{MaxCount: 500}); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+-- name: GameUpdateCheckInCode :exec
+update games
+set
+  updated_at = current_timestamp,
+  check_in_code = sqlc.arg(check_in_code)
+where id = sqlc.arg(id);
+
+-- name: ParticipantSetCheckedIn :execrows
+-- Checks the participant in, or undoes their check-in when checked_in_at is null.
+update game_participants
+set
+  updated_at = current_timestamp,
+  checked_in_at = sqlc.arg(checked_in_at)
+where game_id = sqlc.arg(game_id)
+  and user_id = sqlc.arg(user_id);
+
+-- name: GameListByGroup :many
+-- Lists the games of the group, the caller filters the ones that ended.
+select *
+from games
+where group_id = sqlc.arg(group_id)
+  and deleted_at is null
+  and cancelled_at is null
+order by starts_at asc;
@@ -0,0 +1,126 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: attendance.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+)
+
+const gameListByGroup = `-- name: GameListByGroup :many
+select id, organizer_id, name, description, published_at, total_price_cents, location, starts_at, duration_minutes, max_players, max_guests_per_player, game_spots_left, created_at, updated_at, frozen_at, series_id, series_occurrence_at, group_id, is_private, waitlist_offer_minutes, allocation_mode, registration_closes_at, lottery_seed, lottery_drawn_at, regulars_head_start_minutes, regulars_first, waitlist_mode, max_waitlist_size, waitlist_spots_left, reconfirm_within_minutes, cancellation_deadline_minutes, bill_late_cancellations, check_in_code, cancelled_at, cancellation_reason, deleted_at, split_strategy, price_per_player_cents, guest_price_cents
+from games
+where group_id = ?1
+  and deleted_at is null
+  and cancelled_at is null
+order by starts_at asc
+`
+
+// Lists the games of the group, the caller filters the ones that ended.
+func (q *Queries) GameListByGroup(ctx context.Context, groupID sql.NullString) ([]Game, error) {
+	rows, err := q.db.QueryContext(ctx, gameListByGroup, groupID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Game
+	for rows.Next() {
+		var i Game
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizerID,
+			&i.Name,
+			&i.Description,
+			&i.PublishedAt,
+			&i.TotalPriceCents,
+			&i.Location,
+			&i.StartsAt,
+			&i.DurationMinutes,
+			&i.MaxPlayers,
+			&i.MaxGuestsPerPlayer,
+			&i.GameSpotsLeft,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.FrozenAt,
+			&i.SeriesID,
+			&i.SeriesOccurrenceAt,
+			&i.GroupID,
+			&i.IsPrivate,
+			&i.WaitlistOfferMinutes,
+			&i.AllocationMode,
+			&i.RegistrationClosesAt,
+			&i.LotterySeed,
+			&i.LotteryDrawnAt,
+			&i.RegularsHeadStartMinutes,
+			&i.RegularsFirst,
+			&i.WaitlistMode,
+			&i.MaxWaitlistSize,
+			&i.WaitlistSpotsLeft,
+			&i.ReconfirmWithinMinutes,
+			&i.CancellationDeadlineMinutes,
+			&i.BillLateCancellations,
+			&i.CheckInCode,
+			&i.CancelledAt,
+			&i.CancellationReason,
+			&i.DeletedAt,
+			&i.SplitStrategy,
+			&i.PricePerPlayerCents,
+			&i.GuestPriceCents,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const gameUpdateCheckInCode = `-- name: GameUpdateCheckInCode :exec
+update games
+set
+  updated_at = current_timestamp,
+  check_in_code = ?1
+where id = ?2
+`
+
+type GameUpdateCheckInCodeParams struct {
+	CheckInCode sql.NullString
+	ID          string
+}
+
+func (q *Queries) GameUpdateCheckInCode(ctx context.Context, arg GameUpdateCheckInCodeParams) error {
+	_, err := q.db.ExecContext(ctx, gameUpdateCheckInCode, arg.CheckInCode, arg.ID)
+	return err
+}
+
+const participantSetCheckedIn = `-- name: ParticipantSetCheckedIn :execrows
+update game_participants
+set
+  updated_at = current_timestamp,
+  checked_in_at = ?1
+where game_id = ?2
+  and user_id = ?3
+`
+
+type ParticipantSetCheckedInParams struct {
+	CheckedInAt sql.NullTime
+	GameID      string
+	UserID      int64
+}
+
+// Checks the participant in, or undoes their check-in when checked_in_at is null.
+func (q *Queries) ParticipantSetCheckedIn(ctx context.Context, arg ParticipantSetCheckedInParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, participantSetCheckedIn, arg.CheckedInAt, arg.GameID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -0,0 +1,18 @@
+-- name: GameCancel :execrows
+update games
+set
+  updated_at = current_timestamp,
+  cancelled_at = sqlc.arg(cancelled_at),
+  cancellation_reason = sqlc.arg(cancellation_reason)
+where id = sqlc.arg(id)
+  and cancelled_at is null
+  and deleted_at is null;
+
+-- name: GameDelete :execrows
+-- Soft deletes the game, the row is kept but the game is hidden everywhere.
+update games
+set
+  updated_at = current_timestamp,
+  deleted_at = sqlc.arg(deleted_at)
+where id = sqlc.arg(id)
+  and deleted_at is null;
@@ -0,0 +1,59 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: cancellations.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+)
+
+const gameCancel = `-- name: GameCancel :execrows
+update games
+set
+  updated_at = current_timestamp,
+  cancelled_at = ?1,
+  cancellation_reason = ?2
+where id = ?3
+  and cancelled_at is null
+  and deleted_at is null
+`
+
+type GameCancelParams struct {
+	CancelledAt        sql.NullTime
+	CancellationReason sql.NullString
+	ID                 string
+}
+
+func (q *Queries) GameCancel(ctx context.Context, arg GameCancelParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, gameCancel, arg.CancelledAt, arg.CancellationReason, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const gameDelete = `-- name: GameDelete :execrows
+update games
+set
+  updated_at = current_timestamp,
+  deleted_at = ?1
+where id = ?2
+  and deleted_at is null
+`
+
+type GameDeleteParams struct {
+	DeletedAt sql.NullTime
+	ID        string
+}
+
+// Soft deletes the game, the row is kept but the game is hidden everywhere.
+func (q *Queries) GameDelete(ctx context.Context, arg GameDeleteParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, gameDelete, arg.DeletedAt, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
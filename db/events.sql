@@ -0,0 +1,46 @@
+-- name: EventCreate :one
+insert into events(
+  event_type,
+  game_id,
+  payload
+) values (?, ?, ?)
+returning id;
+
+-- name: EventListPending :many
+-- Lists the events that are due, the events waiting for their backoff don't hold back the next ones.
+-- next_attempt_at is written in UTC, so it compares with now in UTC.
+select *
+from events
+where delivered_at is null and failed_at is null
+  and (next_attempt_at is null or next_attempt_at <= sqlc.arg(now))
+order by id
+limit sqlc.arg(limit);
+
+-- name: EventDeliveryListConsumers :many
+select consumer
+from event_deliveries
+where event_id = ?;
+
+-- name: EventDeliveryCreate :exec
+insert into event_deliveries(event_id, consumer, delivered_at)
+values (?, ?, ?)
+on conflict do nothing;
+
+-- name: EventMarkDelivered :exec
+update events
+set delivered_at = sqlc.arg(delivered_at)
+where id = sqlc.arg(id);
+
+-- name: EventMarkAttemptFailed :exec
+update events
+set
+  attempts = attempts + 1,
+  next_attempt_at = sqlc.arg(next_attempt_at),
+  last_error = sqlc.arg(last_error),
+  failed_at = sqlc.arg(failed_at)
+where id = sqlc.arg(id);
+
+-- name: EventMaxIdByGame :one
+select cast(coalesce(max(id), 0) as integer)
+from events
+where game_id = ?;
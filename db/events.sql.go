@@ -0,0 +1,186 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: events.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const eventCreate = `-- name: EventCreate :one
+insert into events(
+  event_type,
+  game_id,
+  payload
+) values (?, ?, ?)
+returning id
+`
+
+type EventCreateParams struct {
+	EventType string
+	GameID    string
+	Payload   string
+}
+
+func (q *Queries) EventCreate(ctx context.Context, arg EventCreateParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, eventCreate, arg.EventType, arg.GameID, arg.Payload)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const eventDeliveryCreate = `-- name: EventDeliveryCreate :exec
+insert into event_deliveries(event_id, consumer, delivered_at)
+values (?, ?, ?)
+on conflict do nothing
+`
+
+type EventDeliveryCreateParams struct {
+	EventID     int64
+	Consumer    string
+	DeliveredAt time.Time
+}
+
+func (q *Queries) EventDeliveryCreate(ctx context.Context, arg EventDeliveryCreateParams) error {
+	_, err := q.db.ExecContext(ctx, eventDeliveryCreate, arg.EventID, arg.Consumer, arg.DeliveredAt)
+	return err
+}
+
+const eventDeliveryListConsumers = `-- name: EventDeliveryListConsumers :many
+select consumer
+from event_deliveries
+where event_id = ?
+`
+
+func (q *Queries) EventDeliveryListConsumers(ctx context.Context, eventID int64) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, eventDeliveryListConsumers, eventID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var consumer string
+		if err := rows.Scan(&consumer); err != nil {
+			return nil, err
+		}
+		items = append(items, consumer)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const eventListPending = `-- name: EventListPending :many
+select id, event_type, game_id, payload, created_at, attempts, next_attempt_at, last_error, delivered_at, failed_at
+from events
+where delivered_at is null and failed_at is null
+  and (next_attempt_at is null or next_attempt_at <= ?1)
+order by id
+limit ?2
+`
+
+type EventListPendingParams struct {
+	Now   sql.NullTime
+	Limit int64
+}
+
+// Lists the events that are due, the events waiting for their backoff don't hold back the next ones.
+// next_attempt_at is written in UTC, so it compares with now in UTC.
+func (q *Queries) EventListPending(ctx context.Context, arg EventListPendingParams) ([]Event, error) {
+	rows, err := q.db.QueryContext(ctx, eventListPending, arg.Now, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Event
+	for rows.Next() {
+		var i Event
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventType,
+			&i.GameID,
+			&i.Payload,
+			&i.CreatedAt,
+			&i.Attempts,
+			&i.NextAttemptAt,
+			&i.LastError,
+			&i.DeliveredAt,
+			&i.FailedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const eventMarkAttemptFailed = `-- name: EventMarkAttemptFailed :exec
+update events
+set
+  attempts = attempts + 1,
+  next_attempt_at = ?1,
+  last_error = ?2,
+  failed_at = ?3
+where id = ?4
+`
+
+type EventMarkAttemptFailedParams struct {
+	NextAttemptAt sql.NullTime
+	LastError     sql.NullString
+	FailedAt      sql.NullTime
+	ID            int64
+}
+
+func (q *Queries) EventMarkAttemptFailed(ctx context.Context, arg EventMarkAttemptFailedParams) error {
+	_, err := q.db.ExecContext(ctx, eventMarkAttemptFailed,
+		arg.NextAttemptAt,
+		arg.LastError,
+		arg.FailedAt,
+		arg.ID,
+	)
+	return err
+}
+
+const eventMarkDelivered = `-- name: EventMarkDelivered :exec
+update events
+set delivered_at = ?1
+where id = ?2
+`
+
+type EventMarkDeliveredParams struct {
+	DeliveredAt sql.NullTime
+	ID          int64
+}
+
+func (q *Queries) EventMarkDelivered(ctx context.Context, arg EventMarkDeliveredParams) error {
+	_, err := q.db.ExecContext(ctx, eventMarkDelivered, arg.DeliveredAt, arg.ID)
+	return err
+}
+
+const eventMaxIdByGame = `-- name: EventMaxIdByGame :one
+select cast(coalesce(max(id), 0) as integer)
+from events
+where game_id = ?
+`
+
+func (q *Queries) EventMaxIdByGame(ctx context.Context, gameID string) (int64, error) {
+	row := q.db.QueryRowContext(ctx, eventMaxIdByGame, gameID)
+	var column_1 int64
+	err := row.Scan(&column_1)
+	return column_1, err
+}
@@ -0,0 +1,53 @@
+-- name: GameRoleGet :one
+select role
+from game_roles
+where game_id = sqlc.arg(game_id)
+    and user_id = sqlc.arg(user_id);
+
+-- name: GameRoleGetWithUser :one
+select
+    sqlc.embed(game_roles),
+    sqlc.embed(users)
+from game_roles
+join users on users.id = game_roles.user_id
+where game_roles.game_id = sqlc.arg(game_id)
+    and game_roles.user_id = sqlc.arg(user_id);
+
+-- name: GameRoleListByGame :many
+select
+    sqlc.embed(game_roles),
+    sqlc.embed(users)
+from game_roles
+join users on users.id = game_roles.user_id
+where game_roles.game_id = ?
+order by game_roles.created_at asc, game_roles.user_id asc;
+
+-- name: GameRoleCountByRole :one
+select count(*)
+from game_roles
+where game_id = sqlc.arg(game_id)
+    and role = sqlc.arg(role);
+
+-- name: GameRoleUpsert :exec
+insert into game_roles(
+    game_id,
+    user_id,
+    role
+) values (?, ?, ?)
+on conflict(game_id, user_id) do update set
+    role = excluded.role,
+    updated_at = current_timestamp;
+
+-- name: GameRoleDelete :execrows
+delete from game_roles
+where game_id = sqlc.arg(game_id)
+    and user_id = sqlc.arg(user_id);
+
+-- name: GameRoleFirstUserByRole :one
+-- Returns the user given the role first in the game.
+select user_id
+from game_roles
+where game_id = sqlc.arg(game_id)
+    and role = sqlc.arg(role)
+order by created_at asc, user_id asc
+limit 1;
@@ -0,0 +1,204 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: game_roles.sql
+
+package db
+
+import (
+	"context"
+)
+
+const gameRoleCountByRole = `-- name: GameRoleCountByRole :one
+select count(*)
+from game_roles
+where game_id = ?1
+    and role = ?2
+`
+
+type GameRoleCountByRoleParams struct {
+	GameID string
+	Role   string
+}
+
+func (q *Queries) GameRoleCountByRole(ctx context.Context, arg GameRoleCountByRoleParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, gameRoleCountByRole, arg.GameID, arg.Role)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const gameRoleDelete = `-- name: GameRoleDelete :execrows
+delete from game_roles
+where game_id = ?1
+    and user_id = ?2
+`
+
+type GameRoleDeleteParams struct {
+	GameID string
+	UserID int64
+}
+
+func (q *Queries) GameRoleDelete(ctx context.Context, arg GameRoleDeleteParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, gameRoleDelete, arg.GameID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const gameRoleFirstUserByRole = `-- name: GameRoleFirstUserByRole :one
+select user_id
+from game_roles
+where game_id = ?1
+    and role = ?2
+order by created_at asc, user_id asc
+limit 1
+`
+
+type GameRoleFirstUserByRoleParams struct {
+	GameID string
+	Role   string
+}
+
+// Returns the user given the role first in the game.
+func (q *Queries) GameRoleFirstUserByRole(ctx context.Context, arg GameRoleFirstUserByRoleParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, gameRoleFirstUserByRole, arg.GameID, arg.Role)
+	var user_id int64
+	err := row.Scan(&user_id)
+	return user_id, err
+}
+
+const gameRoleGet = `-- name: GameRoleGet :one
+select role
+from game_roles
+where game_id = ?1
+    and user_id = ?2
+`
+
+type GameRoleGetParams struct {
+	GameID string
+	UserID int64
+}
+
+func (q *Queries) GameRoleGet(ctx context.Context, arg GameRoleGetParams) (string, error) {
+	row := q.db.QueryRowContext(ctx, gameRoleGet, arg.GameID, arg.UserID)
+	var role string
+	err := row.Scan(&role)
+	return role, err
+}
+
+const gameRoleGetWithUser = `-- name: GameRoleGetWithUser :one
+select
+    game_roles.game_id, game_roles.user_id, game_roles.role, game_roles.created_at, game_roles.updated_at,
+    users.id, users.name, users.email, users.photo, users.created_at, users.updated_at, users.is_demo, users.is_placeholder
+from game_roles
+join users on users.id = game_roles.user_id
+where game_roles.game_id = ?1
+    and game_roles.user_id = ?2
+`
+
+type GameRoleGetWithUserParams struct {
+	GameID string
+	UserID int64
+}
+
+type GameRoleGetWithUserRow struct {
+	GameRole GameRole
+	User     User
+}
+
+func (q *Queries) GameRoleGetWithUser(ctx context.Context, arg GameRoleGetWithUserParams) (GameRoleGetWithUserRow, error) {
+	row := q.db.QueryRowContext(ctx, gameRoleGetWithUser, arg.GameID, arg.UserID)
+	var i GameRoleGetWithUserRow
+	err := row.Scan(
+		&i.GameRole.GameID,
+		&i.GameRole.UserID,
+		&i.GameRole.Role,
+		&i.GameRole.CreatedAt,
+		&i.GameRole.UpdatedAt,
+		&i.User.ID,
+		&i.User.Name,
+		&i.User.Email,
+		&i.User.Photo,
+		&i.User.CreatedAt,
+		&i.User.UpdatedAt,
+		&i.User.IsDemo,
+		&i.User.IsPlaceholder,
+	)
+	return i, err
+}
+
+const gameRoleListByGame = `-- name: GameRoleListByGame :many
+select
+    game_roles.game_id, game_roles.user_id, game_roles.role, game_roles.created_at, game_roles.updated_at,
+    users.id, users.name, users.email, users.photo, users.created_at, users.updated_at, users.is_demo, users.is_placeholder
+from game_roles
+join users on users.id = game_roles.user_id
+where game_roles.game_id = ?
+order by game_roles.created_at asc, game_roles.user_id asc
+`
+
+type GameRoleListByGameRow struct {
+	GameRole GameRole
+	User     User
+}
+
+func (q *Queries) GameRoleListByGame(ctx context.Context, gameID string) ([]GameRoleListByGameRow, error) {
+	rows, err := q.db.QueryContext(ctx, gameRoleListByGame, gameID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GameRoleListByGameRow
+	for rows.Next() {
+		var i GameRoleListByGameRow
+		if err := rows.Scan(
+			&i.GameRole.GameID,
+			&i.GameRole.UserID,
+			&i.GameRole.Role,
+			&i.GameRole.CreatedAt,
+			&i.GameRole.UpdatedAt,
+			&i.User.ID,
+			&i.User.Name,
+			&i.User.Email,
+			&i.User.Photo,
+			&i.User.CreatedAt,
+			&i.User.UpdatedAt,
+			&i.User.IsDemo,
+			&i.User.IsPlaceholder,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const gameRoleUpsert = `-- name: GameRoleUpsert :exec
+insert into game_roles(
+    game_id,
+    user_id,
+    role
+) values (?, ?, ?)
+on conflict(game_id, user_id) do update set
+    role = excluded.role,
+    updated_at = current_timestamp
+`
+
+type GameRoleUpsertParams struct {
+	GameID string
+	UserID int64
+	Role   string
+}
+
+func (q *Queries) GameRoleUpsert(ctx context.Context, arg GameRoleUpsertParams) error {
+	_, err := q.db.ExecContext(ctx, gameRoleUpsert, arg.GameID, arg.UserID, arg.Role)
+	return err
+}
@@ -11,8 +11,60 @@ insert into games(
   duration_minutes,
   max_players,
   max_guests_per_player,
-  game_spots_left
-) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+  game_spots_left,
+  series_id,
+  series_occurrence_at,
+  group_id,
+  is_private,
+  waitlist_offer_minutes,
+  allocation_mode,
+  registration_closes_at,
+  regulars_head_start_minutes,
+  regulars_first,
+  waitlist_mode,
+  max_waitlist_size,
+  waitlist_spots_left,
+  reconfirm_within_minutes,
+  cancellation_deadline_minutes,
+  bill_late_cancellations,
+  split_strategy,
+  price_per_player_cents,
+  guest_price_cents
+) values (
+  sqlc.arg(id),
+  sqlc.arg(organizer_id),
+  sqlc.arg(name),
+  sqlc.arg(description),
+  sqlc.arg(published_at),
+  sqlc.arg(total_price_cents),
+  sqlc.arg(location),
+  sqlc.arg(starts_at),
+  sqlc.arg(duration_minutes),
+  sqlc.arg(max_players),
+  sqlc.arg(max_guests_per_player),
+  sqlc.arg(game_spots_left),
+  sqlc.arg(series_id),
+  sqlc.arg(series_occurrence_at),
+  sqlc.arg(group_id),
+  sqlc.arg(is_private),
+  sqlc.arg(waitlist_offer_minutes),
+  -- games created without an allocation mode are first come, first served
+  coalesce(nullif(cast(sqlc.arg(allocation_mode) as text), ''), 'first_come_first_served'),
+  sqlc.arg(registration_closes_at),
+  sqlc.arg(regulars_head_start_minutes),
+  sqlc.arg(regulars_first),
+  -- games created without a waitlist mode have an unlimited waitlist
+  coalesce(nullif(cast(sqlc.arg(waitlist_mode) as text), ''), 'unlimited'),
+  sqlc.arg(max_waitlist_size),
+  sqlc.arg(waitlist_spots_left),
+  sqlc.arg(reconfirm_within_minutes),
+  sqlc.arg(cancellation_deadline_minutes),
+  sqlc.arg(bill_late_cancellations),
+  -- games created without a split strategy split their price evenly
+  coalesce(nullif(cast(sqlc.arg(split_strategy) as text), ''), 'even'),
+  sqlc.arg(price_per_player_cents),
+  sqlc.arg(guest_price_cents)
+)
 returning *;
 
 -- name: GameGetByIdWithOrganizer :one
@@ -22,11 +74,19 @@ select
 from games
 join users
   on users.id = games.organizer_id
-where games.id = ?;
+where games.id = ?
+  and games.deleted_at is null;
 
 -- name: GameGetById :one
 select *
 from games
+where games.id = ?
+  and games.deleted_at is null;
+
+-- name: GameGetByIdWithDeleted :one
+-- Deleted games are only retrieved to deliver the event published when they were deleted.
+select *
+from games
 where games.id = ?;
 
 -- name: GameGetPublicInfoById :one
@@ -36,12 +96,19 @@ select
   games.published_at,
   games.starts_at,
   games.game_spots_left,
+  games.waitlist_mode,
+  games.waitlist_spots_left,
+  games.group_id,
+  games.is_private,
+  games.cancelled_at,
+  games.cancellation_reason,
   users.name as organizer_name,
   users.photo as organizer_photo
 from games
 join users
   on users.id = games.organizer_id
-where games.id = ?;
+where games.id = ?
+  and games.deleted_at is null;
 
 -- name: GameUpdate :exec
 update games
@@ -63,10 +130,28 @@ set
   max_players = coalesce(nullif(cast(sqlc.narg(max_players) as integer), 0), max_players),
   max_guests_per_player = coalesce(sqlc.narg(max_guests_per_player), max_guests_per_player),
   game_spots_left = coalesce(sqlc.narg(game_spots_left), game_spots_left),
+  is_private = coalesce(sqlc.narg(is_private), is_private),
+  waitlist_offer_minutes = coalesce(sqlc.narg(waitlist_offer_minutes), waitlist_offer_minutes),
+  allocation_mode = coalesce(sqlc.narg(allocation_mode), allocation_mode),
+  registration_closes_at = coalesce(sqlc.narg(registration_closes_at), registration_closes_at),
+  regulars_head_start_minutes = coalesce(sqlc.narg(regulars_head_start_minutes), regulars_head_start_minutes),
+  regulars_first = coalesce(sqlc.narg(regulars_first), regulars_first),
+  waitlist_mode = coalesce(sqlc.narg(waitlist_mode), waitlist_mode),
+  max_waitlist_size = coalesce(sqlc.narg(max_waitlist_size), max_waitlist_size),
+  waitlist_spots_left = coalesce(sqlc.narg(waitlist_spots_left), waitlist_spots_left),
+  reconfirm_within_minutes = coalesce(sqlc.narg(reconfirm_within_minutes), reconfirm_within_minutes),
+  cancellation_deadline_minutes = coalesce(sqlc.narg(cancellation_deadline_minutes), cancellation_deadline_minutes),
+  bill_late_cancellations = coalesce(sqlc.narg(bill_late_cancellations), bill_late_cancellations),
+  split_strategy = coalesce(sqlc.narg(split_strategy), split_strategy),
+  price_per_player_cents = coalesce(sqlc.narg(price_per_player_cents), price_per_player_cents),
+  guest_price_cents = coalesce(sqlc.narg(guest_price_cents), guest_price_cents),
   updated_at = current_timestamp
 where id = sqlc.arg(id);
 
 -- name: GameListByUser :many
+-- Lists the games the user organizes, participates in or has a role in, and the published games of their groups that aren't private.
+-- Games the user only sees through their groups are listed until they start.
+-- published_at and starts_at are written in UTC, so they compare with now in UTC.
 select
   games.id,
   games.name,
@@ -74,20 +159,55 @@ select
   games.starts_at,
   games.published_at,
   games.updated_at,
+  games.group_id,
+  games.cancelled_at,
+  games.cancellation_reason,
+  game_participants.late_cancelled_at,
   games.organizer_id = sqlc.arg(user_id) as is_organizer,
   sqlc.embed(users)
 from games
 left join game_participants
   on games.id = game_participants.game_id and game_participants.user_id = sqlc.arg(user_id)
+left join game_roles
+  on games.id = game_roles.game_id and game_roles.user_id = sqlc.arg(user_id)
+left join group_members
+  on games.group_id = group_members.group_id and group_members.user_id = sqlc.arg(user_id)
 join users
   on users.id = games.organizer_id
-where games.organizer_id = sqlc.arg(user_id) or game_participants.user_id is not null
+where games.deleted_at is null
+  and (
+    games.organizer_id = sqlc.arg(user_id)
+    or game_participants.user_id is not null
+    or game_roles.user_id is not null
+    or (
+      group_members.user_id is not null
+      and not games.is_private
+      and games.published_at <= sqlc.arg(now)
+      and (games.starts_at is null or games.starts_at > sqlc.arg(now))
+    )
+  )
 order by coalesce(games.published_at, games.updated_at) desc
 limit sqlc.arg(limit) offset sqlc.arg(offset);
 
 -- name: GameCountByUser :one
+-- Counts the games listed by GameListByUser.
 select count(*)
 from games
 left join game_participants
   on games.id = game_participants.game_id and game_participants.user_id = sqlc.arg(user_id)
-where games.organizer_id =sqlc.arg(user_id) or game_participants.user_id is not null;
+left join game_roles
+  on games.id = game_roles.game_id and game_roles.user_id = sqlc.arg(user_id)
+left join group_members
+  on games.group_id = group_members.group_id and group_members.user_id = sqlc.arg(user_id)
+where games.deleted_at is null
+  and (
+    games.organizer_id = sqlc.arg(user_id)
+    or game_participants.user_id is not null
+    or game_roles.user_id is not null
+    or (
+      group_members.user_id is not null
+      and not games.is_private
+      and games.published_at <= sqlc.arg(now)
+      and (games.starts_at is null or games.starts_at > sqlc.arg(now))
+    )
+  );
@@ -16,11 +16,32 @@ select count(*)
 from games
 left join game_participants
   on games.id = game_participants.game_id and game_participants.user_id = ?1
-where games.organizer_id =?1 or game_participants.user_id is not null
+left join game_roles
+  on games.id = game_roles.game_id and game_roles.user_id = ?1
+left join group_members
+  on games.group_id = group_members.group_id and group_members.user_id = ?1
+where games.deleted_at is null
+  and (
+    games.organizer_id = ?1
+    or game_participants.user_id is not null
+    or game_roles.user_id is not null
+    or (
+      group_members.user_id is not null
+      and not games.is_private
+      and games.published_at <= ?2
+      and (games.starts_at is null or games.starts_at > ?2)
+    )
+  )
 `
 
-func (q *Queries) GameCountByUser(ctx context.Context, userID int64) (int64, error) {
-	row := q.db.QueryRowContext(ctx, gameCountByUser, userID)
+type GameCountByUserParams struct {
+	UserID int64
+	Now    sql.NullTime
+}
+
+// Counts the games listed by GameListByUser.
+func (q *Queries) GameCountByUser(ctx context.Context, arg GameCountByUserParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, gameCountByUser, arg.UserID, arg.Now)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
@@ -39,24 +60,94 @@ insert into games(
   duration_minutes,
   max_players,
   max_guests_per_player,
-  game_spots_left
-) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
-returning id, organizer_id, name, description, published_at, total_price_cents, location, starts_at, duration_minutes, max_players, max_guests_per_player, game_spots_left, created_at, updated_at, frozen_at
+  game_spots_left,
+  series_id,
+  series_occurrence_at,
+  group_id,
+  is_private,
+  waitlist_offer_minutes,
+  allocation_mode,
+  registration_closes_at,
+  regulars_head_start_minutes,
+  regulars_first,
+  waitlist_mode,
+  max_waitlist_size,
+  waitlist_spots_left,
+  reconfirm_within_minutes,
+  cancellation_deadline_minutes,
+  bill_late_cancellations,
+  split_strategy,
+  price_per_player_cents,
+  guest_price_cents
+) values (
+  ?1,
+  ?2,
+  ?3,
+  ?4,
+  ?5,
+  ?6,
+  ?7,
+  ?8,
+  ?9,
+  ?10,
+  ?11,
+  ?12,
+  ?13,
+  ?14,
+  ?15,
+  ?16,
+  ?17,
+  -- games created without an allocation mode are first come, first served
+  coalesce(nullif(cast(?18 as text), ''), 'first_come_first_served'),
+  ?19,
+  ?20,
+  ?21,
+  -- games created without a waitlist mode have an unlimited waitlist
+  coalesce(nullif(cast(?22 as text), ''), 'unlimited'),
+  ?23,
+  ?24,
+  ?25,
+  ?26,
+  ?27,
+  -- games created without a split strategy split their price evenly
+  coalesce(nullif(cast(?28 as text), ''), 'even'),
+  ?29,
+  ?30
+)
+returning id, organizer_id, name, description, published_at, total_price_cents, location, starts_at, duration_minutes, max_players, max_guests_per_player, game_spots_left, created_at, updated_at, frozen_at, series_id, series_occurrence_at, group_id, is_private, waitlist_offer_minutes, allocation_mode, registration_closes_at, lottery_seed, lottery_drawn_at, regulars_head_start_minutes, regulars_first, waitlist_mode, max_waitlist_size, waitlist_spots_left, reconfirm_within_minutes, cancellation_deadline_minutes, bill_late_cancellations, check_in_code, cancelled_at, cancellation_reason, deleted_at, split_strategy, price_per_player_cents, guest_price_cents
 `
 
 type GameCreateParams struct {
-	ID                 string
-	OrganizerID        int64
-	Name               string
-	Description        sql.NullString
-	PublishedAt        sql.NullTime
-	TotalPriceCents    int64
-	Location           sql.NullString
-	StartsAt           sql.NullTime
-	DurationMinutes    int64
-	MaxPlayers         int64
-	MaxGuestsPerPlayer int64
-	GameSpotsLeft      int64
+	ID                          string
+	OrganizerID                 int64
+	Name                        string
+	Description                 sql.NullString
+	PublishedAt                 sql.NullTime
+	TotalPriceCents             int64
+	Location                    sql.NullString
+	StartsAt                    sql.NullTime
+	DurationMinutes             int64
+	MaxPlayers                  int64
+	MaxGuestsPerPlayer          int64
+	GameSpotsLeft               int64
+	SeriesID                    sql.NullString
+	SeriesOccurrenceAt          sql.NullTime
+	GroupID                     sql.NullString
+	IsPrivate                   bool
+	WaitlistOfferMinutes        int64
+	AllocationMode              string
+	RegistrationClosesAt        sql.NullTime
+	RegularsHeadStartMinutes    int64
+	RegularsFirst               bool
+	WaitlistMode                string
+	MaxWaitlistSize             int64
+	WaitlistSpotsLeft           int64
+	ReconfirmWithinMinutes      int64
+	CancellationDeadlineMinutes int64
+	BillLateCancellations       bool
+	SplitStrategy               string
+	PricePerPlayerCents         int64
+	GuestPriceCents             int64
 }
 
 func (q *Queries) GameCreate(ctx context.Context, arg GameCreateParams) (Game, error) {
@@ -73,6 +164,24 @@ func (q *Queries) GameCreate(ctx context.Context, arg GameCreateParams) (Game, e
 		arg.MaxPlayers,
 		arg.MaxGuestsPerPlayer,
 		arg.GameSpotsLeft,
+		arg.SeriesID,
+		arg.SeriesOccurrenceAt,
+		arg.GroupID,
+		arg.IsPrivate,
+		arg.WaitlistOfferMinutes,
+		arg.AllocationMode,
+		arg.RegistrationClosesAt,
+		arg.RegularsHeadStartMinutes,
+		arg.RegularsFirst,
+		arg.WaitlistMode,
+		arg.MaxWaitlistSize,
+		arg.WaitlistSpotsLeft,
+		arg.ReconfirmWithinMinutes,
+		arg.CancellationDeadlineMinutes,
+		arg.BillLateCancellations,
+		arg.SplitStrategy,
+		arg.PricePerPlayerCents,
+		arg.GuestPriceCents,
 	)
 	var i Game
 	err := row.Scan(
@@ -91,14 +200,39 @@ func (q *Queries) GameCreate(ctx context.Context, arg GameCreateParams) (Game, e
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.FrozenAt,
+		&i.SeriesID,
+		&i.SeriesOccurrenceAt,
+		&i.GroupID,
+		&i.IsPrivate,
+		&i.WaitlistOfferMinutes,
+		&i.AllocationMode,
+		&i.RegistrationClosesAt,
+		&i.LotterySeed,
+		&i.LotteryDrawnAt,
+		&i.RegularsHeadStartMinutes,
+		&i.RegularsFirst,
+		&i.WaitlistMode,
+		&i.MaxWaitlistSize,
+		&i.WaitlistSpotsLeft,
+		&i.ReconfirmWithinMinutes,
+		&i.CancellationDeadlineMinutes,
+		&i.BillLateCancellations,
+		&i.CheckInCode,
+		&i.CancelledAt,
+		&i.CancellationReason,
+		&i.DeletedAt,
+		&i.SplitStrategy,
+		&i.PricePerPlayerCents,
+		&i.GuestPriceCents,
 	)
 	return i, err
 }
 
 const gameGetById = `-- name: GameGetById :one
-select id, organizer_id, name, description, published_at, total_price_cents, location, starts_at, duration_minutes, max_players, max_guests_per_player, game_spots_left, created_at, updated_at, frozen_at
+select id, organizer_id, name, description, published_at, total_price_cents, location, starts_at, duration_minutes, max_players, max_guests_per_player, game_spots_left, created_at, updated_at, frozen_at, series_id, series_occurrence_at, group_id, is_private, waitlist_offer_minutes, allocation_mode, registration_closes_at, lottery_seed, lottery_drawn_at, regulars_head_start_minutes, regulars_first, waitlist_mode, max_waitlist_size, waitlist_spots_left, reconfirm_within_minutes, cancellation_deadline_minutes, bill_late_cancellations, check_in_code, cancelled_at, cancellation_reason, deleted_at, split_strategy, price_per_player_cents, guest_price_cents
 from games
 where games.id = ?
+  and games.deleted_at is null
 `
 
 func (q *Queries) GameGetById(ctx context.Context, id string) (Game, error) {
@@ -120,18 +254,97 @@ func (q *Queries) GameGetById(ctx context.Context, id string) (Game, error) {
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.FrozenAt,
+		&i.SeriesID,
+		&i.SeriesOccurrenceAt,
+		&i.GroupID,
+		&i.IsPrivate,
+		&i.WaitlistOfferMinutes,
+		&i.AllocationMode,
+		&i.RegistrationClosesAt,
+		&i.LotterySeed,
+		&i.LotteryDrawnAt,
+		&i.RegularsHeadStartMinutes,
+		&i.RegularsFirst,
+		&i.WaitlistMode,
+		&i.MaxWaitlistSize,
+		&i.WaitlistSpotsLeft,
+		&i.ReconfirmWithinMinutes,
+		&i.CancellationDeadlineMinutes,
+		&i.BillLateCancellations,
+		&i.CheckInCode,
+		&i.CancelledAt,
+		&i.CancellationReason,
+		&i.DeletedAt,
+		&i.SplitStrategy,
+		&i.PricePerPlayerCents,
+		&i.GuestPriceCents,
+	)
+	return i, err
+}
+
+const gameGetByIdWithDeleted = `-- name: GameGetByIdWithDeleted :one
+select id, organizer_id, name, description, published_at, total_price_cents, location, starts_at, duration_minutes, max_players, max_guests_per_player, game_spots_left, created_at, updated_at, frozen_at, series_id, series_occurrence_at, group_id, is_private, waitlist_offer_minutes, allocation_mode, registration_closes_at, lottery_seed, lottery_drawn_at, regulars_head_start_minutes, regulars_first, waitlist_mode, max_waitlist_size, waitlist_spots_left, reconfirm_within_minutes, cancellation_deadline_minutes, bill_late_cancellations, check_in_code, cancelled_at, cancellation_reason, deleted_at, split_strategy, price_per_player_cents, guest_price_cents
+from games
+where games.id = ?
+`
+
+// Deleted games are only retrieved to deliver the event published when they were deleted.
+func (q *Queries) GameGetByIdWithDeleted(ctx context.Context, id string) (Game, error) {
+	row := q.db.QueryRowContext(ctx, gameGetByIdWithDeleted, id)
+	var i Game
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizerID,
+		&i.Name,
+		&i.Description,
+		&i.PublishedAt,
+		&i.TotalPriceCents,
+		&i.Location,
+		&i.StartsAt,
+		&i.DurationMinutes,
+		&i.MaxPlayers,
+		&i.MaxGuestsPerPlayer,
+		&i.GameSpotsLeft,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.FrozenAt,
+		&i.SeriesID,
+		&i.SeriesOccurrenceAt,
+		&i.GroupID,
+		&i.IsPrivate,
+		&i.WaitlistOfferMinutes,
+		&i.AllocationMode,
+		&i.RegistrationClosesAt,
+		&i.LotterySeed,
+		&i.LotteryDrawnAt,
+		&i.RegularsHeadStartMinutes,
+		&i.RegularsFirst,
+		&i.WaitlistMode,
+		&i.MaxWaitlistSize,
+		&i.WaitlistSpotsLeft,
+		&i.ReconfirmWithinMinutes,
+		&i.CancellationDeadlineMinutes,
+		&i.BillLateCancellations,
+		&i.CheckInCode,
+		&i.CancelledAt,
+		&i.CancellationReason,
+		&i.DeletedAt,
+		&i.SplitStrategy,
+		&i.PricePerPlayerCents,
+		&i.GuestPriceCents,
 	)
 	return i, err
 }
 
 const gameGetByIdWithOrganizer = `-- name: GameGetByIdWithOrganizer :one
 select
-  games.id, games.organizer_id, games.name, games.description, games.published_at, games.total_price_cents, games.location, games.starts_at, games.duration_minutes, games.max_players, games.max_guests_per_player, games.game_spots_left, games.created_at, games.updated_at, games.frozen_at,
-  users.id, users.name, users.email, users.photo, users.created_at, users.updated_at, users.is_demo
+  games.id, games.organizer_id, games.name, games.description, games.published_at, games.total_price_cents, games.location, games.starts_at, games.duration_minutes, games.max_players, games.max_guests_per_player, games.game_spots_left, games.created_at, games.updated_at, games.frozen_at, games.series_id, games.series_occurrence_at, games.group_id, games.is_private, games.waitlist_offer_minutes, games.allocation_mode, games.registration_closes_at, games.lottery_seed, games.lottery_drawn_at, games.regulars_head_start_minutes, games.regulars_first, games.waitlist_mode, games.max_waitlist_size, games.waitlist_spots_left, games.reconfirm_within_minutes, games.cancellation_deadline_minutes, games.bill_late_cancellations, games.check_in_code, games.cancelled_at, games.cancellation_reason, games.deleted_at, games.split_strategy, games.price_per_player_cents, games.guest_price_cents,
+  users.id, users.name, users.email, users.photo, users.created_at, users.updated_at, users.is_demo, users.is_placeholder
 from games
 join users
   on users.id = games.organizer_id
 where games.id = ?
+  and games.deleted_at is null
 `
 
 type GameGetByIdWithOrganizerRow struct {
@@ -158,6 +371,30 @@ func (q *Queries) GameGetByIdWithOrganizer(ctx context.Context, id string) (Game
 		&i.Game.CreatedAt,
 		&i.Game.UpdatedAt,
 		&i.Game.FrozenAt,
+		&i.Game.SeriesID,
+		&i.Game.SeriesOccurrenceAt,
+		&i.Game.GroupID,
+		&i.Game.IsPrivate,
+		&i.Game.WaitlistOfferMinutes,
+		&i.Game.AllocationMode,
+		&i.Game.RegistrationClosesAt,
+		&i.Game.LotterySeed,
+		&i.Game.LotteryDrawnAt,
+		&i.Game.RegularsHeadStartMinutes,
+		&i.Game.RegularsFirst,
+		&i.Game.WaitlistMode,
+		&i.Game.MaxWaitlistSize,
+		&i.Game.WaitlistSpotsLeft,
+		&i.Game.ReconfirmWithinMinutes,
+		&i.Game.CancellationDeadlineMinutes,
+		&i.Game.BillLateCancellations,
+		&i.Game.CheckInCode,
+		&i.Game.CancelledAt,
+		&i.Game.CancellationReason,
+		&i.Game.DeletedAt,
+		&i.Game.SplitStrategy,
+		&i.Game.PricePerPlayerCents,
+		&i.Game.GuestPriceCents,
 		&i.User.ID,
 		&i.User.Name,
 		&i.User.Email,
@@ -165,6 +402,7 @@ func (q *Queries) GameGetByIdWithOrganizer(ctx context.Context, id string) (Game
 		&i.User.CreatedAt,
 		&i.User.UpdatedAt,
 		&i.User.IsDemo,
+		&i.User.IsPlaceholder,
 	)
 	return i, err
 }
@@ -176,22 +414,35 @@ select
   games.published_at,
   games.starts_at,
   games.game_spots_left,
+  games.waitlist_mode,
+  games.waitlist_spots_left,
+  games.group_id,
+  games.is_private,
+  games.cancelled_at,
+  games.cancellation_reason,
   users.name as organizer_name,
   users.photo as organizer_photo
 from games
 join users
   on users.id = games.organizer_id
 where games.id = ?
+  and games.deleted_at is null
 `
 
 type GameGetPublicInfoByIdRow struct {
-	ID             string
-	Name           string
-	PublishedAt    sql.NullTime
-	StartsAt       sql.NullTime
-	GameSpotsLeft  int64
-	OrganizerName  sql.NullString
-	OrganizerPhoto sql.NullString
+	ID                 string
+	Name               string
+	PublishedAt        sql.NullTime
+	StartsAt           sql.NullTime
+	GameSpotsLeft      int64
+	WaitlistMode       string
+	WaitlistSpotsLeft  int64
+	GroupID            sql.NullString
+	IsPrivate          bool
+	CancelledAt        sql.NullTime
+	CancellationReason sql.NullString
+	OrganizerName      sql.NullString
+	OrganizerPhoto     sql.NullString
 }
 
 func (q *Queries) GameGetPublicInfoById(ctx context.Context, id string) (GameGetPublicInfoByIdRow, error) {
@@ -203,6 +454,12 @@ func (q *Queries) GameGetPublicInfoById(ctx context.Context, id string) (GameGet
 		&i.PublishedAt,
 		&i.StartsAt,
 		&i.GameSpotsLeft,
+		&i.WaitlistMode,
+		&i.WaitlistSpotsLeft,
+		&i.GroupID,
+		&i.IsPrivate,
+		&i.CancelledAt,
+		&i.CancellationReason,
 		&i.OrganizerName,
 		&i.OrganizerPhoto,
 	)
@@ -217,37 +474,69 @@ select
   games.starts_at,
   games.published_at,
   games.updated_at,
+  games.group_id,
+  games.cancelled_at,
+  games.cancellation_reason,
+  game_participants.late_cancelled_at,
   games.organizer_id = ?1 as is_organizer,
-  users.id, users.name, users.email, users.photo, users.created_at, users.updated_at, users.is_demo
+  users.id, users.name, users.email, users.photo, users.created_at, users.updated_at, users.is_demo, users.is_placeholder
 from games
 left join game_participants
   on games.id = game_participants.game_id and game_participants.user_id = ?1
+left join game_roles
+  on games.id = game_roles.game_id and game_roles.user_id = ?1
+left join group_members
+  on games.group_id = group_members.group_id and group_members.user_id = ?1
 join users
   on users.id = games.organizer_id
-where games.organizer_id = ?1 or game_participants.user_id is not null
+where games.deleted_at is null
+  and (
+    games.organizer_id = ?1
+    or game_participants.user_id is not null
+    or game_roles.user_id is not null
+    or (
+      group_members.user_id is not null
+      and not games.is_private
+      and games.published_at <= ?2
+      and (games.starts_at is null or games.starts_at > ?2)
+    )
+  )
 order by coalesce(games.published_at, games.updated_at) desc
-limit ?3 offset ?2
+limit ?4 offset ?3
 `
 
 type GameListByUserParams struct {
 	UserID int64
+	Now    sql.NullTime
 	Offset int64
 	Limit  int64
 }
 
 type GameListByUserRow struct {
-	ID          string
-	Name        string
-	Location    sql.NullString
-	StartsAt    sql.NullTime
-	PublishedAt sql.NullTime
-	UpdatedAt   time.Time
-	IsOrganizer bool
-	User        User
+	ID                 string
+	Name               string
+	Location           sql.NullString
+	StartsAt           sql.NullTime
+	PublishedAt        sql.NullTime
+	UpdatedAt          time.Time
+	GroupID            sql.NullString
+	CancelledAt        sql.NullTime
+	CancellationReason sql.NullString
+	LateCancelledAt    sql.NullTime
+	IsOrganizer        bool
+	User               User
 }
 
+// Lists the games the user organizes, participates in or has a role in, and the published games of their groups that aren't private.
+// Games the user only sees through their groups are listed until they start.
+// published_at and starts_at are written in UTC, so they compare with now in UTC.
 func (q *Queries) GameListByUser(ctx context.Context, arg GameListByUserParams) ([]GameListByUserRow, error) {
-	rows, err := q.db.QueryContext(ctx, gameListByUser, arg.UserID, arg.Offset, arg.Limit)
+	rows, err := q.db.QueryContext(ctx, gameListByUser,
+		arg.UserID,
+		arg.Now,
+		arg.Offset,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -262,6 +551,10 @@ func (q *Queries) GameListByUser(ctx context.Context, arg GameListByUserParams)
 			&i.StartsAt,
 			&i.PublishedAt,
 			&i.UpdatedAt,
+			&i.GroupID,
+			&i.CancelledAt,
+			&i.CancellationReason,
+			&i.LateCancelledAt,
 			&i.IsOrganizer,
 			&i.User.ID,
 			&i.User.Name,
@@ -270,6 +563,7 @@ func (q *Queries) GameListByUser(ctx context.Context, arg GameListByUserParams)
 			&i.User.CreatedAt,
 			&i.User.UpdatedAt,
 			&i.User.IsDemo,
+			&i.User.IsPlaceholder,
 		); err != nil {
 			return nil, err
 		}
@@ -304,25 +598,55 @@ set
   max_players = coalesce(nullif(cast(?11 as integer), 0), max_players),
   max_guests_per_player = coalesce(?12, max_guests_per_player),
   game_spots_left = coalesce(?13, game_spots_left),
+  is_private = coalesce(?14, is_private),
+  waitlist_offer_minutes = coalesce(?15, waitlist_offer_minutes),
+  allocation_mode = coalesce(?16, allocation_mode),
+  registration_closes_at = coalesce(?17, registration_closes_at),
+  regulars_head_start_minutes = coalesce(?18, regulars_head_start_minutes),
+  regulars_first = coalesce(?19, regulars_first),
+  waitlist_mode = coalesce(?20, waitlist_mode),
+  max_waitlist_size = coalesce(?21, max_waitlist_size),
+  waitlist_spots_left = coalesce(?22, waitlist_spots_left),
+  reconfirm_within_minutes = coalesce(?23, reconfirm_within_minutes),
+  cancellation_deadline_minutes = coalesce(?24, cancellation_deadline_minutes),
+  bill_late_cancellations = coalesce(?25, bill_late_cancellations),
+  split_strategy = coalesce(?26, split_strategy),
+  price_per_player_cents = coalesce(?27, price_per_player_cents),
+  guest_price_cents = coalesce(?28, guest_price_cents),
   updated_at = current_timestamp
-where id = ?14
+where id = ?29
 `
 
 type GameUpdateParams struct {
-	Name               sql.NullString
-	Description        sql.NullString
-	ClearPublishedAt   bool
-	PublishedAt        sql.NullTime
-	ClearFrozenAt      bool
-	FrozenAt           sql.NullTime
-	TotalPriceCents    sql.NullInt64
-	Location           sql.NullString
-	StartsAt           sql.NullTime
-	DurationMinutes    int64
-	MaxPlayers         sql.NullInt64
-	MaxGuestsPerPlayer sql.NullInt64
-	GameSpotsLeft      sql.NullInt64
-	ID                 string
+	Name                        sql.NullString
+	Description                 sql.NullString
+	ClearPublishedAt            bool
+	PublishedAt                 sql.NullTime
+	ClearFrozenAt               bool
+	FrozenAt                    sql.NullTime
+	TotalPriceCents             sql.NullInt64
+	Location                    sql.NullString
+	StartsAt                    sql.NullTime
+	DurationMinutes             int64
+	MaxPlayers                  sql.NullInt64
+	MaxGuestsPerPlayer          sql.NullInt64
+	GameSpotsLeft               sql.NullInt64
+	IsPrivate                   sql.NullBool
+	WaitlistOfferMinutes        sql.NullInt64
+	AllocationMode              sql.NullString
+	RegistrationClosesAt        sql.NullTime
+	RegularsHeadStartMinutes    sql.NullInt64
+	RegularsFirst               sql.NullBool
+	WaitlistMode                sql.NullString
+	MaxWaitlistSize             sql.NullInt64
+	WaitlistSpotsLeft           sql.NullInt64
+	ReconfirmWithinMinutes      sql.NullInt64
+	CancellationDeadlineMinutes sql.NullInt64
+	BillLateCancellations       sql.NullBool
+	SplitStrategy               sql.NullString
+	PricePerPlayerCents         sql.NullInt64
+	GuestPriceCents             sql.NullInt64
+	ID                          string
 }
 
 func (q *Queries) GameUpdate(ctx context.Context, arg GameUpdateParams) error {
@@ -340,6 +664,21 @@ func (q *Queries) GameUpdate(ctx context.Context, arg GameUpdateParams) error {
 		arg.MaxPlayers,
 		arg.MaxGuestsPerPlayer,
 		arg.GameSpotsLeft,
+		arg.IsPrivate,
+		arg.WaitlistOfferMinutes,
+		arg.AllocationMode,
+		arg.RegistrationClosesAt,
+		arg.RegularsHeadStartMinutes,
+		arg.RegularsFirst,
+		arg.WaitlistMode,
+		arg.MaxWaitlistSize,
+		arg.WaitlistSpotsLeft,
+		arg.ReconfirmWithinMinutes,
+		arg.CancellationDeadlineMinutes,
+		arg.BillLateCancellations,
+		arg.SplitStrategy,
+		arg.PricePerPlayerCents,
+		arg.GuestPriceCents,
 		arg.ID,
 	)
 	return err
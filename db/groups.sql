@@ -0,0 +1,106 @@
+-- name: GroupCreate :one
+insert into groups(
+    id,
+    name,
+    description,
+    invite_code,
+    regulars_head_start_minutes,
+    regulars_first
+) values (?, ?, ?, ?, ?, ?)
+returning *;
+
+-- name: GroupGetById :one
+select *
+from groups
+where id = ?;
+
+-- name: GroupGetByInviteCode :one
+select *
+from groups
+where invite_code = ?;
+
+-- name: GroupUpdate :exec
+update groups
+set
+    name = coalesce(sqlc.narg(name), name),
+    description = coalesce(sqlc.narg(description), description),
+    regulars_head_start_minutes = coalesce(sqlc.narg(regulars_head_start_minutes), regulars_head_start_minutes),
+    regulars_first = coalesce(sqlc.narg(regulars_first), regulars_first),
+    updated_at = current_timestamp
+where id = sqlc.arg(id);
+
+-- name: GroupUpdateInviteCode :exec
+update groups
+set
+    invite_code = sqlc.arg(invite_code),
+    updated_at = current_timestamp
+where id = sqlc.arg(id);
+
+-- name: GroupListByUser :many
+select
+    sqlc.embed(groups),
+    group_members.role
+from groups
+join group_members on group_members.group_id = groups.id
+where group_members.user_id = ?
+order by groups.name asc, groups.id asc;
+
+-- name: GroupMemberGetRole :one
+select role
+from group_members
+where group_id = sqlc.arg(group_id)
+    and user_id = sqlc.arg(user_id);
+
+-- name: GroupMemberListByGroup :many
+select
+    sqlc.embed(group_members),
+    sqlc.embed(users)
+from group_members
+join users on users.id = group_members.user_id
+where group_members.group_id = ?
+order by group_members.created_at asc, group_members.user_id asc;
+
+-- name: GroupMemberCountByRole :one
+select count(*)
+from group_members
+where group_id = sqlc.arg(group_id)
+    and role = sqlc.arg(role);
+
+-- name: GroupMemberCreate :execrows
+-- Users who are already members keep their role.
+insert into group_members(
+    group_id,
+    user_id,
+    role
+) values (?, ?, ?)
+on conflict(group_id, user_id) do nothing;
+
+-- name: GroupMemberUpdateRole :execrows
+update group_members
+set
+    role = sqlc.arg(role),
+    updated_at = current_timestamp
+where group_id = sqlc.arg(group_id)
+    and user_id = sqlc.arg(user_id);
+
+-- name: GroupMemberUpdateTier :execrows
+update group_members
+set
+    tier = sqlc.arg(tier),
+    updated_at = current_timestamp
+where group_id = sqlc.arg(group_id)
+    and user_id = sqlc.arg(user_id);
+
+-- name: GroupMemberIsRegular :one
+select cast(exists(
+    select 1
+    from group_members
+    where group_id = sqlc.arg(group_id)
+        and user_id = sqlc.arg(user_id)
+        and tier = 'regular'
+) as boolean);
+
+-- name: GroupMemberDelete :execrows
+delete from group_members
+where group_id = sqlc.arg(group_id)
+    and user_id = sqlc.arg(user_id);
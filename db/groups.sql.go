@@ -0,0 +1,397 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: groups.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+)
+
+const groupCreate = `-- name: GroupCreate :one
+insert into groups(
+    id,
+    name,
+    description,
+    invite_code,
+    regulars_head_start_minutes,
+    regulars_first
+) values (?, ?, ?, ?, ?, ?)
+returning id, name, description, invite_code, created_at, updated_at, regulars_head_start_minutes, regulars_first
+`
+
+type GroupCreateParams struct {
+	ID                       string
+	Name                     string
+	Description              sql.NullString
+	InviteCode               string
+	RegularsHeadStartMinutes int64
+	RegularsFirst            bool
+}
+
+func (q *Queries) GroupCreate(ctx context.Context, arg GroupCreateParams) (Group, error) {
+	row := q.db.QueryRowContext(ctx, groupCreate,
+		arg.ID,
+		arg.Name,
+		arg.Description,
+		arg.InviteCode,
+		arg.RegularsHeadStartMinutes,
+		arg.RegularsFirst,
+	)
+	var i Group
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.InviteCode,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.RegularsHeadStartMinutes,
+		&i.RegularsFirst,
+	)
+	return i, err
+}
+
+const groupGetById = `-- name: GroupGetById :one
+select id, name, description, invite_code, created_at, updated_at, regulars_head_start_minutes, regulars_first
+from groups
+where id = ?
+`
+
+func (q *Queries) GroupGetById(ctx context.Context, id string) (Group, error) {
+	row := q.db.QueryRowContext(ctx, groupGetById, id)
+	var i Group
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.InviteCode,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.RegularsHeadStartMinutes,
+		&i.RegularsFirst,
+	)
+	return i, err
+}
+
+const groupGetByInviteCode = `-- name: GroupGetByInviteCode :one
+select id, name, description, invite_code, created_at, updated_at, regulars_head_start_minutes, regulars_first
+from groups
+where invite_code = ?
+`
+
+func (q *Queries) GroupGetByInviteCode(ctx context.Context, inviteCode string) (Group, error) {
+	row := q.db.QueryRowContext(ctx, groupGetByInviteCode, inviteCode)
+	var i Group
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.InviteCode,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.RegularsHeadStartMinutes,
+		&i.RegularsFirst,
+	)
+	return i, err
+}
+
+const groupListByUser = `-- name: GroupListByUser :many
+select
+    "groups".id, "groups".name, "groups".description, "groups".invite_code, "groups".created_at, "groups".updated_at, "groups".regulars_head_start_minutes, "groups".regulars_first,
+    group_members.role
+from groups
+join group_members on group_members.group_id = groups.id
+where group_members.user_id = ?
+order by groups.name asc, groups.id asc
+`
+
+type GroupListByUserRow struct {
+	Group Group
+	Role  string
+}
+
+func (q *Queries) GroupListByUser(ctx context.Context, userID int64) ([]GroupListByUserRow, error) {
+	rows, err := q.db.QueryContext(ctx, groupListByUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GroupListByUserRow
+	for rows.Next() {
+		var i GroupListByUserRow
+		if err := rows.Scan(
+			&i.Group.ID,
+			&i.Group.Name,
+			&i.Group.Description,
+			&i.Group.InviteCode,
+			&i.Group.CreatedAt,
+			&i.Group.UpdatedAt,
+			&i.Group.RegularsHeadStartMinutes,
+			&i.Group.RegularsFirst,
+			&i.Role,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const groupMemberCountByRole = `-- name: GroupMemberCountByRole :one
+select count(*)
+from group_members
+where group_id = ?1
+    and role = ?2
+`
+
+type GroupMemberCountByRoleParams struct {
+	GroupID string
+	Role    string
+}
+
+func (q *Queries) GroupMemberCountByRole(ctx context.Context, arg GroupMemberCountByRoleParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, groupMemberCountByRole, arg.GroupID, arg.Role)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const groupMemberCreate = `-- name: GroupMemberCreate :execrows
+insert into group_members(
+    group_id,
+    user_id,
+    role
+) values (?, ?, ?)
+on conflict(group_id, user_id) do nothing
+`
+
+type GroupMemberCreateParams struct {
+	GroupID string
+	UserID  int64
+	Role    string
+}
+
+// Users who are already members keep their role.
+func (q *Queries) GroupMemberCreate(ctx context.Context, arg GroupMemberCreateParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, groupMemberCreate, arg.GroupID, arg.UserID, arg.Role)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const groupMemberDelete = `-- name: GroupMemberDelete :execrows
+delete from group_members
+where group_id = ?1
+    and user_id = ?2
+`
+
+type GroupMemberDeleteParams struct {
+	GroupID string
+	UserID  int64
+}
+
+func (q *Queries) GroupMemberDelete(ctx context.Context, arg GroupMemberDeleteParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, groupMemberDelete, arg.GroupID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const groupMemberGetRole = `-- name: GroupMemberGetRole :one
+select role
+from group_members
+where group_id = ?1
+    and user_id = ?2
+`
+
+type GroupMemberGetRoleParams struct {
+	GroupID string
+	UserID  int64
+}
+
+func (q *Queries) GroupMemberGetRole(ctx context.Context, arg GroupMemberGetRoleParams) (string, error) {
+	row := q.db.QueryRowContext(ctx, groupMemberGetRole, arg.GroupID, arg.UserID)
+	var role string
+	err := row.Scan(&role)
+	return role, err
+}
+
+const groupMemberIsRegular = `-- name: GroupMemberIsRegular :one
+select cast(exists(
+    select 1
+    from group_members
+    where group_id = ?1
+        and user_id = ?2
+        and tier = 'regular'
+) as boolean)
+`
+
+type GroupMemberIsRegularParams struct {
+	GroupID string
+	UserID  int64
+}
+
+func (q *Queries) GroupMemberIsRegular(ctx context.Context, arg GroupMemberIsRegularParams) (bool, error) {
+	row := q.db.QueryRowContext(ctx, groupMemberIsRegular, arg.GroupID, arg.UserID)
+	var column_1 bool
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const groupMemberListByGroup = `-- name: GroupMemberListByGroup :many
+select
+    group_members.group_id, group_members.user_id, group_members.role, group_members.created_at, group_members.updated_at, group_members.tier,
+    users.id, users.name, users.email, users.photo, users.created_at, users.updated_at, users.is_demo, users.is_placeholder
+from group_members
+join users on users.id = group_members.user_id
+where group_members.group_id = ?
+order by group_members.created_at asc, group_members.user_id asc
+`
+
+type GroupMemberListByGroupRow struct {
+	GroupMember GroupMember
+	User        User
+}
+
+func (q *Queries) GroupMemberListByGroup(ctx context.Context, groupID string) ([]GroupMemberListByGroupRow, error) {
+	rows, err := q.db.QueryContext(ctx, groupMemberListByGroup, groupID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GroupMemberListByGroupRow
+	for rows.Next() {
+		var i GroupMemberListByGroupRow
+		if err := rows.Scan(
+			&i.GroupMember.GroupID,
+			&i.GroupMember.UserID,
+			&i.GroupMember.Role,
+			&i.GroupMember.CreatedAt,
+			&i.GroupMember.UpdatedAt,
+			&i.GroupMember.Tier,
+			&i.User.ID,
+			&i.User.Name,
+			&i.User.Email,
+			&i.User.Photo,
+			&i.User.CreatedAt,
+			&i.User.UpdatedAt,
+			&i.User.IsDemo,
+			&i.User.IsPlaceholder,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const groupMemberUpdateRole = `-- name: GroupMemberUpdateRole :execrows
+update group_members
+set
+    role = ?1,
+    updated_at = current_timestamp
+where group_id = ?2
+    and user_id = ?3
+`
+
+type GroupMemberUpdateRoleParams struct {
+	Role    string
+	GroupID string
+	UserID  int64
+}
+
+func (q *Queries) GroupMemberUpdateRole(ctx context.Context, arg GroupMemberUpdateRoleParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, groupMemberUpdateRole, arg.Role, arg.GroupID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const groupMemberUpdateTier = `-- name: GroupMemberUpdateTier :execrows
+update group_members
+set
+    tier = ?1,
+    updated_at = current_timestamp
+where group_id = ?2
+    and user_id = ?3
+`
+
+type GroupMemberUpdateTierParams struct {
+	Tier    string
+	GroupID string
+	UserID  int64
+}
+
+func (q *Queries) GroupMemberUpdateTier(ctx context.Context, arg GroupMemberUpdateTierParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, groupMemberUpdateTier, arg.Tier, arg.GroupID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const groupUpdate = `-- name: GroupUpdate :exec
+update groups
+set
+    name = coalesce(?1, name),
+    description = coalesce(?2, description),
+    regulars_head_start_minutes = coalesce(?3, regulars_head_start_minutes),
+    regulars_first = coalesce(?4, regulars_first),
+    updated_at = current_timestamp
+where id = ?5
+`
+
+type GroupUpdateParams struct {
+	Name                     sql.NullString
+	Description              sql.NullString
+	RegularsHeadStartMinutes sql.NullInt64
+	RegularsFirst            sql.NullBool
+	ID                       string
+}
+
+func (q *Queries) GroupUpdate(ctx context.Context, arg GroupUpdateParams) error {
+	_, err := q.db.ExecContext(ctx, groupUpdate,
+		arg.Name,
+		arg.Description,
+		arg.RegularsHeadStartMinutes,
+		arg.RegularsFirst,
+		arg.ID,
+	)
+	return err
+}
+
+const groupUpdateInviteCode = `-- name: GroupUpdateInviteCode :exec
+update groups
+set
+    invite_code = ?1,
+    updated_at = current_timestamp
+where id = ?2
+`
+
+type GroupUpdateInviteCodeParams struct {
+	InviteCode string
+	ID         string
+}
+
+func (q *Queries) GroupUpdateInviteCode(ctx context.Context, arg GroupUpdateInviteCodeParams) error {
+	_, err := q.db.ExecContext(ctx, groupUpdateInviteCode, arg.InviteCode, arg.ID)
+	return err
+}
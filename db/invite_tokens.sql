@@ -0,0 +1,49 @@
+-- name: GameInviteTokenCreate :one
+insert into game_invite_tokens(game_id, token)
+values (?, ?)
+returning *;
+
+-- name: GameInviteTokenGetActive :one
+select *
+from game_invite_tokens
+where game_id = sqlc.arg(game_id)
+  and token = sqlc.arg(token)
+  and revoked_at is null;
+
+-- name: GameInviteTokenListByGame :many
+select *
+from game_invite_tokens
+where game_id = ?
+order by id desc;
+
+-- name: GameInviteTokenRevoke :execrows
+update game_invite_tokens
+set revoked_at = sqlc.arg(revoked_at)
+where game_id = sqlc.arg(game_id)
+  and id = sqlc.arg(id)
+  and revoked_at is null;
+
+-- name: GameInviteTokenRevokeAll :exec
+update game_invite_tokens
+set revoked_at = sqlc.arg(revoked_at)
+where game_id = sqlc.arg(game_id)
+  and revoked_at is null;
+
+-- name: GameInviteTokenParticipantsList :many
+-- Lists the participants who joined the game with one of its invite tokens.
+select
+  cast(game_participants.invite_token_id as integer) as invite_token_id,
+  sqlc.embed(users)
+from game_participants
+join users on game_participants.user_id = users.id
+where game_participants.game_id = ?
+  and game_participants.invite_token_id is not null
+order by game_participants.created_at asc, game_participants.rowid asc;
+
+-- name: ParticipantSetInviteToken :exec
+-- Keeps the token the participant first joined with.
+update game_participants
+set invite_token_id = sqlc.arg(invite_token_id)
+where game_id = sqlc.arg(game_id)
+  and user_id = sqlc.arg(user_id)
+  and invite_token_id is null;
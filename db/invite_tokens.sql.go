@@ -0,0 +1,206 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: invite_tokens.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+)
+
+const gameInviteTokenCreate = `-- name: GameInviteTokenCreate :one
+insert into game_invite_tokens(game_id, token)
+values (?, ?)
+returning id, game_id, token, created_at, revoked_at
+`
+
+type GameInviteTokenCreateParams struct {
+	GameID string
+	Token  string
+}
+
+func (q *Queries) GameInviteTokenCreate(ctx context.Context, arg GameInviteTokenCreateParams) (GameInviteToken, error) {
+	row := q.db.QueryRowContext(ctx, gameInviteTokenCreate, arg.GameID, arg.Token)
+	var i GameInviteToken
+	err := row.Scan(
+		&i.ID,
+		&i.GameID,
+		&i.Token,
+		&i.CreatedAt,
+		&i.RevokedAt,
+	)
+	return i, err
+}
+
+const gameInviteTokenGetActive = `-- name: GameInviteTokenGetActive :one
+select id, game_id, token, created_at, revoked_at
+from game_invite_tokens
+where game_id = ?1
+  and token = ?2
+  and revoked_at is null
+`
+
+type GameInviteTokenGetActiveParams struct {
+	GameID string
+	Token  string
+}
+
+func (q *Queries) GameInviteTokenGetActive(ctx context.Context, arg GameInviteTokenGetActiveParams) (GameInviteToken, error) {
+	row := q.db.QueryRowContext(ctx, gameInviteTokenGetActive, arg.GameID, arg.Token)
+	var i GameInviteToken
+	err := row.Scan(
+		&i.ID,
+		&i.GameID,
+		&i.Token,
+		&i.CreatedAt,
+		&i.RevokedAt,
+	)
+	return i, err
+}
+
+const gameInviteTokenListByGame = `-- name: GameInviteTokenListByGame :many
+select id, game_id, token, created_at, revoked_at
+from game_invite_tokens
+where game_id = ?
+order by id desc
+`
+
+func (q *Queries) GameInviteTokenListByGame(ctx context.Context, gameID string) ([]GameInviteToken, error) {
+	rows, err := q.db.QueryContext(ctx, gameInviteTokenListByGame, gameID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GameInviteToken
+	for rows.Next() {
+		var i GameInviteToken
+		if err := rows.Scan(
+			&i.ID,
+			&i.GameID,
+			&i.Token,
+			&i.CreatedAt,
+			&i.RevokedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const gameInviteTokenParticipantsList = `-- name: GameInviteTokenParticipantsList :many
+select
+  cast(game_participants.invite_token_id as integer) as invite_token_id,
+  users.id, users.name, users.email, users.photo, users.created_at, users.updated_at, users.is_demo, users.is_placeholder
+from game_participants
+join users on game_participants.user_id = users.id
+where game_participants.game_id = ?
+  and game_participants.invite_token_id is not null
+order by game_participants.created_at asc, game_participants.rowid asc
+`
+
+type GameInviteTokenParticipantsListRow struct {
+	InviteTokenID int64
+	User          User
+}
+
+// Lists the participants who joined the game with one of its invite tokens.
+func (q *Queries) GameInviteTokenParticipantsList(ctx context.Context, gameID string) ([]GameInviteTokenParticipantsListRow, error) {
+	rows, err := q.db.QueryContext(ctx, gameInviteTokenParticipantsList, gameID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GameInviteTokenParticipantsListRow
+	for rows.Next() {
+		var i GameInviteTokenParticipantsListRow
+		if err := rows.Scan(
+			&i.InviteTokenID,
+			&i.User.ID,
+			&i.User.Name,
+			&i.User.Email,
+			&i.User.Photo,
+			&i.User.CreatedAt,
+			&i.User.UpdatedAt,
+			&i.User.IsDemo,
+			&i.User.IsPlaceholder,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const gameInviteTokenRevoke = `-- name: GameInviteTokenRevoke :execrows
+update game_invite_tokens
+set revoked_at = ?1
+where game_id = ?2
+  and id = ?3
+  and revoked_at is null
+`
+
+type GameInviteTokenRevokeParams struct {
+	RevokedAt sql.NullTime
+	GameID    string
+	ID        int64
+}
+
+func (q *Queries) GameInviteTokenRevoke(ctx context.Context, arg GameInviteTokenRevokeParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, gameInviteTokenRevoke, arg.RevokedAt, arg.GameID, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const gameInviteTokenRevokeAll = `-- name: GameInviteTokenRevokeAll :exec
+update game_invite_tokens
+set revoked_at = ?1
+where game_id = ?2
+  and revoked_at is null
+`
+
+type GameInviteTokenRevokeAllParams struct {
+	RevokedAt sql.NullTime
+	GameID    string
+}
+
+func (q *Queries) GameInviteTokenRevokeAll(ctx context.Context, arg GameInviteTokenRevokeAllParams) error {
+	_, err := q.db.ExecContext(ctx, gameInviteTokenRevokeAll, arg.RevokedAt, arg.GameID)
+	return err
+}
+
+const participantSetInviteToken = `-- name: ParticipantSetInviteToken :exec
+update game_participants
+set invite_token_id = ?1
+where game_id = ?2
+  and user_id = ?3
+  and invite_token_id is null
+`
+
+type ParticipantSetInviteTokenParams struct {
+	InviteTokenID sql.NullInt64
+	GameID        string
+	UserID        int64
+}
+
+// Keeps the token the participant first joined with.
+func (q *Queries) ParticipantSetInviteToken(ctx context.Context, arg ParticipantSetInviteTokenParams) error {
+	_, err := q.db.ExecContext(ctx, participantSetInviteToken, arg.InviteTokenID, arg.GameID, arg.UserID)
+	return err
+}
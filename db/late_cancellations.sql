@@ -0,0 +1,8 @@
+-- name: ParticipantSetLateCancellation :exec
+update game_participants
+set
+  updated_at = current_timestamp,
+  late_cancelled_at = sqlc.arg(late_cancelled_at),
+  late_cancelled_spots = sqlc.arg(late_cancelled_spots)
+where game_id = sqlc.arg(game_id)
+  and user_id = sqlc.arg(user_id);
@@ -0,0 +1,38 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: late_cancellations.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+)
+
+const participantSetLateCancellation = `-- name: ParticipantSetLateCancellation :exec
+update game_participants
+set
+  updated_at = current_timestamp,
+  late_cancelled_at = ?1,
+  late_cancelled_spots = ?2
+where game_id = ?3
+  and user_id = ?4
+`
+
+type ParticipantSetLateCancellationParams struct {
+	LateCancelledAt    sql.NullTime
+	LateCancelledSpots int64
+	GameID             string
+	UserID             int64
+}
+
+func (q *Queries) ParticipantSetLateCancellation(ctx context.Context, arg ParticipantSetLateCancellationParams) error {
+	_, err := q.db.ExecContext(ctx, participantSetLateCancellation,
+		arg.LateCancelledAt,
+		arg.LateCancelledSpots,
+		arg.GameID,
+		arg.UserID,
+	)
+	return err
+}
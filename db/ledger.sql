@@ -0,0 +1,105 @@
+-- name: LedgerParticipantList :many
+-- Lists the participants of the frozen games that bill participants with who collects the money of each game: the treasurer
+-- appointed first, or the owner until they appoint one. Restricted to the games paid to the payee and/or with the participant
+-- when they're given, every participant of those games is listed since the shares depend on each other.
+-- The participants of a game are in the order of ParticipantsList. The caller filters the games frozen in the future.
+select
+  sqlc.embed(games),
+  cast(coalesce(
+    (
+      select game_roles.user_id
+      from game_roles
+      where game_roles.game_id = games.id
+        and game_roles.role = 'treasurer'
+      order by game_roles.created_at asc, game_roles.user_id asc
+      limit 1
+    ),
+    games.organizer_id
+  ) as integer) as payee_id,
+  users.id = games.organizer_id as is_organizer,
+  cast(coalesce(group_members.tier = 'regular', false) as boolean) as is_regular,
+  sqlc.embed(game_participants),
+  sqlc.embed(users)
+from games
+join game_participants on game_participants.game_id = games.id
+join users on game_participants.user_id = users.id
+left join group_members
+  on group_members.group_id = games.group_id and group_members.user_id = game_participants.user_id
+where games.frozen_at is not null
+  and games.deleted_at is null
+  and games.cancelled_at is null
+  and (
+    coalesce(
+      (
+        select game_roles.user_id
+        from game_roles
+        where game_roles.game_id = games.id
+          and game_roles.role = 'treasurer'
+        order by game_roles.created_at asc, game_roles.user_id asc
+        limit 1
+      ),
+      games.organizer_id
+    ) = sqlc.narg(payee_id)
+    or sqlc.narg(payee_id) is null
+  )
+  and (
+    exists (
+      select 1
+      from game_participants as payer_participants
+      where payer_participants.game_id = games.id
+        and payer_participants.user_id = sqlc.narg(participant_id)
+    )
+    or sqlc.narg(participant_id) is null
+  )
+order by
+  games.id asc,
+  users.id = games.organizer_id desc,
+  games.regulars_first and group_members.tier is 'regular' desc,
+  game_participants.draw_position is null,
+  game_participants.draw_position asc,
+  game_participants.going_updated_at asc,
+  game_participants.rowid asc;
+
+-- name: LedgerPaymentCreate :one
+insert into ledger_payments(
+  payer_id,
+  payee_id,
+  amount_cents,
+  received_at,
+  note
+) values (?, ?, ?, ?, ?)
+returning *;
+
+-- name: LedgerPaymentList :many
+-- Lists the payments of the payer and/or to the payee when they're given, the caller sorts them by received_at.
+select *
+from ledger_payments
+where (payer_id = sqlc.narg(payer_id) or sqlc.narg(payer_id) is null)
+  and (payee_id = sqlc.narg(payee_id) or sqlc.narg(payee_id) is null)
+order by id asc;
+
+-- name: LedgerAllocationList :many
+-- Lists the allocations of the payments of the payer and/or to the payee when they're given.
+select
+  ledger_allocations.*,
+  ledger_payments.payer_id,
+  ledger_payments.payee_id
+from ledger_allocations
+join ledger_payments on ledger_allocations.payment_id = ledger_payments.id
+where (ledger_payments.payer_id = sqlc.narg(payer_id) or sqlc.narg(payer_id) is null)
+  and (ledger_payments.payee_id = sqlc.narg(payee_id) or sqlc.narg(payee_id) is null);
+
+-- name: LedgerAllocationAdd :exec
+insert into ledger_allocations(
+  payment_id,
+  game_id,
+  amount_cents
+) values (?, ?, ?)
+on conflict(payment_id, game_id) do update set
+  amount_cents = ledger_allocations.amount_cents + excluded.amount_cents;
+
+-- name: LedgerPaymentTransferPayer :exec
+-- Moves the payments of a placeholder to the user claiming it.
+update ledger_payments
+set payer_id = sqlc.arg(new_payer_id)
+where payer_id = sqlc.arg(payer_id);
@@ -0,0 +1,28 @@
+-- name: GameListWithPendingLifecycleEvents :many
+-- Only the events of the timestamps the game has apply to it: published, frozen, registration closed, and started and ended once it has a start.
+-- An event fired for another moment, e.g. before the game was postponed, doesn't count. The moments are compared
+-- to the second, they're stored in UTC.
+select
+  sqlc.embed(games),
+  cast(coalesce(group_concat(game_lifecycle_events.event_type), '') as text) as fired_event_types
+from games
+left join game_lifecycle_events
+  on game_lifecycle_events.game_id = games.id
+  and datetime(substr(game_lifecycle_events.due_at, 1, 19)) = case game_lifecycle_events.event_type
+    when 'game_published' then datetime(substr(games.published_at, 1, 19))
+    when 'game_frozen' then datetime(substr(games.frozen_at, 1, 19))
+    when 'registration_closed' then datetime(substr(games.registration_closes_at, 1, 19))
+    when 'game_started' then datetime(substr(games.starts_at, 1, 19))
+    when 'game_ended' then datetime(substr(games.starts_at, 1, 19), '+' || games.duration_minutes || ' minutes')
+  end
+where (games.published_at is not null or games.frozen_at is not null or games.registration_closes_at is not null or games.starts_at is not null)
+  and games.deleted_at is null
+  and games.cancelled_at is null
+group by games.id
+having count(distinct game_lifecycle_events.event_type) <
+  (games.published_at is not null) + (games.frozen_at is not null) + (games.registration_closes_at is not null) + 2 * (games.starts_at is not null);
+
+-- name: GameLifecycleEventCreate :execrows
+insert into game_lifecycle_events(game_id, event_type, due_at, fired_at, handlers_skipped)
+values (?, ?, ?, ?, ?)
+on conflict do nothing;
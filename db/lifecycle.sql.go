@@ -0,0 +1,133 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: lifecycle.sql
+
+package db
+
+import (
+	"context"
+	"time"
+)
+
+const gameLifecycleEventCreate = `-- name: GameLifecycleEventCreate :execrows
+insert into game_lifecycle_events(game_id, event_type, due_at, fired_at, handlers_skipped)
+values (?, ?, ?, ?, ?)
+on conflict do nothing
+`
+
+type GameLifecycleEventCreateParams struct {
+	GameID          string
+	EventType       string
+	DueAt           time.Time
+	FiredAt         time.Time
+	HandlersSkipped bool
+}
+
+func (q *Queries) GameLifecycleEventCreate(ctx context.Context, arg GameLifecycleEventCreateParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, gameLifecycleEventCreate,
+		arg.GameID,
+		arg.EventType,
+		arg.DueAt,
+		arg.FiredAt,
+		arg.HandlersSkipped,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const gameListWithPendingLifecycleEvents = `-- name: GameListWithPendingLifecycleEvents :many
+select
+  games.id, games.organizer_id, games.name, games.description, games.published_at, games.total_price_cents, games.location, games.starts_at, games.duration_minutes, games.max_players, games.max_guests_per_player, games.game_spots_left, games.created_at, games.updated_at, games.frozen_at, games.series_id, games.series_occurrence_at, games.group_id, games.is_private, games.waitlist_offer_minutes, games.allocation_mode, games.registration_closes_at, games.lottery_seed, games.lottery_drawn_at, games.regulars_head_start_minutes, games.regulars_first, games.waitlist_mode, games.max_waitlist_size, games.waitlist_spots_left, games.reconfirm_within_minutes, games.cancellation_deadline_minutes, games.bill_late_cancellations, games.check_in_code, games.cancelled_at, games.cancellation_reason, games.deleted_at, games.split_strategy, games.price_per_player_cents, games.guest_price_cents,
+  cast(coalesce(group_concat(game_lifecycle_events.event_type), '') as text) as fired_event_types
+from games
+left join game_lifecycle_events
+  on game_lifecycle_events.game_id = games.id
+  and datetime(substr(game_lifecycle_events.due_at, 1, 19)) = case game_lifecycle_events.event_type
+    when 'game_published' then datetime(substr(games.published_at, 1, 19))
+    when 'game_frozen' then datetime(substr(games.frozen_at, 1, 19))
+    when 'registration_closed' then datetime(substr(games.registration_closes_at, 1, 19))
+    when 'game_started' then datetime(substr(games.starts_at, 1, 19))
+    when 'game_ended' then datetime(substr(games.starts_at, 1, 19), '+' || games.duration_minutes || ' minutes')
+  end
+where (games.published_at is not null or games.frozen_at is not null or games.registration_closes_at is not null or games.starts_at is not null)
+  and games.deleted_at is null
+  and games.cancelled_at is null
+group by games.id
+having count(distinct game_lifecycle_events.event_type) <
+  (games.published_at is not null) + (games.frozen_at is not null) + (games.registration_closes_at is not null) + 2 * (games.starts_at is not null)
+`
+
+type GameListWithPendingLifecycleEventsRow struct {
+	Game            Game
+	FiredEventTypes string
+}
+
+// Only the events of the timestamps the game has apply to it: published, frozen, registration closed, and started and ended once it has a start.
+// An event fired for another moment, e.g. before the game was postponed, doesn't count. The moments are compared
+// to the second, they're stored in UTC.
+func (q *Queries) GameListWithPendingLifecycleEvents(ctx context.Context) ([]GameListWithPendingLifecycleEventsRow, error) {
+	rows, err := q.db.QueryContext(ctx, gameListWithPendingLifecycleEvents)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GameListWithPendingLifecycleEventsRow
+	for rows.Next() {
+		var i GameListWithPendingLifecycleEventsRow
+		if err := rows.Scan(
+			&i.Game.ID,
+			&i.Game.OrganizerID,
+			&i.Game.Name,
+			&i.Game.Description,
+			&i.Game.PublishedAt,
+			&i.Game.TotalPriceCents,
+			&i.Game.Location,
+			&i.Game.StartsAt,
+			&i.Game.DurationMinutes,
+			&i.Game.MaxPlayers,
+			&i.Game.MaxGuestsPerPlayer,
+			&i.Game.GameSpotsLeft,
+			&i.Game.CreatedAt,
+			&i.Game.UpdatedAt,
+			&i.Game.FrozenAt,
+			&i.Game.SeriesID,
+			&i.Game.SeriesOccurrenceAt,
+			&i.Game.GroupID,
+			&i.Game.IsPrivate,
+			&i.Game.WaitlistOfferMinutes,
+			&i.Game.AllocationMode,
+			&i.Game.RegistrationClosesAt,
+			&i.Game.LotterySeed,
+			&i.Game.LotteryDrawnAt,
+			&i.Game.RegularsHeadStartMinutes,
+			&i.Game.RegularsFirst,
+			&i.Game.WaitlistMode,
+			&i.Game.MaxWaitlistSize,
+			&i.Game.WaitlistSpotsLeft,
+			&i.Game.ReconfirmWithinMinutes,
+			&i.Game.CancellationDeadlineMinutes,
+			&i.Game.BillLateCancellations,
+			&i.Game.CheckInCode,
+			&i.Game.CancelledAt,
+			&i.Game.CancellationReason,
+			&i.Game.DeletedAt,
+			&i.Game.SplitStrategy,
+			&i.Game.PricePerPlayerCents,
+			&i.Game.GuestPriceCents,
+			&i.FiredEventTypes,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
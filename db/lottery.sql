@@ -0,0 +1,16 @@
+-- name: GameSetLotteryDrawn :execrows
+update games
+set
+  lottery_seed = sqlc.arg(lottery_seed),
+  lottery_drawn_at = sqlc.arg(lottery_drawn_at),
+  updated_at = current_timestamp
+where id = sqlc.arg(id)
+  and lottery_drawn_at is null;
+
+-- name: ParticipantSetDrawPosition :exec
+update game_participants
+set
+  updated_at = current_timestamp,
+  draw_position = sqlc.arg(draw_position)
+where game_id = sqlc.arg(game_id)
+  and user_id = sqlc.arg(user_id);
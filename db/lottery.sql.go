@@ -0,0 +1,55 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: lottery.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+)
+
+const gameSetLotteryDrawn = `-- name: GameSetLotteryDrawn :execrows
+update games
+set
+  lottery_seed = ?1,
+  lottery_drawn_at = ?2,
+  updated_at = current_timestamp
+where id = ?3
+  and lottery_drawn_at is null
+`
+
+type GameSetLotteryDrawnParams struct {
+	LotterySeed    sql.NullInt64
+	LotteryDrawnAt sql.NullTime
+	ID             string
+}
+
+func (q *Queries) GameSetLotteryDrawn(ctx context.Context, arg GameSetLotteryDrawnParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, gameSetLotteryDrawn, arg.LotterySeed, arg.LotteryDrawnAt, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const participantSetDrawPosition = `-- name: ParticipantSetDrawPosition :exec
+update game_participants
+set
+  updated_at = current_timestamp,
+  draw_position = ?1
+where game_id = ?2
+  and user_id = ?3
+`
+
+type ParticipantSetDrawPositionParams struct {
+	DrawPosition sql.NullInt64
+	GameID       string
+	UserID       int64
+}
+
+func (q *Queries) ParticipantSetDrawPosition(ctx context.Context, arg ParticipantSetDrawPositionParams) error {
+	_, err := q.db.ExecContext(ctx, participantSetDrawPosition, arg.DrawPosition, arg.GameID, arg.UserID)
+	return err
+}
@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+create table game_series (
+  id text primary key, -- same format as games.id
+  organizer_id integer not null,
+  name text not null,
+  description text,
+  total_price_cents integer default 0 not null,
+  location text,
+  duration_minutes integer default 60 not null,
+  max_players integer default 100 not null,
+  max_guests_per_player integer default 0 not null,
+  starts_at datetime not null, -- first possible occurrence, its time of day is used for every game
+  timezone text default 'UTC' not null, -- IANA time zone used to compute occurrences
+  interval_weeks integer default 1 not null, -- 1 = every week, 2 = every other week, ...
+  weekdays text not null, -- comma separated days of the week (0 = sunday)
+  ends_at datetime, -- no games are generated after this time
+  occurrence_count integer, -- no games are generated after this many occurrences
+  publish_minutes_before integer, -- if set, generated games are scheduled to be published this many minutes before they start
+  created_at datetime default current_timestamp not null,
+  updated_at datetime default current_timestamp not null
+);
+
+alter table games add column series_id text;
+alter table games add column series_occurrence_at datetime; -- the occurrence of the series this game was generated for
+
+create unique index idx_games_series_id_series_occurrence_at
+  on games(series_id, series_occurrence_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index idx_games_series_id_series_occurrence_at;
+alter table games drop column series_occurrence_at;
+alter table games drop column series_id;
+drop table game_series;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+create table game_lifecycle_events (
+  game_id text not null,
+  event_type text not null, -- game_published, game_frozen, game_started or game_ended
+  fired_at datetime not null, -- when the scheduler fired the event, it's never fired twice for the same game
+  primary key (game_id, event_type)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table game_lifecycle_events;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+create table notification_preferences (
+  user_id integer not null,
+  notification_type text not null, -- waitlist_promoted, game_published, game_updated or reimbursement_received
+  enabled boolean not null, -- notifications are enabled unless the user opted out
+  updated_at datetime default current_timestamp not null,
+  primary key (user_id, notification_type)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table notification_preferences;
+-- +goose StatementEnd
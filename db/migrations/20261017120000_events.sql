@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+create table events (
+  id integer primary key autoincrement, -- also the order events are delivered in
+  event_type text not null,
+  game_id text not null,
+  payload text not null, -- json
+  created_at datetime default current_timestamp not null,
+  attempts integer default 0 not null, -- failed delivery attempts
+  next_attempt_at datetime, -- set after a failed attempt, null = as soon as possible
+  last_error text,
+  delivered_at datetime, -- when every consumer received the event
+  failed_at datetime -- when the dispatcher gave up on the event
+);
+
+create index idx_events_pending on events(id) where delivered_at is null and failed_at is null;
+
+create table event_deliveries (
+  event_id integer not null,
+  consumer text not null,
+  delivered_at datetime not null,
+  primary key (event_id, consumer)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table event_deliveries;
+drop index idx_events_pending;
+drop table events;
+-- +goose StatementEnd
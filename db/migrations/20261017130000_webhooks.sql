@@ -0,0 +1,44 @@
+-- +goose Up
+-- +goose StatementBegin
+create table webhooks (
+  id integer primary key autoincrement,
+  organizer_id integer not null,
+  game_id text, -- exactly one of game_id and series_id is set
+  series_id text, -- the webhook receives the events of every game of the series
+  url text not null,
+  secret text not null, -- signs the deliveries
+  event_types text not null, -- comma separated event types
+  created_at datetime default current_timestamp not null,
+  check ((game_id is null) != (series_id is null))
+);
+
+create index idx_webhooks_game_id on webhooks(game_id);
+create index idx_webhooks_series_id on webhooks(series_id);
+
+create table webhook_deliveries (
+  id integer primary key autoincrement,
+  webhook_id integer not null,
+  event_id integer not null,
+  event_type text not null,
+  payload text not null, -- the json body sent to the webhook, kept for redeliveries
+  created_at datetime default current_timestamp not null,
+  attempts integer default 0 not null, -- failed delivery attempts
+  next_attempt_at datetime, -- set after a failed attempt, null = as soon as possible
+  last_status_code integer, -- response status of the last attempt, null if no response was received
+  last_error text,
+  delivered_at datetime,
+  failed_at datetime, -- when the deliverer gave up
+  unique (webhook_id, event_id)
+);
+
+create index idx_webhook_deliveries_pending on webhook_deliveries(id) where delivered_at is null and failed_at is null;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index idx_webhook_deliveries_pending;
+drop table webhook_deliveries;
+drop index idx_webhooks_series_id;
+drop index idx_webhooks_game_id;
+drop table webhooks;
+-- +goose StatementEnd
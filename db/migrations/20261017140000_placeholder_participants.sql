@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Placeholders are participants added by an organizer for players without an account,
+-- they have an unroutable email and participate in a single game.
+alter table users add column is_placeholder boolean default false not null;
+
+create table placeholder_claims (
+  token_hash text primary key, -- sha256 of the one-time token sent in the claim link
+  user_id integer not null, -- the placeholder
+  game_id text not null,
+  created_at datetime default current_timestamp not null
+);
+
+create index idx_placeholder_claims_user_id on placeholder_claims(user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index idx_placeholder_claims_user_id;
+drop table placeholder_claims;
+alter table users drop column is_placeholder;
+-- +goose StatementEnd
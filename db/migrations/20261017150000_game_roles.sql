@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The owner of a game is games.organizer_id, game_roles holds the roles the owner delegated to other users.
+create table game_roles (
+  game_id text not null,
+  user_id integer not null,
+  role text not null check (role in ('co_organizer', 'treasurer')),
+  created_at datetime default current_timestamp not null,
+  updated_at datetime default current_timestamp not null,
+  primary key (game_id, user_id)
+);
+
+create index idx_game_roles_user_id on game_roles(user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index idx_game_roles_user_id;
+drop table game_roles;
+-- +goose StatementEnd
@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+create table groups (
+  id text primary key, -- same format as games.id
+  name text not null,
+  description text,
+  invite_code text not null unique, -- anyone with the invite link can join the group
+  created_at datetime default current_timestamp not null,
+  updated_at datetime default current_timestamp not null
+);
+
+create table group_members (
+  group_id text not null,
+  user_id integer not null,
+  role text not null check (role in ('admin', 'member')),
+  created_at datetime default current_timestamp not null,
+  updated_at datetime default current_timestamp not null,
+  primary key (group_id, user_id)
+);
+
+create index idx_group_members_user_id on group_members(user_id);
+
+alter table games add column group_id text; -- group-only games are only visible to the members of the group
+
+create index idx_games_group_id on games(group_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index idx_games_group_id;
+alter table games drop column group_id;
+drop index idx_group_members_user_id;
+drop table group_members;
+drop table groups;
+-- +goose StatementEnd
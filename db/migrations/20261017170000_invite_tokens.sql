@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Private games can only be seen and joined with an invite token, which is carried in the share link.
+alter table games add column is_private boolean default false not null;
+
+create table game_invite_tokens (
+  id integer primary key autoincrement,
+  game_id text not null,
+  token text not null unique,
+  created_at datetime default current_timestamp not null,
+  revoked_at datetime -- revoked tokens no longer let users see or join the game
+);
+
+create index idx_game_invite_tokens_game_id on game_invite_tokens(game_id);
+
+alter table game_participants add column invite_token_id integer; -- the token the participant joined the private game with
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table game_participants drop column invite_token_id;
+drop index idx_game_invite_tokens_game_id;
+drop table game_invite_tokens;
+alter table games drop column is_private;
+-- +goose StatementEnd
@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+-- When set, spots freed in the game are offered to the next waitlisted participant for this long,
+-- instead of promoting them directly. 0 disables offers.
+alter table games add column waitlist_offer_minutes integer default 0 not null;
+
+create table waitlist_offers (
+  id integer primary key autoincrement,
+  game_id text not null,
+  user_id integer not null,
+  status text not null default 'pending' check (status in ('pending', 'accepted', 'declined', 'expired')),
+  expires_at datetime not null,
+  responded_at datetime, -- when the offer was accepted, declined or expired
+  created_at datetime default current_timestamp not null
+);
+
+create index idx_waitlist_offers_game_id on waitlist_offers(game_id);
+create index idx_waitlist_offers_status on waitlist_offers(status);
+-- a participant has at most one pending offer per game
+create unique index idx_waitlist_offers_pending on waitlist_offers(game_id, user_id) where status = 'pending';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop index idx_waitlist_offers_pending;
+drop index idx_waitlist_offers_status;
+drop index idx_waitlist_offers_game_id;
+drop table waitlist_offers;
+alter table games drop column waitlist_offer_minutes;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- In lottery games, participants register until registration_closes_at, then a random draw seeded with lottery_seed
+-- decides who is going and the order of the waitlist.
+alter table games add column allocation_mode text default 'first_come_first_served' not null check (allocation_mode in ('first_come_first_served', 'lottery'));
+alter table games add column registration_closes_at datetime;
+alter table games add column lottery_seed integer;
+alter table games add column lottery_drawn_at datetime;
+
+-- position of the participant in the draw, participants joining after the draw have none and queue after the drawn ones
+alter table game_participants add column draw_position integer;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table game_participants drop column draw_position;
+alter table games drop column lottery_drawn_at;
+alter table games drop column lottery_seed;
+alter table games drop column registration_closes_at;
+alter table games drop column allocation_mode;
+-- +goose StatementEnd
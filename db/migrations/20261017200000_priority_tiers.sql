@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Regulars of a group can get priority over drop-ins in the games of the group.
+alter table group_members add column tier text default 'drop_in' not null check (tier in ('regular', 'drop_in'));
+
+-- Defaults of the games created in the group
+alter table groups add column regulars_head_start_minutes integer default 0 not null;
+alter table groups add column regulars_first boolean default false not null;
+
+-- How long after the game is published only regulars can join, 0 disables the head start
+alter table games add column regulars_head_start_minutes integer default 0 not null;
+-- Whether regulars sort ahead of drop-ins when the game is oversubscribed, the organizer still comes first
+alter table games add column regulars_first boolean default false not null;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table games drop column regulars_first;
+alter table games drop column regulars_head_start_minutes;
+alter table groups drop column regulars_first;
+alter table groups drop column regulars_head_start_minutes;
+alter table group_members drop column tier;
+-- +goose StatementEnd
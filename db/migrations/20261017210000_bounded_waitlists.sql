@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The waitlist of a game can be disabled, capped to max_waitlist_size or unlimited.
+-- Like game_spots_left, waitlist_spots_left is calculated at write time, it's only meaningful for fixed waitlists.
+alter table games add column waitlist_mode text default 'unlimited' not null check (waitlist_mode in ('disabled', 'fixed', 'unlimited'));
+alter table games add column max_waitlist_size integer default 0 not null check (max_waitlist_size >= 0);
+alter table games add column waitlist_spots_left integer default 0 not null;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table games drop column waitlist_spots_left;
+alter table games drop column max_waitlist_size;
+alter table games drop column waitlist_mode;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+-- When important details of a published game change, the participants going to it have to confirm they're still coming.
+-- Participants who didn't reconfirm within reconfirm_within_minutes lose their spot, 0 never releases them.
+alter table games add column reconfirm_within_minutes integer default 0 not null check (reconfirm_within_minutes >= 0);
+
+-- set when the participant was asked to reconfirm, cleared once they confirm or leave the game
+alter table game_participants add column reconfirm_requested_at datetime;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table game_participants drop column reconfirm_requested_at;
+alter table games drop column reconfirm_within_minutes;
+-- +goose StatementEnd
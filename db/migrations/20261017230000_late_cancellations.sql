@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Participants dropping out less than cancellation_deadline_minutes before the game starts cancel late, 0 disables the deadline.
+-- Games billing late cancellations charge them their share when nobody took their spot.
+alter table games add column cancellation_deadline_minutes integer default 0 not null check (cancellation_deadline_minutes >= 0);
+alter table games add column bill_late_cancellations boolean default false not null;
+
+-- set when a participant in the list of players drops out after the deadline, with the spots they freed, cleared if they join again
+alter table game_participants add column late_cancelled_at datetime;
+alter table game_participants add column late_cancelled_spots integer default 0 not null;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table game_participants drop column late_cancelled_spots;
+alter table game_participants drop column late_cancelled_at;
+alter table games drop column bill_late_cancellations;
+alter table games drop column cancellation_deadline_minutes;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Code participants check themselves in with at the venue, set by the organizer
+alter table games add column check_in_code text;
+
+-- set when the participant showed up to the game, checked in by an organizer or by themselves with the check-in code
+alter table game_participants add column checked_in_at datetime;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table game_participants drop column checked_in_at;
+alter table games drop column check_in_code;
+-- +goose StatementEnd
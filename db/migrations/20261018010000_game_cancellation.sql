@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Published games are cancelled with a reason rather than deleted, they keep their participants for history.
+alter table games add column cancelled_at datetime;
+alter table games add column cancellation_reason text;
+
+-- Draft games are soft deleted, deleted games are hidden everywhere but the rows are kept.
+alter table games add column deleted_at datetime;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table games drop column deleted_at;
+alter table games drop column cancellation_reason;
+alter table games drop column cancelled_at;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- How the price of a game is split between the participants billed for it:
+-- even splits total_price_cents between every spot, organizer_exempt between every spot but the organizer's own,
+-- per_player charges price_per_player_cents for every spot and per_player_guest_rate charges guest_price_cents for guests instead.
+alter table games add column split_strategy text default 'even' not null check (split_strategy in ('even', 'per_player', 'per_player_guest_rate', 'organizer_exempt'));
+alter table games add column price_per_player_cents integer default 0 not null check (price_per_player_cents >= 0);
+alter table games add column guest_price_cents integer default 0 not null check (guest_price_cents >= 0);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+alter table games drop column guest_price_cents;
+alter table games drop column price_per_player_cents;
+alter table games drop column split_strategy;
+-- +goose StatementEnd
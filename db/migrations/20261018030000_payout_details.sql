@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Where organizers want to be reimbursed, shown to the participants who owe them money
+create table payout_details (
+  user_id integer primary key references users(id) on delete cascade,
+  iban text, -- normalized, without spaces
+  bic text,
+  beneficiary_name text, -- required with the IBAN
+  mbway_phone text,
+  paypal_me_handle text,
+  updated_at datetime default current_timestamp not null
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table payout_details;
+-- +goose StatementEnd
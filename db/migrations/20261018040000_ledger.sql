@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Payments participants make to an organizer, which can settle what they owe for several games at once.
+-- What they owe is derived from the frozen games, the ledger only records the money received.
+create table ledger_payments (
+  id integer primary key autoincrement,
+  payer_id integer not null references users(id) on delete cascade,
+  payee_id integer not null references users(id) on delete cascade, -- the organizer who received the payment
+  amount_cents integer not null check (amount_cents > 0),
+  received_at datetime not null,
+  note text,
+  created_at datetime default current_timestamp not null
+);
+
+create index idx_ledger_payments_payer_id on ledger_payments(payer_id);
+create index idx_ledger_payments_payee_id on ledger_payments(payee_id);
+
+-- How much of a payment settled the payer's share of a game, the oldest outstanding shares are settled first.
+-- What isn't allocated stays as credit for the next games.
+create table ledger_allocations (
+  payment_id integer not null references ledger_payments(id) on delete cascade,
+  game_id text not null references games(id) on delete cascade,
+  amount_cents integer not null check (amount_cents > 0),
+  primary key (payment_id, game_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+drop table ledger_allocations;
+drop index idx_ledger_payments_payee_id;
+drop index idx_ledger_payments_payer_id;
+drop table ledger_payments;
+-- +goose StatementEnd
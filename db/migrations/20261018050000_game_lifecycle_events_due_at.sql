@@ -0,0 +1,66 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Events are recorded for the moment they were due, so a game moved to another moment fires the event again
+-- while what already fired is kept.
+create table game_lifecycle_events_new (
+  game_id text not null,
+  event_type text not null, -- game_published, game_frozen, game_started or game_ended
+  due_at datetime not null, -- the moment the game reached, it's never fired twice for the same moment
+  fired_at datetime not null,
+  handlers_skipped boolean not null default false, -- the event was overdue when it fired, see scheduler.max-delay
+  primary key (game_id, event_type, due_at)
+);
+
+-- The events that fired for a moment the game no longer has can't be dated, they're dropped
+insert into game_lifecycle_events_new(
+  game_id,
+  event_type,
+  due_at,
+  fired_at
+)
+select
+  game_lifecycle_events.game_id,
+  game_lifecycle_events.event_type,
+  case game_lifecycle_events.event_type
+    when 'game_published' then games.published_at
+    when 'game_frozen' then games.frozen_at
+    when 'game_started' then games.starts_at
+    else datetime(substr(games.starts_at, 1, 19), '+' || games.duration_minutes || ' minutes')
+  end,
+  game_lifecycle_events.fired_at
+from game_lifecycle_events
+join games on games.id = game_lifecycle_events.game_id
+where case game_lifecycle_events.event_type
+    when 'game_published' then games.published_at
+    when 'game_frozen' then games.frozen_at
+    else games.starts_at
+  end is not null;
+
+drop table game_lifecycle_events;
+alter table game_lifecycle_events_new rename to game_lifecycle_events;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+create table game_lifecycle_events_new (
+  game_id text not null,
+  event_type text not null, -- game_published, game_frozen, game_started or game_ended
+  fired_at datetime not null, -- when the scheduler fired the event, it's never fired twice for the same game
+  primary key (game_id, event_type)
+);
+
+insert into game_lifecycle_events_new(
+  game_id,
+  event_type,
+  fired_at
+)
+select
+  game_id,
+  event_type,
+  max(fired_at)
+from game_lifecycle_events
+group by game_id, event_type;
+
+drop table game_lifecycle_events;
+alter table game_lifecycle_events_new rename to game_lifecycle_events;
+-- +goose StatementEnd
@@ -9,22 +9,81 @@ import (
 	"time"
 )
 
+type Event struct {
+	ID            int64
+	EventType     string
+	GameID        string
+	Payload       string
+	CreatedAt     time.Time
+	Attempts      int64
+	NextAttemptAt sql.NullTime
+	LastError     sql.NullString
+	DeliveredAt   sql.NullTime
+	FailedAt      sql.NullTime
+}
+
+type EventDelivery struct {
+	EventID     int64
+	Consumer    string
+	DeliveredAt time.Time
+}
+
 type Game struct {
-	ID                 string
-	OrganizerID        int64
-	Name               string
-	Description        sql.NullString
-	PublishedAt        sql.NullTime
-	TotalPriceCents    int64
-	Location           sql.NullString
-	StartsAt           sql.NullTime
-	DurationMinutes    int64
-	MaxPlayers         int64
-	MaxGuestsPerPlayer int64
-	GameSpotsLeft      int64
-	CreatedAt          time.Time
-	UpdatedAt          time.Time
-	FrozenAt           sql.NullTime
+	ID                          string
+	OrganizerID                 int64
+	Name                        string
+	Description                 sql.NullString
+	PublishedAt                 sql.NullTime
+	TotalPriceCents             int64
+	Location                    sql.NullString
+	StartsAt                    sql.NullTime
+	DurationMinutes             int64
+	MaxPlayers                  int64
+	MaxGuestsPerPlayer          int64
+	GameSpotsLeft               int64
+	CreatedAt                   time.Time
+	UpdatedAt                   time.Time
+	FrozenAt                    sql.NullTime
+	SeriesID                    sql.NullString
+	SeriesOccurrenceAt          sql.NullTime
+	GroupID                     sql.NullString
+	IsPrivate                   bool
+	WaitlistOfferMinutes        int64
+	AllocationMode              string
+	RegistrationClosesAt        sql.NullTime
+	LotterySeed                 sql.NullInt64
+	LotteryDrawnAt              sql.NullTime
+	RegularsHeadStartMinutes    int64
+	RegularsFirst               bool
+	WaitlistMode                string
+	MaxWaitlistSize             int64
+	WaitlistSpotsLeft           int64
+	ReconfirmWithinMinutes      int64
+	CancellationDeadlineMinutes int64
+	BillLateCancellations       bool
+	CheckInCode                 sql.NullString
+	CancelledAt                 sql.NullTime
+	CancellationReason          sql.NullString
+	DeletedAt                   sql.NullTime
+	SplitStrategy               string
+	PricePerPlayerCents         int64
+	GuestPriceCents             int64
+}
+
+type GameInviteToken struct {
+	ID        int64
+	GameID    string
+	Token     string
+	CreatedAt time.Time
+	RevokedAt sql.NullTime
+}
+
+type GameLifecycleEvent struct {
+	GameID          string
+	EventType       string
+	DueAt           time.Time
+	FiredAt         time.Time
+	HandlersSkipped bool
 }
 
 type GameParticipant struct {
@@ -39,14 +98,146 @@ type GameParticipant struct {
 	ReimbursedAt            sql.NullTime
 	ReimbursementReceivedAt sql.NullTime
 	ReimbursementReference  string
+	InviteTokenID           sql.NullInt64
+	DrawPosition            sql.NullInt64
+	ReconfirmRequestedAt    sql.NullTime
+	LateCancelledAt         sql.NullTime
+	LateCancelledSpots      int64
+	CheckedInAt             sql.NullTime
 }
 
-type User struct {
-	ID        int64
-	Name      sql.NullString
-	Email     string
-	Photo     sql.NullString
+type GameRole struct {
+	GameID    string
+	UserID    int64
+	Role      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type GameSeries struct {
+	ID                   string
+	OrganizerID          int64
+	Name                 string
+	Description          sql.NullString
+	TotalPriceCents      int64
+	Location             sql.NullString
+	DurationMinutes      int64
+	MaxPlayers           int64
+	MaxGuestsPerPlayer   int64
+	StartsAt             time.Time
+	Timezone             string
+	IntervalWeeks        int64
+	Weekdays             string
+	EndsAt               sql.NullTime
+	OccurrenceCount      sql.NullInt64
+	PublishMinutesBefore sql.NullInt64
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+}
+
+type Group struct {
+	ID                       string
+	Name                     string
+	Description              sql.NullString
+	InviteCode               string
+	CreatedAt                time.Time
+	UpdatedAt                time.Time
+	RegularsHeadStartMinutes int64
+	RegularsFirst            bool
+}
+
+type GroupMember struct {
+	GroupID   string
+	UserID    int64
+	Role      string
 	CreatedAt time.Time
 	UpdatedAt time.Time
-	IsDemo    bool
+	Tier      string
+}
+
+type LedgerAllocation struct {
+	PaymentID   int64
+	GameID      string
+	AmountCents int64
+}
+
+type LedgerPayment struct {
+	ID          int64
+	PayerID     int64
+	PayeeID     int64
+	AmountCents int64
+	ReceivedAt  time.Time
+	Note        sql.NullString
+	CreatedAt   time.Time
+}
+
+type NotificationPreference struct {
+	UserID           int64
+	NotificationType string
+	Enabled          bool
+	UpdatedAt        time.Time
+}
+
+type PayoutDetail struct {
+	UserID          int64
+	Iban            sql.NullString
+	Bic             sql.NullString
+	BeneficiaryName sql.NullString
+	MbwayPhone      sql.NullString
+	PaypalMeHandle  sql.NullString
+	UpdatedAt       time.Time
+}
+
+type PlaceholderClaim struct {
+	TokenHash string
+	UserID    int64
+	GameID    string
+	CreatedAt time.Time
+}
+
+type User struct {
+	ID            int64
+	Name          sql.NullString
+	Email         string
+	Photo         sql.NullString
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	IsDemo        bool
+	IsPlaceholder bool
+}
+
+type WaitlistOffer struct {
+	ID          int64
+	GameID      string
+	UserID      int64
+	Status      string
+	ExpiresAt   time.Time
+	RespondedAt sql.NullTime
+	CreatedAt   time.Time
+}
+
+type Webhook struct {
+	ID          int64
+	OrganizerID int64
+	GameID      sql.NullString
+	SeriesID    sql.NullString
+	Url         string
+	Secret      string
+	EventTypes  string
+	CreatedAt   time.Time
+}
+
+type WebhookDelivery struct {
+	ID             int64
+	WebhookID      int64
+	EventID        int64
+	EventType      string
+	Payload        string
+	CreatedAt      time.Time
+	Attempts       int64
+	NextAttemptAt  sql.NullTime
+	LastStatusCode sql.NullInt64
+	LastError      sql.NullString
+	DeliveredAt    sql.NullTime
+	FailedAt       sql.NullTime
 }
@@ -0,0 +1,25 @@
+-- name: NotificationPreferencesListByUser :many
+select *
+from notification_preferences
+where user_id = ?;
+
+-- name: NotificationPreferenceIsEnabled :one
+select cast(coalesce(
+  (
+    select enabled
+    from notification_preferences
+    where user_id = sqlc.arg(user_id)
+      and notification_type = sqlc.arg(notification_type)
+  ),
+  true
+) as boolean) as enabled;
+
+-- name: NotificationPreferenceUpsert :exec
+insert into notification_preferences(
+  user_id,
+  notification_type,
+  enabled
+) values (?, ?, ?)
+on conflict(user_id, notification_type) do update set
+  enabled = excluded.enabled,
+  updated_at = current_timestamp;
@@ -0,0 +1,90 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: notifications.sql
+
+package db
+
+import (
+	"context"
+)
+
+const notificationPreferenceIsEnabled = `-- name: NotificationPreferenceIsEnabled :one
+select cast(coalesce(
+  (
+    select enabled
+    from notification_preferences
+    where user_id = ?1
+      and notification_type = ?2
+  ),
+  true
+) as boolean) as enabled
+`
+
+type NotificationPreferenceIsEnabledParams struct {
+	UserID           int64
+	NotificationType string
+}
+
+func (q *Queries) NotificationPreferenceIsEnabled(ctx context.Context, arg NotificationPreferenceIsEnabledParams) (bool, error) {
+	row := q.db.QueryRowContext(ctx, notificationPreferenceIsEnabled, arg.UserID, arg.NotificationType)
+	var enabled bool
+	err := row.Scan(&enabled)
+	return enabled, err
+}
+
+const notificationPreferenceUpsert = `-- name: NotificationPreferenceUpsert :exec
+insert into notification_preferences(
+  user_id,
+  notification_type,
+  enabled
+) values (?, ?, ?)
+on conflict(user_id, notification_type) do update set
+  enabled = excluded.enabled,
+  updated_at = current_timestamp
+`
+
+type NotificationPreferenceUpsertParams struct {
+	UserID           int64
+	NotificationType string
+	Enabled          bool
+}
+
+func (q *Queries) NotificationPreferenceUpsert(ctx context.Context, arg NotificationPreferenceUpsertParams) error {
+	_, err := q.db.ExecContext(ctx, notificationPreferenceUpsert, arg.UserID, arg.NotificationType, arg.Enabled)
+	return err
+}
+
+const notificationPreferencesListByUser = `-- name: NotificationPreferencesListByUser :many
+select user_id, notification_type, enabled, updated_at
+from notification_preferences
+where user_id = ?
+`
+
+func (q *Queries) NotificationPreferencesListByUser(ctx context.Context, userID int64) ([]NotificationPreference, error) {
+	rows, err := q.db.QueryContext(ctx, notificationPreferencesListByUser, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []NotificationPreference
+	for rows.Next() {
+		var i NotificationPreference
+		if err := rows.Scan(
+			&i.UserID,
+			&i.NotificationType,
+			&i.Enabled,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
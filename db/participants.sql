@@ -18,19 +18,43 @@ on conflict(user_id, game_id) do update set
         excluded.going_updated_at
     ),
     confirmed_at = coalesce(excluded.confirmed_at, game_participants.confirmed_at),
+    -- participants asked to reconfirm are done once they confirm or leave the game
+    reconfirm_requested_at = iif(
+        excluded.confirmed_at is not null or excluded.going = 0,
+        null,
+        game_participants.reconfirm_requested_at
+    ),
     guests = coalesce(excluded.guests, game_participants.guests),
+    -- like going_updated_at, participants lose their place in the draw when their place in the queue changes
+    draw_position = iif(
+        excluded.going = game_participants.going and (excluded.guests is null or excluded.guests is game_participants.guests),
+        game_participants.draw_position,
+        null
+    ),
+    -- participants joining again after cancelling late are going after all
+    late_cancelled_at = iif(excluded.going = 1, null, game_participants.late_cancelled_at),
+    late_cancelled_spots = iif(excluded.going = 1, 0, game_participants.late_cancelled_spots),
     reimbursement_reference = coalesce(game_participants.reimbursement_reference, excluded.reimbursement_reference);
 
 -- name: ParticipantsList :many
 select
     users.id = sqlc.arg(organizer_id) as is_organizer,
+    cast(coalesce(group_members.tier = 'regular', false) as boolean) as is_regular,
     sqlc.embed(game_participants),
     sqlc.embed(users)
 from game_participants
 join users on game_participants.user_id = users.id
+join games on game_participants.game_id = games.id
+left join group_members
+    on group_members.group_id = games.group_id and group_members.user_id = game_participants.user_id
 where game_participants.game_id = sqlc.arg(game_id)
 order by
     1 desc, -- if the user is the organizer, they should have priority
+    -- then the regulars of the group, in games where they sort ahead of drop-ins
+    games.regulars_first and group_members.tier is 'regular' desc,
+    -- in lottery games, the drawn participants come first in the order of the draw
+    game_participants.draw_position is null,
+    game_participants.draw_position asc,
     game_participants.going_updated_at asc,
     -- Ties on going_updated_at are common in tests (static clocks) and can happen in
     -- production too; ordering by the auto-incremented participant ID makes queue
@@ -71,3 +95,25 @@ set
     reimbursement_received_at = sqlc.arg(reimbursement_received_at)
 where game_id = sqlc.arg(game_id)
     and user_id = sqlc.arg(user_id);
+
+-- name: ParticipantDelete :execrows
+delete from game_participants
+where game_id = sqlc.arg(game_id)
+    and user_id = sqlc.arg(user_id);
+
+-- name: ParticipantUpdateGoingUpdatedAt :exec
+update game_participants
+set
+    updated_at = current_timestamp,
+    going_updated_at = sqlc.arg(going_updated_at)
+where game_id = sqlc.arg(game_id)
+    and user_id = sqlc.arg(user_id);
+
+-- name: ParticipantTransfer :exec
+-- Gives the participation of a placeholder to a real user, keeping its place in the queue and its reimbursement reference.
+update game_participants
+set
+    updated_at = current_timestamp,
+    user_id = sqlc.arg(new_user_id)
+where game_id = sqlc.arg(game_id)
+    and user_id = sqlc.arg(user_id);
@@ -11,8 +11,27 @@ import (
 	"time"
 )
 
+const participantDelete = `-- name: ParticipantDelete :execrows
+delete from game_participants
+where game_id = ?1
+    and user_id = ?2
+`
+
+type ParticipantDeleteParams struct {
+	GameID string
+	UserID int64
+}
+
+func (q *Queries) ParticipantDelete(ctx context.Context, arg ParticipantDeleteParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, participantDelete, arg.GameID, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const participantGetByGameAndUser = `-- name: ParticipantGetByGameAndUser :one
-select user_id, game_id, created_at, updated_at, going_updated_at, going, confirmed_at, guests, reimbursed_at, reimbursement_received_at, reimbursement_reference
+select user_id, game_id, created_at, updated_at, going_updated_at, going, confirmed_at, guests, reimbursed_at, reimbursement_received_at, reimbursement_reference, invite_token_id, draw_position, reconfirm_requested_at, late_cancelled_at, late_cancelled_spots, checked_in_at
 from game_participants
 where game_id = ?1
     and user_id = ?2
@@ -38,10 +57,57 @@ func (q *Queries) ParticipantGetByGameAndUser(ctx context.Context, arg Participa
 		&i.ReimbursedAt,
 		&i.ReimbursementReceivedAt,
 		&i.ReimbursementReference,
+		&i.InviteTokenID,
+		&i.DrawPosition,
+		&i.ReconfirmRequestedAt,
+		&i.LateCancelledAt,
+		&i.LateCancelledSpots,
+		&i.CheckedInAt,
 	)
 	return i, err
 }
 
+const participantTransfer = `-- name: ParticipantTransfer :exec
+update game_participants
+set
+    updated_at = current_timestamp,
+    user_id = ?1
+where game_id = ?2
+    and user_id = ?3
+`
+
+type ParticipantTransferParams struct {
+	NewUserID int64
+	GameID    string
+	UserID    int64
+}
+
+// Gives the participation of a placeholder to a real user, keeping its place in the queue and its reimbursement reference.
+func (q *Queries) ParticipantTransfer(ctx context.Context, arg ParticipantTransferParams) error {
+	_, err := q.db.ExecContext(ctx, participantTransfer, arg.NewUserID, arg.GameID, arg.UserID)
+	return err
+}
+
+const participantUpdateGoingUpdatedAt = `-- name: ParticipantUpdateGoingUpdatedAt :exec
+update game_participants
+set
+    updated_at = current_timestamp,
+    going_updated_at = ?1
+where game_id = ?2
+    and user_id = ?3
+`
+
+type ParticipantUpdateGoingUpdatedAtParams struct {
+	GoingUpdatedAt time.Time
+	GameID         string
+	UserID         int64
+}
+
+func (q *Queries) ParticipantUpdateGoingUpdatedAt(ctx context.Context, arg ParticipantUpdateGoingUpdatedAtParams) error {
+	_, err := q.db.ExecContext(ctx, participantUpdateGoingUpdatedAt, arg.GoingUpdatedAt, arg.GameID, arg.UserID)
+	return err
+}
+
 const participantUpdateReimbursedAt = `-- name: ParticipantUpdateReimbursedAt :execrows
 update game_participants
 set
@@ -91,13 +157,22 @@ func (q *Queries) ParticipantUpdateReimbursementReceivedAt(ctx context.Context,
 const participantsList = `-- name: ParticipantsList :many
 select
     users.id = ?1 as is_organizer,
-    game_participants.user_id, game_participants.game_id, game_participants.created_at, game_participants.updated_at, game_participants.going_updated_at, game_participants.going, game_participants.confirmed_at, game_participants.guests, game_participants.reimbursed_at, game_participants.reimbursement_received_at, game_participants.reimbursement_reference,
-    users.id, users.name, users.email, users.photo, users.created_at, users.updated_at, users.is_demo
+    cast(coalesce(group_members.tier = 'regular', false) as boolean) as is_regular,
+    game_participants.user_id, game_participants.game_id, game_participants.created_at, game_participants.updated_at, game_participants.going_updated_at, game_participants.going, game_participants.confirmed_at, game_participants.guests, game_participants.reimbursed_at, game_participants.reimbursement_received_at, game_participants.reimbursement_reference, game_participants.invite_token_id, game_participants.draw_position, game_participants.reconfirm_requested_at, game_participants.late_cancelled_at, game_participants.late_cancelled_spots, game_participants.checked_in_at,
+    users.id, users.name, users.email, users.photo, users.created_at, users.updated_at, users.is_demo, users.is_placeholder
 from game_participants
 join users on game_participants.user_id = users.id
+join games on game_participants.game_id = games.id
+left join group_members
+    on group_members.group_id = games.group_id and group_members.user_id = game_participants.user_id
 where game_participants.game_id = ?2
 order by
     1 desc, -- if the user is the organizer, they should have priority
+    -- then the regulars of the group, in games where they sort ahead of drop-ins
+    games.regulars_first and group_members.tier is 'regular' desc,
+    -- in lottery games, the drawn participants come first in the order of the draw
+    game_participants.draw_position is null,
+    game_participants.draw_position asc,
     game_participants.going_updated_at asc,
     -- Ties on going_updated_at are common in tests (static clocks) and can happen in
     -- production too; ordering by the auto-incremented participant ID makes queue
@@ -112,6 +187,7 @@ type ParticipantsListParams struct {
 
 type ParticipantsListRow struct {
 	IsOrganizer     bool
+	IsRegular       bool
 	GameParticipant GameParticipant
 	User            User
 }
@@ -127,6 +203,7 @@ func (q *Queries) ParticipantsList(ctx context.Context, arg ParticipantsListPara
 		var i ParticipantsListRow
 		if err := rows.Scan(
 			&i.IsOrganizer,
+			&i.IsRegular,
 			&i.GameParticipant.UserID,
 			&i.GameParticipant.GameID,
 			&i.GameParticipant.CreatedAt,
@@ -138,6 +215,12 @@ func (q *Queries) ParticipantsList(ctx context.Context, arg ParticipantsListPara
 			&i.GameParticipant.ReimbursedAt,
 			&i.GameParticipant.ReimbursementReceivedAt,
 			&i.GameParticipant.ReimbursementReference,
+			&i.GameParticipant.InviteTokenID,
+			&i.GameParticipant.DrawPosition,
+			&i.GameParticipant.ReconfirmRequestedAt,
+			&i.GameParticipant.LateCancelledAt,
+			&i.GameParticipant.LateCancelledSpots,
+			&i.GameParticipant.CheckedInAt,
 			&i.User.ID,
 			&i.User.Name,
 			&i.User.Email,
@@ -145,6 +228,7 @@ func (q *Queries) ParticipantsList(ctx context.Context, arg ParticipantsListPara
 			&i.User.CreatedAt,
 			&i.User.UpdatedAt,
 			&i.User.IsDemo,
+			&i.User.IsPlaceholder,
 		); err != nil {
 			return nil, err
 		}
@@ -179,7 +263,22 @@ on conflict(user_id, game_id) do update set
         excluded.going_updated_at
     ),
     confirmed_at = coalesce(excluded.confirmed_at, game_participants.confirmed_at),
+    -- participants asked to reconfirm are done once they confirm or leave the game
+    reconfirm_requested_at = iif(
+        excluded.confirmed_at is not null or excluded.going = 0,
+        null,
+        game_participants.reconfirm_requested_at
+    ),
     guests = coalesce(excluded.guests, game_participants.guests),
+    -- like going_updated_at, participants lose their place in the draw when their place in the queue changes
+    draw_position = iif(
+        excluded.going = game_participants.going and (excluded.guests is null or excluded.guests is game_participants.guests),
+        game_participants.draw_position,
+        null
+    ),
+    -- participants joining again after cancelling late are going after all
+    late_cancelled_at = iif(excluded.going = 1, null, game_participants.late_cancelled_at),
+    late_cancelled_spots = iif(excluded.going = 1, 0, game_participants.late_cancelled_spots),
     reimbursement_reference = coalesce(game_participants.reimbursement_reference, excluded.reimbursement_reference)
 `
 
@@ -208,7 +307,7 @@ func (q *Queries) ParticipantsUpsert(ctx context.Context, arg ParticipantsUpsert
 
 const reimbursementsListByGame = `-- name: ReimbursementsListByGame :many
 select
-    users.id, users.name, users.email, users.photo, users.created_at, users.updated_at, users.is_demo,
+    users.id, users.name, users.email, users.photo, users.created_at, users.updated_at, users.is_demo, users.is_placeholder,
     game_participants.reimbursement_reference,
     game_participants.reimbursed_at,
     game_participants.reimbursement_received_at
@@ -244,6 +343,7 @@ func (q *Queries) ReimbursementsListByGame(ctx context.Context, gameID string) (
 			&i.User.CreatedAt,
 			&i.User.UpdatedAt,
 			&i.User.IsDemo,
+			&i.User.IsPlaceholder,
 			&i.ReimbursementReference,
 			&i.ReimbursedAt,
 			&i.ReimbursementReceivedAt,
@@ -0,0 +1,22 @@
+-- name: PayoutDetailsGetByUser :one
+select *
+from payout_details
+where user_id = ?;
+
+-- name: PayoutDetailsUpsert :one
+insert into payout_details(
+  user_id,
+  iban,
+  bic,
+  beneficiary_name,
+  mbway_phone,
+  paypal_me_handle
+) values (?, ?, ?, ?, ?, ?)
+on conflict(user_id) do update set
+  iban = excluded.iban,
+  bic = excluded.bic,
+  beneficiary_name = excluded.beneficiary_name,
+  mbway_phone = excluded.mbway_phone,
+  paypal_me_handle = excluded.paypal_me_handle,
+  updated_at = current_timestamp
+returning *;
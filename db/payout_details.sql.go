@@ -0,0 +1,82 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: payout_details.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+)
+
+const payoutDetailsGetByUser = `-- name: PayoutDetailsGetByUser :one
+select user_id, iban, bic, beneficiary_name, mbway_phone, paypal_me_handle, updated_at
+from payout_details
+where user_id = ?
+`
+
+func (q *Queries) PayoutDetailsGetByUser(ctx context.Context, userID int64) (PayoutDetail, error) {
+	row := q.db.QueryRowContext(ctx, payoutDetailsGetByUser, userID)
+	var i PayoutDetail
+	err := row.Scan(
+		&i.UserID,
+		&i.Iban,
+		&i.Bic,
+		&i.BeneficiaryName,
+		&i.MbwayPhone,
+		&i.PaypalMeHandle,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const payoutDetailsUpsert = `-- name: PayoutDetailsUpsert :one
+insert into payout_details(
+  user_id,
+  iban,
+  bic,
+  beneficiary_name,
+  mbway_phone,
+  paypal_me_handle
+) values (?, ?, ?, ?, ?, ?)
+on conflict(user_id) do update set
+  iban = excluded.iban,
+  bic = excluded.bic,
+  beneficiary_name = excluded.beneficiary_name,
+  mbway_phone = excluded.mbway_phone,
+  paypal_me_handle = excluded.paypal_me_handle,
+  updated_at = current_timestamp
+returning user_id, iban, bic, beneficiary_name, mbway_phone, paypal_me_handle, updated_at
+`
+
+type PayoutDetailsUpsertParams struct {
+	UserID          int64
+	Iban            sql.NullString
+	Bic             sql.NullString
+	BeneficiaryName sql.NullString
+	MbwayPhone      sql.NullString
+	PaypalMeHandle  sql.NullString
+}
+
+func (q *Queries) PayoutDetailsUpsert(ctx context.Context, arg PayoutDetailsUpsertParams) (PayoutDetail, error) {
+	row := q.db.QueryRowContext(ctx, payoutDetailsUpsert,
+		arg.UserID,
+		arg.Iban,
+		arg.Bic,
+		arg.BeneficiaryName,
+		arg.MbwayPhone,
+		arg.PaypalMeHandle,
+	)
+	var i PayoutDetail
+	err := row.Scan(
+		&i.UserID,
+		&i.Iban,
+		&i.Bic,
+		&i.BeneficiaryName,
+		&i.MbwayPhone,
+		&i.PaypalMeHandle,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,27 @@
+-- name: UserCreatePlaceholder :one
+insert into users(
+    name,
+    email,
+    is_placeholder
+) values (?, ?, true)
+returning id;
+
+-- name: UserDeletePlaceholder :exec
+delete from users
+where id = ? and is_placeholder;
+
+-- name: PlaceholderClaimCreate :exec
+insert into placeholder_claims(
+    token_hash,
+    user_id,
+    game_id
+) values (?, ?, ?);
+
+-- name: PlaceholderClaimGetByTokenHash :one
+select *
+from placeholder_claims
+where token_hash = ?;
+
+-- name: PlaceholderClaimDeleteByUser :exec
+delete from placeholder_claims
+where user_id = ?;
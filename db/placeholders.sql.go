@@ -0,0 +1,89 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: placeholders.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+)
+
+const placeholderClaimCreate = `-- name: PlaceholderClaimCreate :exec
+insert into placeholder_claims(
+    token_hash,
+    user_id,
+    game_id
+) values (?, ?, ?)
+`
+
+type PlaceholderClaimCreateParams struct {
+	TokenHash string
+	UserID    int64
+	GameID    string
+}
+
+func (q *Queries) PlaceholderClaimCreate(ctx context.Context, arg PlaceholderClaimCreateParams) error {
+	_, err := q.db.ExecContext(ctx, placeholderClaimCreate, arg.TokenHash, arg.UserID, arg.GameID)
+	return err
+}
+
+const placeholderClaimDeleteByUser = `-- name: PlaceholderClaimDeleteByUser :exec
+delete from placeholder_claims
+where user_id = ?
+`
+
+func (q *Queries) PlaceholderClaimDeleteByUser(ctx context.Context, userID int64) error {
+	_, err := q.db.ExecContext(ctx, placeholderClaimDeleteByUser, userID)
+	return err
+}
+
+const placeholderClaimGetByTokenHash = `-- name: PlaceholderClaimGetByTokenHash :one
+select token_hash, user_id, game_id, created_at
+from placeholder_claims
+where token_hash = ?
+`
+
+func (q *Queries) PlaceholderClaimGetByTokenHash(ctx context.Context, tokenHash string) (PlaceholderClaim, error) {
+	row := q.db.QueryRowContext(ctx, placeholderClaimGetByTokenHash, tokenHash)
+	var i PlaceholderClaim
+	err := row.Scan(
+		&i.TokenHash,
+		&i.UserID,
+		&i.GameID,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const userCreatePlaceholder = `-- name: UserCreatePlaceholder :one
+insert into users(
+    name,
+    email,
+    is_placeholder
+) values (?, ?, true)
+returning id
+`
+
+type UserCreatePlaceholderParams struct {
+	Name  sql.NullString
+	Email string
+}
+
+func (q *Queries) UserCreatePlaceholder(ctx context.Context, arg UserCreatePlaceholderParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, userCreatePlaceholder, arg.Name, arg.Email)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const userDeletePlaceholder = `-- name: UserDeletePlaceholder :exec
+delete from users
+where id = ? and is_placeholder
+`
+
+func (q *Queries) UserDeletePlaceholder(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, userDeletePlaceholder, id)
+	return err
+}
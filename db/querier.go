@@ -6,25 +6,162 @@ package db
 
 import (
 	"context"
+	"database/sql"
+	"time"
 )
 
 type Querier interface {
-	GameCountByUser(ctx context.Context, userID int64) (int64, error)
+	EventCreate(ctx context.Context, arg EventCreateParams) (int64, error)
+	EventDeliveryCreate(ctx context.Context, arg EventDeliveryCreateParams) error
+	EventDeliveryListConsumers(ctx context.Context, eventID int64) ([]string, error)
+	// Lists the events that are due, the events waiting for their backoff don't hold back the next ones.
+	// next_attempt_at is written in UTC, so it compares with now in UTC.
+	EventListPending(ctx context.Context, arg EventListPendingParams) ([]Event, error)
+	EventMarkAttemptFailed(ctx context.Context, arg EventMarkAttemptFailedParams) error
+	EventMarkDelivered(ctx context.Context, arg EventMarkDeliveredParams) error
+	EventMaxIdByGame(ctx context.Context, gameID string) (int64, error)
+	GameCancel(ctx context.Context, arg GameCancelParams) (int64, error)
+	// Counts the games listed by GameListByUser.
+	GameCountByUser(ctx context.Context, arg GameCountByUserParams) (int64, error)
 	GameCreate(ctx context.Context, arg GameCreateParams) (Game, error)
+	// Soft deletes the game, the row is kept but the game is hidden everywhere.
+	GameDelete(ctx context.Context, arg GameDeleteParams) (int64, error)
 	GameGetById(ctx context.Context, id string) (Game, error)
+	// Deleted games are only retrieved to deliver the event published when they were deleted.
+	GameGetByIdWithDeleted(ctx context.Context, id string) (Game, error)
 	GameGetByIdWithOrganizer(ctx context.Context, id string) (GameGetByIdWithOrganizerRow, error)
 	GameGetPublicInfoById(ctx context.Context, id string) (GameGetPublicInfoByIdRow, error)
+	GameInviteTokenCreate(ctx context.Context, arg GameInviteTokenCreateParams) (GameInviteToken, error)
+	GameInviteTokenGetActive(ctx context.Context, arg GameInviteTokenGetActiveParams) (GameInviteToken, error)
+	GameInviteTokenListByGame(ctx context.Context, gameID string) ([]GameInviteToken, error)
+	// Lists the participants who joined the game with one of its invite tokens.
+	GameInviteTokenParticipantsList(ctx context.Context, gameID string) ([]GameInviteTokenParticipantsListRow, error)
+	GameInviteTokenRevoke(ctx context.Context, arg GameInviteTokenRevokeParams) (int64, error)
+	GameInviteTokenRevokeAll(ctx context.Context, arg GameInviteTokenRevokeAllParams) error
+	GameLifecycleEventCreate(ctx context.Context, arg GameLifecycleEventCreateParams) (int64, error)
+	// Lists every game but the deleted ones, to check the spots left computed at write time.
+	GameListAll(ctx context.Context) ([]Game, error)
+	// Lists the games of the group, the caller filters the ones that ended.
+	GameListByGroup(ctx context.Context, groupID sql.NullString) ([]Game, error)
+	// Deleted and cancelled games don't follow the changes of their series.
+	GameListBySeries(ctx context.Context, seriesID sql.NullString) ([]Game, error)
+	// Lists the games the user organizes, participates in or has a role in, and the published games of their groups that aren't private.
+	// Games the user only sees through their groups are listed until they start.
+	// published_at and starts_at are written in UTC, so they compare with now in UTC.
 	GameListByUser(ctx context.Context, arg GameListByUserParams) ([]GameListByUserRow, error)
+	// Lists the moments the pending offers of games expired at, which the scheduler didn't fire yet.
+	// Offers of cancelled games are kept as they were.
+	GameListWithExpiredWaitlistOffers(ctx context.Context, now time.Time) ([]GameListWithExpiredWaitlistOffersRow, error)
+	// Only the events of the timestamps the game has apply to it: published, frozen, registration closed, and started and ended once it has a start.
+	// An event fired for another moment, e.g. before the game was postponed, doesn't count. The moments are compared
+	// to the second, they're stored in UTC.
+	GameListWithPendingLifecycleEvents(ctx context.Context) ([]GameListWithPendingLifecycleEventsRow, error)
+	// Lists the moments participants were asked to reconfirm in games releasing their spots, whose deadline the scheduler didn't fire yet.
+	// The caller checks whether the deadline passed.
+	GameListWithPendingReconfirmations(ctx context.Context) ([]GameListWithPendingReconfirmationsRow, error)
+	GameRoleCountByRole(ctx context.Context, arg GameRoleCountByRoleParams) (int64, error)
+	GameRoleDelete(ctx context.Context, arg GameRoleDeleteParams) (int64, error)
+	// Returns the user given the role first in the game.
+	GameRoleFirstUserByRole(ctx context.Context, arg GameRoleFirstUserByRoleParams) (int64, error)
+	GameRoleGet(ctx context.Context, arg GameRoleGetParams) (string, error)
+	GameRoleGetWithUser(ctx context.Context, arg GameRoleGetWithUserParams) (GameRoleGetWithUserRow, error)
+	GameRoleListByGame(ctx context.Context, gameID string) ([]GameRoleListByGameRow, error)
+	GameRoleUpsert(ctx context.Context, arg GameRoleUpsertParams) error
+	GameSetLotteryDrawn(ctx context.Context, arg GameSetLotteryDrawnParams) (int64, error)
+	GameSetSeriesOccurrence(ctx context.Context, arg GameSetSeriesOccurrenceParams) error
 	GameUpdate(ctx context.Context, arg GameUpdateParams) error
+	GameUpdateCheckInCode(ctx context.Context, arg GameUpdateCheckInCodeParams) error
+	GroupCreate(ctx context.Context, arg GroupCreateParams) (Group, error)
+	GroupGetById(ctx context.Context, id string) (Group, error)
+	GroupGetByInviteCode(ctx context.Context, inviteCode string) (Group, error)
+	GroupListByUser(ctx context.Context, userID int64) ([]GroupListByUserRow, error)
+	GroupMemberCountByRole(ctx context.Context, arg GroupMemberCountByRoleParams) (int64, error)
+	// Users who are already members keep their role.
+	GroupMemberCreate(ctx context.Context, arg GroupMemberCreateParams) (int64, error)
+	GroupMemberDelete(ctx context.Context, arg GroupMemberDeleteParams) (int64, error)
+	GroupMemberGetRole(ctx context.Context, arg GroupMemberGetRoleParams) (string, error)
+	GroupMemberIsRegular(ctx context.Context, arg GroupMemberIsRegularParams) (bool, error)
+	GroupMemberListByGroup(ctx context.Context, groupID string) ([]GroupMemberListByGroupRow, error)
+	GroupMemberUpdateRole(ctx context.Context, arg GroupMemberUpdateRoleParams) (int64, error)
+	GroupMemberUpdateTier(ctx context.Context, arg GroupMemberUpdateTierParams) (int64, error)
+	GroupUpdate(ctx context.Context, arg GroupUpdateParams) error
+	GroupUpdateInviteCode(ctx context.Context, arg GroupUpdateInviteCodeParams) error
+	LedgerAllocationAdd(ctx context.Context, arg LedgerAllocationAddParams) error
+	// Lists the allocations of the payments of the payer and/or to the payee when they're given.
+	LedgerAllocationList(ctx context.Context, arg LedgerAllocationListParams) ([]LedgerAllocationListRow, error)
+	// Lists the participants of the frozen games that bill participants with who collects the money of each game: the treasurer
+	// appointed first, or the owner until they appoint one. Restricted to the games paid to the payee and/or with the participant
+	// when they're given, every participant of those games is listed since the shares depend on each other.
+	// The participants of a game are in the order of ParticipantsList. The caller filters the games frozen in the future.
+	LedgerParticipantList(ctx context.Context, arg LedgerParticipantListParams) ([]LedgerParticipantListRow, error)
+	LedgerPaymentCreate(ctx context.Context, arg LedgerPaymentCreateParams) (LedgerPayment, error)
+	// Lists the payments of the payer and/or to the payee when they're given, the caller sorts them by received_at.
+	LedgerPaymentList(ctx context.Context, arg LedgerPaymentListParams) ([]LedgerPayment, error)
+	// Moves the payments of a placeholder to the user claiming it.
+	LedgerPaymentTransferPayer(ctx context.Context, arg LedgerPaymentTransferPayerParams) error
 	ListDemoUsers(ctx context.Context) ([]ListDemoUsersRow, error)
+	NotificationPreferenceIsEnabled(ctx context.Context, arg NotificationPreferenceIsEnabledParams) (bool, error)
+	NotificationPreferenceUpsert(ctx context.Context, arg NotificationPreferenceUpsertParams) error
+	NotificationPreferencesListByUser(ctx context.Context, userID int64) ([]NotificationPreference, error)
+	ParticipantDelete(ctx context.Context, arg ParticipantDeleteParams) (int64, error)
 	ParticipantGetByGameAndUser(ctx context.Context, arg ParticipantGetByGameAndUserParams) (GameParticipant, error)
+	// Checks the participant in, or undoes their check-in when checked_in_at is null.
+	ParticipantSetCheckedIn(ctx context.Context, arg ParticipantSetCheckedInParams) (int64, error)
+	ParticipantSetDrawPosition(ctx context.Context, arg ParticipantSetDrawPositionParams) error
+	// Keeps the token the participant first joined with.
+	ParticipantSetInviteToken(ctx context.Context, arg ParticipantSetInviteTokenParams) error
+	ParticipantSetLateCancellation(ctx context.Context, arg ParticipantSetLateCancellationParams) error
+	// Gives the participation of a placeholder to a real user, keeping its place in the queue and its reimbursement reference.
+	ParticipantTransfer(ctx context.Context, arg ParticipantTransferParams) error
+	ParticipantUpdateGoingUpdatedAt(ctx context.Context, arg ParticipantUpdateGoingUpdatedAtParams) error
 	ParticipantUpdateReimbursedAt(ctx context.Context, arg ParticipantUpdateReimbursedAtParams) (int64, error)
 	ParticipantUpdateReimbursementReceivedAt(ctx context.Context, arg ParticipantUpdateReimbursementReceivedAtParams) (int64, error)
 	ParticipantsList(ctx context.Context, arg ParticipantsListParams) ([]ParticipantsListRow, error)
+	ParticipantsListPendingReconfirmation(ctx context.Context, gameID string) ([]ParticipantsListPendingReconfirmationRow, error)
+	// Resets the confirmations of the participants going to the game, except the organizer who made the change.
+	ParticipantsRequestReconfirmation(ctx context.Context, arg ParticipantsRequestReconfirmationParams) ([]int64, error)
 	ParticipantsUpsert(ctx context.Context, arg ParticipantsUpsertParams) error
+	PayoutDetailsGetByUser(ctx context.Context, userID int64) (PayoutDetail, error)
+	PayoutDetailsUpsert(ctx context.Context, arg PayoutDetailsUpsertParams) (PayoutDetail, error)
+	PlaceholderClaimCreate(ctx context.Context, arg PlaceholderClaimCreateParams) error
+	PlaceholderClaimDeleteByUser(ctx context.Context, userID int64) error
+	PlaceholderClaimGetByTokenHash(ctx context.Context, tokenHash string) (PlaceholderClaim, error)
 	ReimbursementsListByGame(ctx context.Context, gameID string) ([]ReimbursementsListByGameRow, error)
+	SeriesCreate(ctx context.Context, arg SeriesCreateParams) (GameSeries, error)
+	SeriesGetById(ctx context.Context, id string) (GameSeries, error)
+	SeriesList(ctx context.Context) ([]GameSeries, error)
+	SeriesListByOrganizer(ctx context.Context, organizerID int64) ([]GameSeries, error)
+	SeriesListOccurrences(ctx context.Context, seriesID sql.NullString) ([]sql.NullTime, error)
+	SeriesUpdate(ctx context.Context, arg SeriesUpdateParams) error
+	UserCreatePlaceholder(ctx context.Context, arg UserCreatePlaceholderParams) (int64, error)
+	UserDeletePlaceholder(ctx context.Context, id int64) error
+	UserGetByEmail(ctx context.Context, email string) (UserGetByEmailRow, error)
 	UserGetById(ctx context.Context, id int64) (UserGetByIdRow, error)
 	UserUpsertRetuningId(ctx context.Context, arg UserUpsertRetuningIdParams) (int64, error)
+	// Participants who already have a pending offer keep it.
+	WaitlistOfferCreate(ctx context.Context, arg WaitlistOfferCreateParams) (int64, error)
+	WaitlistOfferGetPending(ctx context.Context, arg WaitlistOfferGetPendingParams) (WaitlistOffer, error)
+	WaitlistOfferListPendingByGame(ctx context.Context, gameID string) ([]WaitlistOffer, error)
+	WaitlistOfferRespond(ctx context.Context, arg WaitlistOfferRespondParams) (int64, error)
+	WebhookCreate(ctx context.Context, arg WebhookCreateParams) (Webhook, error)
+	WebhookDelete(ctx context.Context, id int64) error
+	WebhookDeleteByGameAndOrganizer(ctx context.Context, arg WebhookDeleteByGameAndOrganizerParams) error
+	WebhookDeliveryCreate(ctx context.Context, arg WebhookDeliveryCreateParams) error
+	WebhookDeliveryDeleteByGameAndOrganizer(ctx context.Context, arg WebhookDeliveryDeleteByGameAndOrganizerParams) error
+	WebhookDeliveryDeleteByWebhook(ctx context.Context, webhookID int64) error
+	WebhookDeliveryGetById(ctx context.Context, id int64) (WebhookDelivery, error)
+	WebhookDeliveryListByWebhook(ctx context.Context, arg WebhookDeliveryListByWebhookParams) ([]WebhookDelivery, error)
+	// Lists the deliveries that are due, the deliveries waiting for their backoff don't hold back the next ones.
+	// next_attempt_at is written in UTC, so it compares with now in UTC.
+	WebhookDeliveryListPending(ctx context.Context, arg WebhookDeliveryListPendingParams) ([]WebhookDeliveryListPendingRow, error)
+	WebhookDeliveryMarkAttemptFailed(ctx context.Context, arg WebhookDeliveryMarkAttemptFailedParams) error
+	WebhookDeliveryMarkDelivered(ctx context.Context, arg WebhookDeliveryMarkDeliveredParams) error
+	// Makes the delivery pending again, it's attempted as soon as possible.
+	WebhookDeliveryReset(ctx context.Context, id int64) error
+	WebhookGetById(ctx context.Context, id int64) (Webhook, error)
+	// Webhooks registered on the game, or on the series it was generated from.
+	WebhookListByGame(ctx context.Context, arg WebhookListByGameParams) ([]Webhook, error)
+	WebhookListByOrganizer(ctx context.Context, organizerID int64) ([]Webhook, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -0,0 +1,44 @@
+-- name: ParticipantsRequestReconfirmation :many
+-- Resets the confirmations of the participants going to the game, except the organizer who made the change.
+update game_participants
+set
+  updated_at = current_timestamp,
+  confirmed_at = null,
+  reconfirm_requested_at = sqlc.arg(reconfirm_requested_at)
+where game_id = sqlc.arg(game_id)
+  and going
+  and user_id != sqlc.arg(organizer_id)
+returning user_id;
+
+-- name: ParticipantsListPendingReconfirmation :many
+select
+  sqlc.embed(game_participants),
+  sqlc.embed(users)
+from game_participants
+join users on game_participants.user_id = users.id
+where game_participants.game_id = ?
+  and game_participants.reconfirm_requested_at is not null
+order by game_participants.reconfirm_requested_at asc, game_participants.rowid asc;
+
+-- name: GameListWithPendingReconfirmations :many
+-- Lists the moments participants were asked to reconfirm in games releasing their spots, whose deadline the scheduler didn't fire yet.
+-- The caller checks whether the deadline passed.
+select
+  sqlc.embed(games),
+  game_participants.reconfirm_requested_at
+from game_participants
+join games on game_participants.game_id = games.id
+where game_participants.reconfirm_requested_at is not null
+  and games.reconfirm_within_minutes > 0
+  and games.cancelled_at is null
+  and games.deleted_at is null
+  and not exists (
+    select 1
+    from game_lifecycle_events
+    where game_lifecycle_events.game_id = games.id
+      and game_lifecycle_events.event_type = 'reconfirmation_due'
+      and datetime(substr(game_lifecycle_events.due_at, 1, 19))
+        = datetime(substr(game_participants.reconfirm_requested_at, 1, 19), '+' || games.reconfirm_within_minutes || ' minutes')
+  )
+group by games.id, game_participants.reconfirm_requested_at
+order by game_participants.reconfirm_requested_at asc;
@@ -0,0 +1,86 @@
+-- name: SeriesCreate :one
+insert into game_series(
+  id,
+  organizer_id,
+  name,
+  description,
+  total_price_cents,
+  location,
+  duration_minutes,
+  max_players,
+  max_guests_per_player,
+  starts_at,
+  timezone,
+  interval_weeks,
+  weekdays,
+  ends_at,
+  occurrence_count,
+  publish_minutes_before
+) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+returning *;
+
+-- name: SeriesGetById :one
+select *
+from game_series
+where id = ?;
+
+-- name: SeriesListByOrganizer :many
+select *
+from game_series
+where organizer_id = ?
+order by created_at desc;
+
+-- name: SeriesList :many
+select *
+from game_series;
+
+-- name: SeriesUpdate :exec
+update game_series
+set
+  name = coalesce(sqlc.narg(name), name),
+  description = coalesce(sqlc.narg(description), description),
+  total_price_cents = coalesce(sqlc.narg(total_price_cents), total_price_cents),
+  location = coalesce(sqlc.narg(location), location),
+  duration_minutes = coalesce(sqlc.narg(duration_minutes), duration_minutes),
+  max_players = coalesce(sqlc.narg(max_players), max_players),
+  max_guests_per_player = coalesce(sqlc.narg(max_guests_per_player), max_guests_per_player),
+  starts_at = coalesce(sqlc.narg(starts_at), starts_at),
+  timezone = coalesce(sqlc.narg(timezone), timezone),
+  interval_weeks = coalesce(sqlc.narg(interval_weeks), interval_weeks),
+  weekdays = coalesce(sqlc.narg(weekdays), weekdays),
+  ends_at = case
+    when cast(sqlc.arg(clear_ends_at) as boolean) then null
+    else coalesce(sqlc.narg(ends_at), ends_at)
+  end,
+  occurrence_count = case
+    when cast(sqlc.arg(clear_occurrence_count) as boolean) then null
+    else coalesce(sqlc.narg(occurrence_count), occurrence_count)
+  end,
+  publish_minutes_before = case
+    when cast(sqlc.arg(clear_publish_minutes_before) as boolean) then null
+    else coalesce(sqlc.narg(publish_minutes_before), publish_minutes_before)
+  end,
+  updated_at = current_timestamp
+where id = sqlc.arg(id);
+
+-- name: SeriesListOccurrences :many
+select series_occurrence_at
+from games
+where series_id = sqlc.arg(series_id)
+  and series_occurrence_at is not null;
+
+-- name: GameListBySeries :many
+-- Deleted and cancelled games don't follow the changes of their series.
+select *
+from games
+where series_id = sqlc.arg(series_id)
+  and deleted_at is null
+  and cancelled_at is null
+order by starts_at;
+
+-- name: GameSetSeriesOccurrence :exec
+update games
+set
+  series_occurrence_at = sqlc.arg(series_occurrence_at),
+  updated_at = current_timestamp
+where id = sqlc.arg(id);
@@ -0,0 +1,420 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: series.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const gameListBySeries = `-- name: GameListBySeries :many
+select id, organizer_id, name, description, published_at, total_price_cents, location, starts_at, duration_minutes, max_players, max_guests_per_player, game_spots_left, created_at, updated_at, frozen_at, series_id, series_occurrence_at, group_id, is_private, waitlist_offer_minutes, allocation_mode, registration_closes_at, lottery_seed, lottery_drawn_at, regulars_head_start_minutes, regulars_first, waitlist_mode, max_waitlist_size, waitlist_spots_left, reconfirm_within_minutes, cancellation_deadline_minutes, bill_late_cancellations, check_in_code, cancelled_at, cancellation_reason, deleted_at, split_strategy, price_per_player_cents, guest_price_cents
+from games
+where series_id = ?1
+  and deleted_at is null
+  and cancelled_at is null
+order by starts_at
+`
+
+// Deleted and cancelled games don't follow the changes of their series.
+func (q *Queries) GameListBySeries(ctx context.Context, seriesID sql.NullString) ([]Game, error) {
+	rows, err := q.db.QueryContext(ctx, gameListBySeries, seriesID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Game
+	for rows.Next() {
+		var i Game
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizerID,
+			&i.Name,
+			&i.Description,
+			&i.PublishedAt,
+			&i.TotalPriceCents,
+			&i.Location,
+			&i.StartsAt,
+			&i.DurationMinutes,
+			&i.MaxPlayers,
+			&i.MaxGuestsPerPlayer,
+			&i.GameSpotsLeft,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.FrozenAt,
+			&i.SeriesID,
+			&i.SeriesOccurrenceAt,
+			&i.GroupID,
+			&i.IsPrivate,
+			&i.WaitlistOfferMinutes,
+			&i.AllocationMode,
+			&i.RegistrationClosesAt,
+			&i.LotterySeed,
+			&i.LotteryDrawnAt,
+			&i.RegularsHeadStartMinutes,
+			&i.RegularsFirst,
+			&i.WaitlistMode,
+			&i.MaxWaitlistSize,
+			&i.WaitlistSpotsLeft,
+			&i.ReconfirmWithinMinutes,
+			&i.CancellationDeadlineMinutes,
+			&i.BillLateCancellations,
+			&i.CheckInCode,
+			&i.CancelledAt,
+			&i.CancellationReason,
+			&i.DeletedAt,
+			&i.SplitStrategy,
+			&i.PricePerPlayerCents,
+			&i.GuestPriceCents,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const gameSetSeriesOccurrence = `-- name: GameSetSeriesOccurrence :exec
+update games
+set
+  series_occurrence_at = ?1,
+  updated_at = current_timestamp
+where id = ?2
+`
+
+type GameSetSeriesOccurrenceParams struct {
+	SeriesOccurrenceAt sql.NullTime
+	ID                 string
+}
+
+func (q *Queries) GameSetSeriesOccurrence(ctx context.Context, arg GameSetSeriesOccurrenceParams) error {
+	_, err := q.db.ExecContext(ctx, gameSetSeriesOccurrence, arg.SeriesOccurrenceAt, arg.ID)
+	return err
+}
+
+const seriesCreate = `-- name: SeriesCreate :one
+insert into game_series(
+  id,
+  organizer_id,
+  name,
+  description,
+  total_price_cents,
+  location,
+  duration_minutes,
+  max_players,
+  max_guests_per_player,
+  starts_at,
+  timezone,
+  interval_weeks,
+  weekdays,
+  ends_at,
+  occurrence_count,
+  publish_minutes_before
+) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+returning id, organizer_id, name, description, total_price_cents, location, duration_minutes, max_players, max_guests_per_player, starts_at, timezone, interval_weeks, weekdays, ends_at, occurrence_count, publish_minutes_before, created_at, updated_at
+`
+
+type SeriesCreateParams struct {
+	ID                   string
+	OrganizerID          int64
+	Name                 string
+	Description          sql.NullString
+	TotalPriceCents      int64
+	Location             sql.NullString
+	DurationMinutes      int64
+	MaxPlayers           int64
+	MaxGuestsPerPlayer   int64
+	StartsAt             time.Time
+	Timezone             string
+	IntervalWeeks        int64
+	Weekdays             string
+	EndsAt               sql.NullTime
+	OccurrenceCount      sql.NullInt64
+	PublishMinutesBefore sql.NullInt64
+}
+
+func (q *Queries) SeriesCreate(ctx context.Context, arg SeriesCreateParams) (GameSeries, error) {
+	row := q.db.QueryRowContext(ctx, seriesCreate,
+		arg.ID,
+		arg.OrganizerID,
+		arg.Name,
+		arg.Description,
+		arg.TotalPriceCents,
+		arg.Location,
+		arg.DurationMinutes,
+		arg.MaxPlayers,
+		arg.MaxGuestsPerPlayer,
+		arg.StartsAt,
+		arg.Timezone,
+		arg.IntervalWeeks,
+		arg.Weekdays,
+		arg.EndsAt,
+		arg.OccurrenceCount,
+		arg.PublishMinutesBefore,
+	)
+	var i GameSeries
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizerID,
+		&i.Name,
+		&i.Description,
+		&i.TotalPriceCents,
+		&i.Location,
+		&i.DurationMinutes,
+		&i.MaxPlayers,
+		&i.MaxGuestsPerPlayer,
+		&i.StartsAt,
+		&i.Timezone,
+		&i.IntervalWeeks,
+		&i.Weekdays,
+		&i.EndsAt,
+		&i.OccurrenceCount,
+		&i.PublishMinutesBefore,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const seriesGetById = `-- name: SeriesGetById :one
+select id, organizer_id, name, description, total_price_cents, location, duration_minutes, max_players, max_guests_per_player, starts_at, timezone, interval_weeks, weekdays, ends_at, occurrence_count, publish_minutes_before, created_at, updated_at
+from game_series
+where id = ?
+`
+
+func (q *Queries) SeriesGetById(ctx context.Context, id string) (GameSeries, error) {
+	row := q.db.QueryRowContext(ctx, seriesGetById, id)
+	var i GameSeries
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizerID,
+		&i.Name,
+		&i.Description,
+		&i.TotalPriceCents,
+		&i.Location,
+		&i.DurationMinutes,
+		&i.MaxPlayers,
+		&i.MaxGuestsPerPlayer,
+		&i.StartsAt,
+		&i.Timezone,
+		&i.IntervalWeeks,
+		&i.Weekdays,
+		&i.EndsAt,
+		&i.OccurrenceCount,
+		&i.PublishMinutesBefore,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const seriesList = `-- name: SeriesList :many
+select id, organizer_id, name, description, total_price_cents, location, duration_minutes, max_players, max_guests_per_player, starts_at, timezone, interval_weeks, weekdays, ends_at, occurrence_count, publish_minutes_before, created_at, updated_at
+from game_series
+`
+
+func (q *Queries) SeriesList(ctx context.Context) ([]GameSeries, error) {
+	rows, err := q.db.QueryContext(ctx, seriesList)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GameSeries
+	for rows.Next() {
+		var i GameSeries
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizerID,
+			&i.Name,
+			&i.Description,
+			&i.TotalPriceCents,
+			&i.Location,
+			&i.DurationMinutes,
+			&i.MaxPlayers,
+			&i.MaxGuestsPerPlayer,
+			&i.StartsAt,
+			&i.Timezone,
+			&i.IntervalWeeks,
+			&i.Weekdays,
+			&i.EndsAt,
+			&i.OccurrenceCount,
+			&i.PublishMinutesBefore,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const seriesListByOrganizer = `-- name: SeriesListByOrganizer :many
+select id, organizer_id, name, description, total_price_cents, location, duration_minutes, max_players, max_guests_per_player, starts_at, timezone, interval_weeks, weekdays, ends_at, occurrence_count, publish_minutes_before, created_at, updated_at
+from game_series
+where organizer_id = ?
+order by created_at desc
+`
+
+func (q *Queries) SeriesListByOrganizer(ctx context.Context, organizerID int64) ([]GameSeries, error) {
+	rows, err := q.db.QueryContext(ctx, seriesListByOrganizer, organizerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GameSeries
+	for rows.Next() {
+		var i GameSeries
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizerID,
+			&i.Name,
+			&i.Description,
+			&i.TotalPriceCents,
+			&i.Location,
+			&i.DurationMinutes,
+			&i.MaxPlayers,
+			&i.MaxGuestsPerPlayer,
+			&i.StartsAt,
+			&i.Timezone,
+			&i.IntervalWeeks,
+			&i.Weekdays,
+			&i.EndsAt,
+			&i.OccurrenceCount,
+			&i.PublishMinutesBefore,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const seriesListOccurrences = `-- name: SeriesListOccurrences :many
+select series_occurrence_at
+from games
+where series_id = ?1
+  and series_occurrence_at is not null
+`
+
+func (q *Queries) SeriesListOccurrences(ctx context.Context, seriesID sql.NullString) ([]sql.NullTime, error) {
+	rows, err := q.db.QueryContext(ctx, seriesListOccurrences, seriesID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []sql.NullTime
+	for rows.Next() {
+		var series_occurrence_at sql.NullTime
+		if err := rows.Scan(&series_occurrence_at); err != nil {
+			return nil, err
+		}
+		items = append(items, series_occurrence_at)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const seriesUpdate = `-- name: SeriesUpdate :exec
+update game_series
+set
+  name = coalesce(?1, name),
+  description = coalesce(?2, description),
+  total_price_cents = coalesce(?3, total_price_cents),
+  location = coalesce(?4, location),
+  duration_minutes = coalesce(?5, duration_minutes),
+  max_players = coalesce(?6, max_players),
+  max_guests_per_player = coalesce(?7, max_guests_per_player),
+  starts_at = coalesce(?8, starts_at),
+  timezone = coalesce(?9, timezone),
+  interval_weeks = coalesce(?10, interval_weeks),
+  weekdays = coalesce(?11, weekdays),
+  ends_at = case
+    when cast(?12 as boolean) then null
+    else coalesce(?13, ends_at)
+  end,
+  occurrence_count = case
+    when cast(?14 as boolean) then null
+    else coalesce(?15, occurrence_count)
+  end,
+  publish_minutes_before = case
+    when cast(?16 as boolean) then null
+    else coalesce(?17, publish_minutes_before)
+  end,
+  updated_at = current_timestamp
+where id = ?18
+`
+
+type SeriesUpdateParams struct {
+	Name                      sql.NullString
+	Description               sql.NullString
+	TotalPriceCents           sql.NullInt64
+	Location                  sql.NullString
+	DurationMinutes           sql.NullInt64
+	MaxPlayers                sql.NullInt64
+	MaxGuestsPerPlayer        sql.NullInt64
+	StartsAt                  sql.NullTime
+	Timezone                  sql.NullString
+	IntervalWeeks             sql.NullInt64
+	Weekdays                  sql.NullString
+	ClearEndsAt               bool
+	EndsAt                    sql.NullTime
+	ClearOccurrenceCount      bool
+	OccurrenceCount           sql.NullInt64
+	ClearPublishMinutesBefore bool
+	PublishMinutesBefore      sql.NullInt64
+	ID                        string
+}
+
+func (q *Queries) SeriesUpdate(ctx context.Context, arg SeriesUpdateParams) error {
+	_, err := q.db.ExecContext(ctx, seriesUpdate,
+		arg.Name,
+		arg.Description,
+		arg.TotalPriceCents,
+		arg.Location,
+		arg.DurationMinutes,
+		arg.MaxPlayers,
+		arg.MaxGuestsPerPlayer,
+		arg.StartsAt,
+		arg.Timezone,
+		arg.IntervalWeeks,
+		arg.Weekdays,
+		arg.ClearEndsAt,
+		arg.EndsAt,
+		arg.ClearOccurrenceCount,
+		arg.OccurrenceCount,
+		arg.ClearPublishMinutesBefore,
+		arg.PublishMinutesBefore,
+		arg.ID,
+	)
+	return err
+}
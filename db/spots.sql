@@ -0,0 +1,6 @@
+-- name: GameListAll :many
+-- Lists every game but the deleted ones, to check the spots left computed at write time.
+select *
+from games
+where deleted_at is null
+order by id asc;
@@ -0,0 +1,81 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: spots.sql
+
+package db
+
+import (
+	"context"
+)
+
+const gameListAll = `-- name: GameListAll :many
+select id, organizer_id, name, description, published_at, total_price_cents, location, starts_at, duration_minutes, max_players, max_guests_per_player, game_spots_left, created_at, updated_at, frozen_at, series_id, series_occurrence_at, group_id, is_private, waitlist_offer_minutes, allocation_mode, registration_closes_at, lottery_seed, lottery_drawn_at, regulars_head_start_minutes, regulars_first, waitlist_mode, max_waitlist_size, waitlist_spots_left, reconfirm_within_minutes, cancellation_deadline_minutes, bill_late_cancellations, check_in_code, cancelled_at, cancellation_reason, deleted_at, split_strategy, price_per_player_cents, guest_price_cents
+from games
+where deleted_at is null
+order by id asc
+`
+
+// Lists every game but the deleted ones, to check the spots left computed at write time.
+func (q *Queries) GameListAll(ctx context.Context) ([]Game, error) {
+	rows, err := q.db.QueryContext(ctx, gameListAll)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Game
+	for rows.Next() {
+		var i Game
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizerID,
+			&i.Name,
+			&i.Description,
+			&i.PublishedAt,
+			&i.TotalPriceCents,
+			&i.Location,
+			&i.StartsAt,
+			&i.DurationMinutes,
+			&i.MaxPlayers,
+			&i.MaxGuestsPerPlayer,
+			&i.GameSpotsLeft,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.FrozenAt,
+			&i.SeriesID,
+			&i.SeriesOccurrenceAt,
+			&i.GroupID,
+			&i.IsPrivate,
+			&i.WaitlistOfferMinutes,
+			&i.AllocationMode,
+			&i.RegistrationClosesAt,
+			&i.LotterySeed,
+			&i.LotteryDrawnAt,
+			&i.RegularsHeadStartMinutes,
+			&i.RegularsFirst,
+			&i.WaitlistMode,
+			&i.MaxWaitlistSize,
+			&i.WaitlistSpotsLeft,
+			&i.ReconfirmWithinMinutes,
+			&i.CancellationDeadlineMinutes,
+			&i.BillLateCancellations,
+			&i.CheckInCode,
+			&i.CancelledAt,
+			&i.CancellationReason,
+			&i.DeletedAt,
+			&i.SplitStrategy,
+			&i.PricePerPlayerCents,
+			&i.GuestPriceCents,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
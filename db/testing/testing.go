@@ -2,6 +2,8 @@ package dbtesting
 
 import (
 	"database/sql"
+	"path/filepath"
+	"runtime"
 	"testing"
 
 	"github.com/pressly/goose/v3"
@@ -22,7 +24,7 @@ func SetupTestDB(t *testing.T) *sql.DB {
 		t.Fatalf("Failed to set goose dialect: %v", err)
 	}
 
-	if err := goose.UpContext(t.Context(), sqlDB, "../../db/migrations"); err != nil {
+	if err := goose.UpContext(t.Context(), sqlDB, migrationsDir()); err != nil {
 		t.Fatalf("Failed to run migrations: %v", err)
 	}
 
@@ -46,3 +48,9 @@ func UpsertTestUser(t *testing.T, sqlDB *sql.DB, email string) int64 {
 
 	return userID
 }
+
+// migrationsDir resolves the migrations relative to this file, so tests can live at any depth in the repo.
+func migrationsDir() string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Join(filepath.Dir(file), "..", "migrations")
+}
@@ -22,3 +22,9 @@ limit 1;
 select sqlc.embed(users)
 from users
 where is_demo;
+
+-- name: UserGetByEmail :one
+select sqlc.embed(users)
+from users
+where email = ?
+limit 1;
@@ -11,7 +11,7 @@ import (
 )
 
 const listDemoUsers = `-- name: ListDemoUsers :many
-select users.id, users.name, users.email, users.photo, users.created_at, users.updated_at, users.is_demo
+select users.id, users.name, users.email, users.photo, users.created_at, users.updated_at, users.is_demo, users.is_placeholder
 from users
 where is_demo
 `
@@ -37,6 +37,7 @@ func (q *Queries) ListDemoUsers(ctx context.Context) ([]ListDemoUsersRow, error)
 			&i.User.CreatedAt,
 			&i.User.UpdatedAt,
 			&i.User.IsDemo,
+			&i.User.IsPlaceholder,
 		); err != nil {
 			return nil, err
 		}
@@ -51,8 +52,35 @@ func (q *Queries) ListDemoUsers(ctx context.Context) ([]ListDemoUsersRow, error)
 	return items, nil
 }
 
+const userGetByEmail = `-- name: UserGetByEmail :one
+select users.id, users.name, users.email, users.photo, users.created_at, users.updated_at, users.is_demo, users.is_placeholder
+from users
+where email = ?
+limit 1
+`
+
+type UserGetByEmailRow struct {
+	User User
+}
+
+func (q *Queries) UserGetByEmail(ctx context.Context, email string) (UserGetByEmailRow, error) {
+	row := q.db.QueryRowContext(ctx, userGetByEmail, email)
+	var i UserGetByEmailRow
+	err := row.Scan(
+		&i.User.ID,
+		&i.User.Name,
+		&i.User.Email,
+		&i.User.Photo,
+		&i.User.CreatedAt,
+		&i.User.UpdatedAt,
+		&i.User.IsDemo,
+		&i.User.IsPlaceholder,
+	)
+	return i, err
+}
+
 const userGetById = `-- name: UserGetById :one
-select users.id, users.name, users.email, users.photo, users.created_at, users.updated_at, users.is_demo
+select users.id, users.name, users.email, users.photo, users.created_at, users.updated_at, users.is_demo, users.is_placeholder
 from users
 where id = ?
 limit 1
@@ -73,6 +101,7 @@ func (q *Queries) UserGetById(ctx context.Context, id int64) (UserGetByIdRow, er
 		&i.User.CreatedAt,
 		&i.User.UpdatedAt,
 		&i.User.IsDemo,
+		&i.User.IsPlaceholder,
 	)
 	return i, err
 }
@@ -0,0 +1,48 @@
+-- name: WaitlistOfferCreate :execrows
+-- Participants who already have a pending offer keep it.
+insert into waitlist_offers(game_id, user_id, expires_at)
+values (?, ?, ?)
+on conflict do nothing;
+
+-- name: WaitlistOfferGetPending :one
+select *
+from waitlist_offers
+where game_id = sqlc.arg(game_id)
+  and user_id = sqlc.arg(user_id)
+  and status = 'pending';
+
+-- name: WaitlistOfferListPendingByGame :many
+select *
+from waitlist_offers
+where game_id = ?
+  and status = 'pending';
+
+-- name: GameListWithExpiredWaitlistOffers :many
+-- Lists the moments the pending offers of games expired at, which the scheduler didn't fire yet.
+-- Offers of cancelled games are kept as they were.
+select
+  sqlc.embed(games),
+  waitlist_offers.expires_at
+from waitlist_offers
+join games on waitlist_offers.game_id = games.id
+where waitlist_offers.status = 'pending'
+  and waitlist_offers.expires_at <= sqlc.arg(now)
+  and games.cancelled_at is null
+  and games.deleted_at is null
+  and not exists (
+    select 1
+    from game_lifecycle_events
+    where game_lifecycle_events.game_id = games.id
+      and game_lifecycle_events.event_type = 'waitlist_offer_expired'
+      and datetime(substr(game_lifecycle_events.due_at, 1, 19)) = datetime(substr(waitlist_offers.expires_at, 1, 19))
+  )
+group by games.id, waitlist_offers.expires_at
+order by waitlist_offers.expires_at asc;
+
+-- name: WaitlistOfferRespond :execrows
+update waitlist_offers
+set
+  status = sqlc.arg(status),
+  responded_at = sqlc.arg(responded_at)
+where id = sqlc.arg(id)
+  and status = 'pending';
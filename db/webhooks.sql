@@ -0,0 +1,111 @@
+-- name: WebhookCreate :one
+insert into webhooks(
+  organizer_id,
+  game_id,
+  series_id,
+  url,
+  secret,
+  event_types
+) values (?, ?, ?, ?, ?, ?)
+returning *;
+
+-- name: WebhookGetById :one
+select *
+from webhooks
+where id = ?;
+
+-- name: WebhookListByOrganizer :many
+select *
+from webhooks
+where organizer_id = ?
+order by id;
+
+-- name: WebhookListByGame :many
+-- Webhooks registered on the game, or on the series it was generated from.
+select *
+from webhooks
+where game_id = sqlc.arg(game_id) or series_id = sqlc.narg(series_id)
+order by id;
+
+-- name: WebhookDelete :exec
+delete from webhooks
+where id = ?;
+
+-- name: WebhookDeleteByGameAndOrganizer :exec
+delete from webhooks
+where game_id = ? and organizer_id = ?;
+
+-- name: WebhookDeliveryCreate :exec
+insert into webhook_deliveries(
+  webhook_id,
+  event_id,
+  event_type,
+  payload
+) values (?, ?, ?, ?)
+on conflict do nothing;
+
+-- name: WebhookDeliveryGetById :one
+select *
+from webhook_deliveries
+where id = ?;
+
+-- name: WebhookDeliveryListByWebhook :many
+select *
+from webhook_deliveries
+where webhook_id = ?
+order by id desc
+limit sqlc.arg(limit);
+
+-- name: WebhookDeliveryListPending :many
+-- Lists the deliveries that are due, the deliveries waiting for their backoff don't hold back the next ones.
+-- next_attempt_at is written in UTC, so it compares with now in UTC.
+select
+  sqlc.embed(webhook_deliveries),
+  webhooks.url,
+  webhooks.secret
+from webhook_deliveries
+join webhooks on webhooks.id = webhook_deliveries.webhook_id
+where webhook_deliveries.delivered_at is null and webhook_deliveries.failed_at is null
+  and (webhook_deliveries.next_attempt_at is null or webhook_deliveries.next_attempt_at <= sqlc.arg(now))
+order by webhook_deliveries.id
+limit sqlc.arg(limit);
+
+-- name: WebhookDeliveryMarkDelivered :exec
+update webhook_deliveries
+set
+  delivered_at = sqlc.arg(delivered_at),
+  last_status_code = sqlc.arg(last_status_code),
+  last_error = null
+where id = sqlc.arg(id);
+
+-- name: WebhookDeliveryMarkAttemptFailed :exec
+update webhook_deliveries
+set
+  attempts = attempts + 1,
+  next_attempt_at = sqlc.arg(next_attempt_at),
+  last_status_code = sqlc.arg(last_status_code),
+  last_error = sqlc.arg(last_error),
+  failed_at = sqlc.arg(failed_at)
+where id = sqlc.arg(id);
+
+-- name: WebhookDeliveryReset :exec
+-- Makes the delivery pending again, it's attempted as soon as possible.
+update webhook_deliveries
+set
+  attempts = 0,
+  next_attempt_at = null,
+  delivered_at = null,
+  failed_at = null
+where id = ?;
+
+-- name: WebhookDeliveryDeleteByWebhook :exec
+delete from webhook_deliveries
+where webhook_id = ?;
+
+-- name: WebhookDeliveryDeleteByGameAndOrganizer :exec
+delete from webhook_deliveries
+where webhook_id in (
+  select id
+  from webhooks
+  where game_id = ? and organizer_id = ?
+);
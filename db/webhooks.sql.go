@@ -0,0 +1,447 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: webhooks.sql
+
+package db
+
+import (
+	"context"
+	"database/sql"
+)
+
+const webhookCreate = `-- name: WebhookCreate :one
+insert into webhooks(
+  organizer_id,
+  game_id,
+  series_id,
+  url,
+  secret,
+  event_types
+) values (?, ?, ?, ?, ?, ?)
+returning id, organizer_id, game_id, series_id, url, secret, event_types, created_at
+`
+
+type WebhookCreateParams struct {
+	OrganizerID int64
+	GameID      sql.NullString
+	SeriesID    sql.NullString
+	Url         string
+	Secret      string
+	EventTypes  string
+}
+
+func (q *Queries) WebhookCreate(ctx context.Context, arg WebhookCreateParams) (Webhook, error) {
+	row := q.db.QueryRowContext(ctx, webhookCreate,
+		arg.OrganizerID,
+		arg.GameID,
+		arg.SeriesID,
+		arg.Url,
+		arg.Secret,
+		arg.EventTypes,
+	)
+	var i Webhook
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizerID,
+		&i.GameID,
+		&i.SeriesID,
+		&i.Url,
+		&i.Secret,
+		&i.EventTypes,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const webhookDelete = `-- name: WebhookDelete :exec
+delete from webhooks
+where id = ?
+`
+
+func (q *Queries) WebhookDelete(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, webhookDelete, id)
+	return err
+}
+
+const webhookDeleteByGameAndOrganizer = `-- name: WebhookDeleteByGameAndOrganizer :exec
+delete from webhooks
+where game_id = ? and organizer_id = ?
+`
+
+type WebhookDeleteByGameAndOrganizerParams struct {
+	GameID      sql.NullString
+	OrganizerID int64
+}
+
+func (q *Queries) WebhookDeleteByGameAndOrganizer(ctx context.Context, arg WebhookDeleteByGameAndOrganizerParams) error {
+	_, err := q.db.ExecContext(ctx, webhookDeleteByGameAndOrganizer, arg.GameID, arg.OrganizerID)
+	return err
+}
+
+const webhookDeliveryCreate = `-- name: WebhookDeliveryCreate :exec
+insert into webhook_deliveries(
+  webhook_id,
+  event_id,
+  event_type,
+  payload
+) values (?, ?, ?, ?)
+on conflict do nothing
+`
+
+type WebhookDeliveryCreateParams struct {
+	WebhookID int64
+	EventID   int64
+	EventType string
+	Payload   string
+}
+
+func (q *Queries) WebhookDeliveryCreate(ctx context.Context, arg WebhookDeliveryCreateParams) error {
+	_, err := q.db.ExecContext(ctx, webhookDeliveryCreate,
+		arg.WebhookID,
+		arg.EventID,
+		arg.EventType,
+		arg.Payload,
+	)
+	return err
+}
+
+const webhookDeliveryDeleteByGameAndOrganizer = `-- name: WebhookDeliveryDeleteByGameAndOrganizer :exec
+delete from webhook_deliveries
+where webhook_id in (
+  select id
+  from webhooks
+  where game_id = ? and organizer_id = ?
+)
+`
+
+type WebhookDeliveryDeleteByGameAndOrganizerParams struct {
+	GameID      sql.NullString
+	OrganizerID int64
+}
+
+func (q *Queries) WebhookDeliveryDeleteByGameAndOrganizer(ctx context.Context, arg WebhookDeliveryDeleteByGameAndOrganizerParams) error {
+	_, err := q.db.ExecContext(ctx, webhookDeliveryDeleteByGameAndOrganizer, arg.GameID, arg.OrganizerID)
+	return err
+}
+
+const webhookDeliveryDeleteByWebhook = `-- name: WebhookDeliveryDeleteByWebhook :exec
+delete from webhook_deliveries
+where webhook_id = ?
+`
+
+func (q *Queries) WebhookDeliveryDeleteByWebhook(ctx context.Context, webhookID int64) error {
+	_, err := q.db.ExecContext(ctx, webhookDeliveryDeleteByWebhook, webhookID)
+	return err
+}
+
+const webhookDeliveryGetById = `-- name: WebhookDeliveryGetById :one
+select id, webhook_id, event_id, event_type, payload, created_at, attempts, next_attempt_at, last_status_code, last_error, delivered_at, failed_at
+from webhook_deliveries
+where id = ?
+`
+
+func (q *Queries) WebhookDeliveryGetById(ctx context.Context, id int64) (WebhookDelivery, error) {
+	row := q.db.QueryRowContext(ctx, webhookDeliveryGetById, id)
+	var i WebhookDelivery
+	err := row.Scan(
+		&i.ID,
+		&i.WebhookID,
+		&i.EventID,
+		&i.EventType,
+		&i.Payload,
+		&i.CreatedAt,
+		&i.Attempts,
+		&i.NextAttemptAt,
+		&i.LastStatusCode,
+		&i.LastError,
+		&i.DeliveredAt,
+		&i.FailedAt,
+	)
+	return i, err
+}
+
+const webhookDeliveryListByWebhook = `-- name: WebhookDeliveryListByWebhook :many
+select id, webhook_id, event_id, event_type, payload, created_at, attempts, next_attempt_at, last_status_code, last_error, delivered_at, failed_at
+from webhook_deliveries
+where webhook_id = ?
+order by id desc
+limit ?2
+`
+
+type WebhookDeliveryListByWebhookParams struct {
+	WebhookID int64
+	Limit     int64
+}
+
+func (q *Queries) WebhookDeliveryListByWebhook(ctx context.Context, arg WebhookDeliveryListByWebhookParams) ([]WebhookDelivery, error) {
+	rows, err := q.db.QueryContext(ctx, webhookDeliveryListByWebhook, arg.WebhookID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WebhookDelivery
+	for rows.Next() {
+		var i WebhookDelivery
+		if err := rows.Scan(
+			&i.ID,
+			&i.WebhookID,
+			&i.EventID,
+			&i.EventType,
+			&i.Payload,
+			&i.CreatedAt,
+			&i.Attempts,
+			&i.NextAttemptAt,
+			&i.LastStatusCode,
+			&i.LastError,
+			&i.DeliveredAt,
+			&i.FailedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const webhookDeliveryListPending = `-- name: WebhookDeliveryListPending :many
+select
+  webhook_deliveries.id, webhook_deliveries.webhook_id, webhook_deliveries.event_id, webhook_deliveries.event_type, webhook_deliveries.payload, webhook_deliveries.created_at, webhook_deliveries.attempts, webhook_deliveries.next_attempt_at, webhook_deliveries.last_status_code, webhook_deliveries.last_error, webhook_deliveries.delivered_at, webhook_deliveries.failed_at,
+  webhooks.url,
+  webhooks.secret
+from webhook_deliveries
+join webhooks on webhooks.id = webhook_deliveries.webhook_id
+where webhook_deliveries.delivered_at is null and webhook_deliveries.failed_at is null
+  and (webhook_deliveries.next_attempt_at is null or webhook_deliveries.next_attempt_at <= ?1)
+order by webhook_deliveries.id
+limit ?2
+`
+
+type WebhookDeliveryListPendingParams struct {
+	Now   sql.NullTime
+	Limit int64
+}
+
+type WebhookDeliveryListPendingRow struct {
+	WebhookDelivery WebhookDelivery
+	Url             string
+	Secret          string
+}
+
+// Lists the deliveries that are due, the deliveries waiting for their backoff don't hold back the next ones.
+// next_attempt_at is written in UTC, so it compares with now in UTC.
+func (q *Queries) WebhookDeliveryListPending(ctx context.Context, arg WebhookDeliveryListPendingParams) ([]WebhookDeliveryListPendingRow, error) {
+	rows, err := q.db.QueryContext(ctx, webhookDeliveryListPending, arg.Now, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []WebhookDeliveryListPendingRow
+	for rows.Next() {
+		var i WebhookDeliveryListPendingRow
+		if err := rows.Scan(
+			&i.WebhookDelivery.ID,
+			&i.WebhookDelivery.WebhookID,
+			&i.WebhookDelivery.EventID,
+			&i.WebhookDelivery.EventType,
+			&i.WebhookDelivery.Payload,
+			&i.WebhookDelivery.CreatedAt,
+			&i.WebhookDelivery.Attempts,
+			&i.WebhookDelivery.NextAttemptAt,
+			&i.WebhookDelivery.LastStatusCode,
+			&i.WebhookDelivery.LastError,
+			&i.WebhookDelivery.DeliveredAt,
+			&i.WebhookDelivery.FailedAt,
+			&i.Url,
+			&i.Secret,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const webhookDeliveryMarkAttemptFailed = `-- name: WebhookDeliveryMarkAttemptFailed :exec
+update webhook_deliveries
+set
+  attempts = attempts + 1,
+  next_attempt_at = ?1,
+  last_status_code = ?2,
+  last_error = ?3,
+  failed_at = ?4
+where id = ?5
+`
+
+type WebhookDeliveryMarkAttemptFailedParams struct {
+	NextAttemptAt  sql.NullTime
+	LastStatusCode sql.NullInt64
+	LastError      sql.NullString
+	FailedAt       sql.NullTime
+	ID             int64
+}
+
+func (q *Queries) WebhookDeliveryMarkAttemptFailed(ctx context.Context, arg WebhookDeliveryMarkAttemptFailedParams) error {
+	_, err := q.db.ExecContext(ctx, webhookDeliveryMarkAttemptFailed,
+		arg.NextAttemptAt,
+		arg.LastStatusCode,
+		arg.LastError,
+		arg.FailedAt,
+		arg.ID,
+	)
+	return err
+}
+
+const webhookDeliveryMarkDelivered = `-- name: WebhookDeliveryMarkDelivered :exec
+update webhook_deliveries
+set
+  delivered_at = ?1,
+  last_status_code = ?2,
+  last_error = null
+where id = ?3
+`
+
+type WebhookDeliveryMarkDeliveredParams struct {
+	DeliveredAt    sql.NullTime
+	LastStatusCode sql.NullInt64
+	ID             int64
+}
+
+func (q *Queries) WebhookDeliveryMarkDelivered(ctx context.Context, arg WebhookDeliveryMarkDeliveredParams) error {
+	_, err := q.db.ExecContext(ctx, webhookDeliveryMarkDelivered, arg.DeliveredAt, arg.LastStatusCode, arg.ID)
+	return err
+}
+
+const webhookDeliveryReset = `-- name: WebhookDeliveryReset :exec
+update webhook_deliveries
+set
+  attempts = 0,
+  next_attempt_at = null,
+  delivered_at = null,
+  failed_at = null
+where id = ?
+`
+
+// Makes the delivery pending again, it's attempted as soon as possible.
+func (q *Queries) WebhookDeliveryReset(ctx context.Context, id int64) error {
+	_, err := q.db.ExecContext(ctx, webhookDeliveryReset, id)
+	return err
+}
+
+const webhookGetById = `-- name: WebhookGetById :one
+select id, organizer_id, game_id, series_id, url, secret, event_types, created_at
+from webhooks
+where id = ?
+`
+
+func (q *Queries) WebhookGetById(ctx context.Context, id int64) (Webhook, error) {
+	row := q.db.QueryRowContext(ctx, webhookGetById, id)
+	var i Webhook
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizerID,
+		&i.GameID,
+		&i.SeriesID,
+		&i.Url,
+		&i.Secret,
+		&i.EventTypes,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const webhookListByGame = `-- name: WebhookListByGame :many
+select id, organizer_id, game_id, series_id, url, secret, event_types, created_at
+from webhooks
+where game_id = ?1 or series_id = ?2
+order by id
+`
+
+type WebhookListByGameParams struct {
+	GameID   sql.NullString
+	SeriesID sql.NullString
+}
+
+// Webhooks registered on the game, or on the series it was generated from.
+func (q *Queries) WebhookListByGame(ctx context.Context, arg WebhookListByGameParams) ([]Webhook, error) {
+	rows, err := q.db.QueryContext(ctx, webhookListByGame, arg.GameID, arg.SeriesID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Webhook
+	for rows.Next() {
+		var i Webhook
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizerID,
+			&i.GameID,
+			&i.SeriesID,
+			&i.Url,
+			&i.Secret,
+			&i.EventTypes,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const webhookListByOrganizer = `-- name: WebhookListByOrganizer :many
+select id, organizer_id, game_id, series_id, url, secret, event_types, created_at
+from webhooks
+where organizer_id = ?
+order by id
+`
+
+func (q *Queries) WebhookListByOrganizer(ctx context.Context, organizerID int64) ([]Webhook, error) {
+	rows, err := q.db.QueryContext(ctx, webhookListByOrganizer, organizerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Webhook
+	for rows.Next() {
+		var i Webhook
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizerID,
+			&i.GameID,
+			&i.SeriesID,
+			&i.Url,
+			&i.Secret,
+			&i.EventTypes,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -1,4 +1,4 @@
 // This file is auto-generated by @hey-api/openapi-ts
 
-export { getApiAuthByProviderCallback, getApiAuthByProviderLogin, getApiAuthMe, getApiDemoUsers, getApiGames, getApiGamesById, getApiGamesByIdParticipants, getApiGamesByIdReimbursements, getApiGamesByIdReimbursementsByParticipantId, getPublicApiGamesById, type Options, patchApiGamesById, postApiAuthLogout, postApiDemoUsersByUserIdImpersonate, postApiGames, putApiGamesByIdParticipants, putApiGamesByIdReimbursements } from './sdk.gen';
-export type { AuthResponse, ClientOptions, CreateGameRequest, Error, Game, GameDetail, GameFields, GameListItem, GameListResponse, GameParticipation, GameReimbursementEntry, GetApiAuthByProviderCallbackData, GetApiAuthByProviderCallbackError, GetApiAuthByProviderCallbackErrors, GetApiAuthByProviderCallbackResponse, GetApiAuthByProviderCallbackResponses, GetApiAuthByProviderLoginData, GetApiAuthByProviderLoginError, GetApiAuthByProviderLoginErrors, GetApiAuthMeData, GetApiAuthMeError, GetApiAuthMeErrors, GetApiAuthMeResponse, GetApiAuthMeResponses, GetApiDemoUsersData, GetApiDemoUsersError, GetApiDemoUsersErrors, GetApiDemoUsersResponse, GetApiDemoUsersResponses, GetApiGamesByIdData, GetApiGamesByIdError, GetApiGamesByIdErrors, GetApiGamesByIdParticipantsData, GetApiGamesByIdParticipantsError, GetApiGamesByIdParticipantsErrors, GetApiGamesByIdParticipantsResponse, GetApiGamesByIdParticipantsResponses, GetApiGamesByIdReimbursementsByParticipantIdData, GetApiGamesByIdReimbursementsByParticipantIdError, GetApiGamesByIdReimbursementsByParticipantIdErrors, GetApiGamesByIdReimbursementsByParticipantIdResponse, GetApiGamesByIdReimbursementsByParticipantIdResponses, GetApiGamesByIdReimbursementsData, GetApiGamesByIdReimbursementsError, GetApiGamesByIdReimbursementsErrors, GetApiGamesByIdReimbursementsResponse, GetApiGamesByIdReimbursementsResponses, GetApiGamesByIdResponse, GetApiGamesByIdResponses, GetApiGamesData, GetApiGamesError, GetApiGamesErrors, GetApiGamesResponse, GetApiGamesResponses, GetPublicApiGamesByIdData, GetPublicApiGamesByIdError, GetPublicApiGamesByIdErrors, GetPublicApiGamesByIdResponse, GetPublicApiGamesByIdResponses, Pagination, ParticipantWithUser, ParticipationStatus, ParticipationStatusUpdate, PatchApiGamesByIdData, PatchApiGamesByIdError, PatchApiGamesByIdErrors, PatchApiGamesByIdResponse, PatchApiGamesByIdResponses, PostApiAuthLogoutData, PostApiAuthLogoutError, PostApiAuthLogoutErrors, PostApiAuthLogoutResponse, PostApiAuthLogoutResponses, PostApiDemoUsersByUserIdImpersonateData, PostApiDemoUsersByUserIdImpersonateError, PostApiDemoUsersByUserIdImpersonateErrors, PostApiDemoUsersByUserIdImpersonateResponse, PostApiDemoUsersByUserIdImpersonateResponses, PostApiGamesData, PostApiGamesError, PostApiGamesErrors, PostApiGamesResponse, PostApiGamesResponses, PublicGameDetail, PutApiGamesByIdParticipantsData, PutApiGamesByIdParticipantsError, PutApiGamesByIdParticipantsErrors, PutApiGamesByIdParticipantsResponse, PutApiGamesByIdParticipantsResponses, PutApiGamesByIdReimbursementsData, PutApiGamesByIdReimbursementsError, PutApiGamesByIdReimbursementsErrors, PutApiGamesByIdReimbursementsResponse, PutApiGamesByIdReimbursementsResponses, ReimbursementRecord, UpdateGameParticipationRequest, UpdateGameRequest, UpdateReimbursementRequest, User } from './types.gen';
+export { deleteApiGamesById, deleteApiGamesByIdCheckInsByUserId, deleteApiGamesByIdInviteTokensByTokenId, deleteApiGamesByIdParticipantsByUserId, deleteApiGamesByIdRolesByUserId, deleteApiGroupsByIdMembersByUserId, deleteApiWebhooksById, getApiAuthByProviderCallback, getApiAuthByProviderLogin, getApiAuthMe, getApiDemoUsers, getApiGames, getApiGamesById, getApiGamesByIdAttendance, getApiGamesByIdEvents, getApiGamesByIdInviteTokens, getApiGamesByIdParticipants, getApiGamesByIdReconfirmations, getApiGamesByIdReimbursements, getApiGamesByIdReimbursementsByParticipantId, getApiGamesByIdRoles, getApiGroups, getApiGroupsById, getApiGroupsByIdAttendance, getApiGroupsByIdMembers, getApiLedger, getApiSeries, getApiSeriesById, getApiUsersMeBalances, getApiUsersMeNotificationPreferences, getApiUsersMeOrganizerBalances, getApiUsersMePayoutDetails, getApiWebhooks, getApiWebhooksByIdDeliveries, getPublicApiGamesById, type Options, patchApiGamesById, patchApiGroupsById, patchApiSeriesById, postApiAuthLogout, postApiDemoUsersByUserIdImpersonate, postApiGames, postApiGamesByIdCancel, postApiGamesByIdCheckInCode, postApiGamesByIdCheckIns, postApiGamesByIdInviteTokens, postApiGamesByIdPlaceholders, postApiGamesByIdPlaceholdersByUserIdClaimLink, postApiGamesByIdReimbursementsBulk, postApiGamesByIdReimbursementsMatches, postApiGamesByIdReimbursementsStatement, postApiGamesByIdRoles, postApiGamesByIdWaitlistOfferAccept, postApiGamesByIdWaitlistOfferDecline, postApiGroups, postApiGroupsByIdInviteLink, postApiGroupsJoin, postApiLedgerPayments, postApiPlaceholdersClaim, postApiSeries, postApiWebhooks, postApiWebhooksByIdDeliveriesByDeliveryIdRedeliver, putApiGamesByIdParticipants, putApiGamesByIdParticipantsOrder, putApiGamesByIdReimbursements, putApiGamesByIdRolesByUserId, putApiGroupsByIdMembersByUserId, putApiUsersMeNotificationPreferences, putApiUsersMePayoutDetails } from './sdk.gen';
+export type { ApplyReimbursementMatchesRequest, AttendanceRecord, AttendanceStatus, AuthResponse, BulkReimbursementRequest, BulkReimbursementResponse, BulkReimbursementResult, BulkReimbursementStatus, BulkReimbursementUpdate, CancelGameRequest, CheckInCode, CheckInRequest, ClaimedPlaceholder, ClaimPlaceholderRequest, ClientOptions, CreatedWebhook, CreateGameRequest, CreateGroupRequest, CreatePlaceholderRequest, CreateSeriesRequest, CreateWebhookRequest, DeleteApiGamesByIdCheckInsByUserIdData, DeleteApiGamesByIdCheckInsByUserIdError, DeleteApiGamesByIdCheckInsByUserIdErrors, DeleteApiGamesByIdCheckInsByUserIdResponse, DeleteApiGamesByIdCheckInsByUserIdResponses, DeleteApiGamesByIdData, DeleteApiGamesByIdError, DeleteApiGamesByIdErrors, DeleteApiGamesByIdInviteTokensByTokenIdData, DeleteApiGamesByIdInviteTokensByTokenIdError, DeleteApiGamesByIdInviteTokensByTokenIdErrors, DeleteApiGamesByIdInviteTokensByTokenIdResponse, DeleteApiGamesByIdInviteTokensByTokenIdResponses, DeleteApiGamesByIdParticipantsByUserIdData, DeleteApiGamesByIdParticipantsByUserIdError, DeleteApiGamesByIdParticipantsByUserIdErrors, DeleteApiGamesByIdParticipantsByUserIdResponse, DeleteApiGamesByIdParticipantsByUserIdResponses, DeleteApiGamesByIdResponse, DeleteApiGamesByIdResponses, DeleteApiGamesByIdRolesByUserIdData, DeleteApiGamesByIdRolesByUserIdError, DeleteApiGamesByIdRolesByUserIdErrors, DeleteApiGamesByIdRolesByUserIdResponse, DeleteApiGamesByIdRolesByUserIdResponses, DeleteApiGroupsByIdMembersByUserIdData, DeleteApiGroupsByIdMembersByUserIdError, DeleteApiGroupsByIdMembersByUserIdErrors, DeleteApiGroupsByIdMembersByUserIdResponse, DeleteApiGroupsByIdMembersByUserIdResponses, DeleteApiWebhooksByIdData, DeleteApiWebhooksByIdError, DeleteApiWebhooksByIdErrors, DeleteApiWebhooksByIdResponse, DeleteApiWebhooksByIdResponses, Error, Game, GameAllocationMode, GameAttendance, GameDetail, GameFields, GameInviteToken, GameListItem, GameListResponse, GameParticipation, GameReimbursementEntry, GameRole, GameRoleName, GameSplitStrategy, GameSpots, GameVisibility, GameWaitlistMode, GetApiAuthByProviderCallbackData, GetApiAuthByProviderCallbackError, GetApiAuthByProviderCallbackErrors, GetApiAuthByProviderCallbackResponse, GetApiAuthByProviderCallbackResponses, GetApiAuthByProviderLoginData, GetApiAuthByProviderLoginError, GetApiAuthByProviderLoginErrors, GetApiAuthMeData, GetApiAuthMeError, GetApiAuthMeErrors, GetApiAuthMeResponse, GetApiAuthMeResponses, GetApiDemoUsersData, GetApiDemoUsersError, GetApiDemoUsersErrors, GetApiDemoUsersResponse, GetApiDemoUsersResponses, GetApiGamesByIdAttendanceData, GetApiGamesByIdAttendanceError, GetApiGamesByIdAttendanceErrors, GetApiGamesByIdAttendanceResponse, GetApiGamesByIdAttendanceResponses, GetApiGamesByIdData, GetApiGamesByIdError, GetApiGamesByIdErrors, GetApiGamesByIdEventsData, GetApiGamesByIdEventsError, GetApiGamesByIdEventsErrors, GetApiGamesByIdEventsResponse, GetApiGamesByIdEventsResponses, GetApiGamesByIdInviteTokensData, GetApiGamesByIdInviteTokensError, GetApiGamesByIdInviteTokensErrors, GetApiGamesByIdInviteTokensResponse, GetApiGamesByIdInviteTokensResponses, GetApiGamesByIdParticipantsData, GetApiGamesByIdParticipantsError, GetApiGamesByIdParticipantsErrors, GetApiGamesByIdParticipantsResponse, GetApiGamesByIdParticipantsResponses, GetApiGamesByIdReconfirmationsData, GetApiGamesByIdReconfirmationsError, GetApiGamesByIdReconfirmationsErrors, GetApiGamesByIdReconfirmationsResponse, GetApiGamesByIdReconfirmationsResponses, GetApiGamesByIdReimbursementsByParticipantIdData, GetApiGamesByIdReimbursementsByParticipantIdError, GetApiGamesByIdReimbursementsByParticipantIdErrors, GetApiGamesByIdReimbursementsByParticipantIdResponse, GetApiGamesByIdReimbursementsByParticipantIdResponses, GetApiGamesByIdReimbursementsData, GetApiGamesByIdReimbursementsError, GetApiGamesByIdReimbursementsErrors, GetApiGamesByIdReimbursementsResponse, GetApiGamesByIdReimbursementsResponses, GetApiGamesByIdResponse, GetApiGamesByIdResponses, GetApiGamesByIdRolesData, GetApiGamesByIdRolesError, GetApiGamesByIdRolesErrors, GetApiGamesByIdRolesResponse, GetApiGamesByIdRolesResponses, GetApiGamesData, GetApiGamesError, GetApiGamesErrors, GetApiGamesResponse, GetApiGamesResponses, GetApiGroupsByIdAttendanceData, GetApiGroupsByIdAttendanceError, GetApiGroupsByIdAttendanceErrors, GetApiGroupsByIdAttendanceResponse, GetApiGroupsByIdAttendanceResponses, GetApiGroupsByIdData, GetApiGroupsByIdError, GetApiGroupsByIdErrors, GetApiGroupsByIdMembersData, GetApiGroupsByIdMembersError, GetApiGroupsByIdMembersErrors, GetApiGroupsByIdMembersResponse, GetApiGroupsByIdMembersResponses, GetApiGroupsByIdResponse, GetApiGroupsByIdResponses, GetApiGroupsData, GetApiGroupsError, GetApiGroupsErrors, GetApiGroupsResponse, GetApiGroupsResponses, GetApiLedgerData, GetApiLedgerError, GetApiLedgerErrors, GetApiLedgerResponse, GetApiLedgerResponses, GetApiSeriesByIdData, GetApiSeriesByIdError, GetApiSeriesByIdErrors, GetApiSeriesByIdResponse, GetApiSeriesByIdResponses, GetApiSeriesData, GetApiSeriesError, GetApiSeriesErrors, GetApiSeriesResponse, GetApiSeriesResponses, GetApiUsersMeBalancesData, GetApiUsersMeBalancesError, GetApiUsersMeBalancesErrors, GetApiUsersMeBalancesResponse, GetApiUsersMeBalancesResponses, GetApiUsersMeNotificationPreferencesData, GetApiUsersMeNotificationPreferencesError, GetApiUsersMeNotificationPreferencesErrors, GetApiUsersMeNotificationPreferencesResponse, GetApiUsersMeNotificationPreferencesResponses, GetApiUsersMeOrganizerBalancesData, GetApiUsersMeOrganizerBalancesError, GetApiUsersMeOrganizerBalancesErrors, GetApiUsersMeOrganizerBalancesResponse, GetApiUsersMeOrganizerBalancesResponses, GetApiUsersMePayoutDetailsData, GetApiUsersMePayoutDetailsError, GetApiUsersMePayoutDetailsErrors, GetApiUsersMePayoutDetailsResponse, GetApiUsersMePayoutDetailsResponses, GetApiWebhooksByIdDeliveriesData, GetApiWebhooksByIdDeliveriesError, GetApiWebhooksByIdDeliveriesErrors, GetApiWebhooksByIdDeliveriesResponse, GetApiWebhooksByIdDeliveriesResponses, GetApiWebhooksData, GetApiWebhooksError, GetApiWebhooksErrors, GetApiWebhooksResponse, GetApiWebhooksResponses, GetPublicApiGamesByIdData, GetPublicApiGamesByIdError, GetPublicApiGamesByIdErrors, GetPublicApiGamesByIdResponse, GetPublicApiGamesByIdResponses, Group, GroupMember, GroupMemberRole, GroupMemberTier, InviteGameRoleRequest, JoinGroupRequest, LedgerBalance, LedgerEntry, LedgerEntryType, LedgerStatement, MemberAttendance, NotificationPreference, NotificationType, Pagination, ParticipantWithUser, ParticipationStatus, ParticipationStatusUpdate, PatchApiGamesByIdData, PatchApiGamesByIdError, PatchApiGamesByIdErrors, PatchApiGamesByIdResponse, PatchApiGamesByIdResponses, PatchApiGroupsByIdData, PatchApiGroupsByIdError, PatchApiGroupsByIdErrors, PatchApiGroupsByIdResponse, PatchApiGroupsByIdResponses, PatchApiSeriesByIdData, PatchApiSeriesByIdError, PatchApiSeriesByIdErrors, PatchApiSeriesByIdResponse, PatchApiSeriesByIdResponses, PaymentInstructions, PayoutDetails, PendingReconfirmation, PlaceholderParticipant, PostApiAuthLogoutData, PostApiAuthLogoutError, PostApiAuthLogoutErrors, PostApiAuthLogoutResponse, PostApiAuthLogoutResponses, PostApiDemoUsersByUserIdImpersonateData, PostApiDemoUsersByUserIdImpersonateError, PostApiDemoUsersByUserIdImpersonateErrors, PostApiDemoUsersByUserIdImpersonateResponse, PostApiDemoUsersByUserIdImpersonateResponses, PostApiGamesByIdCancelData, PostApiGamesByIdCancelError, PostApiGamesByIdCancelErrors, PostApiGamesByIdCancelResponse, PostApiGamesByIdCancelResponses, PostApiGamesByIdCheckInCodeData, PostApiGamesByIdCheckInCodeError, PostApiGamesByIdCheckInCodeErrors, PostApiGamesByIdCheckInCodeResponse, PostApiGamesByIdCheckInCodeResponses, PostApiGamesByIdCheckInsData, PostApiGamesByIdCheckInsError, PostApiGamesByIdCheckInsErrors, PostApiGamesByIdCheckInsResponse, PostApiGamesByIdCheckInsResponses, PostApiGamesByIdInviteTokensData, PostApiGamesByIdInviteTokensError, PostApiGamesByIdInviteTokensErrors, PostApiGamesByIdInviteTokensResponse, PostApiGamesByIdInviteTokensResponses, PostApiGamesByIdPlaceholdersByUserIdClaimLinkData, PostApiGamesByIdPlaceholdersByUserIdClaimLinkError, PostApiGamesByIdPlaceholdersByUserIdClaimLinkErrors, PostApiGamesByIdPlaceholdersByUserIdClaimLinkResponse, PostApiGamesByIdPlaceholdersByUserIdClaimLinkResponses, PostApiGamesByIdPlaceholdersData, PostApiGamesByIdPlaceholdersError, PostApiGamesByIdPlaceholdersErrors, PostApiGamesByIdPlaceholdersResponse, PostApiGamesByIdPlaceholdersResponses, PostApiGamesByIdReimbursementsBulkData, PostApiGamesByIdReimbursementsBulkError, PostApiGamesByIdReimbursementsBulkErrors, PostApiGamesByIdReimbursementsBulkResponse, PostApiGamesByIdReimbursementsBulkResponses, PostApiGamesByIdReimbursementsMatchesData, PostApiGamesByIdReimbursementsMatchesError, PostApiGamesByIdReimbursementsMatchesErrors, PostApiGamesByIdReimbursementsMatchesResponse, PostApiGamesByIdReimbursementsMatchesResponses, PostApiGamesByIdReimbursementsStatementData, PostApiGamesByIdReimbursementsStatementError, PostApiGamesByIdReimbursementsStatementErrors, PostApiGamesByIdReimbursementsStatementResponse, PostApiGamesByIdReimbursementsStatementResponses, PostApiGamesByIdRolesData, PostApiGamesByIdRolesError, PostApiGamesByIdRolesErrors, PostApiGamesByIdRolesResponse, PostApiGamesByIdRolesResponses, PostApiGamesByIdWaitlistOfferAcceptData, PostApiGamesByIdWaitlistOfferAcceptError, PostApiGamesByIdWaitlistOfferAcceptErrors, PostApiGamesByIdWaitlistOfferAcceptResponse, PostApiGamesByIdWaitlistOfferAcceptResponses, PostApiGamesByIdWaitlistOfferDeclineData, PostApiGamesByIdWaitlistOfferDeclineError, PostApiGamesByIdWaitlistOfferDeclineErrors, PostApiGamesByIdWaitlistOfferDeclineResponse, PostApiGamesByIdWaitlistOfferDeclineResponses, PostApiGamesData, PostApiGamesError, PostApiGamesErrors, PostApiGamesResponse, PostApiGamesResponses, PostApiGroupsByIdInviteLinkData, PostApiGroupsByIdInviteLinkError, PostApiGroupsByIdInviteLinkErrors, PostApiGroupsByIdInviteLinkResponse, PostApiGroupsByIdInviteLinkResponses, PostApiGroupsData, PostApiGroupsError, PostApiGroupsErrors, PostApiGroupsJoinData, PostApiGroupsJoinError, PostApiGroupsJoinErrors, PostApiGroupsJoinResponse, PostApiGroupsJoinResponses, PostApiGroupsResponse, PostApiGroupsResponses, PostApiLedgerPaymentsData, PostApiLedgerPaymentsError, PostApiLedgerPaymentsErrors, PostApiLedgerPaymentsResponse, PostApiLedgerPaymentsResponses, PostApiPlaceholdersClaimData, PostApiPlaceholdersClaimError, PostApiPlaceholdersClaimErrors, PostApiPlaceholdersClaimResponse, PostApiPlaceholdersClaimResponses, PostApiSeriesData, PostApiSeriesError, PostApiSeriesErrors, PostApiSeriesResponse, PostApiSeriesResponses, PostApiWebhooksByIdDeliveriesByDeliveryIdRedeliverData, PostApiWebhooksByIdDeliveriesByDeliveryIdRedeliverError, PostApiWebhooksByIdDeliveriesByDeliveryIdRedeliverErrors, PostApiWebhooksByIdDeliveriesByDeliveryIdRedeliverResponse, PostApiWebhooksByIdDeliveriesByDeliveryIdRedeliverResponses, PostApiWebhooksData, PostApiWebhooksError, PostApiWebhooksErrors, PostApiWebhooksResponse, PostApiWebhooksResponses, PublicGameDetail, PutApiGamesByIdParticipantsData, PutApiGamesByIdParticipantsError, PutApiGamesByIdParticipantsErrors, PutApiGamesByIdParticipantsOrderData, PutApiGamesByIdParticipantsOrderError, PutApiGamesByIdParticipantsOrderErrors, PutApiGamesByIdParticipantsOrderResponse, PutApiGamesByIdParticipantsOrderResponses, PutApiGamesByIdParticipantsResponse, PutApiGamesByIdParticipantsResponses, PutApiGamesByIdReimbursementsData, PutApiGamesByIdReimbursementsError, PutApiGamesByIdReimbursementsErrors, PutApiGamesByIdReimbursementsResponse, PutApiGamesByIdReimbursementsResponses, PutApiGamesByIdRolesByUserIdData, PutApiGamesByIdRolesByUserIdError, PutApiGamesByIdRolesByUserIdErrors, PutApiGamesByIdRolesByUserIdResponse, PutApiGamesByIdRolesByUserIdResponses, PutApiGroupsByIdMembersByUserIdData, PutApiGroupsByIdMembersByUserIdError, PutApiGroupsByIdMembersByUserIdErrors, PutApiGroupsByIdMembersByUserIdResponse, PutApiGroupsByIdMembersByUserIdResponses, PutApiUsersMeNotificationPreferencesData, PutApiUsersMeNotificationPreferencesError, PutApiUsersMeNotificationPreferencesErrors, PutApiUsersMeNotificationPreferencesResponse, PutApiUsersMeNotificationPreferencesResponses, PutApiUsersMePayoutDetailsData, PutApiUsersMePayoutDetailsError, PutApiUsersMePayoutDetailsErrors, PutApiUsersMePayoutDetailsResponse, PutApiUsersMePayoutDetailsResponses, RecordLedgerPaymentRequest, RecurrenceRule, ReimbursementMatch, ReimbursementRecord, ReorderParticipantsRequest, Series, SeriesFields, StatementLine, StatementMatch, StatementMatchReport, UnmatchedStatementLine, UnmatchedStatementLineReason, UpdateGameParticipationRequest, UpdateGameRequest, UpdateGameRoleRequest, UpdateGroupMemberRequest, UpdateGroupRequest, UpdateReimbursementRequest, UpdateSeriesRequest, User, Webhook, WebhookDelivery, WebhookEventType, Weekday } from './types.gen';
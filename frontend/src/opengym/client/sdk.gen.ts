@@ -2,7 +2,7 @@
 
 import type { Client, Options as Options2, TDataShape } from './client';
 import { client } from './client.gen';
-import type { GetApiAuthByProviderCallbackData, GetApiAuthByProviderCallbackErrors, GetApiAuthByProviderCallbackResponses, GetApiAuthByProviderLoginData, GetApiAuthByProviderLoginErrors, GetApiAuthMeData, GetApiAuthMeErrors, GetApiAuthMeResponses, GetApiDemoUsersData, GetApiDemoUsersErrors, GetApiDemoUsersResponses, GetApiGamesByIdData, GetApiGamesByIdErrors, GetApiGamesByIdParticipantsData, GetApiGamesByIdParticipantsErrors, GetApiGamesByIdParticipantsResponses, GetApiGamesByIdReimbursementsByParticipantIdData, GetApiGamesByIdReimbursementsByParticipantIdErrors, GetApiGamesByIdReimbursementsByParticipantIdResponses, GetApiGamesByIdReimbursementsData, GetApiGamesByIdReimbursementsErrors, GetApiGamesByIdReimbursementsResponses, GetApiGamesByIdResponses, GetApiGamesData, GetApiGamesErrors, GetApiGamesResponses, GetPublicApiGamesByIdData, GetPublicApiGamesByIdErrors, GetPublicApiGamesByIdResponses, PatchApiGamesByIdData, PatchApiGamesByIdErrors, PatchApiGamesByIdResponses, PostApiAuthLogoutData, PostApiAuthLogoutErrors, PostApiAuthLogoutResponses, PostApiDemoUsersByUserIdImpersonateData, PostApiDemoUsersByUserIdImpersonateErrors, PostApiDemoUsersByUserIdImpersonateResponses, PostApiGamesData, PostApiGamesErrors, PostApiGamesResponses, PutApiGamesByIdParticipantsData, PutApiGamesByIdParticipantsErrors, PutApiGamesByIdParticipantsResponses, PutApiGamesByIdReimbursementsData, PutApiGamesByIdReimbursementsErrors, PutApiGamesByIdReimbursementsResponses } from './types.gen';
+import type { DeleteApiGamesByIdCheckInsByUserIdData, DeleteApiGamesByIdCheckInsByUserIdErrors, DeleteApiGamesByIdCheckInsByUserIdResponses, DeleteApiGamesByIdData, DeleteApiGamesByIdErrors, DeleteApiGamesByIdInviteTokensByTokenIdData, DeleteApiGamesByIdInviteTokensByTokenIdErrors, DeleteApiGamesByIdInviteTokensByTokenIdResponses, DeleteApiGamesByIdParticipantsByUserIdData, DeleteApiGamesByIdParticipantsByUserIdErrors, DeleteApiGamesByIdParticipantsByUserIdResponses, DeleteApiGamesByIdResponses, DeleteApiGamesByIdRolesByUserIdData, DeleteApiGamesByIdRolesByUserIdErrors, DeleteApiGamesByIdRolesByUserIdResponses, DeleteApiGroupsByIdMembersByUserIdData, DeleteApiGroupsByIdMembersByUserIdErrors, DeleteApiGroupsByIdMembersByUserIdResponses, DeleteApiWebhooksByIdData, DeleteApiWebhooksByIdErrors, DeleteApiWebhooksByIdResponses, GetApiAuthByProviderCallbackData, GetApiAuthByProviderCallbackErrors, GetApiAuthByProviderCallbackResponses, GetApiAuthByProviderLoginData, GetApiAuthByProviderLoginErrors, GetApiAuthMeData, GetApiAuthMeErrors, GetApiAuthMeResponses, GetApiDemoUsersData, GetApiDemoUsersErrors, GetApiDemoUsersResponses, GetApiGamesByIdAttendanceData, GetApiGamesByIdAttendanceErrors, GetApiGamesByIdAttendanceResponses, GetApiGamesByIdData, GetApiGamesByIdErrors, GetApiGamesByIdEventsData, GetApiGamesByIdEventsErrors, GetApiGamesByIdEventsResponses, GetApiGamesByIdInviteTokensData, GetApiGamesByIdInviteTokensErrors, GetApiGamesByIdInviteTokensResponses, GetApiGamesByIdParticipantsData, GetApiGamesByIdParticipantsErrors, GetApiGamesByIdParticipantsResponses, GetApiGamesByIdReconfirmationsData, GetApiGamesByIdReconfirmationsErrors, GetApiGamesByIdReconfirmationsResponses, GetApiGamesByIdReimbursementsByParticipantIdData, GetApiGamesByIdReimbursementsByParticipantIdErrors, GetApiGamesByIdReimbursementsByParticipantIdResponses, GetApiGamesByIdReimbursementsData, GetApiGamesByIdReimbursementsErrors, GetApiGamesByIdReimbursementsResponses, GetApiGamesByIdResponses, GetApiGamesByIdRolesData, GetApiGamesByIdRolesErrors, GetApiGamesByIdRolesResponses, GetApiGamesData, GetApiGamesErrors, GetApiGamesResponses, GetApiGroupsByIdAttendanceData, GetApiGroupsByIdAttendanceErrors, GetApiGroupsByIdAttendanceResponses, GetApiGroupsByIdData, GetApiGroupsByIdErrors, GetApiGroupsByIdMembersData, GetApiGroupsByIdMembersErrors, GetApiGroupsByIdMembersResponses, GetApiGroupsByIdResponses, GetApiGroupsData, GetApiGroupsErrors, GetApiGroupsResponses, GetApiLedgerData, GetApiLedgerErrors, GetApiLedgerResponses, GetApiSeriesByIdData, GetApiSeriesByIdErrors, GetApiSeriesByIdResponses, GetApiSeriesData, GetApiSeriesErrors, GetApiSeriesResponses, GetApiUsersMeBalancesData, GetApiUsersMeBalancesErrors, GetApiUsersMeBalancesResponses, GetApiUsersMeNotificationPreferencesData, GetApiUsersMeNotificationPreferencesErrors, GetApiUsersMeNotificationPreferencesResponses, GetApiUsersMeOrganizerBalancesData, GetApiUsersMeOrganizerBalancesErrors, GetApiUsersMeOrganizerBalancesResponses, GetApiUsersMePayoutDetailsData, GetApiUsersMePayoutDetailsErrors, GetApiUsersMePayoutDetailsResponses, GetApiWebhooksByIdDeliveriesData, GetApiWebhooksByIdDeliveriesErrors, GetApiWebhooksByIdDeliveriesResponses, GetApiWebhooksData, GetApiWebhooksErrors, GetApiWebhooksResponses, GetPublicApiGamesByIdData, GetPublicApiGamesByIdErrors, GetPublicApiGamesByIdResponses, PatchApiGamesByIdData, PatchApiGamesByIdErrors, PatchApiGamesByIdResponses, PatchApiGroupsByIdData, PatchApiGroupsByIdErrors, PatchApiGroupsByIdResponses, PatchApiSeriesByIdData, PatchApiSeriesByIdErrors, PatchApiSeriesByIdResponses, PostApiAuthLogoutData, PostApiAuthLogoutErrors, PostApiAuthLogoutResponses, PostApiDemoUsersByUserIdImpersonateData, PostApiDemoUsersByUserIdImpersonateErrors, PostApiDemoUsersByUserIdImpersonateResponses, PostApiGamesByIdCancelData, PostApiGamesByIdCancelErrors, PostApiGamesByIdCancelResponses, PostApiGamesByIdCheckInCodeData, PostApiGamesByIdCheckInCodeErrors, PostApiGamesByIdCheckInCodeResponses, PostApiGamesByIdCheckInsData, PostApiGamesByIdCheckInsErrors, PostApiGamesByIdCheckInsResponses, PostApiGamesByIdInviteTokensData, PostApiGamesByIdInviteTokensErrors, PostApiGamesByIdInviteTokensResponses, PostApiGamesByIdPlaceholdersByUserIdClaimLinkData, PostApiGamesByIdPlaceholdersByUserIdClaimLinkErrors, PostApiGamesByIdPlaceholdersByUserIdClaimLinkResponses, PostApiGamesByIdPlaceholdersData, PostApiGamesByIdPlaceholdersErrors, PostApiGamesByIdPlaceholdersResponses, PostApiGamesByIdReimbursementsBulkData, PostApiGamesByIdReimbursementsBulkErrors, PostApiGamesByIdReimbursementsBulkResponses, PostApiGamesByIdReimbursementsMatchesData, PostApiGamesByIdReimbursementsMatchesErrors, PostApiGamesByIdReimbursementsMatchesResponses, PostApiGamesByIdReimbursementsStatementData, PostApiGamesByIdReimbursementsStatementErrors, PostApiGamesByIdReimbursementsStatementResponses, PostApiGamesByIdRolesData, PostApiGamesByIdRolesErrors, PostApiGamesByIdRolesResponses, PostApiGamesByIdWaitlistOfferAcceptData, PostApiGamesByIdWaitlistOfferAcceptErrors, PostApiGamesByIdWaitlistOfferAcceptResponses, PostApiGamesByIdWaitlistOfferDeclineData, PostApiGamesByIdWaitlistOfferDeclineErrors, PostApiGamesByIdWaitlistOfferDeclineResponses, PostApiGamesData, PostApiGamesErrors, PostApiGamesResponses, PostApiGroupsByIdInviteLinkData, PostApiGroupsByIdInviteLinkErrors, PostApiGroupsByIdInviteLinkResponses, PostApiGroupsData, PostApiGroupsErrors, PostApiGroupsJoinData, PostApiGroupsJoinErrors, PostApiGroupsJoinResponses, PostApiGroupsResponses, PostApiLedgerPaymentsData, PostApiLedgerPaymentsErrors, PostApiLedgerPaymentsResponses, PostApiPlaceholdersClaimData, PostApiPlaceholdersClaimErrors, PostApiPlaceholdersClaimResponses, PostApiSeriesData, PostApiSeriesErrors, PostApiSeriesResponses, PostApiWebhooksByIdDeliveriesByDeliveryIdRedeliverData, PostApiWebhooksByIdDeliveriesByDeliveryIdRedeliverErrors, PostApiWebhooksByIdDeliveriesByDeliveryIdRedeliverResponses, PostApiWebhooksData, PostApiWebhooksErrors, PostApiWebhooksResponses, PutApiGamesByIdParticipantsData, PutApiGamesByIdParticipantsErrors, PutApiGamesByIdParticipantsOrderData, PutApiGamesByIdParticipantsOrderErrors, PutApiGamesByIdParticipantsOrderResponses, PutApiGamesByIdParticipantsResponses, PutApiGamesByIdReimbursementsData, PutApiGamesByIdReimbursementsErrors, PutApiGamesByIdReimbursementsResponses, PutApiGamesByIdRolesByUserIdData, PutApiGamesByIdRolesByUserIdErrors, PutApiGamesByIdRolesByUserIdResponses, PutApiGroupsByIdMembersByUserIdData, PutApiGroupsByIdMembersByUserIdErrors, PutApiGroupsByIdMembersByUserIdResponses, PutApiUsersMeNotificationPreferencesData, PutApiUsersMeNotificationPreferencesErrors, PutApiUsersMeNotificationPreferencesResponses, PutApiUsersMePayoutDetailsData, PutApiUsersMePayoutDetailsErrors, PutApiUsersMePayoutDetailsResponses } from './types.gen';
 
 export type Options<TData extends TDataShape = TDataShape, ThrowOnError extends boolean = boolean> = Options2<TData, ThrowOnError> & {
     /**
@@ -71,7 +71,7 @@ export const getApiDemoUsers = <ThrowOnError extends boolean = false>(options?:
 /**
  * List user's games
  *
- * Returns all games the authenticated user is either organizing or participating in
+ * Returns all games the authenticated user is organizing, participating in or has a role in, and the upcoming published games of the groups they belong to
  */
 export const getApiGames = <ThrowOnError extends boolean = false>(options?: Options<GetApiGamesData, ThrowOnError>) => (options?.client ?? client).get<GetApiGamesResponses, GetApiGamesErrors, ThrowOnError>({
     security: [{ scheme: 'bearer', type: 'http' }],
@@ -94,17 +94,28 @@ export const postApiGames = <ThrowOnError extends boolean = false>(options: Opti
     }
 });
 
+/**
+ * Delete a draft game
+ *
+ * Deletes a game that isn't published yet, published games are cancelled instead. The game is hidden everywhere but kept for history. Only the owner and co-organizers can delete the game.
+ */
+export const deleteApiGamesById = <ThrowOnError extends boolean = false>(options: Options<DeleteApiGamesByIdData, ThrowOnError>) => (options.client ?? client).delete<DeleteApiGamesByIdResponses, DeleteApiGamesByIdErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/games/{id}',
+    ...options
+});
+
 /**
  * Get a game by ID
  *
- * Retrieves a single game by its ID
+ * Retrieves a single game by its ID. Private games are only visible to users with a role, participants, and users with a valid invite token.
  */
 export const getApiGamesById = <ThrowOnError extends boolean = false>(options: Options<GetApiGamesByIdData, ThrowOnError>) => (options.client ?? client).get<GetApiGamesByIdResponses, GetApiGamesByIdErrors, ThrowOnError>({ url: '/api/games/{id}', ...options });
 
 /**
  * Update a game
  *
- * Updates an existing game. Only the organizer can update their game. Publishing is irreversible once its effective time has passed. Freezing can be scheduled, rescheduled, or cleared.
+ * Updates an existing game. Only the owner and co-organizers can update the game. Publishing is irreversible once its effective time has passed. Freezing can be scheduled, rescheduled, or cleared.
  */
 export const patchApiGamesById = <ThrowOnError extends boolean = false>(options: Options<PatchApiGamesByIdData, ThrowOnError>) => (options.client ?? client).patch<PatchApiGamesByIdResponses, PatchApiGamesByIdErrors, ThrowOnError>({
     security: [{ scheme: 'bearer', type: 'http' }],
@@ -116,13 +127,42 @@ export const patchApiGamesById = <ThrowOnError extends boolean = false>(options:
     }
 });
 
+/**
+ * Cancel a published game
+ *
+ * Cancels a published game with a reason shown to the participants, for example when the court booking fell through. Cancelled games keep their participants for history, but participations can't change anymore and the game isn't billed. Only the owner and co-organizers can cancel the game, until it ends.
+ */
+export const postApiGamesByIdCancel = <ThrowOnError extends boolean = false>(options: Options<PostApiGamesByIdCancelData, ThrowOnError>) => (options.client ?? client).post<PostApiGamesByIdCancelResponses, PostApiGamesByIdCancelErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/games/{id}/cancel',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
 /**
  * Get public game information
  *
- * Retrieves limited public information about a game. If the game is published, returns spots left and start time. If not yet published, returns when it will be published.
+ * Retrieves limited public information about a game. If the game is published, returns spots left and start time. If not yet published, returns when it will be published. Private games are not found without a valid invite token.
  */
 export const getPublicApiGamesById = <ThrowOnError extends boolean = false>(options: Options<GetPublicApiGamesByIdData, ThrowOnError>) => (options.client ?? client).get<GetPublicApiGamesByIdResponses, GetPublicApiGamesByIdErrors, ThrowOnError>({ url: '/public/api/games/{id}', ...options });
 
+/**
+ * Stream game updates
+ *
+ * Streams Server-Sent Events when the game changes. Each message carries the current state rather than a diff:
+ * `participants` (array of ParticipantWithUser), `spots` (GameSpots) and `game` (GameDetail).
+ * Comments are sent periodically as heartbeats. The current state is sent when connecting, unless the
+ * `Last-Event-ID` header shows the client is already up to date.
+ */
+export const getApiGamesByIdEvents = <ThrowOnError extends boolean = false>(options: Options<GetApiGamesByIdEventsData, ThrowOnError>) => (options.client ?? client).sse.get<GetApiGamesByIdEventsResponses, GetApiGamesByIdEventsErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/games/{id}/events',
+    ...options
+});
+
 /**
  * List game participants
  *
@@ -149,10 +189,244 @@ export const putApiGamesByIdParticipants = <ThrowOnError extends boolean = false
     }
 });
 
+/**
+ * List the participants who still have to reconfirm
+ *
+ * Returns the participants asked to confirm they're still coming after important details of the game changed, who didn't confirm or leave the game yet. Participants reconfirm by updating their participation with confirmed set to true. Only the owner and co-organizers can list them.
+ */
+export const getApiGamesByIdReconfirmations = <ThrowOnError extends boolean = false>(options: Options<GetApiGamesByIdReconfirmationsData, ThrowOnError>) => (options.client ?? client).get<GetApiGamesByIdReconfirmationsResponses, GetApiGamesByIdReconfirmationsErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/games/{id}/reconfirmations',
+    ...options
+});
+
+/**
+ * List the attendance of a started game
+ *
+ * Returns the attendance record of every participant expected at the game, who cancelled late or checked in, and the code participants check in with. Only the owner and co-organizers can list the attendance, once the game started.
+ */
+export const getApiGamesByIdAttendance = <ThrowOnError extends boolean = false>(options: Options<GetApiGamesByIdAttendanceData, ThrowOnError>) => (options.client ?? client).get<GetApiGamesByIdAttendanceResponses, GetApiGamesByIdAttendanceErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/games/{id}/attendance',
+    ...options
+});
+
+/**
+ * Reset the check-in code of a game
+ *
+ * Creates a new code for participants to check themselves in at the venue, the previous code stops working. Only the owner and co-organizers can reset the code.
+ */
+export const postApiGamesByIdCheckInCode = <ThrowOnError extends boolean = false>(options: Options<PostApiGamesByIdCheckInCodeData, ThrowOnError>) => (options.client ?? client).post<PostApiGamesByIdCheckInCodeResponses, PostApiGamesByIdCheckInCodeErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/games/{id}/check-in-code',
+    ...options
+});
+
+/**
+ * Check a participant in
+ *
+ * Records that a participant showed up to a started game. Organizers check in any participant by their user ID, even after the game ended. Participants check themselves in with the check-in code of the game until it ends.
+ */
+export const postApiGamesByIdCheckIns = <ThrowOnError extends boolean = false>(options: Options<PostApiGamesByIdCheckInsData, ThrowOnError>) => (options.client ?? client).post<PostApiGamesByIdCheckInsResponses, PostApiGamesByIdCheckInsErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/games/{id}/check-ins',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Undo the check-in of a participant
+ *
+ * Removes the check-in of a participant, for example when they were checked in by mistake. Only the owner and co-organizers can undo check-ins.
+ */
+export const deleteApiGamesByIdCheckInsByUserId = <ThrowOnError extends boolean = false>(options: Options<DeleteApiGamesByIdCheckInsByUserIdData, ThrowOnError>) => (options.client ?? client).delete<DeleteApiGamesByIdCheckInsByUserIdResponses, DeleteApiGamesByIdCheckInsByUserIdErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/games/{id}/check-ins/{userId}',
+    ...options
+});
+
+/**
+ * Accept the spot offered to the user
+ *
+ * Accepts the spot offered to the authenticated user when it was freed, they move from the waitlist to the list of players.
+ */
+export const postApiGamesByIdWaitlistOfferAccept = <ThrowOnError extends boolean = false>(options: Options<PostApiGamesByIdWaitlistOfferAcceptData, ThrowOnError>) => (options.client ?? client).post<PostApiGamesByIdWaitlistOfferAcceptResponses, PostApiGamesByIdWaitlistOfferAcceptErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/games/{id}/waitlist-offer/accept',
+    ...options
+});
+
+/**
+ * Decline the spot offered to the user
+ *
+ * Declines the spot offered to the authenticated user, they leave the game and the spot is offered to the next waitlisted participant.
+ */
+export const postApiGamesByIdWaitlistOfferDecline = <ThrowOnError extends boolean = false>(options: Options<PostApiGamesByIdWaitlistOfferDeclineData, ThrowOnError>) => (options.client ?? client).post<PostApiGamesByIdWaitlistOfferDeclineResponses, PostApiGamesByIdWaitlistOfferDeclineErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/games/{id}/waitlist-offer/decline',
+    ...options
+});
+
+/**
+ * Reorder the participants
+ *
+ * Sets the order of the participants going to the game, which decides who is in the main list and who is on the waitlist. The organizer always comes first and must not be listed. Only the owner and co-organizers can reorder participants, until the game is frozen.
+ */
+export const putApiGamesByIdParticipantsOrder = <ThrowOnError extends boolean = false>(options: Options<PutApiGamesByIdParticipantsOrderData, ThrowOnError>) => (options.client ?? client).put<PutApiGamesByIdParticipantsOrderResponses, PutApiGamesByIdParticipantsOrderErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/games/{id}/participants/order',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Remove a participant
+ *
+ * Removes a participant from the game, including placeholders. Only the owner and co-organizers can remove participants, until the game is frozen.
+ */
+export const deleteApiGamesByIdParticipantsByUserId = <ThrowOnError extends boolean = false>(options: Options<DeleteApiGamesByIdParticipantsByUserIdData, ThrowOnError>) => (options.client ?? client).delete<DeleteApiGamesByIdParticipantsByUserIdResponses, DeleteApiGamesByIdParticipantsByUserIdErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/games/{id}/participants/{userId}',
+    ...options
+});
+
+/**
+ * Add a placeholder participant
+ *
+ * Adds a participant without an account, known by their name only. The placeholder goes to the end of the queue like any participant going to the game, and can be claimed later by a real user through the returned one-time link. Only the owner and co-organizers can add placeholders, until the game is frozen.
+ */
+export const postApiGamesByIdPlaceholders = <ThrowOnError extends boolean = false>(options: Options<PostApiGamesByIdPlaceholdersData, ThrowOnError>) => (options.client ?? client).post<PostApiGamesByIdPlaceholdersResponses, PostApiGamesByIdPlaceholdersErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/games/{id}/placeholders',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Create a new claim link for a placeholder
+ *
+ * Returns a new one-time link to claim the placeholder, previous links stop working. Only the owner and co-organizers can create claim links.
+ */
+export const postApiGamesByIdPlaceholdersByUserIdClaimLink = <ThrowOnError extends boolean = false>(options: Options<PostApiGamesByIdPlaceholdersByUserIdClaimLinkData, ThrowOnError>) => (options.client ?? client).post<PostApiGamesByIdPlaceholdersByUserIdClaimLinkResponses, PostApiGamesByIdPlaceholdersByUserIdClaimLinkErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/games/{id}/placeholders/{userId}/claim-link',
+    ...options
+});
+
+/**
+ * Claim a placeholder
+ *
+ * Gives the participation of a placeholder to the authenticated user, keeping its place in the queue and its reimbursement reference. The claim token can only be used once.
+ */
+export const postApiPlaceholdersClaim = <ThrowOnError extends boolean = false>(options: Options<PostApiPlaceholdersClaimData, ThrowOnError>) => (options.client ?? client).post<PostApiPlaceholdersClaimResponses, PostApiPlaceholdersClaimErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/placeholders/claim',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * List the invite tokens of a game
+ *
+ * Returns the invite tokens of the game, newest first, with the participants who joined with each of them. Only the owner and co-organizers can list invite tokens.
+ */
+export const getApiGamesByIdInviteTokens = <ThrowOnError extends boolean = false>(options: Options<GetApiGamesByIdInviteTokensData, ThrowOnError>) => (options.client ?? client).get<GetApiGamesByIdInviteTokensResponses, GetApiGamesByIdInviteTokensErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/games/{id}/invite-tokens',
+    ...options
+});
+
+/**
+ * Rotate the invite token of a game
+ *
+ * Creates a new invite token for the game and revokes the previous ones, their share links stop working. Participants who already joined stay in the game. Only the owner and co-organizers can rotate the invite token.
+ */
+export const postApiGamesByIdInviteTokens = <ThrowOnError extends boolean = false>(options: Options<PostApiGamesByIdInviteTokensData, ThrowOnError>) => (options.client ?? client).post<PostApiGamesByIdInviteTokensResponses, PostApiGamesByIdInviteTokensErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/games/{id}/invite-tokens',
+    ...options
+});
+
+/**
+ * Revoke an invite token
+ *
+ * Revokes an invite token, its share link stops working. Participants who already joined stay in the game. Only the owner and co-organizers can revoke invite tokens.
+ */
+export const deleteApiGamesByIdInviteTokensByTokenId = <ThrowOnError extends boolean = false>(options: Options<DeleteApiGamesByIdInviteTokensByTokenIdData, ThrowOnError>) => (options.client ?? client).delete<DeleteApiGamesByIdInviteTokensByTokenIdResponses, DeleteApiGamesByIdInviteTokensByTokenIdErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/games/{id}/invite-tokens/{tokenId}',
+    ...options
+});
+
+/**
+ * List the roles of a game
+ *
+ * Returns the owner of the game and the users the owner delegated a role to. Only users with a role in the game can list the roles.
+ */
+export const getApiGamesByIdRoles = <ThrowOnError extends boolean = false>(options: Options<GetApiGamesByIdRolesData, ThrowOnError>) => (options.client ?? client).get<GetApiGamesByIdRolesResponses, GetApiGamesByIdRolesErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/games/{id}/roles',
+    ...options
+});
+
+/**
+ * Invite a user to a role
+ *
+ * Gives a role in the game to a user, identified by the email they signed in with. Only the owner can invite users.
+ * Co-organizers can manage the game and its participants, treasurers manage its reimbursements.
+ */
+export const postApiGamesByIdRoles = <ThrowOnError extends boolean = false>(options: Options<PostApiGamesByIdRolesData, ThrowOnError>) => (options.client ?? client).post<PostApiGamesByIdRolesResponses, PostApiGamesByIdRolesErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/games/{id}/roles',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Revoke the role of a user
+ *
+ * Revokes the role of a user invited to the game. The owner can revoke any role, other users can only give up their own.
+ */
+export const deleteApiGamesByIdRolesByUserId = <ThrowOnError extends boolean = false>(options: Options<DeleteApiGamesByIdRolesByUserIdData, ThrowOnError>) => (options.client ?? client).delete<DeleteApiGamesByIdRolesByUserIdResponses, DeleteApiGamesByIdRolesByUserIdErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/games/{id}/roles/{userId}',
+    ...options
+});
+
+/**
+ * Change the role of a user
+ *
+ * Changes the role of a user invited to the game. Only the owner can change roles, and the owner's own role can't be changed.
+ */
+export const putApiGamesByIdRolesByUserId = <ThrowOnError extends boolean = false>(options: Options<PutApiGamesByIdRolesByUserIdData, ThrowOnError>) => (options.client ?? client).put<PutApiGamesByIdRolesByUserIdResponses, PutApiGamesByIdRolesByUserIdErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/games/{id}/roles/{userId}',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
 /**
  * Get reimbursement record for a participant
  *
- * Returns the reimbursement record for a specific participant. Accessible only to the participant themselves or the game organizer.
+ * Returns the reimbursement record for a specific participant. Accessible only to the participant themselves or the game's treasurer.
  */
 export const getApiGamesByIdReimbursementsByParticipantId = <ThrowOnError extends boolean = false>(options: Options<GetApiGamesByIdReimbursementsByParticipantIdData, ThrowOnError>) => (options.client ?? client).get<GetApiGamesByIdReimbursementsByParticipantIdResponses, GetApiGamesByIdReimbursementsByParticipantIdErrors, ThrowOnError>({
     security: [{ scheme: 'bearer', type: 'http' }],
@@ -163,7 +437,7 @@ export const getApiGamesByIdReimbursementsByParticipantId = <ThrowOnError extend
 /**
  * List reimbursements for a game
  *
- * Returns the reimbursement tracking entries needed to build the reimbursements page. Accessible only to the game organizer and only after the game is frozen.
+ * Returns the reimbursement tracking entries needed to build the reimbursements page. Accessible only to the game's treasurer and only after the game is frozen. The owner acts as the treasurer until one is appointed.
  */
 export const getApiGamesByIdReimbursements = <ThrowOnError extends boolean = false>(options: Options<GetApiGamesByIdReimbursementsData, ThrowOnError>) => (options.client ?? client).get<GetApiGamesByIdReimbursementsResponses, GetApiGamesByIdReimbursementsErrors, ThrowOnError>({
     security: [{ scheme: 'bearer', type: 'http' }],
@@ -174,7 +448,7 @@ export const getApiGamesByIdReimbursements = <ThrowOnError extends boolean = fal
 /**
  * Update reimbursement status for a participant
  *
- * Update reimbursement tracking for a game participant. Organizers provide participantId and reimbursementReceivedAt. Participants set their own reimbursedAt without providing participantId.
+ * Update reimbursement tracking for a game participant. Treasurers provide participantId and reimbursementReceivedAt, the owner acts as the treasurer until one is appointed. Participants set their own reimbursedAt without providing participantId.
  */
 export const putApiGamesByIdReimbursements = <ThrowOnError extends boolean = false>(options: Options<PutApiGamesByIdReimbursementsData, ThrowOnError>) => (options.client ?? client).put<PutApiGamesByIdReimbursementsResponses, PutApiGamesByIdReimbursementsErrors, ThrowOnError>({
     security: [{ scheme: 'bearer', type: 'http' }],
@@ -185,3 +459,403 @@ export const putApiGamesByIdReimbursements = <ThrowOnError extends boolean = fal
         ...options.headers
     }
 });
+
+/**
+ * Match a bank statement against the open reimbursements
+ *
+ * Reads a bank export, in CSV or ISO 20022 CAMT.053 XML, and proposes to mark the reimbursements it contains as received.
+ * Money received with the game ID and the reimbursement reference of a participant in its description is matched against the reimbursements not received yet.
+ * Nothing is updated, the treasurer reviews the proposed matches and applies them with POST /api/games/{id}/reimbursements/matches.
+ * Money sent from the account is ignored. Accessible only to the game's treasurer and only after the game is frozen.
+ */
+export const postApiGamesByIdReimbursementsStatement = <ThrowOnError extends boolean = false>(options: Options<PostApiGamesByIdReimbursementsStatementData, ThrowOnError>) => (options.client ?? client).post<PostApiGamesByIdReimbursementsStatementResponses, PostApiGamesByIdReimbursementsStatementErrors, ThrowOnError>({
+    bodySerializer: null,
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/games/{id}/reimbursements/statement',
+    ...options,
+    headers: {
+        'Content-Type': 'text/csv',
+        ...options.headers
+    }
+});
+
+/**
+ * Mark matched reimbursements as received
+ *
+ * Marks the reimbursements of the given participants as received in bulk, typically the matches proposed from a bank statement.
+ * Matches are applied like the updates of the bulk endpoint, the participants that can't be updated are reported in the results
+ * and the others are still updated. Accessible only to the game's treasurer and only after the game is frozen.
+ */
+export const postApiGamesByIdReimbursementsMatches = <ThrowOnError extends boolean = false>(options: Options<PostApiGamesByIdReimbursementsMatchesData, ThrowOnError>) => (options.client ?? client).post<PostApiGamesByIdReimbursementsMatchesResponses, PostApiGamesByIdReimbursementsMatchesErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/games/{id}/reimbursements/matches',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Update the reimbursement status of many participants
+ *
+ * Marks the reimbursements of the given participants as received, or clears them, or marks every reimbursement of the game not
+ * received yet as received. Runs in a single transaction, the participants that can't be updated are reported in the results and
+ * the others are still updated. Accessible only to the game's treasurer and only after the game is frozen.
+ */
+export const postApiGamesByIdReimbursementsBulk = <ThrowOnError extends boolean = false>(options: Options<PostApiGamesByIdReimbursementsBulkData, ThrowOnError>) => (options.client ?? client).post<PostApiGamesByIdReimbursementsBulkResponses, PostApiGamesByIdReimbursementsBulkErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/games/{id}/reimbursements/bulk',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * List the user's groups
+ *
+ * Returns the groups the authenticated user is a member of
+ */
+export const getApiGroups = <ThrowOnError extends boolean = false>(options?: Options<GetApiGroupsData, ThrowOnError>) => (options?.client ?? client).get<GetApiGroupsResponses, GetApiGroupsErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/groups',
+    ...options
+});
+
+/**
+ * Create a group
+ *
+ * Creates a group, the authenticated user becomes its first admin
+ */
+export const postApiGroups = <ThrowOnError extends boolean = false>(options: Options<PostApiGroupsData, ThrowOnError>) => (options.client ?? client).post<PostApiGroupsResponses, PostApiGroupsErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/groups',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Join a group
+ *
+ * Joins the group of an invite link. Members who join again keep their role.
+ */
+export const postApiGroupsJoin = <ThrowOnError extends boolean = false>(options: Options<PostApiGroupsJoinData, ThrowOnError>) => (options.client ?? client).post<PostApiGroupsJoinResponses, PostApiGroupsJoinErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/groups/join',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Get a group
+ *
+ * Retrieves a group. Only members can see the group.
+ */
+export const getApiGroupsById = <ThrowOnError extends boolean = false>(options: Options<GetApiGroupsByIdData, ThrowOnError>) => (options.client ?? client).get<GetApiGroupsByIdResponses, GetApiGroupsByIdErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/groups/{id}',
+    ...options
+});
+
+/**
+ * Update a group
+ *
+ * Updates the name and description of a group. Only admins can update the group.
+ */
+export const patchApiGroupsById = <ThrowOnError extends boolean = false>(options: Options<PatchApiGroupsByIdData, ThrowOnError>) => (options.client ?? client).patch<PatchApiGroupsByIdResponses, PatchApiGroupsByIdErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/groups/{id}',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Reset the invite link of a group
+ *
+ * Creates a new invite link for the group, the previous link stops working. Only admins can reset the invite link.
+ */
+export const postApiGroupsByIdInviteLink = <ThrowOnError extends boolean = false>(options: Options<PostApiGroupsByIdInviteLinkData, ThrowOnError>) => (options.client ?? client).post<PostApiGroupsByIdInviteLinkResponses, PostApiGroupsByIdInviteLinkErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/groups/{id}/invite-link',
+    ...options
+});
+
+/**
+ * List the members of a group
+ *
+ * Returns the members of a group. Only members can list the members.
+ */
+export const getApiGroupsByIdMembers = <ThrowOnError extends boolean = false>(options: Options<GetApiGroupsByIdMembersData, ThrowOnError>) => (options.client ?? client).get<GetApiGroupsByIdMembersResponses, GetApiGroupsByIdMembersErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/groups/{id}/members',
+    ...options
+});
+
+/**
+ * Remove a member
+ *
+ * Removes a member from the group. Admins can remove any member, other members can only leave. The last admin cannot leave the group.
+ */
+export const deleteApiGroupsByIdMembersByUserId = <ThrowOnError extends boolean = false>(options: Options<DeleteApiGroupsByIdMembersByUserIdData, ThrowOnError>) => (options.client ?? client).delete<DeleteApiGroupsByIdMembersByUserIdResponses, DeleteApiGroupsByIdMembersByUserIdErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/groups/{id}/members/{userId}',
+    ...options
+});
+
+/**
+ * Change the role or tier of a member
+ *
+ * Makes a member an admin or a member, and a regular or a drop-in. Only admins can change roles and tiers, and groups always keep at least one admin.
+ */
+export const putApiGroupsByIdMembersByUserId = <ThrowOnError extends boolean = false>(options: Options<PutApiGroupsByIdMembersByUserIdData, ThrowOnError>) => (options.client ?? client).put<PutApiGroupsByIdMembersByUserIdResponses, PutApiGroupsByIdMembersByUserIdErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/groups/{id}/members/{userId}',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * List the attendance of the members of a group
+ *
+ * Returns how often each member attended the games of the group that ended, did not show up or cancelled late, to help admins decide who gets priority. Only admins can list the attendance.
+ */
+export const getApiGroupsByIdAttendance = <ThrowOnError extends boolean = false>(options: Options<GetApiGroupsByIdAttendanceData, ThrowOnError>) => (options.client ?? client).get<GetApiGroupsByIdAttendanceResponses, GetApiGroupsByIdAttendanceErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/groups/{id}/attendance',
+    ...options
+});
+
+/**
+ * List the user's series
+ *
+ * Returns all the series organized by the authenticated user
+ */
+export const getApiSeries = <ThrowOnError extends boolean = false>(options?: Options<GetApiSeriesData, ThrowOnError>) => (options?.client ?? client).get<GetApiSeriesResponses, GetApiSeriesErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/series',
+    ...options
+});
+
+/**
+ * Create a new series
+ *
+ * Creates a recurring series of games. Games are generated in the background for upcoming occurrences of the recurrence rule, using the series' game defaults.
+ */
+export const postApiSeries = <ThrowOnError extends boolean = false>(options: Options<PostApiSeriesData, ThrowOnError>) => (options.client ?? client).post<PostApiSeriesResponses, PostApiSeriesErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/series',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Get a series by ID
+ *
+ * Retrieves a single series by its ID. Only the organizer can see their series.
+ */
+export const getApiSeriesById = <ThrowOnError extends boolean = false>(options: Options<GetApiSeriesByIdData, ThrowOnError>) => (options.client ?? client).get<GetApiSeriesByIdResponses, GetApiSeriesByIdErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/series/{id}',
+    ...options
+});
+
+/**
+ * Update a series
+ *
+ * Updates an existing series. Changes to the recurrence rule only affect games that haven't been generated yet. Changes to the game defaults can optionally be applied to the generated games that haven't started and aren't frozen.
+ */
+export const patchApiSeriesById = <ThrowOnError extends boolean = false>(options: Options<PatchApiSeriesByIdData, ThrowOnError>) => (options.client ?? client).patch<PatchApiSeriesByIdResponses, PatchApiSeriesByIdErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/series/{id}',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * List the user's webhooks
+ *
+ * Returns all the webhooks registered by the authenticated user
+ */
+export const getApiWebhooks = <ThrowOnError extends boolean = false>(options?: Options<GetApiWebhooksData, ThrowOnError>) => (options?.client ?? client).get<GetApiWebhooksResponses, GetApiWebhooksErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/webhooks',
+    ...options
+});
+
+/**
+ * Register a webhook
+ *
+ * Registers a URL receiving the selected events of a game, or of every game of a series. Only the owner and co-organizers of the game, or the organizer of the series, can register webhooks.
+ * Events are sent as JSON in POST requests, signed with the secret returned when the webhook is created:
+ * the `X-Opengym-Signature` header is the unpadded base64url HMAC-SHA256 of the body, followed by `:` and the `X-Opengym-Timestamp` header.
+ * Failed deliveries are retried with an exponential backoff.
+ */
+export const postApiWebhooks = <ThrowOnError extends boolean = false>(options: Options<PostApiWebhooksData, ThrowOnError>) => (options.client ?? client).post<PostApiWebhooksResponses, PostApiWebhooksErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/webhooks',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Delete a webhook
+ *
+ * Deletes a webhook along with its delivery log, pending deliveries are not sent.
+ */
+export const deleteApiWebhooksById = <ThrowOnError extends boolean = false>(options: Options<DeleteApiWebhooksByIdData, ThrowOnError>) => (options.client ?? client).delete<DeleteApiWebhooksByIdResponses, DeleteApiWebhooksByIdErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/webhooks/{id}',
+    ...options
+});
+
+/**
+ * List the deliveries of a webhook
+ *
+ * Returns the most recent deliveries of a webhook, newest first.
+ */
+export const getApiWebhooksByIdDeliveries = <ThrowOnError extends boolean = false>(options: Options<GetApiWebhooksByIdDeliveriesData, ThrowOnError>) => (options.client ?? client).get<GetApiWebhooksByIdDeliveriesResponses, GetApiWebhooksByIdDeliveriesErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/webhooks/{id}/deliveries',
+    ...options
+});
+
+/**
+ * Redeliver a webhook delivery
+ *
+ * Sends a delivery again with the same payload, whether it succeeded or not. It's attempted in the background as soon as possible.
+ */
+export const postApiWebhooksByIdDeliveriesByDeliveryIdRedeliver = <ThrowOnError extends boolean = false>(options: Options<PostApiWebhooksByIdDeliveriesByDeliveryIdRedeliverData, ThrowOnError>) => (options.client ?? client).post<PostApiWebhooksByIdDeliveriesByDeliveryIdRedeliverResponses, PostApiWebhooksByIdDeliveriesByDeliveryIdRedeliverErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/webhooks/{id}/deliveries/{deliveryId}/redeliver',
+    ...options
+});
+
+/**
+ * Get the user's notification preferences
+ *
+ * Returns whether each type of notification is enabled for the authenticated user. Notifications are enabled unless the user opted out.
+ */
+export const getApiUsersMeNotificationPreferences = <ThrowOnError extends boolean = false>(options?: Options<GetApiUsersMeNotificationPreferencesData, ThrowOnError>) => (options?.client ?? client).get<GetApiUsersMeNotificationPreferencesResponses, GetApiUsersMeNotificationPreferencesErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/users/me/notification-preferences',
+    ...options
+});
+
+/**
+ * Update the user's notification preferences
+ *
+ * Enables or disables the given types of notifications for the authenticated user, types that aren't listed are left unchanged.
+ */
+export const putApiUsersMeNotificationPreferences = <ThrowOnError extends boolean = false>(options: Options<PutApiUsersMeNotificationPreferencesData, ThrowOnError>) => (options.client ?? client).put<PutApiUsersMeNotificationPreferencesResponses, PutApiUsersMeNotificationPreferencesErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/users/me/notification-preferences',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Get the user's payout details
+ *
+ * Returns where the authenticated user wants to be reimbursed for the games they organize. Every detail is missing until the user sets it.
+ */
+export const getApiUsersMePayoutDetails = <ThrowOnError extends boolean = false>(options?: Options<GetApiUsersMePayoutDetailsData, ThrowOnError>) => (options?.client ?? client).get<GetApiUsersMePayoutDetailsResponses, GetApiUsersMePayoutDetailsErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/users/me/payout-details',
+    ...options
+});
+
+/**
+ * Update the user's payout details
+ *
+ * Replaces the payout details of the authenticated user, details that aren't provided are cleared.
+ */
+export const putApiUsersMePayoutDetails = <ThrowOnError extends boolean = false>(options: Options<PutApiUsersMePayoutDetailsData, ThrowOnError>) => (options.client ?? client).put<PutApiUsersMePayoutDetailsResponses, PutApiUsersMePayoutDetailsErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/users/me/payout-details',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * List the user's balances with organizers
+ *
+ * Returns what the authenticated user owes each organizer across the frozen games they're billed for, and the payments the organizer
+ * recorded but didn't allocate to a game yet.
+ * The money of a game goes to its treasurer, or to its owner until they appoint one.
+ */
+export const getApiUsersMeBalances = <ThrowOnError extends boolean = false>(options?: Options<GetApiUsersMeBalancesData, ThrowOnError>) => (options?.client ?? client).get<GetApiUsersMeBalancesResponses, GetApiUsersMeBalancesErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/users/me/balances',
+    ...options
+});
+
+/**
+ * List the balances of the participants with the user
+ *
+ * Returns what each participant owes the authenticated user across the frozen games whose money they collect, as the treasurer or as the owner
+ * until they appoint one, and the credit left from their payments.
+ */
+export const getApiUsersMeOrganizerBalances = <ThrowOnError extends boolean = false>(options?: Options<GetApiUsersMeOrganizerBalancesData, ThrowOnError>) => (options?.client ?? client).get<GetApiUsersMeOrganizerBalancesResponses, GetApiUsersMeOrganizerBalancesErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/users/me/organizer-balances',
+    ...options
+});
+
+/**
+ * Get the ledger between a participant and an organizer
+ *
+ * Returns the shares the participant owes for the organizer's frozen games (debits), and the payments and per-game reimbursements
+ * the organizer received (credits), oldest first with the running balance. Accessible only to the participant and the organizer.
+ */
+export const getApiLedger = <ThrowOnError extends boolean = false>(options: Options<GetApiLedgerData, ThrowOnError>) => (options.client ?? client).get<GetApiLedgerResponses, GetApiLedgerErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/ledger',
+    ...options
+});
+
+/**
+ * Record a payment from a participant
+ *
+ * Records money the authenticated organizer received from a participant, for any number of their games. The payment and the credit
+ * left from earlier payments settle the participant's oldest outstanding shares first, the shares fully paid are marked as received
+ * on their games. What is left stays as credit for the next games. Payments are only recorded from participants who owe the organizer
+ * a share.
+ */
+export const postApiLedgerPayments = <ThrowOnError extends boolean = false>(options: Options<PostApiLedgerPaymentsData, ThrowOnError>) => (options.client ?? client).post<PostApiLedgerPaymentsResponses, PostApiLedgerPaymentsErrors, ThrowOnError>({
+    security: [{ scheme: 'bearer', type: 'http' }],
+    url: '/api/ledger/payments',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
@@ -49,6 +49,10 @@ export type User = {
      * Whether the user is a demo user
      */
     isDemo: boolean;
+    /**
+     * Whether the user is a placeholder added by an organizer for a player without an account
+     */
+    isPlaceholder: boolean;
 };
 
 export type GameFields = {
@@ -88,12 +92,113 @@ export type GameFields = {
      * Number of spots left in the game, excluding the waitlist
      */
     gameSpotsLeft?: number;
+    visibility?: GameVisibility;
+    /**
+     * When set, a spot freed in the game is offered to the next waitlisted participant, who must accept it within this many minutes or the offer moves on to the next one. 0 promotes waitlisted participants directly.
+     */
+    waitlistOfferMinutes?: number;
+    allocationMode?: GameAllocationMode;
+    /**
+     * When the registration of a lottery game closes and the draw happens. Required for lottery games.
+     */
+    registrationClosesAt?: string;
+    /**
+     * How many minutes after the game is published only the regulars of its group can join. 0 disables the head start. Only available for group games.
+     */
+    regularsHeadStartMinutes?: number;
+    /**
+     * Whether the regulars of the group sort ahead of drop-ins when the game is oversubscribed, the organizer still comes first. Only available for group games.
+     */
+    regularsFirst?: boolean;
+    waitlistMode?: GameWaitlistMode;
+    /**
+     * Number of spots on the waitlist of games with a fixed waitlist, guests included
+     */
+    maxWaitlistSize?: number;
+    /**
+     * How many minutes participants have to confirm they're still coming after the start time, location, price or duration of the published game changed, before their spot is released. 0 never releases their spot.
+     */
+    reconfirmWithinMinutes?: number;
+    /**
+     * How many minutes before the game starts participants can still drop out without it being a late cancellation. 0 disables the deadline.
+     */
+    cancellationDeadlineMinutes?: number;
+    /**
+     * Whether participants who cancelled late still owe their share of the price when nobody took their spot
+     */
+    billLateCancellations?: boolean;
+    splitStrategy?: GameSplitStrategy;
+    /**
+     * Price of a spot in cents, for the per_player and per_player_guest_rate split strategies
+     */
+    pricePerPlayerCents?: number;
+    /**
+     * Price of a guest's spot in cents, for the per_player_guest_rate split strategy
+     */
+    guestPriceCents?: number;
+};
+
+/**
+ * - public: anyone with the link to the game can see and join it once it's published
+ * - private: users need an invite token, carried in the share link, to see and join the game
+ */
+export type GameVisibility = 'public' | 'private';
+
+/**
+ * - first_come_first_served: participants take the spots in the order they joined
+ * - lottery: participants register until registrationClosesAt, then a random draw decides who is going and the order of the waitlist. The organizer keeps their priority. Participants joining after registrationClosesAt are queued after the drawn participants.
+ */
+export type GameAllocationMode = 'first_come_first_served' | 'lottery';
+
+/**
+ * - disabled: participants can only join while there are spots left in the game
+ * - fixed: up to maxWaitlistSize participants, guests included, can be put on the waitlist
+ * - unlimited: participants can always join the waitlist
+ */
+export type GameWaitlistMode = 'disabled' | 'fixed' | 'unlimited';
+
+/**
+ * - even: totalPriceCents is split evenly between every spot, the cents left over are paid by the first participants to join
+ * - per_player: every spot, guests included, costs pricePerPlayerCents
+ * - per_player_guest_rate: every participant pays pricePerPlayerCents, and guestPriceCents for each of their guests
+ * - organizer_exempt: like even, but the organizer doesn't pay for their own spot
+ */
+export type GameSplitStrategy = 'even' | 'per_player' | 'per_player_guest_rate' | 'organizer_exempt';
+
+export type GameInviteToken = {
+    /**
+     * Unique invite token identifier
+     */
+    id: number;
+    /**
+     * Share link carrying the invite token
+     */
+    url: string;
+    /**
+     * Timestamp when the invite token was created
+     */
+    createdAt: string;
+    /**
+     * Timestamp when the invite token was revoked, it no longer lets users see or join the game
+     */
+    revokedAt?: string;
+    /**
+     * Participants who joined the game with this invite token
+     */
+    participants: Array<User>;
 };
 
 export type CreateGameRequest = GameFields & {
-    [key: string]: unknown;
+    /**
+     * ID of a group the user belongs to, the game is then only visible to the members of the group
+     */
+    groupId?: string;
 };
 
+/**
+ * Only the fields set are updated. They're validated like when the game is created, so a durationMinutes of 0 or a maxPlayers
+ * below 1 is refused instead of keeping the current value. Once the game is frozen, only description and frozenAt can change.
+ */
 export type UpdateGameRequest = GameFields & {
     /**
      * When the game should become publicly visible. Past timestamps publish immediately. While in the future, it can be rescheduled or cleared.
@@ -122,6 +227,38 @@ export type Game = GameFields & {
      * When the game is frozen
      */
     frozenAt?: string;
+    /**
+     * ID of the series this game was generated from, if any
+     */
+    seriesId?: string;
+    /**
+     * ID of the group the game belongs to, if any. Group games are only visible to the members of the group
+     */
+    groupId?: string;
+    /**
+     * Seed of the draw of a lottery game, set once it's drawn so participants can reproduce it
+     */
+    lotterySeed?: number;
+    /**
+     * When the draw of a lottery game happened
+     */
+    lotteryDrawnAt?: string;
+    /**
+     * Number of spots left on the waitlist, guests included. Not set when the waitlist is unlimited
+     */
+    waitlistSpotsLeft?: number;
+    /**
+     * After when dropping out of the game is a late cancellation, only set when the game has a start time and a cancellation deadline
+     */
+    cancellationDeadline?: string;
+    /**
+     * When the game was cancelled, cancelled games can't be joined or left
+     */
+    cancelledAt?: string;
+    /**
+     * Why the game was cancelled
+     */
+    cancellationReason?: string;
     /**
      * Timestamp when game was created
      */
@@ -132,11 +269,199 @@ export type Game = GameFields & {
     updatedAt: string;
 };
 
+export type Weekday = 'monday' | 'tuesday' | 'wednesday' | 'thursday' | 'friday' | 'saturday' | 'sunday';
+
+/**
+ * Weekly recurrence rule, the time of day of startsAt is used for every occurrence
+ */
+export type RecurrenceRule = {
+    /**
+     * First possible occurrence of the series
+     */
+    startsAt: string;
+    /**
+     * IANA time zone used to compute occurrences, so games keep their local time across daylight saving changes
+     */
+    timezone?: string;
+    /**
+     * Number of weeks between occurrences (1 = every week)
+     */
+    intervalWeeks?: number;
+    /**
+     * Days of the week games take place on
+     */
+    weekdays: Array<Weekday>;
+    /**
+     * No games are generated after this time
+     */
+    endsAt?: string | null;
+    /**
+     * No games are generated after this many occurrences
+     */
+    occurrenceCount?: number | null;
+};
+
+export type SeriesFields = {
+    gameDefaults?: GameFields;
+    recurrence?: RecurrenceRule;
+    /**
+     * If set, generated games are scheduled to be published this many minutes before they start, otherwise they're created as drafts
+     */
+    publishMinutesBefore?: number | null;
+};
+
+export type CreateSeriesRequest = SeriesFields & {
+    [key: string]: unknown;
+};
+
+export type UpdateSeriesRequest = SeriesFields & {
+    /**
+     * Whether the game defaults should also be applied to the generated games that haven't started and aren't frozen
+     */
+    applyToFutureGames?: boolean;
+};
+
+/**
+ * Role of a user in a group. Admins manage the group and its members.
+ */
+export type GroupMemberRole = 'admin' | 'member';
+
+export type Group = {
+    /**
+     * Unique group identifier
+     */
+    id: string;
+    /**
+     * Name of the group
+     */
+    name: string;
+    /**
+     * Description of the group
+     */
+    description?: string;
+    role: GroupMemberRole;
+    /**
+     * Link letting anyone join the group, only returned to admins
+     */
+    inviteUrl?: string;
+    /**
+     * Default head start of the regulars in the games created in the group, see the game field of the same name
+     */
+    regularsHeadStartMinutes?: number;
+    /**
+     * Default priority of the regulars in the games created in the group, see the game field of the same name
+     */
+    regularsFirst?: boolean;
+    /**
+     * Timestamp when the group was created
+     */
+    createdAt: string;
+    /**
+     * Timestamp when the group was last updated
+     */
+    updatedAt: string;
+};
+
+/**
+ * Priority tier of a member in the games of the group. Regulars can get priority over drop-ins.
+ */
+export type GroupMemberTier = 'regular' | 'drop_in';
+
+export type GroupMember = {
+    user: User;
+    role: GroupMemberRole;
+    tier: GroupMemberTier;
+    /**
+     * Timestamp when the user joined the group
+     */
+    joinedAt: string;
+};
+
+export type CreateGroupRequest = {
+    /**
+     * Name of the group
+     */
+    name: string;
+    /**
+     * Description of the group
+     */
+    description?: string;
+    /**
+     * Default head start of the regulars in the games created in the group, see the game field of the same name
+     */
+    regularsHeadStartMinutes?: number;
+    /**
+     * Default priority of the regulars in the games created in the group, see the game field of the same name
+     */
+    regularsFirst?: boolean;
+};
+
+export type UpdateGroupRequest = {
+    /**
+     * Name of the group
+     */
+    name?: string;
+    /**
+     * Description of the group
+     */
+    description?: string;
+    /**
+     * Default head start of the regulars in the games created in the group, see the game field of the same name
+     */
+    regularsHeadStartMinutes?: number;
+    /**
+     * Default priority of the regulars in the games created in the group, see the game field of the same name
+     */
+    regularsFirst?: boolean;
+};
+
+export type JoinGroupRequest = {
+    /**
+     * The code of the invite link
+     */
+    inviteCode: string;
+};
+
+export type UpdateGroupMemberRequest = {
+    role?: GroupMemberRole;
+    tier?: GroupMemberTier;
+};
+
+export type Series = SeriesFields & {
+    /**
+     * Unique series identifier
+     */
+    id: string;
+    /**
+     * ID of the user who organizes the series
+     */
+    organizerId: number;
+    /**
+     * Timestamp when the series was created
+     */
+    createdAt: string;
+    /**
+     * Timestamp when the series was last updated
+     */
+    updatedAt: string;
+};
+
 export type GameDetail = {
     game: Game;
     organizer: User;
 };
 
+export type GameSpots = {
+    /**
+     * Number of spots left in the game, excluding the waitlist
+     */
+    gameSpotsLeft: number;
+    /**
+     * Number of spots left on the waitlist, guests included. Not set when the waitlist is unlimited
+     */
+    waitlistSpotsLeft?: number;
+};
+
 export type PublicGameDetail = {
     /**
      * Unique game identifier
@@ -160,10 +485,22 @@ export type PublicGameDetail = {
      * Number of spots left in the game
      */
     gameSpotsLeft: number;
+    /**
+     * Number of spots left on the waitlist. Not set when the waitlist is unlimited
+     */
+    waitlistSpotsLeft?: number;
     /**
      * When the game starts
      */
     startsAt: string;
+    /**
+     * When the game was cancelled, cancelled games can't be joined or left
+     */
+    cancelledAt?: string;
+    /**
+     * Why the game was cancelled
+     */
+    cancellationReason?: string;
 } | {
     /**
      * Unique game identifier
@@ -190,15 +527,56 @@ export type PublicGameDetail = {
 };
 
 /**
- * Participation status of the authenticated user
+ * Participation status of the authenticated user.
+ * - registered: going to a lottery game that wasn't drawn yet, the draw decides whether they get a spot
  */
-export type ParticipationStatus = ParticipationStatusUpdate | 'waitlisted';
+export type ParticipationStatus = ParticipationStatusUpdate | 'waitlisted' | 'offered' | 'registered';
 
 /**
  * Allowed participation statuses that a user can set directly
  */
 export type ParticipationStatusUpdate = 'going' | 'not_going';
 
+export type ReorderParticipantsRequest = {
+    /**
+     * The IDs of every participant going to the game except the organizer, in the new order
+     */
+    userIds: Array<string>;
+};
+
+export type CreatePlaceholderRequest = {
+    /**
+     * Display name of the placeholder
+     */
+    name: string;
+    /**
+     * Number of guests the placeholder is bringing
+     */
+    guests?: number;
+};
+
+export type PlaceholderParticipant = {
+    user: User;
+    /**
+     * One-time link letting a real user claim the placeholder
+     */
+    claimUrl: string;
+};
+
+export type ClaimPlaceholderRequest = {
+    /**
+     * The token of the claim link
+     */
+    token: string;
+};
+
+export type ClaimedPlaceholder = {
+    /**
+     * The game the user now participates in
+     */
+    gameId: string;
+};
+
 export type GameParticipation = {
     status: ParticipationStatus;
     /**
@@ -221,6 +599,26 @@ export type GameParticipation = {
 
 export type ParticipantWithUser = {
     status: ParticipationStatus;
+    /**
+     * When the spot offered to the participant expires, only set when the status is offered
+     */
+    offerExpiresAt?: string;
+    /**
+     * Whether the participant is a regular of the group of the game
+     */
+    regular?: boolean;
+    /**
+     * Position of the participant in the draw of a lottery game, participants who joined after the draw or changed their participation since have none
+     */
+    drawPosition?: number;
+    /**
+     * When the participant was asked to confirm they're still coming because important details of the game changed, only set until they confirm or leave the game
+     */
+    reconfirmationRequestedAt?: string;
+    /**
+     * When the participant dropped out of the list of players after the cancellation deadline, only set until they join again
+     */
+    lateCancelledAt?: string;
     /**
      * Number of guests the participant is bringing
      */
@@ -236,49 +634,144 @@ export type ParticipantWithUser = {
     updatedAt?: string;
 };
 
-export type GameReimbursementEntry = {
-    /**
-     * 4-character case-sensitive alphanumeric reference used to identify participant reimbursements
-     */
-    reimbursementReference: string;
+export type PendingReconfirmation = {
+    user: User;
     /**
-     * Amount owed by this participant in cents, including guests and excluding waitlisted participants
+     * When the participant was asked to confirm they're still coming
      */
-    amountOwedCents: number;
+    requestedAt: string;
     /**
-     * Number of guests this participant is bringing
+     * When the spot of the participant is released if they don't reconfirm, not set when the game doesn't release spots
      */
-    guests: number;
+    releaseAt?: string;
+};
+
+/**
+ * Attendance of a participant at a started game:
+ * - attended: they checked in
+ * - expected: they are going and didn't check in yet, while the game is running
+ * - no_show: they were going and didn't check in before the game ended
+ * - late_cancel: they dropped out after the cancellation deadline and didn't check in
+ */
+export type AttendanceStatus = 'attended' | 'expected' | 'no_show' | 'late_cancel';
+
+export type AttendanceRecord = {
     participant: User;
+    status: AttendanceStatus;
     /**
-     * When the participant claims to have sent the reimbursement
+     * When the participant checked in
      */
-    reimbursedAt?: string | null;
+    checkedInAt?: string;
     /**
-     * When the organizer claims to have received the reimbursement
+     * When the participant dropped out after the cancellation deadline
      */
-    reimbursementReceivedAt?: string | null;
+    lateCancelledAt?: string;
 };
 
-export type UpdateGameParticipationRequest = {
-    status: ParticipationStatusUpdate;
+export type GameAttendance = {
     /**
-     * If the participant has confirmed (can only be set to false by the server when important details are changed on the game)
+     * Code participants check themselves in with, not set until the organizer creates it
      */
-    confirmed?: true;
+    checkInCode?: string;
+    records: Array<AttendanceRecord>;
+};
+
+export type CheckInCode = {
     /**
-     * Number of guests the participant is bringing
+     * Code participants check themselves in with
      */
-    guests?: number;
+    checkInCode: string;
 };
 
-export type GameListItem = {
+export type CheckInRequest = {
     /**
-     * Unique game identifier
+     * User ID of the participant checked in by an organizer
      */
-    id: string;
+    participantId?: string;
     /**
-     * Name of the game
+     * Check-in code of the game, for participants checking themselves in
+     */
+    code?: string;
+};
+
+export type MemberAttendance = {
+    user: User;
+    /**
+     * Number of games of the group the member attended
+     */
+    attended: number;
+    /**
+     * Number of games of the group the member was going to and didn't show up
+     */
+    noShows: number;
+    /**
+     * Number of games of the group the member dropped out of after the cancellation deadline
+     */
+    lateCancellations: number;
+    /**
+     * Share of the games the member was expected at that they attended, from 0 to 1, not set when they weren't expected at any game
+     */
+    attendanceRate?: number;
+};
+
+export type CancelGameRequest = {
+    /**
+     * Why the game is cancelled, shown to the participants
+     */
+    reason: string;
+};
+
+export type GameReimbursementEntry = {
+    /**
+     * 4-character case-sensitive alphanumeric reference used to identify participant reimbursements
+     */
+    reimbursementReference: string;
+    /**
+     * Amount owed by this participant in cents, including guests and excluding waitlisted participants
+     */
+    amountOwedCents: number;
+    /**
+     * Number of guests this participant is bringing
+     */
+    guests: number;
+    participant: User;
+    /**
+     * When the participant claims to have sent the reimbursement
+     */
+    reimbursedAt?: string | null;
+    /**
+     * When the organizer claims to have received the reimbursement
+     */
+    reimbursementReceivedAt?: string | null;
+    /**
+     * When the participant cancelled late, only set for participants billed for a spot nobody took after they dropped out
+     */
+    lateCancelledAt?: string;
+};
+
+export type UpdateGameParticipationRequest = {
+    status: ParticipationStatusUpdate;
+    /**
+     * If the participant has confirmed, also used to reconfirm after important details changed on the game (can only be set to false by the server when important details are changed on the game)
+     */
+    confirmed?: true;
+    /**
+     * Number of guests the participant is bringing
+     */
+    guests?: number;
+    /**
+     * Invite token from the share link, required to join private games
+     */
+    inviteToken?: string;
+};
+
+export type GameListItem = {
+    /**
+     * Unique game identifier
+     */
+    id: string;
+    /**
+     * Name of the game
      */
     name: string;
     /**
@@ -302,6 +795,22 @@ export type GameListItem = {
      * Timestamp when game was last updated
      */
     updatedAt: string;
+    /**
+     * ID of the group the game belongs to, if any
+     */
+    groupId?: string;
+    /**
+     * When the authenticated user cancelled late, if they dropped out of the game after its cancellation deadline
+     */
+    lateCancelledAt?: string;
+    /**
+     * When the game was cancelled, cancelled games can't be joined or left
+     */
+    cancelledAt?: string;
+    /**
+     * Why the game was cancelled
+     */
+    cancellationReason?: string;
 };
 
 export type Pagination = {
@@ -376,129 +885,557 @@ export type ReimbursementRecord = {
      * Timestamp when the reimbursement record was last updated
      */
     updatedAt?: string;
+    paymentInstructions?: PaymentInstructions;
 };
 
-export type GetApiAuthByProviderLoginData = {
-    body?: never;
-    path: {
-        /**
-         * The OAuth provider to use for authentication
-         */
-        provider: 'google';
-    };
-    query?: never;
-    url: '/api/auth/{provider}/login';
+export type PayoutDetails = {
+    /**
+     * IBAN of the account to reimburse, spaces are removed
+     */
+    iban?: string;
+    /**
+     * BIC of the bank of the account, optional for SEPA transfers within the EEA
+     */
+    bic?: string;
+    /**
+     * Name of the account holder, required with the IBAN
+     */
+    beneficiaryName?: string;
+    /**
+     * Phone number to send MB WAY payments to, in international format
+     */
+    mbwayPhone?: string;
+    /**
+     * PayPal.me handle, without the paypal.me/ prefix
+     */
+    paypalMeHandle?: string;
 };
 
-export type GetApiAuthByProviderLoginErrors = {
+/**
+ * How the participant can pay what they owe for the game. The money goes to the treasurer of the game, or its owner until they appoint one.
+ * Only provided once the game is frozen, to the participants billed for it, when the treasurer or owner set their payout details.
+ */
+export type PaymentInstructions = {
     /**
-     * Invalid provider specified
+     * Amount owed by the participant in cents
      */
-    400: Error;
+    amountOwedCents: number;
+    /**
+     * What to write in the description of the transfer, the game ID and the reimbursement reference, so the organizer can match it
+     */
+    remittanceInformation: string;
+    /**
+     * Name of the account holder to transfer to
+     */
+    beneficiaryName?: string;
+    /**
+     * IBAN to transfer to
+     */
+    iban?: string;
+    /**
+     * BIC of the bank to transfer to
+     */
+    bic?: string;
+    /**
+     * EPC069-12 "SEPA credit transfer" QR code payload, prefilled with the amount owed in euros and the remittance information.
+     * Encode it in a QR code for banking apps to scan. Only provided with an IBAN, when something is owed.
+     */
+    epcQrPayload?: string;
+    /**
+     * Phone number to send an MB WAY payment to
+     */
+    mbwayPhone?: string;
+    /**
+     * PayPal.me link prefilled with the amount owed
+     */
+    paypalMeUrl?: string;
 };
 
-export type GetApiAuthByProviderLoginError = GetApiAuthByProviderLoginErrors[keyof GetApiAuthByProviderLoginErrors];
-
-export type GetApiAuthByProviderCallbackData = {
-    body?: never;
-    path: {
-        /**
-         * The OAuth provider
-         */
-        provider: 'google';
-    };
-    query?: {
-        /**
-         * Authorization code returned by the provider on success
-         */
-        code?: string;
-        /**
-         * State parameter for CSRF protection
-         */
-        state?: string;
-        /**
-         * Error code if authorization failed
-         */
-        error?: string;
-        /**
-         * Human-readable error description
-         */
-        error_description?: string;
-    };
-    url: '/api/auth/{provider}/callback';
+export type LedgerBalance = {
+    user: User;
+    /**
+     * What the participant still owes for the games that aren't settled, in cents
+     */
+    outstandingCents: number;
+    /**
+     * What the participant paid that isn't allocated to a game yet, in cents
+     */
+    creditCents: number;
+    /**
+     * The credit minus what is outstanding, negative when the participant owes money to the organizer
+     */
+    balanceCents: number;
 };
 
-export type GetApiAuthByProviderCallbackErrors = {
+export type LedgerStatement = {
+    payer: User;
+    payee: User;
     /**
-     * Invalid request or error from provider
+     * What the participant still owes for the games that aren't settled, in cents
      */
-    400: Error;
+    outstandingCents: number;
     /**
-     * Authentication failed
+     * What the participant paid that isn't allocated to a game yet, in cents
      */
-    401: Error;
+    creditCents: number;
+    /**
+     * The credit minus what is outstanding, negative when the participant owes money to the organizer
+     */
+    balanceCents: number;
+    /**
+     * Debits and credits, oldest first
+     */
+    entries: Array<LedgerEntry>;
 };
 
-export type GetApiAuthByProviderCallbackError = GetApiAuthByProviderCallbackErrors[keyof GetApiAuthByProviderCallbackErrors];
+/**
+ * - share: what the participant owes for a frozen game, a debit
+ * - payment: a payment recorded in the ledger, a credit
+ * - reimbursement: the share of a game marked as received on the game without a payment in the ledger, a credit
+ */
+export type LedgerEntryType = 'share' | 'payment' | 'reimbursement';
 
-export type GetApiAuthByProviderCallbackResponses = {
+export type LedgerEntry = {
+    type: LedgerEntryType;
     /**
-     * Successfully authenticated user
+     * When the game starts for shares, when the money was received for credits
      */
-    200: AuthResponse;
+    at: string;
+    /**
+     * Negative for debits, positive for credits
+     */
+    amountCents: number;
+    /**
+     * Running balance after the entry
+     */
+    balanceCents: number;
+    /**
+     * The game of shares and reimbursements
+     */
+    gameId?: string;
+    /**
+     * Name of the game of shares and reimbursements
+     */
+    gameName?: string;
+    /**
+     * What is still owed for the share
+     */
+    outstandingCents?: number;
+    /**
+     * ID of the payment
+     */
+    paymentId?: number;
+    /**
+     * Note the organizer left on the payment
+     */
+    note?: string;
+    /**
+     * What is left of the payment as credit
+     */
+    unallocatedCents?: number;
 };
 
-export type GetApiAuthByProviderCallbackResponse = GetApiAuthByProviderCallbackResponses[keyof GetApiAuthByProviderCallbackResponses];
+export type RecordLedgerPaymentRequest = {
+    /**
+     * ID of the participant who paid
+     */
+    payerId: string;
+    /**
+     * Amount received in cents
+     */
+    amountCents: number;
+    /**
+     * When the money was received, defaults to now
+     */
+    receivedAt?: string;
+    /**
+     * Note about the payment, e.g. how it was paid
+     */
+    note?: string;
+};
 
-export type GetApiAuthMeData = {
-    body?: never;
-    path?: never;
-    query?: never;
-    url: '/api/auth/me';
+/**
+ * Either the updates of the given participants, or markAllOutstanding
+ */
+export type BulkReimbursementRequest = {
+    updates?: Array<BulkReimbursementUpdate>;
+    /**
+     * Marks the reimbursements of every participant billed for the game not received yet as received
+     */
+    markAllOutstanding?: boolean;
+    /**
+     * When the outstanding reimbursements were received with markAllOutstanding, defaults to now
+     */
+    receivedAt?: string;
 };
 
-export type GetApiAuthMeErrors = {
+export type BulkReimbursementUpdate = {
     /**
-     * Unauthorized - invalid or missing token
+     * ID of the participant to update reimbursement for
      */
-    401: Error;
+    participantId: string;
+    /**
+     * When the organizer received and confirmed the reimbursement. Set to null to clear.
+     */
+    reimbursementReceivedAt: string | null;
 };
 
-export type GetApiAuthMeError = GetApiAuthMeErrors[keyof GetApiAuthMeErrors];
+/**
+ * - updated: the reimbursement was updated
+ * - invalid_participant_id: the participant ID isn't a valid user ID
+ * - participant_not_found: the user isn't a participant of the game
+ */
+export type BulkReimbursementStatus = 'updated' | 'invalid_participant_id' | 'participant_not_found';
 
-export type GetApiAuthMeResponses = {
+export type BulkReimbursementResult = {
+    participantId: string;
+    status: BulkReimbursementStatus;
     /**
-     * Authenticated user
+     * Why the reimbursement couldn't be updated
      */
-    200: User;
+    error?: string;
+    record?: ReimbursementRecord;
 };
 
-export type GetApiAuthMeResponse = GetApiAuthMeResponses[keyof GetApiAuthMeResponses];
-
-export type PostApiAuthLogoutData = {
-    body?: never;
-    path?: never;
-    query?: never;
-    url: '/api/auth/logout';
+export type BulkReimbursementResponse = {
+    /**
+     * Number of reimbursements updated
+     */
+    updated: number;
+    /**
+     * Number of reimbursements that couldn't be updated
+     */
+    failed: number;
+    /**
+     * One result per update in the order of the request, or per outstanding reimbursement in the order participants queued
+     */
+    results: Array<BulkReimbursementResult>;
 };
 
-export type PostApiAuthLogoutErrors = {
+export type StatementMatchReport = {
     /**
-     * Unauthorized - invalid or missing token
+     * Lines of the statement matched with a reimbursement not received yet
      */
-    401: Error;
+    matches: Array<StatementMatch>;
+    /**
+     * Money received that couldn't be matched with a reimbursement
+     */
+    unmatched: Array<UnmatchedStatementLine>;
 };
 
-export type PostApiAuthLogoutError = PostApiAuthLogoutErrors[keyof PostApiAuthLogoutErrors];
+export type StatementLine = {
+    /**
+     * Line of the transaction in a CSV file, or number of its entry in a CAMT.053 statement
+     */
+    line: number;
+    /**
+     * When the bank booked the transaction, missing when the statement doesn't say
+     */
+    bookedAt?: string;
+    /**
+     * Amount received in cents
+     */
+    amountCents: number;
+    /**
+     * Description of the transfer
+     */
+    description: string;
+};
 
-export type PostApiAuthLogoutResponses = {
+export type StatementMatch = StatementLine & {
+    participant: User;
     /**
-     * Successfully logged out
+     * Reimbursement reference found in the description
      */
-    204: void;
+    reimbursementReference: string;
+    /**
+     * Amount owed by the participant in cents
+     */
+    amountOwedCents: number;
+    /**
+     * Whether the amount received differs from the amount owed
+     */
+    amountMismatch: boolean;
 };
 
-export type PostApiAuthLogoutResponse = PostApiAuthLogoutResponses[keyof PostApiAuthLogoutResponses];
+export type UnmatchedStatementLine = StatementLine & {
+    reason: UnmatchedStatementLineReason;
+};
+
+/**
+ * - game_not_mentioned: the description doesn't contain the game ID
+ * - reference_not_found: the description doesn't contain the reference of a participant billed for the game
+ * - already_received: the reimbursement of the participant was already marked as received
+ * - duplicate: an earlier line of the statement was matched with the same reimbursement
+ */
+export type UnmatchedStatementLineReason = 'game_not_mentioned' | 'reference_not_found' | 'already_received' | 'duplicate';
+
+export type ApplyReimbursementMatchesRequest = {
+    matches: Array<ReimbursementMatch>;
+};
+
+export type ReimbursementMatch = {
+    /**
+     * ID of the participant whose reimbursement was received
+     */
+    participantId: string;
+    /**
+     * When the reimbursement was received, typically when the bank booked it. Defaults to now.
+     */
+    receivedAt?: string;
+};
+
+export type WebhookEventType = 'participant_joined' | 'participant_left' | 'participant_promoted' | 'game_updated' | 'game_rescheduled' | 'game_published' | 'game_frozen' | 'game_started' | 'game_ended' | 'reimbursement_marked' | 'participants_reordered' | 'placeholder_claimed' | 'waitlist_offered' | 'waitlist_offer_accepted' | 'waitlist_offer_declined' | 'lottery_drawn' | 'game_cancelled' | 'game_deleted';
+
+/**
+ * Exactly one of gameId and seriesId must be set
+ */
+export type CreateWebhookRequest = {
+    /**
+     * HTTP or HTTPS URL receiving the events
+     */
+    url: string;
+    /**
+     * The game whose events are sent
+     */
+    gameId?: string;
+    /**
+     * The series whose games' events are sent
+     */
+    seriesId?: string;
+    eventTypes: Array<WebhookEventType>;
+};
+
+/**
+ * Role of a user in a game. The owner created the game and can do everything, except managing reimbursements once a treasurer is appointed.
+ * Co-organizers manage the game and its participants, treasurers manage its reimbursements.
+ */
+export type GameRoleName = 'owner' | 'co_organizer' | 'treasurer';
+
+export type GameRole = {
+    user: User;
+    role: GameRoleName;
+    /**
+     * Timestamp when the role was given
+     */
+    createdAt?: string;
+};
+
+export type InviteGameRoleRequest = {
+    /**
+     * Email of the user, they must have signed in at least once
+     */
+    email: string;
+    role: GameRoleName;
+};
+
+export type UpdateGameRoleRequest = {
+    role: GameRoleName;
+};
+
+export type Webhook = {
+    /**
+     * Unique webhook identifier
+     */
+    id: number;
+    /**
+     * URL receiving the events
+     */
+    url: string;
+    /**
+     * The game whose events are sent
+     */
+    gameId?: string;
+    /**
+     * The series whose games' events are sent
+     */
+    seriesId?: string;
+    eventTypes: Array<WebhookEventType>;
+    /**
+     * Timestamp when the webhook was registered
+     */
+    createdAt: string;
+};
+
+export type CreatedWebhook = Webhook & {
+    /**
+     * Secret signing the deliveries, it's only returned when the webhook is created
+     */
+    secret: string;
+};
+
+export type WebhookDelivery = {
+    /**
+     * Unique delivery identifier, sent in the X-Opengym-Delivery header
+     */
+    id: number;
+    webhookId: number;
+    /**
+     * ID of the event, the same event may be delivered more than once
+     */
+    eventId: number;
+    eventType: WebhookEventType;
+    createdAt: string;
+    /**
+     * Number of failed attempts
+     */
+    attempts: number;
+    /**
+     * When the delivery is retried after a failed attempt
+     */
+    nextAttemptAt?: string;
+    /**
+     * Response status of the last attempt, missing if no response was received
+     */
+    lastStatusCode?: number;
+    /**
+     * Error of the last failed attempt
+     */
+    lastError?: string;
+    deliveredAt?: string;
+    /**
+     * When delivery attempts stopped after too many failures
+     */
+    failedAt?: string;
+};
+
+/**
+ * - waitlist_promoted: the user moved from the waitlist to the list of players
+ * - game_published: a game the user joined was published
+ * - game_updated: important details of a game the user joined changed
+ * - reimbursement_received: the organizer confirmed receiving the user's reimbursement
+ */
+export type NotificationType = 'waitlist_promoted' | 'game_published' | 'game_updated' | 'reimbursement_received';
+
+export type NotificationPreference = {
+    type: NotificationType;
+    /**
+     * Whether the user receives this type of notification
+     */
+    enabled: boolean;
+};
+
+export type GetApiAuthByProviderLoginData = {
+    body?: never;
+    path: {
+        /**
+         * The OAuth provider to use for authentication
+         */
+        provider: 'google';
+    };
+    query?: never;
+    url: '/api/auth/{provider}/login';
+};
+
+export type GetApiAuthByProviderLoginErrors = {
+    /**
+     * Invalid provider specified
+     */
+    400: Error;
+};
+
+export type GetApiAuthByProviderLoginError = GetApiAuthByProviderLoginErrors[keyof GetApiAuthByProviderLoginErrors];
+
+export type GetApiAuthByProviderCallbackData = {
+    body?: never;
+    path: {
+        /**
+         * The OAuth provider
+         */
+        provider: 'google';
+    };
+    query?: {
+        /**
+         * Authorization code returned by the provider on success
+         */
+        code?: string;
+        /**
+         * State parameter for CSRF protection
+         */
+        state?: string;
+        /**
+         * Error code if authorization failed
+         */
+        error?: string;
+        /**
+         * Human-readable error description
+         */
+        error_description?: string;
+    };
+    url: '/api/auth/{provider}/callback';
+};
+
+export type GetApiAuthByProviderCallbackErrors = {
+    /**
+     * Invalid request or error from provider
+     */
+    400: Error;
+    /**
+     * Authentication failed
+     */
+    401: Error;
+};
+
+export type GetApiAuthByProviderCallbackError = GetApiAuthByProviderCallbackErrors[keyof GetApiAuthByProviderCallbackErrors];
+
+export type GetApiAuthByProviderCallbackResponses = {
+    /**
+     * Successfully authenticated user
+     */
+    200: AuthResponse;
+};
+
+export type GetApiAuthByProviderCallbackResponse = GetApiAuthByProviderCallbackResponses[keyof GetApiAuthByProviderCallbackResponses];
+
+export type GetApiAuthMeData = {
+    body?: never;
+    path?: never;
+    query?: never;
+    url: '/api/auth/me';
+};
+
+export type GetApiAuthMeErrors = {
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+};
+
+export type GetApiAuthMeError = GetApiAuthMeErrors[keyof GetApiAuthMeErrors];
+
+export type GetApiAuthMeResponses = {
+    /**
+     * Authenticated user
+     */
+    200: User;
+};
+
+export type GetApiAuthMeResponse = GetApiAuthMeResponses[keyof GetApiAuthMeResponses];
+
+export type PostApiAuthLogoutData = {
+    body?: never;
+    path?: never;
+    query?: never;
+    url: '/api/auth/logout';
+};
+
+export type PostApiAuthLogoutErrors = {
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+};
+
+export type PostApiAuthLogoutError = PostApiAuthLogoutErrors[keyof PostApiAuthLogoutErrors];
+
+export type PostApiAuthLogoutResponses = {
+    /**
+     * Successfully logged out
+     */
+    204: void;
+};
+
+export type PostApiAuthLogoutResponse = PostApiAuthLogoutResponses[keyof PostApiAuthLogoutResponses];
 
 export type PostApiDemoUsersByUserIdImpersonateData = {
     body?: never;
@@ -534,69 +1471,1975 @@ export type GetApiDemoUsersData = {
     body?: never;
     path?: never;
     query?: never;
-    url: '/api/demo/users';
+    url: '/api/demo/users';
+};
+
+export type GetApiDemoUsersErrors = {
+    /**
+     * The back-end was not started in demo mode so this endpoint is disabled.
+     */
+    403: Error;
+};
+
+export type GetApiDemoUsersError = GetApiDemoUsersErrors[keyof GetApiDemoUsersErrors];
+
+export type GetApiDemoUsersResponses = {
+    /**
+     * List of demo users retrieved successfully
+     */
+    200: Array<User>;
+};
+
+export type GetApiDemoUsersResponse = GetApiDemoUsersResponses[keyof GetApiDemoUsersResponses];
+
+export type GetApiGamesData = {
+    body?: never;
+    path?: never;
+    query?: {
+        /**
+         * Page number (1-based) for pagination
+         */
+        page?: number;
+        /**
+         * Number of games per page (max 25)
+         */
+        pageSize?: number;
+    };
+    url: '/api/games';
+};
+
+export type GetApiGamesErrors = {
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+};
+
+export type GetApiGamesError = GetApiGamesErrors[keyof GetApiGamesErrors];
+
+export type GetApiGamesResponses = {
+    /**
+     * List of games retrieved successfully
+     */
+    200: GameListResponse;
+};
+
+export type GetApiGamesResponse = GetApiGamesResponses[keyof GetApiGamesResponses];
+
+export type PostApiGamesData = {
+    body: CreateGameRequest;
+    path?: never;
+    query?: never;
+    url: '/api/games';
+};
+
+export type PostApiGamesErrors = {
+    /**
+     * Invalid request data
+     */
+    400: Error;
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+};
+
+export type PostApiGamesError = PostApiGamesErrors[keyof PostApiGamesErrors];
+
+export type PostApiGamesResponses = {
+    /**
+     * Game created successfully
+     */
+    200: GameDetail;
+};
+
+export type PostApiGamesResponse = PostApiGamesResponses[keyof PostApiGamesResponses];
+
+export type DeleteApiGamesByIdData = {
+    body?: never;
+    path: {
+        /**
+         * The game ID
+         */
+        id: string;
+    };
+    query?: never;
+    url: '/api/games/{id}';
+};
+
+export type DeleteApiGamesByIdErrors = {
+    /**
+     * The game is published
+     */
+    400: Error;
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+    /**
+     * Forbidden - not the owner or a co-organizer of the game
+     */
+    403: Error;
+    /**
+     * Game not found
+     */
+    404: Error;
+};
+
+export type DeleteApiGamesByIdError = DeleteApiGamesByIdErrors[keyof DeleteApiGamesByIdErrors];
+
+export type DeleteApiGamesByIdResponses = {
+    /**
+     * Game deleted successfully
+     */
+    204: void;
+};
+
+export type DeleteApiGamesByIdResponse = DeleteApiGamesByIdResponses[keyof DeleteApiGamesByIdResponses];
+
+export type GetApiGamesByIdData = {
+    body?: never;
+    path: {
+        /**
+         * The game ID
+         */
+        id: string;
+    };
+    query?: {
+        /**
+         * Invite token from the share link, required to see private games without a role or participation
+         */
+        inviteToken?: string;
+    };
+    url: '/api/games/{id}';
+};
+
+export type GetApiGamesByIdErrors = {
+    /**
+     * Game not found
+     */
+    404: Error;
+};
+
+export type GetApiGamesByIdError = GetApiGamesByIdErrors[keyof GetApiGamesByIdErrors];
+
+export type GetApiGamesByIdResponses = {
+    /**
+     * Game retrieved successfully
+     */
+    200: GameDetail;
+};
+
+export type GetApiGamesByIdResponse = GetApiGamesByIdResponses[keyof GetApiGamesByIdResponses];
+
+export type PatchApiGamesByIdData = {
+    body: UpdateGameRequest;
+    path: {
+        /**
+         * The game ID
+         */
+        id: string;
+    };
+    query?: never;
+    url: '/api/games/{id}';
+};
+
+export type PatchApiGamesByIdErrors = {
+    /**
+     * Invalid request data
+     */
+    400: Error;
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+    /**
+     * Forbidden - not the owner or a co-organizer of the game
+     */
+    403: Error;
+    /**
+     * Game not found
+     */
+    404: Error;
+};
+
+export type PatchApiGamesByIdError = PatchApiGamesByIdErrors[keyof PatchApiGamesByIdErrors];
+
+export type PatchApiGamesByIdResponses = {
+    /**
+     * Game updated successfully
+     */
+    200: GameDetail;
+};
+
+export type PatchApiGamesByIdResponse = PatchApiGamesByIdResponses[keyof PatchApiGamesByIdResponses];
+
+export type PostApiGamesByIdCancelData = {
+    body: CancelGameRequest;
+    path: {
+        /**
+         * The game ID
+         */
+        id: string;
+    };
+    query?: never;
+    url: '/api/games/{id}/cancel';
+};
+
+export type PostApiGamesByIdCancelErrors = {
+    /**
+     * Invalid request data, the game isn't published yet or it ended
+     */
+    400: Error;
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+    /**
+     * Forbidden - not the owner or a co-organizer of the game
+     */
+    403: Error;
+    /**
+     * Game not found
+     */
+    404: Error;
+    /**
+     * The game is already cancelled
+     */
+    409: Error;
+};
+
+export type PostApiGamesByIdCancelError = PostApiGamesByIdCancelErrors[keyof PostApiGamesByIdCancelErrors];
+
+export type PostApiGamesByIdCancelResponses = {
+    /**
+     * Game cancelled successfully
+     */
+    200: GameDetail;
+};
+
+export type PostApiGamesByIdCancelResponse = PostApiGamesByIdCancelResponses[keyof PostApiGamesByIdCancelResponses];
+
+export type GetPublicApiGamesByIdData = {
+    body?: never;
+    path: {
+        /**
+         * The game ID
+         */
+        id: string;
+    };
+    query?: {
+        /**
+         * Invite token from the share link, required to see private games without a role or participation
+         */
+        inviteToken?: string;
+    };
+    url: '/public/api/games/{id}';
+};
+
+export type GetPublicApiGamesByIdErrors = {
+    /**
+     * Game not found
+     */
+    404: Error;
+};
+
+export type GetPublicApiGamesByIdError = GetPublicApiGamesByIdErrors[keyof GetPublicApiGamesByIdErrors];
+
+export type GetPublicApiGamesByIdResponses = {
+    /**
+     * Public game information retrieved successfully
+     */
+    200: PublicGameDetail;
+};
+
+export type GetPublicApiGamesByIdResponse = GetPublicApiGamesByIdResponses[keyof GetPublicApiGamesByIdResponses];
+
+export type GetApiGamesByIdEventsData = {
+    body?: never;
+    headers?: {
+        /**
+         * ID of the last event received, sent by the browser when reconnecting
+         */
+        'Last-Event-ID'?: string;
+    };
+    path: {
+        /**
+         * The game ID
+         */
+        id: string;
+    };
+    query?: never;
+    url: '/api/games/{id}/events';
+};
+
+export type GetApiGamesByIdEventsErrors = {
+    /**
+     * Invalid Last-Event-ID header
+     */
+    400: Error;
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+    /**
+     * Game not found
+     */
+    404: Error;
+};
+
+export type GetApiGamesByIdEventsError = GetApiGamesByIdEventsErrors[keyof GetApiGamesByIdEventsErrors];
+
+export type GetApiGamesByIdEventsResponses = {
+    /**
+     * Stream of game updates
+     */
+    200: string;
+};
+
+export type GetApiGamesByIdEventsResponse = GetApiGamesByIdEventsResponses[keyof GetApiGamesByIdEventsResponses];
+
+export type GetApiGamesByIdParticipantsData = {
+    body?: never;
+    path: {
+        /**
+         * The game ID
+         */
+        id: string;
+    };
+    query?: never;
+    url: '/api/games/{id}/participants';
+};
+
+export type GetApiGamesByIdParticipantsErrors = {
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+    /**
+     * Game not found
+     */
+    404: Error;
+};
+
+export type GetApiGamesByIdParticipantsError = GetApiGamesByIdParticipantsErrors[keyof GetApiGamesByIdParticipantsErrors];
+
+export type GetApiGamesByIdParticipantsResponses = {
+    /**
+     * List of participants retrieved successfully
+     */
+    200: Array<ParticipantWithUser>;
+};
+
+export type GetApiGamesByIdParticipantsResponse = GetApiGamesByIdParticipantsResponses[keyof GetApiGamesByIdParticipantsResponses];
+
+export type PutApiGamesByIdParticipantsData = {
+    body: UpdateGameParticipationRequest;
+    path: {
+        /**
+         * The game ID
+         */
+        id: string;
+    };
+    query?: never;
+    url: '/api/games/{id}/participants';
+};
+
+export type PutApiGamesByIdParticipantsErrors = {
+    /**
+     * Invalid request data
+     */
+    400: Error;
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+    /**
+     * Game not found
+     */
+    404: Error;
+};
+
+export type PutApiGamesByIdParticipantsError = PutApiGamesByIdParticipantsErrors[keyof PutApiGamesByIdParticipantsErrors];
+
+export type PutApiGamesByIdParticipantsResponses = {
+    /**
+     * Participation status updated successfully
+     */
+    200: GameParticipation;
+};
+
+export type PutApiGamesByIdParticipantsResponse = PutApiGamesByIdParticipantsResponses[keyof PutApiGamesByIdParticipantsResponses];
+
+export type GetApiGamesByIdReconfirmationsData = {
+    body?: never;
+    path: {
+        /**
+         * The game ID
+         */
+        id: string;
+    };
+    query?: never;
+    url: '/api/games/{id}/reconfirmations';
+};
+
+export type GetApiGamesByIdReconfirmationsErrors = {
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+    /**
+     * Forbidden - not an organizer of the game
+     */
+    403: Error;
+    /**
+     * Game not found
+     */
+    404: Error;
+};
+
+export type GetApiGamesByIdReconfirmationsError = GetApiGamesByIdReconfirmationsErrors[keyof GetApiGamesByIdReconfirmationsErrors];
+
+export type GetApiGamesByIdReconfirmationsResponses = {
+    /**
+     * Pending reconfirmations retrieved successfully
+     */
+    200: Array<PendingReconfirmation>;
+};
+
+export type GetApiGamesByIdReconfirmationsResponse = GetApiGamesByIdReconfirmationsResponses[keyof GetApiGamesByIdReconfirmationsResponses];
+
+export type GetApiGamesByIdAttendanceData = {
+    body?: never;
+    path: {
+        /**
+         * The game ID
+         */
+        id: string;
+    };
+    query?: never;
+    url: '/api/games/{id}/attendance';
+};
+
+export type GetApiGamesByIdAttendanceErrors = {
+    /**
+     * The game didn't start yet
+     */
+    400: Error;
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+    /**
+     * Forbidden - not an organizer of the game
+     */
+    403: Error;
+    /**
+     * Game not found
+     */
+    404: Error;
+};
+
+export type GetApiGamesByIdAttendanceError = GetApiGamesByIdAttendanceErrors[keyof GetApiGamesByIdAttendanceErrors];
+
+export type GetApiGamesByIdAttendanceResponses = {
+    /**
+     * Attendance retrieved successfully
+     */
+    200: GameAttendance;
+};
+
+export type GetApiGamesByIdAttendanceResponse = GetApiGamesByIdAttendanceResponses[keyof GetApiGamesByIdAttendanceResponses];
+
+export type PostApiGamesByIdCheckInCodeData = {
+    body?: never;
+    path: {
+        /**
+         * The game ID
+         */
+        id: string;
+    };
+    query?: never;
+    url: '/api/games/{id}/check-in-code';
+};
+
+export type PostApiGamesByIdCheckInCodeErrors = {
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+    /**
+     * Forbidden - not an organizer of the game
+     */
+    403: Error;
+    /**
+     * Game not found
+     */
+    404: Error;
+};
+
+export type PostApiGamesByIdCheckInCodeError = PostApiGamesByIdCheckInCodeErrors[keyof PostApiGamesByIdCheckInCodeErrors];
+
+export type PostApiGamesByIdCheckInCodeResponses = {
+    /**
+     * Check-in code reset successfully
+     */
+    200: CheckInCode;
+};
+
+export type PostApiGamesByIdCheckInCodeResponse = PostApiGamesByIdCheckInCodeResponses[keyof PostApiGamesByIdCheckInCodeResponses];
+
+export type PostApiGamesByIdCheckInsData = {
+    body: CheckInRequest;
+    path: {
+        /**
+         * The game ID
+         */
+        id: string;
+    };
+    query?: never;
+    url: '/api/games/{id}/check-ins';
+};
+
+export type PostApiGamesByIdCheckInsErrors = {
+    /**
+     * Invalid request data, the game didn't start yet or it ended
+     */
+    400: Error;
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+    /**
+     * Forbidden - not an organizer of the game, or the check-in code is wrong
+     */
+    403: Error;
+    /**
+     * Game or participant not found
+     */
+    404: Error;
+};
+
+export type PostApiGamesByIdCheckInsError = PostApiGamesByIdCheckInsErrors[keyof PostApiGamesByIdCheckInsErrors];
+
+export type PostApiGamesByIdCheckInsResponses = {
+    /**
+     * Participant checked in successfully
+     */
+    200: AttendanceRecord;
+};
+
+export type PostApiGamesByIdCheckInsResponse = PostApiGamesByIdCheckInsResponses[keyof PostApiGamesByIdCheckInsResponses];
+
+export type DeleteApiGamesByIdCheckInsByUserIdData = {
+    body?: never;
+    path: {
+        /**
+         * The game ID
+         */
+        id: string;
+        /**
+         * The participant's user ID
+         */
+        userId: string;
+    };
+    query?: never;
+    url: '/api/games/{id}/check-ins/{userId}';
+};
+
+export type DeleteApiGamesByIdCheckInsByUserIdErrors = {
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+    /**
+     * Forbidden - not an organizer of the game
+     */
+    403: Error;
+    /**
+     * Game or participant not found
+     */
+    404: Error;
+};
+
+export type DeleteApiGamesByIdCheckInsByUserIdError = DeleteApiGamesByIdCheckInsByUserIdErrors[keyof DeleteApiGamesByIdCheckInsByUserIdErrors];
+
+export type DeleteApiGamesByIdCheckInsByUserIdResponses = {
+    /**
+     * Check-in removed successfully
+     */
+    204: void;
+};
+
+export type DeleteApiGamesByIdCheckInsByUserIdResponse = DeleteApiGamesByIdCheckInsByUserIdResponses[keyof DeleteApiGamesByIdCheckInsByUserIdResponses];
+
+export type PostApiGamesByIdWaitlistOfferAcceptData = {
+    body?: never;
+    path: {
+        /**
+         * The game ID
+         */
+        id: string;
+    };
+    query?: never;
+    url: '/api/games/{id}/waitlist-offer/accept';
+};
+
+export type PostApiGamesByIdWaitlistOfferAcceptErrors = {
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+    /**
+     * Game not found, or the user has no pending offer
+     */
+    404: Error;
+    /**
+     * The offer expired, or the spot is no longer available
+     */
+    409: Error;
+};
+
+export type PostApiGamesByIdWaitlistOfferAcceptError = PostApiGamesByIdWaitlistOfferAcceptErrors[keyof PostApiGamesByIdWaitlistOfferAcceptErrors];
+
+export type PostApiGamesByIdWaitlistOfferAcceptResponses = {
+    /**
+     * Offer accepted successfully
+     */
+    204: void;
+};
+
+export type PostApiGamesByIdWaitlistOfferAcceptResponse = PostApiGamesByIdWaitlistOfferAcceptResponses[keyof PostApiGamesByIdWaitlistOfferAcceptResponses];
+
+export type PostApiGamesByIdWaitlistOfferDeclineData = {
+    body?: never;
+    path: {
+        /**
+         * The game ID
+         */
+        id: string;
+    };
+    query?: never;
+    url: '/api/games/{id}/waitlist-offer/decline';
+};
+
+export type PostApiGamesByIdWaitlistOfferDeclineErrors = {
+    /**
+     * The game is frozen
+     */
+    400: Error;
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+    /**
+     * Game not found, or the user has no pending offer
+     */
+    404: Error;
+    /**
+     * The offer expired, or the spot is no longer available
+     */
+    409: Error;
+};
+
+export type PostApiGamesByIdWaitlistOfferDeclineError = PostApiGamesByIdWaitlistOfferDeclineErrors[keyof PostApiGamesByIdWaitlistOfferDeclineErrors];
+
+export type PostApiGamesByIdWaitlistOfferDeclineResponses = {
+    /**
+     * Offer declined successfully
+     */
+    204: void;
+};
+
+export type PostApiGamesByIdWaitlistOfferDeclineResponse = PostApiGamesByIdWaitlistOfferDeclineResponses[keyof PostApiGamesByIdWaitlistOfferDeclineResponses];
+
+export type PutApiGamesByIdParticipantsOrderData = {
+    body: ReorderParticipantsRequest;
+    path: {
+        /**
+         * The game ID
+         */
+        id: string;
+    };
+    query?: never;
+    url: '/api/games/{id}/participants/order';
+};
+
+export type PutApiGamesByIdParticipantsOrderErrors = {
+    /**
+     * Invalid order, or the game is frozen
+     */
+    400: Error;
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+    /**
+     * Forbidden - not the owner or a co-organizer of the game
+     */
+    403: Error;
+    /**
+     * Game not found
+     */
+    404: Error;
+};
+
+export type PutApiGamesByIdParticipantsOrderError = PutApiGamesByIdParticipantsOrderErrors[keyof PutApiGamesByIdParticipantsOrderErrors];
+
+export type PutApiGamesByIdParticipantsOrderResponses = {
+    /**
+     * Participants reordered successfully
+     */
+    200: Array<ParticipantWithUser>;
+};
+
+export type PutApiGamesByIdParticipantsOrderResponse = PutApiGamesByIdParticipantsOrderResponses[keyof PutApiGamesByIdParticipantsOrderResponses];
+
+export type DeleteApiGamesByIdParticipantsByUserIdData = {
+    body?: never;
+    path: {
+        /**
+         * The game ID
+         */
+        id: string;
+        /**
+         * The participant's user ID
+         */
+        userId: string;
+    };
+    query?: never;
+    url: '/api/games/{id}/participants/{userId}';
+};
+
+export type DeleteApiGamesByIdParticipantsByUserIdErrors = {
+    /**
+     * The game is frozen
+     */
+    400: Error;
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+    /**
+     * Forbidden - not the owner or a co-organizer of the game
+     */
+    403: Error;
+    /**
+     * Game or participant not found
+     */
+    404: Error;
+};
+
+export type DeleteApiGamesByIdParticipantsByUserIdError = DeleteApiGamesByIdParticipantsByUserIdErrors[keyof DeleteApiGamesByIdParticipantsByUserIdErrors];
+
+export type DeleteApiGamesByIdParticipantsByUserIdResponses = {
+    /**
+     * Participant removed successfully
+     */
+    204: void;
+};
+
+export type DeleteApiGamesByIdParticipantsByUserIdResponse = DeleteApiGamesByIdParticipantsByUserIdResponses[keyof DeleteApiGamesByIdParticipantsByUserIdResponses];
+
+export type PostApiGamesByIdPlaceholdersData = {
+    body: CreatePlaceholderRequest;
+    path: {
+        /**
+         * The game ID
+         */
+        id: string;
+    };
+    query?: never;
+    url: '/api/games/{id}/placeholders';
+};
+
+export type PostApiGamesByIdPlaceholdersErrors = {
+    /**
+     * Invalid request data, or the game is frozen
+     */
+    400: Error;
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+    /**
+     * Forbidden - not the owner or a co-organizer of the game
+     */
+    403: Error;
+    /**
+     * Game not found
+     */
+    404: Error;
+};
+
+export type PostApiGamesByIdPlaceholdersError = PostApiGamesByIdPlaceholdersErrors[keyof PostApiGamesByIdPlaceholdersErrors];
+
+export type PostApiGamesByIdPlaceholdersResponses = {
+    /**
+     * Placeholder added successfully
+     */
+    201: PlaceholderParticipant;
+};
+
+export type PostApiGamesByIdPlaceholdersResponse = PostApiGamesByIdPlaceholdersResponses[keyof PostApiGamesByIdPlaceholdersResponses];
+
+export type PostApiGamesByIdPlaceholdersByUserIdClaimLinkData = {
+    body?: never;
+    path: {
+        /**
+         * The game ID
+         */
+        id: string;
+        /**
+         * The participant's user ID
+         */
+        userId: string;
+    };
+    query?: never;
+    url: '/api/games/{id}/placeholders/{userId}/claim-link';
+};
+
+export type PostApiGamesByIdPlaceholdersByUserIdClaimLinkErrors = {
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+    /**
+     * Forbidden - not the owner or a co-organizer of the game
+     */
+    403: Error;
+    /**
+     * Game or placeholder not found
+     */
+    404: Error;
+};
+
+export type PostApiGamesByIdPlaceholdersByUserIdClaimLinkError = PostApiGamesByIdPlaceholdersByUserIdClaimLinkErrors[keyof PostApiGamesByIdPlaceholdersByUserIdClaimLinkErrors];
+
+export type PostApiGamesByIdPlaceholdersByUserIdClaimLinkResponses = {
+    /**
+     * Claim link created successfully
+     */
+    200: PlaceholderParticipant;
+};
+
+export type PostApiGamesByIdPlaceholdersByUserIdClaimLinkResponse = PostApiGamesByIdPlaceholdersByUserIdClaimLinkResponses[keyof PostApiGamesByIdPlaceholdersByUserIdClaimLinkResponses];
+
+export type PostApiPlaceholdersClaimData = {
+    body: ClaimPlaceholderRequest;
+    path?: never;
+    query?: never;
+    url: '/api/placeholders/claim';
+};
+
+export type PostApiPlaceholdersClaimErrors = {
+    /**
+     * Invalid request data
+     */
+    400: Error;
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+    /**
+     * Invalid or already used claim token
+     */
+    404: Error;
+    /**
+     * The user already participates in the game
+     */
+    409: Error;
+};
+
+export type PostApiPlaceholdersClaimError = PostApiPlaceholdersClaimErrors[keyof PostApiPlaceholdersClaimErrors];
+
+export type PostApiPlaceholdersClaimResponses = {
+    /**
+     * Placeholder claimed successfully
+     */
+    200: ClaimedPlaceholder;
+};
+
+export type PostApiPlaceholdersClaimResponse = PostApiPlaceholdersClaimResponses[keyof PostApiPlaceholdersClaimResponses];
+
+export type GetApiGamesByIdInviteTokensData = {
+    body?: never;
+    path: {
+        /**
+         * The game ID
+         */
+        id: string;
+    };
+    query?: never;
+    url: '/api/games/{id}/invite-tokens';
+};
+
+export type GetApiGamesByIdInviteTokensErrors = {
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+    /**
+     * Forbidden - not an organizer of the game
+     */
+    403: Error;
+    /**
+     * Game not found
+     */
+    404: Error;
+};
+
+export type GetApiGamesByIdInviteTokensError = GetApiGamesByIdInviteTokensErrors[keyof GetApiGamesByIdInviteTokensErrors];
+
+export type GetApiGamesByIdInviteTokensResponses = {
+    /**
+     * Invite tokens retrieved successfully
+     */
+    200: Array<GameInviteToken>;
+};
+
+export type GetApiGamesByIdInviteTokensResponse = GetApiGamesByIdInviteTokensResponses[keyof GetApiGamesByIdInviteTokensResponses];
+
+export type PostApiGamesByIdInviteTokensData = {
+    body?: never;
+    path: {
+        /**
+         * The game ID
+         */
+        id: string;
+    };
+    query?: never;
+    url: '/api/games/{id}/invite-tokens';
+};
+
+export type PostApiGamesByIdInviteTokensErrors = {
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+    /**
+     * Forbidden - not an organizer of the game
+     */
+    403: Error;
+    /**
+     * Game not found
+     */
+    404: Error;
+};
+
+export type PostApiGamesByIdInviteTokensError = PostApiGamesByIdInviteTokensErrors[keyof PostApiGamesByIdInviteTokensErrors];
+
+export type PostApiGamesByIdInviteTokensResponses = {
+    /**
+     * Invite token created successfully
+     */
+    201: GameInviteToken;
+};
+
+export type PostApiGamesByIdInviteTokensResponse = PostApiGamesByIdInviteTokensResponses[keyof PostApiGamesByIdInviteTokensResponses];
+
+export type DeleteApiGamesByIdInviteTokensByTokenIdData = {
+    body?: never;
+    path: {
+        /**
+         * The game ID
+         */
+        id: string;
+        /**
+         * The invite token ID
+         */
+        tokenId: number;
+    };
+    query?: never;
+    url: '/api/games/{id}/invite-tokens/{tokenId}';
+};
+
+export type DeleteApiGamesByIdInviteTokensByTokenIdErrors = {
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+    /**
+     * Forbidden - not an organizer of the game
+     */
+    403: Error;
+    /**
+     * Game or active invite token not found
+     */
+    404: Error;
+};
+
+export type DeleteApiGamesByIdInviteTokensByTokenIdError = DeleteApiGamesByIdInviteTokensByTokenIdErrors[keyof DeleteApiGamesByIdInviteTokensByTokenIdErrors];
+
+export type DeleteApiGamesByIdInviteTokensByTokenIdResponses = {
+    /**
+     * Invite token revoked successfully
+     */
+    204: void;
+};
+
+export type DeleteApiGamesByIdInviteTokensByTokenIdResponse = DeleteApiGamesByIdInviteTokensByTokenIdResponses[keyof DeleteApiGamesByIdInviteTokensByTokenIdResponses];
+
+export type GetApiGamesByIdRolesData = {
+    body?: never;
+    path: {
+        /**
+         * The game ID
+         */
+        id: string;
+    };
+    query?: never;
+    url: '/api/games/{id}/roles';
+};
+
+export type GetApiGamesByIdRolesErrors = {
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+    /**
+     * Forbidden - no role in the game
+     */
+    403: Error;
+    /**
+     * Game not found
+     */
+    404: Error;
+};
+
+export type GetApiGamesByIdRolesError = GetApiGamesByIdRolesErrors[keyof GetApiGamesByIdRolesErrors];
+
+export type GetApiGamesByIdRolesResponses = {
+    /**
+     * Roles retrieved successfully
+     */
+    200: Array<GameRole>;
+};
+
+export type GetApiGamesByIdRolesResponse = GetApiGamesByIdRolesResponses[keyof GetApiGamesByIdRolesResponses];
+
+export type PostApiGamesByIdRolesData = {
+    body: InviteGameRoleRequest;
+    path: {
+        /**
+         * The game ID
+         */
+        id: string;
+    };
+    query?: never;
+    url: '/api/games/{id}/roles';
+};
+
+export type PostApiGamesByIdRolesErrors = {
+    /**
+     * Invalid request data
+     */
+    400: Error;
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+    /**
+     * Forbidden - not the owner of the game
+     */
+    403: Error;
+    /**
+     * Game or user not found
+     */
+    404: Error;
+    /**
+     * The user already has a role in the game
+     */
+    409: Error;
+};
+
+export type PostApiGamesByIdRolesError = PostApiGamesByIdRolesErrors[keyof PostApiGamesByIdRolesErrors];
+
+export type PostApiGamesByIdRolesResponses = {
+    /**
+     * Role given successfully
+     */
+    201: GameRole;
+};
+
+export type PostApiGamesByIdRolesResponse = PostApiGamesByIdRolesResponses[keyof PostApiGamesByIdRolesResponses];
+
+export type DeleteApiGamesByIdRolesByUserIdData = {
+    body?: never;
+    path: {
+        /**
+         * The game ID
+         */
+        id: string;
+        /**
+         * The user's ID
+         */
+        userId: string;
+    };
+    query?: never;
+    url: '/api/games/{id}/roles/{userId}';
+};
+
+export type DeleteApiGamesByIdRolesByUserIdErrors = {
+    /**
+     * The owner's role can't be revoked
+     */
+    400: Error;
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+    /**
+     * Forbidden - not the owner of the game
+     */
+    403: Error;
+    /**
+     * Game not found, or the user has no role in it
+     */
+    404: Error;
+};
+
+export type DeleteApiGamesByIdRolesByUserIdError = DeleteApiGamesByIdRolesByUserIdErrors[keyof DeleteApiGamesByIdRolesByUserIdErrors];
+
+export type DeleteApiGamesByIdRolesByUserIdResponses = {
+    /**
+     * Role revoked successfully
+     */
+    204: void;
+};
+
+export type DeleteApiGamesByIdRolesByUserIdResponse = DeleteApiGamesByIdRolesByUserIdResponses[keyof DeleteApiGamesByIdRolesByUserIdResponses];
+
+export type PutApiGamesByIdRolesByUserIdData = {
+    body: UpdateGameRoleRequest;
+    path: {
+        /**
+         * The game ID
+         */
+        id: string;
+        /**
+         * The user's ID
+         */
+        userId: string;
+    };
+    query?: never;
+    url: '/api/games/{id}/roles/{userId}';
+};
+
+export type PutApiGamesByIdRolesByUserIdErrors = {
+    /**
+     * Invalid request data
+     */
+    400: Error;
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+    /**
+     * Forbidden - not the owner of the game
+     */
+    403: Error;
+    /**
+     * Game not found, or the user has no role in it
+     */
+    404: Error;
+};
+
+export type PutApiGamesByIdRolesByUserIdError = PutApiGamesByIdRolesByUserIdErrors[keyof PutApiGamesByIdRolesByUserIdErrors];
+
+export type PutApiGamesByIdRolesByUserIdResponses = {
+    /**
+     * Role changed successfully
+     */
+    200: GameRole;
+};
+
+export type PutApiGamesByIdRolesByUserIdResponse = PutApiGamesByIdRolesByUserIdResponses[keyof PutApiGamesByIdRolesByUserIdResponses];
+
+export type GetApiGamesByIdReimbursementsByParticipantIdData = {
+    body?: never;
+    path: {
+        /**
+         * The game ID
+         */
+        id: string;
+        /**
+         * The participant's user ID
+         */
+        participant_id: string;
+    };
+    query?: never;
+    url: '/api/games/{id}/reimbursements/{participant_id}';
+};
+
+export type GetApiGamesByIdReimbursementsByParticipantIdErrors = {
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+    /**
+     * Forbidden - not the participant or the game's treasurer
+     */
+    403: Error;
+    /**
+     * Game or participant not found
+     */
+    404: Error;
+};
+
+export type GetApiGamesByIdReimbursementsByParticipantIdError = GetApiGamesByIdReimbursementsByParticipantIdErrors[keyof GetApiGamesByIdReimbursementsByParticipantIdErrors];
+
+export type GetApiGamesByIdReimbursementsByParticipantIdResponses = {
+    /**
+     * Reimbursement record retrieved successfully
+     */
+    200: ReimbursementRecord;
+};
+
+export type GetApiGamesByIdReimbursementsByParticipantIdResponse = GetApiGamesByIdReimbursementsByParticipantIdResponses[keyof GetApiGamesByIdReimbursementsByParticipantIdResponses];
+
+export type GetApiGamesByIdReimbursementsData = {
+    body?: never;
+    path: {
+        /**
+         * The game ID
+         */
+        id: string;
+    };
+    query?: never;
+    url: '/api/games/{id}/reimbursements';
+};
+
+export type GetApiGamesByIdReimbursementsErrors = {
+    /**
+     * Bad request - reimbursements are only available for frozen games
+     */
+    400: Error;
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+    /**
+     * Forbidden - only the treasurer can access this endpoint
+     */
+    403: Error;
+    /**
+     * Game not found
+     */
+    404: Error;
+};
+
+export type GetApiGamesByIdReimbursementsError = GetApiGamesByIdReimbursementsErrors[keyof GetApiGamesByIdReimbursementsErrors];
+
+export type GetApiGamesByIdReimbursementsResponses = {
+    /**
+     * Reimbursements retrieved successfully
+     */
+    200: Array<GameReimbursementEntry>;
+};
+
+export type GetApiGamesByIdReimbursementsResponse = GetApiGamesByIdReimbursementsResponses[keyof GetApiGamesByIdReimbursementsResponses];
+
+export type PutApiGamesByIdReimbursementsData = {
+    body: UpdateReimbursementRequest;
+    path: {
+        /**
+         * The game ID
+         */
+        id: string;
+    };
+    query?: never;
+    url: '/api/games/{id}/reimbursements';
+};
+
+export type PutApiGamesByIdReimbursementsErrors = {
+    /**
+     * Invalid request data or participant tried to include participantId/reimbursementReceivedAt fields
+     */
+    400: Error;
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+    /**
+     * Forbidden - not the treasurer or participant for this reimbursement
+     */
+    403: Error;
+    /**
+     * Game or participant not found
+     */
+    404: Error;
+};
+
+export type PutApiGamesByIdReimbursementsError = PutApiGamesByIdReimbursementsErrors[keyof PutApiGamesByIdReimbursementsErrors];
+
+export type PutApiGamesByIdReimbursementsResponses = {
+    /**
+     * Reimbursement status updated successfully
+     */
+    200: ReimbursementRecord;
+};
+
+export type PutApiGamesByIdReimbursementsResponse = PutApiGamesByIdReimbursementsResponses[keyof PutApiGamesByIdReimbursementsResponses];
+
+export type PostApiGamesByIdReimbursementsStatementData = {
+    body: string;
+    path: {
+        /**
+         * The game ID
+         */
+        id: string;
+    };
+    query?: never;
+    url: '/api/games/{id}/reimbursements/statement';
+};
+
+export type PostApiGamesByIdReimbursementsStatementErrors = {
+    /**
+     * Bad request - the statement couldn't be read, or the game isn't frozen
+     */
+    400: Error;
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+    /**
+     * Forbidden - only the treasurer can access this endpoint
+     */
+    403: Error;
+    /**
+     * Game not found
+     */
+    404: Error;
+    /**
+     * Unsupported media type - statements are CSV or CAMT.053 XML
+     */
+    415: Error;
+};
+
+export type PostApiGamesByIdReimbursementsStatementError = PostApiGamesByIdReimbursementsStatementErrors[keyof PostApiGamesByIdReimbursementsStatementErrors];
+
+export type PostApiGamesByIdReimbursementsStatementResponses = {
+    /**
+     * Proposed matches and the lines that couldn't be matched
+     */
+    200: StatementMatchReport;
+};
+
+export type PostApiGamesByIdReimbursementsStatementResponse = PostApiGamesByIdReimbursementsStatementResponses[keyof PostApiGamesByIdReimbursementsStatementResponses];
+
+export type PostApiGamesByIdReimbursementsMatchesData = {
+    body: ApplyReimbursementMatchesRequest;
+    path: {
+        /**
+         * The game ID
+         */
+        id: string;
+    };
+    query?: never;
+    url: '/api/games/{id}/reimbursements/matches';
+};
+
+export type PostApiGamesByIdReimbursementsMatchesErrors = {
+    /**
+     * Bad request - invalid request data, or the game isn't frozen
+     */
+    400: Error;
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+    /**
+     * Forbidden - only the treasurer can access this endpoint
+     */
+    403: Error;
+    /**
+     * Game not found
+     */
+    404: Error;
+};
+
+export type PostApiGamesByIdReimbursementsMatchesError = PostApiGamesByIdReimbursementsMatchesErrors[keyof PostApiGamesByIdReimbursementsMatchesErrors];
+
+export type PostApiGamesByIdReimbursementsMatchesResponses = {
+    /**
+     * Reimbursements marked as received, check the status of each result for the participants that couldn't be updated
+     */
+    200: BulkReimbursementResponse;
+};
+
+export type PostApiGamesByIdReimbursementsMatchesResponse = PostApiGamesByIdReimbursementsMatchesResponses[keyof PostApiGamesByIdReimbursementsMatchesResponses];
+
+export type PostApiGamesByIdReimbursementsBulkData = {
+    body: BulkReimbursementRequest;
+    path: {
+        /**
+         * The game ID
+         */
+        id: string;
+    };
+    query?: never;
+    url: '/api/games/{id}/reimbursements/bulk';
+};
+
+export type PostApiGamesByIdReimbursementsBulkErrors = {
+    /**
+     * Bad request - invalid request data, or the game isn't frozen
+     */
+    400: Error;
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+    /**
+     * Forbidden - only the treasurer can access this endpoint
+     */
+    403: Error;
+    /**
+     * Game not found
+     */
+    404: Error;
+};
+
+export type PostApiGamesByIdReimbursementsBulkError = PostApiGamesByIdReimbursementsBulkErrors[keyof PostApiGamesByIdReimbursementsBulkErrors];
+
+export type PostApiGamesByIdReimbursementsBulkResponses = {
+    /**
+     * Reimbursements updated, check the status of each result for the participants that couldn't be updated
+     */
+    200: BulkReimbursementResponse;
+};
+
+export type PostApiGamesByIdReimbursementsBulkResponse = PostApiGamesByIdReimbursementsBulkResponses[keyof PostApiGamesByIdReimbursementsBulkResponses];
+
+export type GetApiGroupsData = {
+    body?: never;
+    path?: never;
+    query?: never;
+    url: '/api/groups';
+};
+
+export type GetApiGroupsErrors = {
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+};
+
+export type GetApiGroupsError = GetApiGroupsErrors[keyof GetApiGroupsErrors];
+
+export type GetApiGroupsResponses = {
+    /**
+     * Groups retrieved successfully
+     */
+    200: Array<Group>;
+};
+
+export type GetApiGroupsResponse = GetApiGroupsResponses[keyof GetApiGroupsResponses];
+
+export type PostApiGroupsData = {
+    body: CreateGroupRequest;
+    path?: never;
+    query?: never;
+    url: '/api/groups';
+};
+
+export type PostApiGroupsErrors = {
+    /**
+     * Invalid request data
+     */
+    400: Error;
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+};
+
+export type PostApiGroupsError = PostApiGroupsErrors[keyof PostApiGroupsErrors];
+
+export type PostApiGroupsResponses = {
+    /**
+     * Group created successfully
+     */
+    201: Group;
+};
+
+export type PostApiGroupsResponse = PostApiGroupsResponses[keyof PostApiGroupsResponses];
+
+export type PostApiGroupsJoinData = {
+    body: JoinGroupRequest;
+    path?: never;
+    query?: never;
+    url: '/api/groups/join';
+};
+
+export type PostApiGroupsJoinErrors = {
+    /**
+     * Invalid request data
+     */
+    400: Error;
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+    /**
+     * No group has this invite code
+     */
+    404: Error;
+};
+
+export type PostApiGroupsJoinError = PostApiGroupsJoinErrors[keyof PostApiGroupsJoinErrors];
+
+export type PostApiGroupsJoinResponses = {
+    /**
+     * Group joined successfully
+     */
+    200: Group;
+};
+
+export type PostApiGroupsJoinResponse = PostApiGroupsJoinResponses[keyof PostApiGroupsJoinResponses];
+
+export type GetApiGroupsByIdData = {
+    body?: never;
+    path: {
+        /**
+         * The group ID
+         */
+        id: string;
+    };
+    query?: never;
+    url: '/api/groups/{id}';
+};
+
+export type GetApiGroupsByIdErrors = {
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+    /**
+     * Group not found, or the user is not a member
+     */
+    404: Error;
+};
+
+export type GetApiGroupsByIdError = GetApiGroupsByIdErrors[keyof GetApiGroupsByIdErrors];
+
+export type GetApiGroupsByIdResponses = {
+    /**
+     * Group retrieved successfully
+     */
+    200: Group;
+};
+
+export type GetApiGroupsByIdResponse = GetApiGroupsByIdResponses[keyof GetApiGroupsByIdResponses];
+
+export type PatchApiGroupsByIdData = {
+    body: UpdateGroupRequest;
+    path: {
+        /**
+         * The group ID
+         */
+        id: string;
+    };
+    query?: never;
+    url: '/api/groups/{id}';
+};
+
+export type PatchApiGroupsByIdErrors = {
+    /**
+     * Invalid request data
+     */
+    400: Error;
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+    /**
+     * Forbidden - not an admin of the group
+     */
+    403: Error;
+    /**
+     * Group not found, or the user is not a member
+     */
+    404: Error;
+};
+
+export type PatchApiGroupsByIdError = PatchApiGroupsByIdErrors[keyof PatchApiGroupsByIdErrors];
+
+export type PatchApiGroupsByIdResponses = {
+    /**
+     * Group updated successfully
+     */
+    200: Group;
+};
+
+export type PatchApiGroupsByIdResponse = PatchApiGroupsByIdResponses[keyof PatchApiGroupsByIdResponses];
+
+export type PostApiGroupsByIdInviteLinkData = {
+    body?: never;
+    path: {
+        /**
+         * The group ID
+         */
+        id: string;
+    };
+    query?: never;
+    url: '/api/groups/{id}/invite-link';
+};
+
+export type PostApiGroupsByIdInviteLinkErrors = {
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+    /**
+     * Forbidden - not an admin of the group
+     */
+    403: Error;
+    /**
+     * Group not found, or the user is not a member
+     */
+    404: Error;
+};
+
+export type PostApiGroupsByIdInviteLinkError = PostApiGroupsByIdInviteLinkErrors[keyof PostApiGroupsByIdInviteLinkErrors];
+
+export type PostApiGroupsByIdInviteLinkResponses = {
+    /**
+     * Invite link reset successfully
+     */
+    200: Group;
+};
+
+export type PostApiGroupsByIdInviteLinkResponse = PostApiGroupsByIdInviteLinkResponses[keyof PostApiGroupsByIdInviteLinkResponses];
+
+export type GetApiGroupsByIdMembersData = {
+    body?: never;
+    path: {
+        /**
+         * The group ID
+         */
+        id: string;
+    };
+    query?: never;
+    url: '/api/groups/{id}/members';
+};
+
+export type GetApiGroupsByIdMembersErrors = {
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+    /**
+     * Group not found, or the user is not a member
+     */
+    404: Error;
+};
+
+export type GetApiGroupsByIdMembersError = GetApiGroupsByIdMembersErrors[keyof GetApiGroupsByIdMembersErrors];
+
+export type GetApiGroupsByIdMembersResponses = {
+    /**
+     * Members retrieved successfully
+     */
+    200: Array<GroupMember>;
+};
+
+export type GetApiGroupsByIdMembersResponse = GetApiGroupsByIdMembersResponses[keyof GetApiGroupsByIdMembersResponses];
+
+export type DeleteApiGroupsByIdMembersByUserIdData = {
+    body?: never;
+    path: {
+        /**
+         * The group ID
+         */
+        id: string;
+        /**
+         * The member's user ID
+         */
+        userId: string;
+    };
+    query?: never;
+    url: '/api/groups/{id}/members/{userId}';
+};
+
+export type DeleteApiGroupsByIdMembersByUserIdErrors = {
+    /**
+     * The last admin cannot leave the group
+     */
+    400: Error;
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+    /**
+     * Forbidden - not an admin of the group
+     */
+    403: Error;
+    /**
+     * Group or member not found
+     */
+    404: Error;
+};
+
+export type DeleteApiGroupsByIdMembersByUserIdError = DeleteApiGroupsByIdMembersByUserIdErrors[keyof DeleteApiGroupsByIdMembersByUserIdErrors];
+
+export type DeleteApiGroupsByIdMembersByUserIdResponses = {
+    /**
+     * Member removed successfully
+     */
+    204: void;
+};
+
+export type DeleteApiGroupsByIdMembersByUserIdResponse = DeleteApiGroupsByIdMembersByUserIdResponses[keyof DeleteApiGroupsByIdMembersByUserIdResponses];
+
+export type PutApiGroupsByIdMembersByUserIdData = {
+    body: UpdateGroupMemberRequest;
+    path: {
+        /**
+         * The group ID
+         */
+        id: string;
+        /**
+         * The member's user ID
+         */
+        userId: string;
+    };
+    query?: never;
+    url: '/api/groups/{id}/members/{userId}';
+};
+
+export type PutApiGroupsByIdMembersByUserIdErrors = {
+    /**
+     * Invalid request data, or the last admin would be demoted
+     */
+    400: Error;
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+    /**
+     * Forbidden - not an admin of the group
+     */
+    403: Error;
+    /**
+     * Group or member not found
+     */
+    404: Error;
+};
+
+export type PutApiGroupsByIdMembersByUserIdError = PutApiGroupsByIdMembersByUserIdErrors[keyof PutApiGroupsByIdMembersByUserIdErrors];
+
+export type PutApiGroupsByIdMembersByUserIdResponses = {
+    /**
+     * Member updated successfully
+     */
+    200: GroupMember;
+};
+
+export type PutApiGroupsByIdMembersByUserIdResponse = PutApiGroupsByIdMembersByUserIdResponses[keyof PutApiGroupsByIdMembersByUserIdResponses];
+
+export type GetApiGroupsByIdAttendanceData = {
+    body?: never;
+    path: {
+        /**
+         * The group ID
+         */
+        id: string;
+    };
+    query?: never;
+    url: '/api/groups/{id}/attendance';
+};
+
+export type GetApiGroupsByIdAttendanceErrors = {
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+    /**
+     * Forbidden - not an admin of the group
+     */
+    403: Error;
+    /**
+     * Group not found, or the user is not a member
+     */
+    404: Error;
+};
+
+export type GetApiGroupsByIdAttendanceError = GetApiGroupsByIdAttendanceErrors[keyof GetApiGroupsByIdAttendanceErrors];
+
+export type GetApiGroupsByIdAttendanceResponses = {
+    /**
+     * Attendance retrieved successfully
+     */
+    200: Array<MemberAttendance>;
+};
+
+export type GetApiGroupsByIdAttendanceResponse = GetApiGroupsByIdAttendanceResponses[keyof GetApiGroupsByIdAttendanceResponses];
+
+export type GetApiSeriesData = {
+    body?: never;
+    path?: never;
+    query?: never;
+    url: '/api/series';
+};
+
+export type GetApiSeriesErrors = {
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+};
+
+export type GetApiSeriesError = GetApiSeriesErrors[keyof GetApiSeriesErrors];
+
+export type GetApiSeriesResponses = {
+    /**
+     * List of series retrieved successfully
+     */
+    200: Array<Series>;
+};
+
+export type GetApiSeriesResponse = GetApiSeriesResponses[keyof GetApiSeriesResponses];
+
+export type PostApiSeriesData = {
+    body: CreateSeriesRequest;
+    path?: never;
+    query?: never;
+    url: '/api/series';
+};
+
+export type PostApiSeriesErrors = {
+    /**
+     * Invalid request data
+     */
+    400: Error;
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+};
+
+export type PostApiSeriesError = PostApiSeriesErrors[keyof PostApiSeriesErrors];
+
+export type PostApiSeriesResponses = {
+    /**
+     * Series created successfully
+     */
+    201: Series;
+};
+
+export type PostApiSeriesResponse = PostApiSeriesResponses[keyof PostApiSeriesResponses];
+
+export type GetApiSeriesByIdData = {
+    body?: never;
+    path: {
+        /**
+         * The series ID
+         */
+        id: string;
+    };
+    query?: never;
+    url: '/api/series/{id}';
+};
+
+export type GetApiSeriesByIdErrors = {
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+    /**
+     * Series not found
+     */
+    404: Error;
+};
+
+export type GetApiSeriesByIdError = GetApiSeriesByIdErrors[keyof GetApiSeriesByIdErrors];
+
+export type GetApiSeriesByIdResponses = {
+    /**
+     * Series retrieved successfully
+     */
+    200: Series;
+};
+
+export type GetApiSeriesByIdResponse = GetApiSeriesByIdResponses[keyof GetApiSeriesByIdResponses];
+
+export type PatchApiSeriesByIdData = {
+    body: UpdateSeriesRequest;
+    path: {
+        /**
+         * The series ID
+         */
+        id: string;
+    };
+    query?: never;
+    url: '/api/series/{id}';
 };
 
-export type GetApiDemoUsersErrors = {
+export type PatchApiSeriesByIdErrors = {
     /**
-     * The back-end was not started in demo mode so this endpoint is disabled.
+     * Invalid request data
+     */
+    400: Error;
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+    /**
+     * Forbidden - not the series organizer
      */
     403: Error;
+    /**
+     * Series not found
+     */
+    404: Error;
 };
 
-export type GetApiDemoUsersError = GetApiDemoUsersErrors[keyof GetApiDemoUsersErrors];
+export type PatchApiSeriesByIdError = PatchApiSeriesByIdErrors[keyof PatchApiSeriesByIdErrors];
 
-export type GetApiDemoUsersResponses = {
+export type PatchApiSeriesByIdResponses = {
     /**
-     * List of demo users retrieved successfully
+     * Series updated successfully
      */
-    200: Array<User>;
+    200: Series;
 };
 
-export type GetApiDemoUsersResponse = GetApiDemoUsersResponses[keyof GetApiDemoUsersResponses];
+export type PatchApiSeriesByIdResponse = PatchApiSeriesByIdResponses[keyof PatchApiSeriesByIdResponses];
 
-export type GetApiGamesData = {
+export type GetApiWebhooksData = {
     body?: never;
     path?: never;
-    query?: {
-        /**
-         * Page number (1-based) for pagination
-         */
-        page?: number;
-        /**
-         * Number of games per page (max 25)
-         */
-        pageSize?: number;
-    };
-    url: '/api/games';
+    query?: never;
+    url: '/api/webhooks';
 };
 
-export type GetApiGamesErrors = {
+export type GetApiWebhooksErrors = {
     /**
      * Unauthorized - invalid or missing token
      */
     401: Error;
 };
 
-export type GetApiGamesError = GetApiGamesErrors[keyof GetApiGamesErrors];
+export type GetApiWebhooksError = GetApiWebhooksErrors[keyof GetApiWebhooksErrors];
 
-export type GetApiGamesResponses = {
+export type GetApiWebhooksResponses = {
     /**
-     * List of games retrieved successfully
+     * List of webhooks retrieved successfully
      */
-    200: GameListResponse;
+    200: Array<Webhook>;
 };
 
-export type GetApiGamesResponse = GetApiGamesResponses[keyof GetApiGamesResponses];
+export type GetApiWebhooksResponse = GetApiWebhooksResponses[keyof GetApiWebhooksResponses];
 
-export type PostApiGamesData = {
-    body: CreateGameRequest;
+export type PostApiWebhooksData = {
+    body: CreateWebhookRequest;
     path?: never;
     query?: never;
-    url: '/api/games';
+    url: '/api/webhooks';
 };
 
-export type PostApiGamesErrors = {
+export type PostApiWebhooksErrors = {
     /**
      * Invalid request data
      */
@@ -605,168 +3448,178 @@ export type PostApiGamesErrors = {
      * Unauthorized - invalid or missing token
      */
     401: Error;
+    /**
+     * Forbidden - not the organizer of the game or series
+     */
+    403: Error;
+    /**
+     * Game or series not found
+     */
+    404: Error;
 };
 
-export type PostApiGamesError = PostApiGamesErrors[keyof PostApiGamesErrors];
+export type PostApiWebhooksError = PostApiWebhooksErrors[keyof PostApiWebhooksErrors];
 
-export type PostApiGamesResponses = {
+export type PostApiWebhooksResponses = {
     /**
-     * Game created successfully
+     * Webhook registered successfully
      */
-    200: GameDetail;
+    201: CreatedWebhook;
 };
 
-export type PostApiGamesResponse = PostApiGamesResponses[keyof PostApiGamesResponses];
+export type PostApiWebhooksResponse = PostApiWebhooksResponses[keyof PostApiWebhooksResponses];
 
-export type GetApiGamesByIdData = {
+export type DeleteApiWebhooksByIdData = {
     body?: never;
     path: {
         /**
-         * The game ID
+         * The webhook ID
          */
-        id: string;
+        id: number;
     };
     query?: never;
-    url: '/api/games/{id}';
+    url: '/api/webhooks/{id}';
 };
 
-export type GetApiGamesByIdErrors = {
+export type DeleteApiWebhooksByIdErrors = {
     /**
-     * Game not found
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+    /**
+     * Forbidden - not the owner of the webhook
+     */
+    403: Error;
+    /**
+     * Webhook not found
      */
     404: Error;
 };
 
-export type GetApiGamesByIdError = GetApiGamesByIdErrors[keyof GetApiGamesByIdErrors];
+export type DeleteApiWebhooksByIdError = DeleteApiWebhooksByIdErrors[keyof DeleteApiWebhooksByIdErrors];
 
-export type GetApiGamesByIdResponses = {
+export type DeleteApiWebhooksByIdResponses = {
     /**
-     * Game retrieved successfully
+     * Webhook deleted successfully
      */
-    200: GameDetail;
+    204: void;
 };
 
-export type GetApiGamesByIdResponse = GetApiGamesByIdResponses[keyof GetApiGamesByIdResponses];
+export type DeleteApiWebhooksByIdResponse = DeleteApiWebhooksByIdResponses[keyof DeleteApiWebhooksByIdResponses];
 
-export type PatchApiGamesByIdData = {
-    body: UpdateGameRequest;
+export type GetApiWebhooksByIdDeliveriesData = {
+    body?: never;
     path: {
         /**
-         * The game ID
+         * The webhook ID
          */
-        id: string;
+        id: number;
     };
     query?: never;
-    url: '/api/games/{id}';
+    url: '/api/webhooks/{id}/deliveries';
 };
 
-export type PatchApiGamesByIdErrors = {
-    /**
-     * Invalid request data
-     */
-    400: Error;
+export type GetApiWebhooksByIdDeliveriesErrors = {
     /**
      * Unauthorized - invalid or missing token
      */
     401: Error;
     /**
-     * Forbidden - not the game organizer
+     * Forbidden - not the owner of the webhook
      */
     403: Error;
     /**
-     * Game not found
+     * Webhook not found
      */
     404: Error;
 };
 
-export type PatchApiGamesByIdError = PatchApiGamesByIdErrors[keyof PatchApiGamesByIdErrors];
+export type GetApiWebhooksByIdDeliveriesError = GetApiWebhooksByIdDeliveriesErrors[keyof GetApiWebhooksByIdDeliveriesErrors];
 
-export type PatchApiGamesByIdResponses = {
+export type GetApiWebhooksByIdDeliveriesResponses = {
     /**
-     * Game updated successfully
+     * Deliveries retrieved successfully
      */
-    200: GameDetail;
+    200: Array<WebhookDelivery>;
 };
 
-export type PatchApiGamesByIdResponse = PatchApiGamesByIdResponses[keyof PatchApiGamesByIdResponses];
+export type GetApiWebhooksByIdDeliveriesResponse = GetApiWebhooksByIdDeliveriesResponses[keyof GetApiWebhooksByIdDeliveriesResponses];
 
-export type GetPublicApiGamesByIdData = {
+export type PostApiWebhooksByIdDeliveriesByDeliveryIdRedeliverData = {
     body?: never;
     path: {
         /**
-         * The game ID
+         * The webhook ID
          */
-        id: string;
+        id: number;
+        /**
+         * The delivery ID
+         */
+        deliveryId: number;
     };
     query?: never;
-    url: '/public/api/games/{id}';
+    url: '/api/webhooks/{id}/deliveries/{deliveryId}/redeliver';
 };
 
-export type GetPublicApiGamesByIdErrors = {
+export type PostApiWebhooksByIdDeliveriesByDeliveryIdRedeliverErrors = {
     /**
-     * Game not found
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+    /**
+     * Forbidden - not the owner of the webhook
+     */
+    403: Error;
+    /**
+     * Webhook or delivery not found
      */
     404: Error;
 };
 
-export type GetPublicApiGamesByIdError = GetPublicApiGamesByIdErrors[keyof GetPublicApiGamesByIdErrors];
+export type PostApiWebhooksByIdDeliveriesByDeliveryIdRedeliverError = PostApiWebhooksByIdDeliveriesByDeliveryIdRedeliverErrors[keyof PostApiWebhooksByIdDeliveriesByDeliveryIdRedeliverErrors];
 
-export type GetPublicApiGamesByIdResponses = {
+export type PostApiWebhooksByIdDeliveriesByDeliveryIdRedeliverResponses = {
     /**
-     * Public game information retrieved successfully
+     * Delivery scheduled
      */
-    200: PublicGameDetail;
+    202: WebhookDelivery;
 };
 
-export type GetPublicApiGamesByIdResponse = GetPublicApiGamesByIdResponses[keyof GetPublicApiGamesByIdResponses];
+export type PostApiWebhooksByIdDeliveriesByDeliveryIdRedeliverResponse = PostApiWebhooksByIdDeliveriesByDeliveryIdRedeliverResponses[keyof PostApiWebhooksByIdDeliveriesByDeliveryIdRedeliverResponses];
 
-export type GetApiGamesByIdParticipantsData = {
+export type GetApiUsersMeNotificationPreferencesData = {
     body?: never;
-    path: {
-        /**
-         * The game ID
-         */
-        id: string;
-    };
+    path?: never;
     query?: never;
-    url: '/api/games/{id}/participants';
+    url: '/api/users/me/notification-preferences';
 };
 
-export type GetApiGamesByIdParticipantsErrors = {
+export type GetApiUsersMeNotificationPreferencesErrors = {
     /**
      * Unauthorized - invalid or missing token
      */
     401: Error;
-    /**
-     * Game not found
-     */
-    404: Error;
 };
 
-export type GetApiGamesByIdParticipantsError = GetApiGamesByIdParticipantsErrors[keyof GetApiGamesByIdParticipantsErrors];
+export type GetApiUsersMeNotificationPreferencesError = GetApiUsersMeNotificationPreferencesErrors[keyof GetApiUsersMeNotificationPreferencesErrors];
 
-export type GetApiGamesByIdParticipantsResponses = {
+export type GetApiUsersMeNotificationPreferencesResponses = {
     /**
-     * List of participants retrieved successfully
+     * Notification preferences retrieved successfully
      */
-    200: Array<ParticipantWithUser>;
+    200: Array<NotificationPreference>;
 };
 
-export type GetApiGamesByIdParticipantsResponse = GetApiGamesByIdParticipantsResponses[keyof GetApiGamesByIdParticipantsResponses];
+export type GetApiUsersMeNotificationPreferencesResponse = GetApiUsersMeNotificationPreferencesResponses[keyof GetApiUsersMeNotificationPreferencesResponses];
 
-export type PutApiGamesByIdParticipantsData = {
-    body: UpdateGameParticipationRequest;
-    path: {
-        /**
-         * The game ID
-         */
-        id: string;
-    };
+export type PutApiUsersMeNotificationPreferencesData = {
+    body: Array<NotificationPreference>;
+    path?: never;
     query?: never;
-    url: '/api/games/{id}/participants';
+    url: '/api/users/me/notification-preferences';
 };
 
-export type PutApiGamesByIdParticipantsErrors = {
+export type PutApiUsersMeNotificationPreferencesErrors = {
     /**
      * Invalid request data
      */
@@ -775,80 +3628,142 @@ export type PutApiGamesByIdParticipantsErrors = {
      * Unauthorized - invalid or missing token
      */
     401: Error;
+};
+
+export type PutApiUsersMeNotificationPreferencesError = PutApiUsersMeNotificationPreferencesErrors[keyof PutApiUsersMeNotificationPreferencesErrors];
+
+export type PutApiUsersMeNotificationPreferencesResponses = {
     /**
-     * Game not found
+     * Notification preferences updated successfully
      */
-    404: Error;
+    200: Array<NotificationPreference>;
 };
 
-export type PutApiGamesByIdParticipantsError = PutApiGamesByIdParticipantsErrors[keyof PutApiGamesByIdParticipantsErrors];
+export type PutApiUsersMeNotificationPreferencesResponse = PutApiUsersMeNotificationPreferencesResponses[keyof PutApiUsersMeNotificationPreferencesResponses];
 
-export type PutApiGamesByIdParticipantsResponses = {
+export type GetApiUsersMePayoutDetailsData = {
+    body?: never;
+    path?: never;
+    query?: never;
+    url: '/api/users/me/payout-details';
+};
+
+export type GetApiUsersMePayoutDetailsErrors = {
     /**
-     * Participation status updated successfully
+     * Unauthorized - invalid or missing token
      */
-    200: GameParticipation;
+    401: Error;
 };
 
-export type PutApiGamesByIdParticipantsResponse = PutApiGamesByIdParticipantsResponses[keyof PutApiGamesByIdParticipantsResponses];
+export type GetApiUsersMePayoutDetailsError = GetApiUsersMePayoutDetailsErrors[keyof GetApiUsersMePayoutDetailsErrors];
 
-export type GetApiGamesByIdReimbursementsByParticipantIdData = {
+export type GetApiUsersMePayoutDetailsResponses = {
+    /**
+     * Payout details retrieved successfully
+     */
+    200: PayoutDetails;
+};
+
+export type GetApiUsersMePayoutDetailsResponse = GetApiUsersMePayoutDetailsResponses[keyof GetApiUsersMePayoutDetailsResponses];
+
+export type PutApiUsersMePayoutDetailsData = {
+    body: PayoutDetails;
+    path?: never;
+    query?: never;
+    url: '/api/users/me/payout-details';
+};
+
+export type PutApiUsersMePayoutDetailsErrors = {
+    /**
+     * Invalid request data, e.g. an invalid IBAN or an IBAN without a beneficiary name
+     */
+    400: Error;
+    /**
+     * Unauthorized - invalid or missing token
+     */
+    401: Error;
+};
+
+export type PutApiUsersMePayoutDetailsError = PutApiUsersMePayoutDetailsErrors[keyof PutApiUsersMePayoutDetailsErrors];
+
+export type PutApiUsersMePayoutDetailsResponses = {
+    /**
+     * Payout details updated successfully
+     */
+    200: PayoutDetails;
+};
+
+export type PutApiUsersMePayoutDetailsResponse = PutApiUsersMePayoutDetailsResponses[keyof PutApiUsersMePayoutDetailsResponses];
+
+export type GetApiUsersMeBalancesData = {
     body?: never;
-    path: {
-        /**
-         * The game ID
-         */
-        id: string;
-        /**
-         * The participant's user ID
-         */
-        participant_id: string;
-    };
+    path?: never;
     query?: never;
-    url: '/api/games/{id}/reimbursements/{participant_id}';
+    url: '/api/users/me/balances';
 };
 
-export type GetApiGamesByIdReimbursementsByParticipantIdErrors = {
+export type GetApiUsersMeBalancesErrors = {
     /**
      * Unauthorized - invalid or missing token
      */
     401: Error;
+};
+
+export type GetApiUsersMeBalancesError = GetApiUsersMeBalancesErrors[keyof GetApiUsersMeBalancesErrors];
+
+export type GetApiUsersMeBalancesResponses = {
     /**
-     * Forbidden - not the participant or the game organizer
+     * Balances retrieved successfully, one per organizer
      */
-    403: Error;
+    200: Array<LedgerBalance>;
+};
+
+export type GetApiUsersMeBalancesResponse = GetApiUsersMeBalancesResponses[keyof GetApiUsersMeBalancesResponses];
+
+export type GetApiUsersMeOrganizerBalancesData = {
+    body?: never;
+    path?: never;
+    query?: never;
+    url: '/api/users/me/organizer-balances';
+};
+
+export type GetApiUsersMeOrganizerBalancesErrors = {
     /**
-     * Game or participant not found
+     * Unauthorized - invalid or missing token
      */
-    404: Error;
+    401: Error;
 };
 
-export type GetApiGamesByIdReimbursementsByParticipantIdError = GetApiGamesByIdReimbursementsByParticipantIdErrors[keyof GetApiGamesByIdReimbursementsByParticipantIdErrors];
+export type GetApiUsersMeOrganizerBalancesError = GetApiUsersMeOrganizerBalancesErrors[keyof GetApiUsersMeOrganizerBalancesErrors];
 
-export type GetApiGamesByIdReimbursementsByParticipantIdResponses = {
+export type GetApiUsersMeOrganizerBalancesResponses = {
     /**
-     * Reimbursement record retrieved successfully
+     * Balances retrieved successfully, one per participant
      */
-    200: ReimbursementRecord;
+    200: Array<LedgerBalance>;
 };
 
-export type GetApiGamesByIdReimbursementsByParticipantIdResponse = GetApiGamesByIdReimbursementsByParticipantIdResponses[keyof GetApiGamesByIdReimbursementsByParticipantIdResponses];
+export type GetApiUsersMeOrganizerBalancesResponse = GetApiUsersMeOrganizerBalancesResponses[keyof GetApiUsersMeOrganizerBalancesResponses];
 
-export type GetApiGamesByIdReimbursementsData = {
+export type GetApiLedgerData = {
     body?: never;
-    path: {
+    path?: never;
+    query: {
         /**
-         * The game ID
+         * ID of the participant
          */
-        id: string;
+        payerId: string;
+        /**
+         * ID of the organizer
+         */
+        payeeId: string;
     };
-    query?: never;
-    url: '/api/games/{id}/reimbursements';
+    url: '/api/ledger';
 };
 
-export type GetApiGamesByIdReimbursementsErrors = {
+export type GetApiLedgerErrors = {
     /**
-     * Bad request - reimbursements are only available for frozen games
+     * Bad request - invalid user IDs
      */
     400: Error;
     /**
@@ -856,41 +3771,36 @@ export type GetApiGamesByIdReimbursementsErrors = {
      */
     401: Error;
     /**
-     * Forbidden - only the organizer can access this endpoint
+     * Forbidden - only the participant and the organizer can access the ledger
      */
     403: Error;
     /**
-     * Game not found
+     * Participant or organizer not found
      */
     404: Error;
 };
 
-export type GetApiGamesByIdReimbursementsError = GetApiGamesByIdReimbursementsErrors[keyof GetApiGamesByIdReimbursementsErrors];
+export type GetApiLedgerError = GetApiLedgerErrors[keyof GetApiLedgerErrors];
 
-export type GetApiGamesByIdReimbursementsResponses = {
+export type GetApiLedgerResponses = {
     /**
-     * Reimbursements retrieved successfully
+     * Ledger retrieved successfully
      */
-    200: Array<GameReimbursementEntry>;
+    200: LedgerStatement;
 };
 
-export type GetApiGamesByIdReimbursementsResponse = GetApiGamesByIdReimbursementsResponses[keyof GetApiGamesByIdReimbursementsResponses];
+export type GetApiLedgerResponse = GetApiLedgerResponses[keyof GetApiLedgerResponses];
 
-export type PutApiGamesByIdReimbursementsData = {
-    body: UpdateReimbursementRequest;
-    path: {
-        /**
-         * The game ID
-         */
-        id: string;
-    };
+export type PostApiLedgerPaymentsData = {
+    body: RecordLedgerPaymentRequest;
+    path?: never;
     query?: never;
-    url: '/api/games/{id}/reimbursements';
+    url: '/api/ledger/payments';
 };
 
-export type PutApiGamesByIdReimbursementsErrors = {
+export type PostApiLedgerPaymentsErrors = {
     /**
-     * Invalid request data or participant tried to include participantId/reimbursementReceivedAt fields
+     * Bad request - invalid request data
      */
     400: Error;
     /**
@@ -898,22 +3808,22 @@ export type PutApiGamesByIdReimbursementsErrors = {
      */
     401: Error;
     /**
-     * Forbidden - not the organizer or participant for this reimbursement
+     * Participant not found
      */
-    403: Error;
+    404: Error;
     /**
-     * Game or participant not found
+     * The participant doesn't owe the organizer anything
      */
-    404: Error;
+    409: Error;
 };
 
-export type PutApiGamesByIdReimbursementsError = PutApiGamesByIdReimbursementsErrors[keyof PutApiGamesByIdReimbursementsErrors];
+export type PostApiLedgerPaymentsError = PostApiLedgerPaymentsErrors[keyof PostApiLedgerPaymentsErrors];
 
-export type PutApiGamesByIdReimbursementsResponses = {
+export type PostApiLedgerPaymentsResponses = {
     /**
-     * Reimbursement status updated successfully
+     * Payment recorded, returns the updated ledger between the participant and the organizer
      */
-    200: ReimbursementRecord;
+    201: LedgerStatement;
 };
 
-export type PutApiGamesByIdReimbursementsResponse = PutApiGamesByIdReimbursementsResponses[keyof PutApiGamesByIdReimbursementsResponses];
+export type PostApiLedgerPaymentsResponse = PostApiLedgerPaymentsResponses[keyof PostApiLedgerPaymentsResponses];
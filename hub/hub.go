@@ -0,0 +1,83 @@
+package hub
+
+import (
+	"context"
+	"sync"
+
+	"github.com/dmateusp/opengym/outbox"
+)
+
+// subscriptionBufferSize is how many events a subscriber can fall behind before it's dropped.
+const subscriptionBufferSize = 16
+
+// Hub fans out the events of each game to the subscribers of that game, in process.
+type Hub struct {
+	mu          sync.Mutex
+	subscribers map[string]map[*Subscription]struct{}
+}
+
+func New() *Hub {
+	return &Hub{subscribers: make(map[string]map[*Subscription]struct{})}
+}
+
+// Subscription receives the events of a game until it's closed.
+type Subscription struct {
+	hub    *Hub
+	gameID string
+	events chan outbox.Event
+	once   sync.Once
+}
+
+// Events is closed when the subscription is closed, or when the subscriber fell too far behind.
+// Slow subscribers should reconnect and resume from the last event they received.
+func (s *Subscription) Events() <-chan outbox.Event {
+	return s.events
+}
+
+func (s *Subscription) Close() {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+	s.hub.remove(s)
+}
+
+// remove must be called with the hub's lock held.
+func (h *Hub) remove(s *Subscription) {
+	s.once.Do(func() {
+		delete(h.subscribers[s.gameID], s)
+		if len(h.subscribers[s.gameID]) == 0 {
+			delete(h.subscribers, s.gameID)
+		}
+		close(s.events)
+	})
+}
+
+func (h *Hub) Subscribe(gameID string) *Subscription {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	s := &Subscription{
+		hub:    h,
+		gameID: gameID,
+		events: make(chan outbox.Event, subscriptionBufferSize),
+	}
+	if h.subscribers[gameID] == nil {
+		h.subscribers[gameID] = make(map[*Subscription]struct{})
+	}
+	h.subscribers[gameID][s] = struct{}{}
+	return s
+}
+
+// Publish is an [outbox.Consumer] sending the event to the subscribers of its game. It never blocks on slow subscribers.
+func (h *Hub) Publish(ctx context.Context, event outbox.Event) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for s := range h.subscribers[event.GameID] {
+		select {
+		case s.events <- event:
+		default:
+			h.remove(s)
+		}
+	}
+	return nil
+}
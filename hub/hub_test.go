@@ -0,0 +1,60 @@
+package hub_test
+
+import (
+	"testing"
+
+	"github.com/dmateusp/opengym/hub"
+	"github.com/dmateusp/opengym/outbox"
+)
+
+func TestHub_PublishesToSubscribersOfTheGame(t *testing.T) {
+	h := hub.New()
+
+	g1 := h.Subscribe("g1")
+	defer g1.Close()
+	g2 := h.Subscribe("g2")
+	defer g2.Close()
+
+	if err := h.Publish(t.Context(), outbox.Event{ID: 1, GameID: "g1"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	select {
+	case event := <-g1.Events():
+		if event.ID != 1 {
+			t.Errorf("expected event 1, got %d", event.ID)
+		}
+	default:
+		t.Fatalf("expected the subscriber of g1 to receive the event")
+	}
+
+	select {
+	case event := <-g2.Events():
+		t.Fatalf("expected the subscriber of g2 not to receive events of g1, got %+v", event)
+	default:
+	}
+}
+
+func TestHub_DropsSlowSubscribers(t *testing.T) {
+	h := hub.New()
+
+	slow := h.Subscribe("g1")
+	defer slow.Close()
+
+	for i := range 100 {
+		if err := h.Publish(t.Context(), outbox.Event{ID: int64(i), GameID: "g1"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	var received int
+	for range slow.Events() {
+		received++
+	}
+	if received == 0 || received >= 100 {
+		t.Fatalf("expected the slow subscriber to be closed after receiving some events, got %d", received)
+	}
+
+	// closing a dropped subscription is safe
+	slow.Close()
+}
@@ -0,0 +1,36 @@
+package notify
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/dmateusp/opengym/flagsecret"
+)
+
+var (
+	sender       = flag.String("notify.sender", "none", "How notifications are sent: none, smtp or file")
+	filePath     = flag.String("notify.file.path", "./notifications.log", "File notifications are appended to when -notify.sender=file")
+	smtpAddr     = flag.String("notify.smtp.addr", "localhost:587", "SMTP server address (host:port)")
+	smtpUsername = flag.String("notify.smtp.username", "", "SMTP username, authentication is disabled if empty")
+	smtpFrom     = flag.String("notify.smtp.from", "opengym@localhost", "Address emails are sent from")
+)
+
+var smtpPassword flagsecret.Secret
+
+func init() {
+	flag.Var(&smtpPassword, "notify.smtp.password", "SMTP password (if set as a flag, supports file://<path to file>)")
+}
+
+// NewSenderFromFlags returns the sender configured with the notify.* flags.
+func NewSenderFromFlags() (Sender, error) {
+	switch *sender {
+	case "none":
+		return DiscardSender{}, nil
+	case "file":
+		return NewFileSender(*filePath), nil
+	case "smtp":
+		return NewSMTPSender(*smtpAddr, *smtpUsername, smtpPassword.Value(), *smtpFrom), nil
+	default:
+		return nil, fmt.Errorf("unknown notification sender %q", *sender)
+	}
+}
@@ -0,0 +1,87 @@
+package notify
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/dmateusp/opengym/db"
+)
+
+type Type string
+
+const (
+	TypeWaitlistPromoted      Type = "waitlist_promoted"
+	TypeGamePublished         Type = "game_published"
+	TypeGameUpdated           Type = "game_updated"
+	TypeReimbursementReceived Type = "reimbursement_received"
+)
+
+// Types lists every notification type, users can opt out of each of them.
+var Types = []Type{TypeWaitlistPromoted, TypeGamePublished, TypeGameUpdated, TypeReimbursementReceived}
+
+func (t Type) Valid() bool {
+	for _, notificationType := range Types {
+		if t == notificationType {
+			return true
+		}
+	}
+	return false
+}
+
+type Message struct {
+	To      string
+	Subject string
+	Body    string // plain text
+}
+
+// Sender delivers messages, e.g. over SMTP.
+type Sender interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// Notifier sends notifications to users who haven't opted out of them.
+type Notifier struct {
+	sender Sender
+}
+
+func NewNotifier(sender Sender) *Notifier {
+	return &Notifier{sender: sender}
+}
+
+// Notify sends a notification of the given type to the user, unless they opted out of it.
+func (n *Notifier) Notify(ctx context.Context, querier db.Querier, userID int64, notificationType Type, subject, body string) error {
+	enabled, err := querier.NotificationPreferenceIsEnabled(ctx, db.NotificationPreferenceIsEnabledParams{
+		UserID:           userID,
+		NotificationType: string(notificationType),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to retrieve notification preference: %w", err)
+	}
+	if !enabled {
+		return nil
+	}
+
+	user, err := querier.UserGetById(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			// removed placeholder
+			return nil
+		}
+		return fmt.Errorf("failed to retrieve user: %w", err)
+	}
+	// placeholders have no account, their email doesn't exist
+	if user.User.IsPlaceholder {
+		return nil
+	}
+
+	if err := n.sender.Send(ctx, Message{
+		To:      user.User.Email,
+		Subject: subject,
+		Body:    body,
+	}); err != nil {
+		return fmt.Errorf("failed to send notification: %w", err)
+	}
+
+	return nil
+}
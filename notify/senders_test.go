@@ -0,0 +1,47 @@
+package notify
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFormatEmail(t *testing.T) {
+	date := time.Date(2026, time.March, 3, 19, 0, 0, 0, time.UTC)
+	email := string(formatEmail("opengym@example.com", Message{
+		To:      "user@example.com",
+		Subject: "Game\r\nBcc: someone@example.com",
+		Body:    "line 1\nline 2",
+	}, date))
+
+	if !strings.Contains(email, "Subject: Game  Bcc: someone@example.com\r\n") {
+		t.Errorf("expected the subject to be sanitized, got %q", email)
+	}
+	if strings.Contains(email, "\r\nBcc:") {
+		t.Errorf("expected no injected header, got %q", email)
+	}
+	if !strings.HasSuffix(email, "\r\n\r\nline 1\r\nline 2") {
+		t.Errorf("expected the body to use CRLF line endings, got %q", email)
+	}
+}
+
+func TestFileSender(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "notifications.log")
+	sender := NewFileSender(path)
+
+	for _, to := range []string{"user1@example.com", "user2@example.com"} {
+		if err := sender.Send(t.Context(), Message{To: to, Subject: "Hello", Body: "World"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read file: %v", err)
+	}
+	if !strings.Contains(string(b), "To: user1@example.com") || !strings.Contains(string(b), "To: user2@example.com") {
+		t.Errorf("expected both messages to be appended, got %q", string(b))
+	}
+}
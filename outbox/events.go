@@ -0,0 +1,218 @@
+package outbox
+
+import (
+	"slices"
+	"time"
+)
+
+type EventType string
+
+const (
+	EventParticipantJoined     EventType = "participant_joined"
+	EventParticipantLeft       EventType = "participant_left"
+	EventParticipantPromoted   EventType = "participant_promoted"
+	EventGameUpdated           EventType = "game_updated"
+	EventGameRescheduled       EventType = "game_rescheduled"
+	EventGamePublished         EventType = "game_published"
+	EventGameFrozen            EventType = "game_frozen"
+	EventGameStarted           EventType = "game_started"
+	EventGameEnded             EventType = "game_ended"
+	EventReimbursementMarked   EventType = "reimbursement_marked"
+	EventParticipantsReordered EventType = "participants_reordered"
+	EventPlaceholderClaimed    EventType = "placeholder_claimed"
+	EventWaitlistOffered       EventType = "waitlist_offered"
+	EventWaitlistOfferAccepted EventType = "waitlist_offer_accepted"
+	EventWaitlistOfferDeclined EventType = "waitlist_offer_declined"
+	EventLotteryDrawn          EventType = "lottery_drawn"
+	EventGameCancelled         EventType = "game_cancelled"
+	EventGameDeleted           EventType = "game_deleted"
+)
+
+// EventTypes lists every type of event.
+var EventTypes = []EventType{
+	EventParticipantJoined,
+	EventParticipantLeft,
+	EventParticipantPromoted,
+	EventGameUpdated,
+	EventGameRescheduled,
+	EventGamePublished,
+	EventGameFrozen,
+	EventGameStarted,
+	EventGameEnded,
+	EventReimbursementMarked,
+	EventParticipantsReordered,
+	EventPlaceholderClaimed,
+	EventWaitlistOffered,
+	EventWaitlistOfferAccepted,
+	EventWaitlistOfferDeclined,
+	EventLotteryDrawn,
+	EventGameCancelled,
+	EventGameDeleted,
+}
+
+func (t EventType) Valid() bool {
+	return slices.Contains(EventTypes, t)
+}
+
+// Payload is the content of a domain event, it's stored as JSON.
+type Payload interface {
+	EventType() EventType
+}
+
+// ParticipantJoined is published when a user marks themselves as going to a game,
+// or when the organizer adds a placeholder participant.
+type ParticipantJoined struct {
+	UserID      int64 `json:"userId"`
+	Guests      int64 `json:"guests"`
+	Waitlisted  bool  `json:"waitlisted"`
+	ByOrganizer bool  `json:"byOrganizer"`
+}
+
+func (ParticipantJoined) EventType() EventType { return EventParticipantJoined }
+
+// ParticipantLeft is published when a user marks themselves as not going to a game,
+// or when the organizer removes them.
+type ParticipantLeft struct {
+	UserID      int64 `json:"userId"`
+	ByOrganizer bool  `json:"byOrganizer"`
+	// Unconfirmed is set when their spot was released because they didn't reconfirm in time.
+	Unconfirmed bool `json:"unconfirmed"`
+	// Late is set when they dropped out of the list of players after the cancellation deadline of the game.
+	Late bool `json:"late"`
+}
+
+func (ParticipantLeft) EventType() EventType { return EventParticipantLeft }
+
+// ParticipantPromoted is published when a participant moves from the waitlist to the list of players.
+type ParticipantPromoted struct {
+	UserID int64 `json:"userId"`
+}
+
+func (ParticipantPromoted) EventType() EventType { return EventParticipantPromoted }
+
+// GameUpdated is published when the organizer changes the details of a game.
+type GameUpdated struct {
+	ChangedFields []string `json:"changedFields"` // e.g. "location", "startsAt", named after the API fields
+	// Reconfirmation is set when the participants going to the game have to confirm they're still coming.
+	Reconfirmation *Reconfirmation `json:"reconfirmation,omitempty"`
+}
+
+// Reconfirmation asks participants to confirm they're still coming to a game whose important details changed.
+type Reconfirmation struct {
+	UserIDs []int64 `json:"userIds"`
+	// ReleaseAt is when the spots of the participants who didn't reconfirm are released, if the game releases them.
+	ReleaseAt *time.Time `json:"releaseAt,omitempty"`
+}
+
+func (GameUpdated) EventType() EventType { return EventGameUpdated }
+
+// GameRescheduled is published when the start time of a game changes, along with [GameUpdated].
+type GameRescheduled struct {
+	PreviousStartsAt *time.Time `json:"previousStartsAt"`
+	StartsAt         *time.Time `json:"startsAt"`
+}
+
+func (GameRescheduled) EventType() EventType { return EventGameRescheduled }
+
+// GamePublished is published once the publication time of a game is reached.
+type GamePublished struct {
+	PublishedAt time.Time `json:"publishedAt"`
+}
+
+func (GamePublished) EventType() EventType { return EventGamePublished }
+
+// GameFrozen is published once the freeze time of a game is reached.
+type GameFrozen struct {
+	FrozenAt time.Time `json:"frozenAt"`
+}
+
+func (GameFrozen) EventType() EventType { return EventGameFrozen }
+
+// GameStarted is published once a game starts.
+type GameStarted struct {
+	StartsAt time.Time `json:"startsAt"`
+}
+
+func (GameStarted) EventType() EventType { return EventGameStarted }
+
+// GameEnded is published once a game ends.
+type GameEnded struct {
+	EndsAt time.Time `json:"endsAt"`
+}
+
+func (GameEnded) EventType() EventType { return EventGameEnded }
+
+// GameCancelled is published when the organizer cancels a published game.
+type GameCancelled struct {
+	CancelledAt time.Time `json:"cancelledAt"`
+	Reason      string    `json:"reason"`
+}
+
+func (GameCancelled) EventType() EventType { return EventGameCancelled }
+
+// GameDeleted is published when the organizer deletes a game that wasn't published yet.
+type GameDeleted struct {
+	DeletedAt time.Time `json:"deletedAt"`
+}
+
+func (GameDeleted) EventType() EventType { return EventGameDeleted }
+
+// ReimbursementMarked is published when a participant marks their reimbursement as sent,
+// or when the organizer marks it as received. Null values mean the date was cleared.
+type ReimbursementMarked struct {
+	UserID                  int64      `json:"userId"`
+	ReimbursedAt            *time.Time `json:"reimbursedAt"`
+	ReimbursementReceivedAt *time.Time `json:"reimbursementReceivedAt"`
+	ByOrganizer             bool       `json:"byOrganizer"`
+}
+
+func (ReimbursementMarked) EventType() EventType { return EventReimbursementMarked }
+
+// ParticipantsReordered is published when the organizer changes the order of the participants in the queue.
+type ParticipantsReordered struct {
+	UserIDs []int64 `json:"userIds"` // the new order of the participants going, excluding the organizer
+}
+
+func (ParticipantsReordered) EventType() EventType { return EventParticipantsReordered }
+
+// PlaceholderClaimed is published when a user claims the participation of a placeholder.
+type PlaceholderClaimed struct {
+	PlaceholderUserID int64 `json:"placeholderUserId"`
+	UserID            int64 `json:"userId"`
+}
+
+func (PlaceholderClaimed) EventType() EventType { return EventPlaceholderClaimed }
+
+// WaitlistOffered is published when a spot is offered to a waitlisted participant, instead of [ParticipantPromoted]
+// for games where waitlisted participants must accept freed spots.
+type WaitlistOffered struct {
+	UserID    int64     `json:"userId"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+func (WaitlistOffered) EventType() EventType { return EventWaitlistOffered }
+
+// WaitlistOfferAccepted is published when a participant accepts the spot offered to them.
+type WaitlistOfferAccepted struct {
+	UserID int64 `json:"userId"`
+}
+
+func (WaitlistOfferAccepted) EventType() EventType { return EventWaitlistOfferAccepted }
+
+// WaitlistOfferDeclined is published when a participant declines the spot offered to them, or lets the offer expire.
+// They leave the game, and the spot is offered to the next waitlisted participant.
+type WaitlistOfferDeclined struct {
+	UserID  int64 `json:"userId"`
+	Expired bool  `json:"expired"`
+}
+
+func (WaitlistOfferDeclined) EventType() EventType { return EventWaitlistOfferDeclined }
+
+// LotteryDrawn is published when the registration of a lottery game closes and the draw decides the order of the participants.
+type LotteryDrawn struct {
+	Seed int64 `json:"seed"`
+	// UserIDs lists the participants in the order they were drawn, the organizer isn't part of the draw.
+	UserIDs []int64 `json:"userIds"`
+}
+
+func (LotteryDrawn) EventType() EventType { return EventLotteryDrawn }
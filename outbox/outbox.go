@@ -0,0 +1,213 @@
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/dmateusp/opengym/clock"
+	"github.com/dmateusp/opengym/db"
+	"github.com/dmateusp/opengym/log"
+)
+
+var (
+	pollInterval = flag.Duration("outbox.poll-interval", 2*time.Second, "How often the dispatcher checks for new events")
+	maxAttempts  = flag.Int("outbox.max-attempts", 10, "How many times the delivery of an event is attempted before giving up")
+	batchSize    = flag.Int("outbox.batch-size", 500, "Maximum number of events loaded by the dispatcher at once")
+)
+
+const (
+	initialBackoff = 10 * time.Second
+	maxBackoff     = time.Hour
+)
+
+// Event is a domain event read back from the outbox.
+type Event struct {
+	ID        int64
+	Type      EventType
+	GameID    string
+	Payload   json.RawMessage
+	CreatedAt time.Time
+}
+
+// Decode unmarshals the payload of the event, v should be a pointer to the [Payload] matching the event type.
+func (e Event) Decode(v Payload) error {
+	if v.EventType() != e.Type {
+		return fmt.Errorf("cannot decode event %s into %T", e.Type, v)
+	}
+	return json.Unmarshal(e.Payload, v)
+}
+
+// Publish records an event, the querier should be part of the transaction making the change
+// so that the event is only published if the change is committed.
+func Publish(ctx context.Context, querier db.Querier, gameID string, payload Payload) error {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s event: %w", payload.EventType(), err)
+	}
+
+	if _, err := querier.EventCreate(ctx, db.EventCreateParams{
+		EventType: string(payload.EventType()),
+		GameID:    gameID,
+		Payload:   string(b),
+	}); err != nil {
+		return fmt.Errorf("failed to record %s event: %w", payload.EventType(), err)
+	}
+
+	return nil
+}
+
+// Consumer reacts to events. Events are delivered at least once, so consumers should tolerate duplicates.
+type Consumer func(ctx context.Context, event Event) error
+
+type namedConsumer struct {
+	name     string
+	consumer Consumer
+}
+
+// Dispatcher delivers the events of the outbox to the registered consumers.
+// Each consumer receives an event until it succeeds, a failure doesn't redeliver the event to the consumers that succeeded.
+type Dispatcher struct {
+	querier db.QuerierWithTxSupport
+	clock   clock.Clock
+
+	mu        sync.RWMutex
+	consumers []namedConsumer
+}
+
+func NewDispatcher(querier db.QuerierWithTxSupport, clock clock.Clock) *Dispatcher {
+	return &Dispatcher{
+		querier: querier,
+		clock:   clock,
+	}
+}
+
+// Register adds a consumer, the name identifies its deliveries so it must stay stable across restarts.
+func (d *Dispatcher) Register(name string, consumer Consumer) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.consumers = append(d.consumers, namedConsumer{name: name, consumer: consumer})
+}
+
+// Run dispatches events every [pollInterval] until ctx is cancelled.
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(*pollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := d.Dispatch(ctx); err != nil {
+			log.FromCtx(ctx).ErrorContext(ctx, "Failed to dispatch events", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Dispatch delivers the pending events that are due, in the order they were published.
+// Failed deliveries are retried with an exponential backoff.
+func (d *Dispatcher) Dispatch(ctx context.Context) error {
+	events, err := d.querier.EventListPending(ctx, db.EventListPendingParams{
+		Now:   sql.NullTime{Time: d.clock.Now().UTC(), Valid: true},
+		Limit: int64(*batchSize),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list pending events: %w", err)
+	}
+
+	d.mu.RLock()
+	consumers := slices.Clone(d.consumers)
+	d.mu.RUnlock()
+
+	var errs []error
+	for _, event := range events {
+		if err := d.deliver(ctx, event, consumers); err != nil {
+			errs = append(errs, fmt.Errorf("event %d: %w", event.ID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (d *Dispatcher) deliver(ctx context.Context, dbEvent db.Event, consumers []namedConsumer) error {
+	delivered, err := d.querier.EventDeliveryListConsumers(ctx, dbEvent.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list deliveries: %w", err)
+	}
+
+	event := Event{
+		ID:        dbEvent.ID,
+		Type:      EventType(dbEvent.EventType),
+		GameID:    dbEvent.GameID,
+		Payload:   json.RawMessage(dbEvent.Payload),
+		CreatedAt: dbEvent.CreatedAt,
+	}
+
+	var consumerErrs []error
+	for _, c := range consumers {
+		if slices.Contains(delivered, c.name) {
+			continue
+		}
+
+		if err := c.consumer(ctx, event); err != nil {
+			consumerErrs = append(consumerErrs, fmt.Errorf("consumer %s: %w", c.name, err))
+			continue
+		}
+
+		if err := d.querier.EventDeliveryCreate(ctx, db.EventDeliveryCreateParams{
+			EventID:     event.ID,
+			Consumer:    c.name,
+			DeliveredAt: d.clock.Now(),
+		}); err != nil {
+			return fmt.Errorf("failed to record delivery to %s: %w", c.name, err)
+		}
+	}
+
+	now := d.clock.Now()
+	if len(consumerErrs) == 0 {
+		if err := d.querier.EventMarkDelivered(ctx, db.EventMarkDeliveredParams{
+			ID:          event.ID,
+			DeliveredAt: sql.NullTime{Time: now, Valid: true},
+		}); err != nil {
+			return fmt.Errorf("failed to mark event as delivered: %w", err)
+		}
+		return nil
+	}
+
+	consumerErr := errors.Join(consumerErrs...)
+	params := db.EventMarkAttemptFailedParams{
+		ID:            event.ID,
+		NextAttemptAt: sql.NullTime{Time: now.Add(backoff(dbEvent.Attempts + 1)).UTC(), Valid: true},
+		LastError:     sql.NullString{String: consumerErr.Error(), Valid: true},
+	}
+	if dbEvent.Attempts+1 >= int64(*maxAttempts) {
+		params.FailedAt = sql.NullTime{Time: now, Valid: true}
+		log.FromCtx(ctx).ErrorContext(ctx, "Giving up on delivering an event", "event_id", event.ID, "event_type", event.Type, "error", consumerErr)
+	}
+	if err := d.querier.EventMarkAttemptFailed(ctx, params); err != nil {
+		return fmt.Errorf("failed to record failed attempt: %w", err)
+	}
+
+	return consumerErr
+}
+
+// backoff returns how long to wait before the next attempt, doubling after each failed attempt.
+func backoff(attempts int64) time.Duration {
+	wait := initialBackoff
+	for range attempts - 1 {
+		wait *= 2
+		if wait >= maxBackoff {
+			return maxBackoff
+		}
+	}
+	return wait
+}
@@ -0,0 +1,208 @@
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"slices"
+	"testing"
+	"time"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/dmateusp/opengym/clock"
+	"github.com/dmateusp/opengym/db"
+	dbtesting "github.com/dmateusp/opengym/db/testing"
+	"github.com/dmateusp/opengym/outbox"
+)
+
+type recordingConsumer struct {
+	events []outbox.Event
+	err    error
+}
+
+func (c *recordingConsumer) consume(ctx context.Context, event outbox.Event) error {
+	if c.err != nil {
+		return c.err
+	}
+	c.events = append(c.events, event)
+	return nil
+}
+
+func TestDispatcher_DeliversInOrder(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+
+	testClock := &clock.StaticClock{Time: time.Date(2026, time.March, 2, 12, 0, 0, 0, time.UTC)}
+	querier := db.New(sqlDB)
+
+	if err := outbox.Publish(t.Context(), querier, "g1", outbox.ParticipantJoined{UserID: 1, Guests: 2}); err != nil {
+		t.Fatalf("failed to publish: %v", err)
+	}
+	if err := outbox.Publish(t.Context(), querier, "g1", outbox.ParticipantLeft{UserID: 1}); err != nil {
+		t.Fatalf("failed to publish: %v", err)
+	}
+
+	dispatcher := outbox.NewDispatcher(db.NewQuerierWrapper(querier), testClock)
+	consumer := &recordingConsumer{}
+	dispatcher.Register("test", consumer.consume)
+
+	if err := dispatcher.Dispatch(t.Context()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var types []outbox.EventType
+	for _, event := range consumer.events {
+		types = append(types, event.Type)
+	}
+	if want := []outbox.EventType{outbox.EventParticipantJoined, outbox.EventParticipantLeft}; !slices.Equal(types, want) {
+		t.Fatalf("expected %v, got %v", want, types)
+	}
+
+	var joined outbox.ParticipantJoined
+	if err := consumer.events[0].Decode(&joined); err != nil {
+		t.Fatalf("failed to decode: %v", err)
+	}
+	if joined.UserID != 1 || joined.Guests != 2 || consumer.events[0].GameID != "g1" {
+		t.Errorf("unexpected event %+v, payload %+v", consumer.events[0], joined)
+	}
+	if err := consumer.events[0].Decode(&outbox.ParticipantLeft{}); err == nil {
+		t.Errorf("expected an error when decoding into the wrong payload")
+	}
+
+	// delivered events aren't delivered again
+	if err := dispatcher.Dispatch(t.Context()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(consumer.events) != 2 {
+		t.Fatalf("expected events to be delivered once, got %d deliveries", len(consumer.events))
+	}
+}
+
+func TestDispatcher_RetriesFailedConsumersWithBackoff(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+
+	now := time.Date(2026, time.March, 2, 12, 0, 0, 0, time.UTC)
+	testClock := &clock.StaticClock{Time: now}
+	querier := db.New(sqlDB)
+
+	if err := outbox.Publish(t.Context(), querier, "g1", outbox.ParticipantPromoted{UserID: 1}); err != nil {
+		t.Fatalf("failed to publish: %v", err)
+	}
+
+	dispatcher := outbox.NewDispatcher(db.NewQuerierWrapper(querier), testClock)
+	healthy := &recordingConsumer{}
+	failing := &recordingConsumer{err: errors.New("smtp is down")}
+	dispatcher.Register("healthy", healthy.consume)
+	dispatcher.Register("failing", failing.consume)
+
+	if err := dispatcher.Dispatch(t.Context()); err == nil {
+		t.Fatalf("expected an error")
+	}
+
+	// the event isn't retried before the backoff
+	failing.err = nil
+	testClock.Time = now.Add(time.Second)
+	if err := dispatcher.Dispatch(t.Context()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(failing.events) != 0 {
+		t.Fatalf("expected the retry to wait for the backoff")
+	}
+
+	testClock.Time = now.Add(time.Minute)
+	if err := dispatcher.Dispatch(t.Context()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(failing.events) != 1 {
+		t.Fatalf("expected the failing consumer to receive the event after the backoff, got %d deliveries", len(failing.events))
+	}
+	if len(healthy.events) != 1 {
+		t.Fatalf("expected the healthy consumer to receive the event once, got %d deliveries", len(healthy.events))
+	}
+}
+
+func TestDispatcher_GivesUpAfterMaxAttempts(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+
+	now := time.Date(2026, time.March, 2, 12, 0, 0, 0, time.UTC)
+	testClock := &clock.StaticClock{Time: now}
+	querier := db.New(sqlDB)
+
+	if err := outbox.Publish(t.Context(), querier, "g1", outbox.ParticipantPromoted{UserID: 1}); err != nil {
+		t.Fatalf("failed to publish: %v", err)
+	}
+
+	dispatcher := outbox.NewDispatcher(db.NewQuerierWrapper(querier), testClock)
+	failing := &recordingConsumer{err: errors.New("smtp is down")}
+	dispatcher.Register("failing", failing.consume)
+
+	for range 20 {
+		_ = dispatcher.Dispatch(t.Context())
+		testClock.Time = testClock.Time.Add(2 * time.Hour)
+	}
+
+	var attempts int
+	var failed bool
+	if err := sqlDB.QueryRow("select attempts, failed_at is not null from events").Scan(&attempts, &failed); err != nil {
+		t.Fatalf("failed to read event: %v", err)
+	}
+	if attempts != 10 || !failed {
+		t.Fatalf("expected the dispatcher to give up after 10 attempts, got %d attempts (failed: %v)", attempts, failed)
+	}
+}
+
+func TestDispatcher_BackedOffEventsDontHoldBackNewerOnes(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+
+	setBatchSize(t, "outbox.batch-size", "2")
+
+	now := time.Date(2026, time.March, 2, 12, 0, 0, 0, time.UTC)
+	testClock := &clock.StaticClock{Time: now}
+	querier := db.New(sqlDB)
+
+	for range 3 {
+		if err := outbox.Publish(t.Context(), querier, "g1", outbox.ParticipantPromoted{UserID: 1}); err != nil {
+			t.Fatalf("failed to publish: %v", err)
+		}
+	}
+
+	dispatcher := outbox.NewDispatcher(db.NewQuerierWrapper(querier), testClock)
+	var delivered []string
+	dispatcher.Register("test", func(ctx context.Context, event outbox.Event) error {
+		if event.GameID == "g1" {
+			return errors.New("smtp is down")
+		}
+		delivered = append(delivered, event.GameID)
+		return nil
+	})
+
+	// every event of g1 is waiting for its backoff, more of them than a batch
+	for range 2 {
+		_ = dispatcher.Dispatch(t.Context())
+	}
+	if err := outbox.Publish(t.Context(), querier, "g2", outbox.ParticipantPromoted{UserID: 1}); err != nil {
+		t.Fatalf("failed to publish: %v", err)
+	}
+
+	testClock.Time = now.Add(time.Second)
+	if err := dispatcher.Dispatch(t.Context()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Equal(delivered, []string{"g2"}) {
+		t.Fatalf("expected the newer event to be delivered while the others back off, got %v", delivered)
+	}
+}
+
+func setBatchSize(t *testing.T, name, value string) {
+	t.Helper()
+
+	previous := flag.Lookup(name).Value.String()
+	if err := flag.Set(name, value); err != nil {
+		t.Fatalf("failed to set %s: %v", name, err)
+	}
+	t.Cleanup(func() { _ = flag.Set(name, previous) })
+}
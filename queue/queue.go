@@ -0,0 +1,98 @@
+// Package queue computes who is going to a game and who is on the waitlist,
+// from the participants in the order they queued.
+package queue
+
+type Status string
+
+const (
+	StatusGoing      Status = "going"
+	StatusWaitlisted Status = "waitlisted"
+	StatusNotGoing   Status = "not_going"
+)
+
+// Participant is a participation in a game.
+type Participant struct {
+	UserID int64
+	Going  bool
+	Guests int64
+}
+
+// Size is the number of spots the participant takes: themselves and their guests.
+func (p Participant) Size() int64 {
+	return 1 + max(p.Guests, 0)
+}
+
+// Entry is a participant with their place in the game.
+type Entry struct {
+	Participant
+	Status Status
+	// Position is the 1-based position of the participant among the ones going to the game,
+	// the main list and the waitlist included. It's 0 for participants who aren't going.
+	Position int
+}
+
+// Queue is the outcome of a game's queue.
+type Queue struct {
+	// Entries has an entry per participant, in the order they were given.
+	Entries []Entry
+	// Going is the number of spots taken in the main list, guests included.
+	Going int64
+	// Waitlisted is the number of spots taken on the waitlist, guests included.
+	Waitlisted int64
+	// SpotsLeft is the number of spots left in the main list.
+	SpotsLeft int64
+}
+
+// Compute places the participants, given in the order of the queue, in the main list of the game or on its waitlist.
+// Participants take their spots in order, and those whose group doesn't fit in the spots left go to the waitlist:
+// a smaller group queued after them may still fit. The waitlist is unlimited.
+func Compute(maxPlayers int64, participants []Participant) Queue {
+	q := Queue{Entries: make([]Entry, 0, len(participants))}
+	position := 0
+	for _, participant := range participants {
+		entry := Entry{Participant: participant, Status: StatusNotGoing}
+		if participant.Going {
+			position++
+			entry.Position = position
+			entry.Status = StatusWaitlisted
+			if q.Going+participant.Size() <= maxPlayers {
+				entry.Status = StatusGoing
+				q.Going += participant.Size()
+			} else {
+				q.Waitlisted += participant.Size()
+			}
+		}
+		q.Entries = append(q.Entries, entry)
+	}
+	q.SpotsLeft = max(maxPlayers-q.Going, 0)
+	return q
+}
+
+// Entry returns the entry of the user, it reports false when they never joined the game.
+func (q Queue) Entry(userID int64) (Entry, bool) {
+	for _, entry := range q.Entries {
+		if entry.UserID == userID {
+			return entry, true
+		}
+	}
+	return Entry{}, false
+}
+
+// Status returns the status of the user in the game, users who never joined aren't going.
+func (q Queue) Status(userID int64) Status {
+	if entry, ok := q.Entry(userID); ok {
+		return entry.Status
+	}
+	return StatusNotGoing
+}
+
+// Billable returns the participants in the main list, who share the price of the game.
+func (q Queue) Billable() []Entry {
+	var billable []Entry
+	for _, entry := range q.Entries {
+		if entry.Status == StatusGoing {
+			billable = append(billable, entry)
+		}
+	}
+	return billable
+}
@@ -0,0 +1,138 @@
+package queue
+
+import (
+	"math/rand"
+	"reflect"
+	"slices"
+	"testing"
+	"testing/quick"
+)
+
+func TestCompute(t *testing.T) {
+	participants := []Participant{
+		{UserID: 1, Going: true},            // organizer, queued first
+		{UserID: 2, Going: true, Guests: 2}, // fits
+		{UserID: 3, Going: false},           // left
+		{UserID: 4, Going: true, Guests: 1}, // doesn't fit
+		{UserID: 5, Going: true},            // fits in the last spot
+		{UserID: 6, Going: true},            // full
+	}
+
+	q := Compute(5, participants)
+
+	wantStatuses := []Status{StatusGoing, StatusGoing, StatusNotGoing, StatusWaitlisted, StatusGoing, StatusWaitlisted}
+	wantPositions := []int{1, 2, 0, 3, 4, 5}
+	for i, entry := range q.Entries {
+		if entry.Status != wantStatuses[i] || entry.Position != wantPositions[i] {
+			t.Errorf("participant %d: expected %s at position %d, got %s at position %d", entry.UserID, wantStatuses[i], wantPositions[i], entry.Status, entry.Position)
+		}
+	}
+	if q.Going != 5 || q.SpotsLeft != 0 || q.Waitlisted != 3 {
+		t.Errorf("expected 5 going, 3 waitlisted and no spots left, got %d going, %d waitlisted and %d spots left", q.Going, q.Waitlisted, q.SpotsLeft)
+	}
+	if got := q.Status(4); got != StatusWaitlisted {
+		t.Errorf("expected participant 4 to be waitlisted, got %s", got)
+	}
+	if got := q.Status(42); got != StatusNotGoing {
+		t.Errorf("expected unknown users not to be going, got %s", got)
+	}
+
+	var billable []int64
+	for _, entry := range q.Billable() {
+		billable = append(billable, entry.UserID)
+	}
+	if !slices.Equal(billable, []int64{1, 2, 5}) {
+		t.Errorf("expected participants 1, 2 and 5 to be billable, got %v", billable)
+	}
+}
+
+// game is a random game for property-based tests.
+type game struct {
+	MaxPlayers   int64
+	Participants []Participant
+}
+
+func (game) Generate(r *rand.Rand, size int) reflect.Value {
+	g := game{MaxPlayers: 1 + r.Int63n(20)}
+	for i := range r.Intn(size + 1) {
+		g.Participants = append(g.Participants, Participant{
+			UserID: int64(i + 1),
+			Going:  r.Intn(4) > 0,
+			Guests: r.Int63n(4),
+		})
+	}
+	return reflect.ValueOf(g)
+}
+
+func TestCompute_Invariants(t *testing.T) {
+	properties := map[string]func(g game) bool{
+		"never more than max players going": func(g game) bool {
+			q := Compute(g.MaxPlayers, g.Participants)
+			going := int64(0)
+			for _, entry := range q.Billable() {
+				going += entry.Size()
+			}
+			return going == q.Going && q.Going <= g.MaxPlayers
+		},
+		"every participant going is in the main list or on the waitlist": func(g game) bool {
+			q := Compute(g.MaxPlayers, g.Participants)
+			total := int64(0)
+			for _, participant := range g.Participants {
+				if participant.Going {
+					total += participant.Size()
+				}
+			}
+			return q.Going+q.Waitlisted == total
+		},
+		"spots left complete the main list": func(g game) bool {
+			q := Compute(g.MaxPlayers, g.Participants)
+			return q.SpotsLeft >= 0 && q.Going+q.SpotsLeft == g.MaxPlayers
+		},
+		"an entry per participant, in order": func(g game) bool {
+			q := Compute(g.MaxPlayers, g.Participants)
+			if len(q.Entries) != len(g.Participants) {
+				return false
+			}
+			for i, entry := range q.Entries {
+				if entry.Participant != g.Participants[i] {
+					return false
+				}
+			}
+			return true
+		},
+		"only participants going take a place": func(g game) bool {
+			q := Compute(g.MaxPlayers, g.Participants)
+			position := 0
+			for _, entry := range q.Entries {
+				if !entry.Going {
+					if entry.Status != StatusNotGoing || entry.Position != 0 {
+						return false
+					}
+					continue
+				}
+				position++
+				if entry.Status == StatusNotGoing || entry.Position != position {
+					return false
+				}
+			}
+			return true
+		},
+		"waitlisted participants don't fit in the spots left": func(g game) bool {
+			q := Compute(g.MaxPlayers, g.Participants)
+			for _, entry := range q.Entries {
+				if entry.Status == StatusWaitlisted && entry.Size() <= q.SpotsLeft {
+					return false
+				}
+			}
+			return true
+		},
+	}
+
+	for name, property := range properties {
+		t.Run(name, func(t *testing.T) {
+			if err := quick.Check(property, &quick.Config{MaxCount: 500}); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package recurrence
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Rule describes a weekly recurrence, e.g. "every Tuesday and Thursday at 19:00".
+// The time of day and location of Start are used for every occurrence, so daylight saving
+// changes don't shift the games.
+type Rule struct {
+	Start         time.Time      // first possible occurrence
+	IntervalWeeks int            // 1 = every week, 2 = every other week, ...
+	Weekdays      []time.Weekday // days of the week the rule fires on
+	Until         time.Time      // optional, zero value means no end date
+	Count         int            // optional, 0 means no limit on the number of occurrences
+}
+
+func (r Rule) Validate() error {
+	if r.Start.IsZero() {
+		return fmt.Errorf("start is required")
+	}
+	if r.IntervalWeeks < 1 {
+		return fmt.Errorf("interval must be at least 1 week")
+	}
+	if len(r.Weekdays) == 0 {
+		return fmt.Errorf("at least one weekday is required")
+	}
+	for _, weekday := range r.Weekdays {
+		if weekday < time.Sunday || weekday > time.Saturday {
+			return fmt.Errorf("invalid weekday %d", weekday)
+		}
+	}
+	if r.Count < 0 {
+		return fmt.Errorf("count cannot be negative")
+	}
+	if !r.Until.IsZero() && r.Until.Before(r.Start) {
+		return fmt.Errorf("until cannot be before start")
+	}
+	return nil
+}
+
+// Occurrences returns the occurrences of the rule that start within [from, to].
+// Occurrences before from still count towards Count.
+func (r Rule) Occurrences(from, to time.Time) []time.Time {
+	if r.Validate() != nil {
+		return nil
+	}
+
+	// Weeks start on Monday, like in most of the places opengym is used.
+	offsets := make([]int, 0, len(r.Weekdays))
+	for _, weekday := range r.Weekdays {
+		offsets = append(offsets, (int(weekday)+6)%7)
+	}
+	slices.Sort(offsets)
+	offsets = slices.Compact(offsets)
+
+	loc := r.Start.Location()
+	startOffset := (int(r.Start.Weekday()) + 6) % 7
+	weekStart := time.Date(r.Start.Year(), r.Start.Month(), r.Start.Day()-startOffset, r.Start.Hour(), r.Start.Minute(), r.Start.Second(), 0, loc)
+
+	var occurrences []time.Time
+	count := 0
+	for week := 0; ; week += r.IntervalWeeks {
+		for _, offset := range offsets {
+			occurrence := time.Date(weekStart.Year(), weekStart.Month(), weekStart.Day()+week*7+offset, weekStart.Hour(), weekStart.Minute(), weekStart.Second(), 0, loc)
+			if occurrence.Before(r.Start) {
+				continue
+			}
+			if occurrence.After(to) || (!r.Until.IsZero() && occurrence.After(r.Until)) {
+				return occurrences
+			}
+			count++
+			if r.Count > 0 && count > r.Count {
+				return occurrences
+			}
+			if !occurrence.Before(from) {
+				occurrences = append(occurrences, occurrence)
+			}
+		}
+	}
+}
+
+// FormatWeekdays serializes weekdays for storage, e.g. "2,4" for Tuesday and Thursday.
+func FormatWeekdays(weekdays []time.Weekday) string {
+	parts := make([]string, 0, len(weekdays))
+	for _, weekday := range weekdays {
+		parts = append(parts, strconv.Itoa(int(weekday)))
+	}
+	return strings.Join(parts, ",")
+}
+
+// ParseWeekdays is the inverse of [FormatWeekdays].
+func ParseWeekdays(s string) ([]time.Weekday, error) {
+	if s == "" {
+		return nil, nil
+	}
+	parts := strings.Split(s, ",")
+	weekdays := make([]time.Weekday, 0, len(parts))
+	for _, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid weekday %q: %w", part, err)
+		}
+		if n < int(time.Sunday) || n > int(time.Saturday) {
+			return nil, fmt.Errorf("invalid weekday %d", n)
+		}
+		weekdays = append(weekdays, time.Weekday(n))
+	}
+	return weekdays, nil
+}
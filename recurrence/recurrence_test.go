@@ -0,0 +1,114 @@
+package recurrence
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestRule_Occurrences(t *testing.T) {
+	lisbon, err := time.LoadLocation("Europe/Lisbon")
+	if err != nil {
+		t.Fatalf("failed to load location: %v", err)
+	}
+
+	// Tuesday
+	start := time.Date(2026, time.March, 3, 19, 0, 0, 0, lisbon)
+
+	t.Run("weekly on tuesdays and thursdays", func(t *testing.T) {
+		rule := Rule{Start: start, IntervalWeeks: 1, Weekdays: []time.Weekday{time.Thursday, time.Tuesday}}
+		got := rule.Occurrences(start, start.AddDate(0, 0, 14))
+		want := []time.Time{
+			time.Date(2026, time.March, 3, 19, 0, 0, 0, lisbon),
+			time.Date(2026, time.March, 5, 19, 0, 0, 0, lisbon),
+			time.Date(2026, time.March, 10, 19, 0, 0, 0, lisbon),
+			time.Date(2026, time.March, 12, 19, 0, 0, 0, lisbon),
+			time.Date(2026, time.March, 17, 19, 0, 0, 0, lisbon),
+		}
+		if !slices.EqualFunc(got, want, time.Time.Equal) {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("keeps the local time across daylight saving changes", func(t *testing.T) {
+		rule := Rule{Start: start, IntervalWeeks: 1, Weekdays: []time.Weekday{time.Tuesday}}
+		got := rule.Occurrences(start.AddDate(0, 0, 21), start.AddDate(0, 0, 28))
+		want := []time.Time{
+			time.Date(2026, time.March, 24, 19, 0, 0, 0, lisbon),
+			time.Date(2026, time.March, 31, 19, 0, 0, 0, lisbon),
+		}
+		if !slices.EqualFunc(got, want, time.Time.Equal) {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+		if got[0].UTC().Hour() == got[1].UTC().Hour() {
+			t.Errorf("expected the UTC hour to change across daylight saving, got %v and %v", got[0].UTC(), got[1].UTC())
+		}
+	})
+
+	t.Run("skips weekdays before the start in the first week", func(t *testing.T) {
+		rule := Rule{Start: start, IntervalWeeks: 1, Weekdays: []time.Weekday{time.Monday}}
+		got := rule.Occurrences(start, start.AddDate(0, 0, 7))
+		want := []time.Time{time.Date(2026, time.March, 9, 19, 0, 0, 0, lisbon)}
+		if !slices.EqualFunc(got, want, time.Time.Equal) {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("every other week", func(t *testing.T) {
+		rule := Rule{Start: start, IntervalWeeks: 2, Weekdays: []time.Weekday{time.Tuesday}}
+		got := rule.Occurrences(start, start.AddDate(0, 0, 28))
+		want := []time.Time{
+			time.Date(2026, time.March, 3, 19, 0, 0, 0, lisbon),
+			time.Date(2026, time.March, 17, 19, 0, 0, 0, lisbon),
+			time.Date(2026, time.March, 31, 19, 0, 0, 0, lisbon),
+		}
+		if !slices.EqualFunc(got, want, time.Time.Equal) {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("count includes occurrences before from", func(t *testing.T) {
+		rule := Rule{Start: start, IntervalWeeks: 1, Weekdays: []time.Weekday{time.Tuesday}, Count: 3}
+		got := rule.Occurrences(start.AddDate(0, 0, 1), start.AddDate(1, 0, 0))
+		want := []time.Time{
+			time.Date(2026, time.March, 10, 19, 0, 0, 0, lisbon),
+			time.Date(2026, time.March, 17, 19, 0, 0, 0, lisbon),
+		}
+		if !slices.EqualFunc(got, want, time.Time.Equal) {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+	})
+
+	t.Run("stops at until", func(t *testing.T) {
+		rule := Rule{Start: start, IntervalWeeks: 1, Weekdays: []time.Weekday{time.Tuesday}, Until: start.AddDate(0, 0, 7)}
+		got := rule.Occurrences(start, start.AddDate(1, 0, 0))
+		if len(got) != 2 {
+			t.Errorf("expected 2 occurrences, got %v", got)
+		}
+	})
+
+	t.Run("invalid rule has no occurrences", func(t *testing.T) {
+		rule := Rule{Start: start, IntervalWeeks: 0, Weekdays: []time.Weekday{time.Tuesday}}
+		if got := rule.Occurrences(start, start.AddDate(1, 0, 0)); len(got) != 0 {
+			t.Errorf("expected no occurrences, got %v", got)
+		}
+	})
+}
+
+func TestWeekdays_RoundTrip(t *testing.T) {
+	weekdays := []time.Weekday{time.Tuesday, time.Thursday}
+	formatted := FormatWeekdays(weekdays)
+	if formatted != "2,4" {
+		t.Fatalf("expected %q, got %q", "2,4", formatted)
+	}
+	parsed, err := ParseWeekdays(formatted)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Equal(parsed, weekdays) {
+		t.Errorf("expected %v, got %v", weekdays, parsed)
+	}
+	if _, err := ParseWeekdays("9"); err == nil {
+		t.Errorf("expected an error for an invalid weekday")
+	}
+}
@@ -0,0 +1,222 @@
+package scheduler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"flag"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dmateusp/opengym/clock"
+	"github.com/dmateusp/opengym/db"
+	"github.com/dmateusp/opengym/log"
+)
+
+var (
+	interval = flag.Duration("scheduler.interval", 30*time.Second, "How often the scheduler checks for game lifecycle events to fire")
+	maxDelay = flag.Duration("scheduler.max-delay", 24*time.Hour, "Events that are due for longer than this (e.g. the server was down) are recorded without running their handlers, unless they settle the game like drawing lotteries, releasing unconfirmed spots or expiring waitlist offers")
+)
+
+type EventType string
+
+const (
+	EventGamePublished EventType = "game_published"
+	EventGameFrozen    EventType = "game_frozen"
+	EventGameStarted   EventType = "game_started"
+	EventGameEnded     EventType = "game_ended"
+
+	// EventRegistrationClosed fires when the registration of a game closes, e.g. to draw lottery games.
+	EventRegistrationClosed EventType = "registration_closed"
+	// EventReconfirmationDue fires when the participants asked to reconfirm at the same moment had to answer by.
+	EventReconfirmationDue EventType = "reconfirmation_due"
+	// EventWaitlistOfferExpired fires when the pending waitlist offers of a game expire, at their expiry.
+	EventWaitlistOfferExpired EventType = "waitlist_offer_expired"
+)
+
+// settlingEvents change the game rather than announce it, their handlers run however overdue they are,
+// e.g. the spot of an offer that expired while the server was down still moves on.
+var settlingEvents = []EventType{EventRegistrationClosed, EventReconfirmationDue, EventWaitlistOfferExpired}
+
+// Event is a moment in the lifecycle of a game, it's fired once the game reaches it.
+type Event struct {
+	Type EventType
+	Game db.Game
+	At   time.Time // when the event was due, it can be earlier than when it fired
+}
+
+// Handler reacts to an event. The querier runs in the same transaction that records the event,
+// so if the handler returns an error nothing is persisted and the event is retried on the next tick.
+type Handler func(ctx context.Context, querier db.QuerierWithTxSupport, event Event) error
+
+// Scheduler fires the lifecycle events of games exactly once per moment, recording its progress in the database
+// so a restart doesn't replay or miss events. A game moved to another moment, e.g. postponed, fires the events of its new moment.
+type Scheduler struct {
+	querier db.QuerierWithTxSupport
+	dbConn  *sql.DB
+	clock   clock.Clock
+
+	mu       sync.RWMutex
+	handlers map[EventType][]Handler
+}
+
+func New(querier db.QuerierWithTxSupport, dbConn *sql.DB, clock clock.Clock) *Scheduler {
+	return &Scheduler{
+		querier:  querier,
+		dbConn:   dbConn,
+		clock:    clock,
+		handlers: make(map[EventType][]Handler),
+	}
+}
+
+// On registers a handler for an event type, handlers run in the order they were registered.
+func (s *Scheduler) On(eventType EventType, handler Handler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers[eventType] = append(s.handlers[eventType], handler)
+}
+
+// Run fires the due events every [interval] until ctx is cancelled.
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(*interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.Tick(ctx); err != nil {
+			log.FromCtx(ctx).ErrorContext(ctx, "Failed to fire game lifecycle events", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Tick fires every event that is due and hasn't fired yet, oldest first.
+func (s *Scheduler) Tick(ctx context.Context) error {
+	now := s.clock.Now()
+
+	rows, err := s.querier.GameListWithPendingLifecycleEvents(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list games with pending lifecycle events: %w", err)
+	}
+
+	var due []Event
+	for _, row := range rows {
+		fired := strings.Split(row.FiredEventTypes, ",")
+		for _, event := range dueEvents(row.Game, now) {
+			if !slices.Contains(fired, string(event.Type)) {
+				due = append(due, event)
+			}
+		}
+	}
+
+	reconfirmations, err := s.querier.GameListWithPendingReconfirmations(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list games with pending reconfirmations: %w", err)
+	}
+	for _, row := range reconfirmations {
+		dueAt := row.ReconfirmRequestedAt.Time.Add(time.Duration(row.Game.ReconfirmWithinMinutes) * time.Minute)
+		if !dueAt.After(now) {
+			due = append(due, Event{Type: EventReconfirmationDue, Game: row.Game, At: dueAt})
+		}
+	}
+
+	offers, err := s.querier.GameListWithExpiredWaitlistOffers(ctx, now.UTC())
+	if err != nil {
+		return fmt.Errorf("failed to list games with expired waitlist offers: %w", err)
+	}
+	for _, row := range offers {
+		due = append(due, Event{Type: EventWaitlistOfferExpired, Game: row.Game, At: row.ExpiresAt})
+	}
+	slices.SortStableFunc(due, func(a, b Event) int {
+		return a.At.Compare(b.At)
+	})
+
+	var errs []error
+	for _, event := range due {
+		if err := s.fire(ctx, event, now); err != nil {
+			errs = append(errs, fmt.Errorf("game %s, event %s: %w", event.Game.ID, event.Type, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *Scheduler) fire(ctx context.Context, event Event, now time.Time) error {
+	tx, err := s.dbConn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	querierWithTx := s.querier.WithTx(tx)
+
+	// the handlers of an event that is overdue, e.g. the server was down, are skipped, the event is recorded as such
+	overdue := now.Sub(event.At) > *maxDelay && !slices.Contains(settlingEvents, event.Type)
+	inserted, err := querierWithTx.GameLifecycleEventCreate(ctx, db.GameLifecycleEventCreateParams{
+		GameID:          event.Game.ID,
+		EventType:       string(event.Type),
+		DueAt:           event.At,
+		FiredAt:         now,
+		HandlersSkipped: overdue,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record event: %w", err)
+	}
+	if inserted == 0 {
+		// another scheduler fired it in the meantime
+		return nil
+	}
+
+	if overdue {
+		log.FromCtx(ctx).WarnContext(ctx, "Skipping the handlers of a game lifecycle event that is overdue",
+			"game_id", event.Game.ID, "event_type", event.Type, "due_at", event.At)
+	} else {
+		s.mu.RLock()
+		handlers := slices.Clone(s.handlers[event.Type])
+		s.mu.RUnlock()
+
+		for _, handler := range handlers {
+			if err := handler(ctx, querierWithTx, event); err != nil {
+				return fmt.Errorf("handler failed: %w", err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	log.FromCtx(ctx).InfoContext(ctx, "Fired game lifecycle event", "game_id", event.Game.ID, "event_type", event.Type)
+	return nil
+}
+
+// dueEvents returns the events of the game that are due at now, whether they fired or not.
+func dueEvents(game db.Game, now time.Time) []Event {
+	var events []Event
+	if game.PublishedAt.Valid && !game.PublishedAt.Time.After(now) {
+		events = append(events, Event{Type: EventGamePublished, Game: game, At: game.PublishedAt.Time})
+	}
+	if game.FrozenAt.Valid && !game.FrozenAt.Time.After(now) {
+		events = append(events, Event{Type: EventGameFrozen, Game: game, At: game.FrozenAt.Time})
+	}
+	if game.RegistrationClosesAt.Valid && !game.RegistrationClosesAt.Time.After(now) {
+		events = append(events, Event{Type: EventRegistrationClosed, Game: game, At: game.RegistrationClosesAt.Time})
+	}
+	if game.StartsAt.Valid {
+		if !game.StartsAt.Time.After(now) {
+			events = append(events, Event{Type: EventGameStarted, Game: game, At: game.StartsAt.Time})
+		}
+		endsAt := game.StartsAt.Time.Add(time.Duration(game.DurationMinutes) * time.Minute)
+		if !endsAt.After(now) {
+			events = append(events, Event{Type: EventGameEnded, Game: game, At: endsAt})
+		}
+	}
+	return events
+}
@@ -0,0 +1,319 @@
+package scheduler_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/dmateusp/opengym/clock"
+	"github.com/dmateusp/opengym/db"
+	dbtesting "github.com/dmateusp/opengym/db/testing"
+	"github.com/dmateusp/opengym/scheduler"
+)
+
+func createGame(t *testing.T, querier *db.Queries, id string, organizerID int64, publishedAt, startsAt time.Time) {
+	t.Helper()
+
+	_, err := querier.GameCreate(context.Background(), db.GameCreateParams{
+		ID:                 id,
+		OrganizerID:        organizerID,
+		Name:               "Test Game",
+		PublishedAt:        sql.NullTime{Time: publishedAt, Valid: true},
+		StartsAt:           sql.NullTime{Time: startsAt, Valid: true},
+		DurationMinutes:    60,
+		MaxPlayers:         10,
+		MaxGuestsPerPlayer: 0,
+		GameSpotsLeft:      10,
+	})
+	if err != nil {
+		t.Fatalf("failed to create game: %v", err)
+	}
+}
+
+type recorder struct {
+	events []string
+	err    error
+}
+
+func (r *recorder) register(s *scheduler.Scheduler) {
+	for _, eventType := range []scheduler.EventType{
+		scheduler.EventGamePublished,
+		scheduler.EventGameFrozen,
+		scheduler.EventGameStarted,
+		scheduler.EventGameEnded,
+	} {
+		s.On(eventType, func(ctx context.Context, querier db.QuerierWithTxSupport, event scheduler.Event) error {
+			if r.err != nil {
+				return r.err
+			}
+			r.events = append(r.events, event.Game.ID+":"+string(event.Type))
+			return nil
+		})
+	}
+}
+
+func TestScheduler_Tick(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+
+	now := time.Date(2026, time.March, 2, 12, 0, 0, 0, time.UTC)
+	testClock := &clock.StaticClock{Time: now}
+
+	userID := dbtesting.UpsertTestUser(t, sqlDB, "organizer@example.com")
+	querier := db.New(sqlDB)
+	createGame(t, querier, "g1", userID, now.Add(-time.Hour), now.Add(2*time.Hour))
+
+	s := scheduler.New(db.NewQuerierWrapper(querier), sqlDB, testClock)
+	rec := &recorder{}
+	rec.register(s)
+
+	if err := s.Tick(t.Context()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []string{"g1:game_published"}; !slices.Equal(rec.events, want) {
+		t.Fatalf("expected %v, got %v", want, rec.events)
+	}
+
+	// nothing new is due
+	if err := s.Tick(t.Context()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(rec.events) != 1 {
+		t.Fatalf("expected events to fire once, got %v", rec.events)
+	}
+
+	testClock.Time = now.Add(4 * time.Hour)
+	if err := s.Tick(t.Context()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []string{"g1:game_published", "g1:game_started", "g1:game_ended"}; !slices.Equal(rec.events, want) {
+		t.Fatalf("expected %v, got %v", want, rec.events)
+	}
+
+	// the game isn't frozen, so every event that applies to it fired
+	rows, err := querier.GameListWithPendingLifecycleEvents(t.Context())
+	if err != nil {
+		t.Fatalf("failed to list games: %v", err)
+	}
+	if len(rows) != 0 {
+		t.Fatalf("expected no game with pending events, got %+v", rows)
+	}
+}
+
+func TestScheduler_Tick_FrozenGame(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+
+	now := time.Date(2026, time.March, 2, 12, 0, 0, 0, time.UTC)
+	testClock := &clock.StaticClock{Time: now}
+
+	userID := dbtesting.UpsertTestUser(t, sqlDB, "organizer@example.com")
+	querier := db.New(sqlDB)
+	createGame(t, querier, "g1", userID, now.Add(-2*time.Hour), now.Add(2*time.Hour))
+	if err := querier.GameUpdate(t.Context(), db.GameUpdateParams{
+		ID:       "g1",
+		FrozenAt: sql.NullTime{Time: now.Add(-time.Hour), Valid: true},
+	}); err != nil {
+		t.Fatalf("failed to freeze game: %v", err)
+	}
+
+	s := scheduler.New(db.NewQuerierWrapper(querier), sqlDB, testClock)
+	rec := &recorder{}
+	rec.register(s)
+
+	if err := s.Tick(t.Context()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []string{"g1:game_published", "g1:game_frozen"}; !slices.Equal(rec.events, want) {
+		t.Fatalf("expected %v, got %v", want, rec.events)
+	}
+}
+
+func TestScheduler_Tick_RetriesFailedHandlers(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+
+	now := time.Date(2026, time.March, 2, 12, 0, 0, 0, time.UTC)
+	testClock := &clock.StaticClock{Time: now}
+
+	userID := dbtesting.UpsertTestUser(t, sqlDB, "organizer@example.com")
+	querier := db.New(sqlDB)
+	createGame(t, querier, "g1", userID, now.Add(-time.Hour), now.Add(2*time.Hour))
+
+	s := scheduler.New(db.NewQuerierWrapper(querier), sqlDB, testClock)
+	rec := &recorder{err: errors.New("boom")}
+	rec.register(s)
+
+	if err := s.Tick(t.Context()); err == nil {
+		t.Fatalf("expected an error")
+	}
+
+	rec.err = nil
+	if err := s.Tick(t.Context()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []string{"g1:game_published"}; !slices.Equal(rec.events, want) {
+		t.Fatalf("expected %v, got %v", want, rec.events)
+	}
+}
+
+func TestScheduler_Tick_DoesNotReplayAfterRestart(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+
+	now := time.Date(2026, time.March, 2, 12, 0, 0, 0, time.UTC)
+	testClock := &clock.StaticClock{Time: now}
+
+	userID := dbtesting.UpsertTestUser(t, sqlDB, "organizer@example.com")
+	querier := db.New(sqlDB)
+	createGame(t, querier, "g1", userID, now.Add(-time.Hour), now.Add(2*time.Hour))
+
+	first := scheduler.New(db.NewQuerierWrapper(querier), sqlDB, testClock)
+	firstRec := &recorder{}
+	firstRec.register(first)
+	if err := first.Tick(t.Context()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	testClock.Time = now.Add(2 * time.Hour)
+	second := scheduler.New(db.NewQuerierWrapper(querier), sqlDB, testClock)
+	secondRec := &recorder{}
+	secondRec.register(second)
+	if err := second.Tick(t.Context()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []string{"g1:game_started"}; !slices.Equal(secondRec.events, want) {
+		t.Fatalf("expected %v, got %v", want, secondRec.events)
+	}
+}
+
+func TestScheduler_Tick_SkipsOverdueHandlers(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+
+	now := time.Date(2026, time.March, 2, 12, 0, 0, 0, time.UTC)
+	testClock := &clock.StaticClock{Time: now}
+
+	userID := dbtesting.UpsertTestUser(t, sqlDB, "organizer@example.com")
+	querier := db.New(sqlDB)
+	createGame(t, querier, "g1", userID, now.AddDate(0, 0, -7), now.Add(2*time.Hour))
+
+	s := scheduler.New(db.NewQuerierWrapper(querier), sqlDB, testClock)
+	rec := &recorder{}
+	rec.register(s)
+
+	if err := s.Tick(t.Context()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(rec.events) != 0 {
+		t.Fatalf("expected overdue events to skip their handlers, got %v", rec.events)
+	}
+
+	var count int
+	if err := sqlDB.QueryRow("select count(*) from game_lifecycle_events where game_id = 'g1' and handlers_skipped").Scan(&count); err != nil {
+		t.Fatalf("failed to count events: %v", err)
+	}
+	if count != 1 {
+		t.Fatalf("expected the overdue event to be recorded with its handlers skipped, got %d events", count)
+	}
+}
+
+func TestScheduler_Tick_FiresAgainForANewMoment(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+
+	now := time.Date(2026, time.March, 2, 12, 0, 0, 0, time.UTC)
+	testClock := &clock.StaticClock{Time: now}
+
+	userID := dbtesting.UpsertTestUser(t, sqlDB, "organizer@example.com")
+	querier := db.New(sqlDB)
+	createGame(t, querier, "g1", userID, now.Add(-2*time.Hour), now.Add(-time.Hour))
+
+	s := scheduler.New(db.NewQuerierWrapper(querier), sqlDB, testClock)
+	rec := &recorder{}
+	rec.register(s)
+
+	if err := s.Tick(t.Context()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []string{"g1:game_published", "g1:game_started", "g1:game_ended"}; !slices.Equal(rec.events, want) {
+		t.Fatalf("expected %v, got %v", want, rec.events)
+	}
+
+	// the game is postponed to tomorrow
+	if err := querier.GameUpdate(t.Context(), db.GameUpdateParams{
+		ID:       "g1",
+		StartsAt: sql.NullTime{Time: now.AddDate(0, 0, 1), Valid: true},
+	}); err != nil {
+		t.Fatalf("failed to postpone game: %v", err)
+	}
+	if err := s.Tick(t.Context()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(rec.events) != 3 {
+		t.Fatalf("expected nothing to fire before the new start, got %v", rec.events)
+	}
+
+	testClock.Time = now.AddDate(0, 0, 1).Add(2 * time.Hour)
+	if err := s.Tick(t.Context()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := []string{"g1:game_published", "g1:game_started", "g1:game_ended", "g1:game_started", "g1:game_ended"}; !slices.Equal(rec.events, want) {
+		t.Fatalf("expected %v, got %v", want, rec.events)
+	}
+
+	// what fired for the previous start is kept
+	var count int
+	if err := sqlDB.QueryRow("select count(*) from game_lifecycle_events where game_id = 'g1'").Scan(&count); err != nil {
+		t.Fatalf("failed to count events: %v", err)
+	}
+	if count != 5 {
+		t.Fatalf("expected 5 recorded events, got %d", count)
+	}
+}
+
+func TestScheduler_Tick_RunsOverdueSettlingEvents(t *testing.T) {
+	sqlDB := dbtesting.SetupTestDB(t)
+	defer sqlDB.Close()
+
+	now := time.Date(2026, time.March, 2, 12, 0, 0, 0, time.UTC)
+	testClock := &clock.StaticClock{Time: now}
+
+	userID := dbtesting.UpsertTestUser(t, sqlDB, "organizer@example.com")
+	querier := db.New(sqlDB)
+	createGame(t, querier, "g1", userID, now.AddDate(0, 0, -14), now.AddDate(0, 0, 7))
+	if _, err := querier.WaitlistOfferCreate(t.Context(), db.WaitlistOfferCreateParams{
+		GameID:    "g1",
+		UserID:    userID,
+		ExpiresAt: now.AddDate(0, 0, -7),
+	}); err != nil {
+		t.Fatalf("failed to create waitlist offer: %v", err)
+	}
+
+	s := scheduler.New(db.NewQuerierWrapper(querier), sqlDB, testClock)
+	var expired []time.Time
+	s.On(scheduler.EventWaitlistOfferExpired, func(ctx context.Context, querier db.QuerierWithTxSupport, event scheduler.Event) error {
+		expired = append(expired, event.At)
+		return nil
+	})
+
+	if err := s.Tick(t.Context()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(expired) != 1 || !expired[0].Equal(now.AddDate(0, 0, -7)) {
+		t.Fatalf("expected the overdue offer to expire, got %v", expired)
+	}
+
+	// the moment fired, it isn't listed again
+	if err := s.Tick(t.Context()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(expired) != 1 {
+		t.Fatalf("expected the offer to expire once, got %v", expired)
+	}
+}
@@ -0,0 +1,142 @@
+// Package sepa validates the details of SEPA bank accounts, and builds the EPC069-12 QR code payloads
+// banking apps scan to prefill a SEPA credit transfer.
+package sepa
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+	"unicode/utf8"
+)
+
+// NormalizeIBAN removes the spaces of an IBAN and uppercases it, the way it's printed in QR codes.
+func NormalizeIBAN(iban string) string {
+	return strings.ToUpper(strings.Join(strings.Fields(iban), ""))
+}
+
+// ValidateIBAN checks the format and the check digits of a normalized IBAN.
+func ValidateIBAN(iban string) error {
+	if len(iban) < 15 || len(iban) > 34 {
+		return fmt.Errorf("IBAN must be between 15 and 34 characters")
+	}
+	for i, r := range iban {
+		switch {
+		case i < 2 && !isUpper(r):
+			return fmt.Errorf("IBAN must start with a country code")
+		case i >= 2 && i < 4 && !isDigit(r):
+			return fmt.Errorf("IBAN must have check digits after the country code")
+		case !isUpper(r) && !isDigit(r):
+			return fmt.Errorf("IBAN can only contain letters and digits")
+		}
+	}
+
+	// the country code and check digits move to the end, letters become numbers from 10 to 35
+	var digits strings.Builder
+	for _, r := range iban[4:] + iban[:4] {
+		if isDigit(r) {
+			digits.WriteRune(r)
+		} else {
+			fmt.Fprintf(&digits, "%d", r-'A'+10)
+		}
+	}
+	n, _ := new(big.Int).SetString(digits.String(), 10)
+	if new(big.Int).Mod(n, big.NewInt(97)).Int64() != 1 {
+		return fmt.Errorf("IBAN check digits don't match")
+	}
+	return nil
+}
+
+// ValidateBIC checks the format of a BIC: a bank code, a country code, a location code and optionally a branch code.
+func ValidateBIC(bic string) error {
+	if len(bic) != 8 && len(bic) != 11 {
+		return fmt.Errorf("BIC must be 8 or 11 characters")
+	}
+	for i, r := range bic {
+		if i < 6 && !isUpper(r) {
+			return fmt.Errorf("BIC must start with a bank code and a country code")
+		}
+		if !isUpper(r) && !isDigit(r) {
+			return fmt.Errorf("BIC can only contain uppercase letters and digits")
+		}
+	}
+	return nil
+}
+
+// CreditTransfer is a SEPA credit transfer to prefill in a banking app.
+type CreditTransfer struct {
+	// BIC of the beneficiary's bank, optional within the EEA.
+	BIC string
+	// Name of the beneficiary.
+	Name string
+	// IBAN of the beneficiary, normalized.
+	IBAN string
+	// AmountCents is the amount in euro cents, 0 lets the payer fill it in.
+	AmountCents int64
+	// Remittance is the unstructured remittance information the beneficiary sees on their statement.
+	Remittance string
+}
+
+const (
+	maxNameLength       = 70
+	maxRemittanceLength = 140
+	maxAmountCents      = 999_999_999_99
+	maxPayloadBytes     = 331
+)
+
+// EPCQRPayload returns the text to encode in an EPC069-12 QR code, version 002 in UTF-8.
+func (t CreditTransfer) EPCQRPayload() (string, error) {
+	if t.Name == "" || utf8.RuneCountInString(t.Name) > maxNameLength {
+		return "", fmt.Errorf("beneficiary name must be between 1 and %d characters", maxNameLength)
+	}
+	if err := ValidateIBAN(t.IBAN); err != nil {
+		return "", err
+	}
+	if t.BIC != "" {
+		if err := ValidateBIC(t.BIC); err != nil {
+			return "", err
+		}
+	}
+	if t.AmountCents < 0 || t.AmountCents > maxAmountCents {
+		return "", fmt.Errorf("amount must be between 0.01 and 999999999.99 euros")
+	}
+	if utf8.RuneCountInString(t.Remittance) > maxRemittanceLength {
+		return "", fmt.Errorf("remittance information cannot exceed %d characters", maxRemittanceLength)
+	}
+
+	var amount string
+	if t.AmountCents > 0 {
+		amount = fmt.Sprintf("EUR%d.%02d", t.AmountCents/100, t.AmountCents%100)
+	}
+
+	lines := []string{
+		"BCD", // service tag
+		"002", // version
+		"1",   // UTF-8
+		"SCT", // SEPA credit transfer
+		t.BIC,
+		t.Name,
+		t.IBAN,
+		amount,
+		"", // purpose
+		"", // structured remittance reference, exclusive with the unstructured one
+		t.Remittance,
+	}
+	// trailing empty elements are left out
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	payload := strings.Join(lines, "\n")
+	if len(payload) > maxPayloadBytes {
+		return "", fmt.Errorf("payload cannot exceed %d bytes", maxPayloadBytes)
+	}
+	return payload, nil
+}
+
+func isUpper(r rune) bool {
+	return r >= 'A' && r <= 'Z'
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
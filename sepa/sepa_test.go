@@ -0,0 +1,112 @@
+package sepa
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateIBAN(t *testing.T) {
+	valid := []string{"DE89 3704 0044 0532 0130 00", "GB82 WEST 1234 5698 7654 32", "nl91abna0417164300", "PT50000201231234567890154"}
+	for _, iban := range valid {
+		if err := ValidateIBAN(NormalizeIBAN(iban)); err != nil {
+			t.Errorf("expected %q to be valid, got %v", iban, err)
+		}
+	}
+
+	invalid := []string{
+		"DE89 3704 0044 0532 0130 01", // wrong check digits
+		"DE89 3704",                   // too short
+		"1289 3704 0044 0532 0130 00", // no country code
+		"DE89-3704-0044-0532-0130-00", // not alphanumeric
+	}
+	for _, iban := range invalid {
+		if err := ValidateIBAN(NormalizeIBAN(iban)); err == nil {
+			t.Errorf("expected %q to be invalid", iban)
+		}
+	}
+}
+
+func TestValidateBIC(t *testing.T) {
+	for _, bic := range []string{"DEUTDEFF", "DEUTDEFF500", "BCOMPTPL"} {
+		if err := ValidateBIC(bic); err != nil {
+			t.Errorf("expected %q to be valid, got %v", bic, err)
+		}
+	}
+	for _, bic := range []string{"DEUTDE", "deutdeff", "DEU1DEFF", "DEUTDEFF5"} {
+		if err := ValidateBIC(bic); err == nil {
+			t.Errorf("expected %q to be invalid", bic)
+		}
+	}
+}
+
+func TestCreditTransfer_EPCQRPayload(t *testing.T) {
+	tests := []struct {
+		name     string
+		transfer CreditTransfer
+		want     string
+	}{
+		{
+			name: "every element",
+			transfer: CreditTransfer{
+				BIC:         "DEUTDEFF",
+				Name:        "Jane Doe",
+				IBAN:        "DE89370400440532013000",
+				AmountCents: 1250,
+				Remittance:  "g1 Bb22",
+			},
+			want: "BCD\n002\n1\nSCT\nDEUTDEFF\nJane Doe\nDE89370400440532013000\nEUR12.50\n\n\ng1 Bb22",
+		},
+		{
+			name: "without a BIC and an amount",
+			transfer: CreditTransfer{
+				Name:       "Jane Doe",
+				IBAN:       "DE89370400440532013000",
+				Remittance: "g1 Bb22",
+			},
+			want: "BCD\n002\n1\nSCT\n\nJane Doe\nDE89370400440532013000\n\n\n\ng1 Bb22",
+		},
+		{
+			name: "trailing empty elements are left out",
+			transfer: CreditTransfer{
+				Name:        "Jane Doe",
+				IBAN:        "DE89370400440532013000",
+				AmountCents: 5,
+			},
+			want: "BCD\n002\n1\nSCT\n\nJane Doe\nDE89370400440532013000\nEUR0.05",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.transfer.EPCQRPayload()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != test.want {
+				t.Errorf("expected %q, got %q", test.want, got)
+			}
+		})
+	}
+}
+
+func TestCreditTransfer_EPCQRPayload_Errors(t *testing.T) {
+	valid := CreditTransfer{Name: "Jane Doe", IBAN: "DE89370400440532013000", AmountCents: 1250}
+
+	tests := map[string]func(*CreditTransfer){
+		"no name":             func(t *CreditTransfer) { t.Name = "" },
+		"name too long":       func(t *CreditTransfer) { t.Name = strings.Repeat("a", 71) },
+		"invalid IBAN":        func(t *CreditTransfer) { t.IBAN = "DE89370400440532013001" },
+		"invalid BIC":         func(t *CreditTransfer) { t.BIC = "DEUT" },
+		"negative amount":     func(t *CreditTransfer) { t.AmountCents = -1 },
+		"remittance too long": func(t *CreditTransfer) { t.Remittance = strings.Repeat("a", 141) },
+	}
+	for name, modify := range tests {
+		t.Run(name, func(t *testing.T) {
+			transfer := valid
+			modify(&transfer)
+			if _, err := transfer.EPCQRPayload(); err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+}
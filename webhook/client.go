@@ -0,0 +1,67 @@
+package webhook
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+)
+
+// ErrForbiddenAddress is returned when a webhook resolves to an address of the server's network.
+var ErrForbiddenAddress = errors.New("webhooks can't be delivered to loopback, private or link-local addresses")
+
+// sharedAddressSpace is the carrier-grade NAT range, it isn't reachable from the internet either.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// IsAllowedAddress reports whether deliveries can be sent to the address, only addresses reachable from the internet are.
+func IsAllowedAddress(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsValid() &&
+		!addr.IsUnspecified() &&
+		!addr.IsLoopback() &&
+		!addr.IsPrivate() &&
+		!addr.IsLinkLocalUnicast() &&
+		!addr.IsLinkLocalMulticast() &&
+		!addr.IsInterfaceLocalMulticast() &&
+		!addr.IsMulticast() &&
+		!sharedAddressSpace.Contains(addr)
+}
+
+// NewClient returns the HTTP client to send deliveries with. Organizers choose the URLs of their webhooks,
+// so the client only connects to addresses reachable from the internet, checked once the host is resolved
+// so a DNS record can't point it back to the server's network, and it doesn't follow redirects.
+func NewClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout: 10 * time.Second,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return fmt.Errorf("invalid address %q: %w", address, err)
+			}
+			addr, err := netip.ParseAddr(host)
+			if err != nil {
+				return fmt.Errorf("invalid address %q: %w", address, err)
+			}
+			if !IsAllowedAddress(addr) {
+				return fmt.Errorf("%w: %s", ErrForbiddenAddress, addr)
+			}
+			return nil
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = dialer.DialContext
+	// a proxy would connect to the webhook on behalf of the client, without the check
+	transport.Proxy = nil
+
+	return &http.Client{
+		Transport: transport,
+		// the redirect is returned as the response, which fails the delivery since it isn't a 2xx
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
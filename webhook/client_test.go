@@ -0,0 +1,62 @@
+package webhook_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"github.com/dmateusp/opengym/webhook"
+)
+
+func TestIsAllowedAddress(t *testing.T) {
+	for _, addr := range []string{"93.184.216.34", "2606:2800:220:1:248:1893:25c8:1946"} {
+		if !webhook.IsAllowedAddress(netip.MustParseAddr(addr)) {
+			t.Errorf("expected %s to be allowed", addr)
+		}
+	}
+
+	forbidden := []string{
+		"127.0.0.1",
+		"::1",
+		"10.0.0.1",
+		"172.16.0.1",
+		"192.168.1.1",
+		"169.254.169.254", // cloud metadata
+		"fe80::1",
+		"fd00::1",
+		"0.0.0.0",
+		"100.64.0.1",
+		"::ffff:127.0.0.1",
+	}
+	for _, addr := range forbidden {
+		if webhook.IsAllowedAddress(netip.MustParseAddr(addr)) {
+			t.Errorf("expected %s to be forbidden", addr)
+		}
+	}
+}
+
+func TestNewClient_RefusesLoopback(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	}))
+	defer server.Close()
+
+	resp, err := webhook.NewClient().Post(server.URL, "application/json", nil)
+	if err == nil {
+		resp.Body.Close()
+		t.Fatalf("expected the connection to be refused")
+	}
+	if !errors.Is(err, webhook.ErrForbiddenAddress) || requests != 0 {
+		t.Fatalf("expected the loopback address to be refused, got %v after %d requests", err, requests)
+	}
+}
+
+func TestNewClient_DoesntFollowRedirects(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "https://example.com/hook", nil)
+	if err := webhook.NewClient().CheckRedirect(req, []*http.Request{req}); !errors.Is(err, http.ErrUseLastResponse) {
+		t.Fatalf("expected redirects not to be followed, got %v", err)
+	}
+}
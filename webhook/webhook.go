@@ -0,0 +1,280 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dmateusp/opengym/clock"
+	"github.com/dmateusp/opengym/db"
+	"github.com/dmateusp/opengym/log"
+	"github.com/dmateusp/opengym/outbox"
+)
+
+var (
+	pollInterval = flag.Duration("webhook.poll-interval", 5*time.Second, "How often pending webhook deliveries are attempted")
+	maxAttempts  = flag.Int("webhook.max-attempts", 8, "How many times a webhook delivery is attempted before giving up")
+	timeout      = flag.Duration("webhook.timeout", 10*time.Second, "How long to wait for a webhook to respond")
+	batchSize    = flag.Int("webhook.batch-size", 100, "Maximum number of webhook deliveries attempted at once")
+)
+
+const (
+	initialBackoff = 30 * time.Second
+	maxBackoff     = 6 * time.Hour
+
+	// maxErrorBodyBytes limits how much of an error response is kept in the delivery log
+	maxErrorBodyBytes = 512
+)
+
+// Headers sent with every delivery.
+const (
+	HeaderEvent     = "X-Opengym-Event"
+	HeaderDelivery  = "X-Opengym-Delivery"
+	HeaderTimestamp = "X-Opengym-Timestamp"
+	HeaderSignature = "X-Opengym-Signature"
+)
+
+// Payload is the JSON body of a delivery.
+type Payload struct {
+	EventID   int64            `json:"eventId"`
+	Type      outbox.EventType `json:"type"`
+	GameID    string           `json:"gameId"`
+	CreatedAt time.Time        `json:"createdAt"`
+	Data      json.RawMessage  `json:"data"` // the payload of the event
+}
+
+// NewSecret generates a secret used to sign the deliveries of a webhook.
+func NewSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate secret: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// Sign computes the signature sent in [HeaderSignature], receivers compute it again
+// from the body and [HeaderTimestamp] to verify a delivery.
+func Sign(secret string, body []byte, timestamp int64) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	mac.Write([]byte(":"))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether the signature matches the body and timestamp of a delivery.
+func Verify(secret string, body []byte, timestamp int64, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, body, timestamp)), []byte(signature))
+}
+
+// FormatEventTypes and ParseEventTypes convert the event types a webhook subscribes to from and to their stored format.
+func FormatEventTypes(eventTypes []outbox.EventType) string {
+	parts := make([]string, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		parts = append(parts, string(eventType))
+	}
+	return strings.Join(parts, ",")
+}
+
+func ParseEventTypes(s string) []outbox.EventType {
+	var eventTypes []outbox.EventType
+	for part := range strings.SplitSeq(s, ",") {
+		if part != "" {
+			eventTypes = append(eventTypes, outbox.EventType(part))
+		}
+	}
+	return eventTypes
+}
+
+// Deliverer sends the events of the outbox to the webhooks registered by organizers.
+type Deliverer struct {
+	querier db.QuerierWithTxSupport
+	clock   clock.Clock
+	client  *http.Client
+}
+
+func NewDeliverer(querier db.QuerierWithTxSupport, clock clock.Clock, client *http.Client) *Deliverer {
+	return &Deliverer{
+		querier: querier,
+		clock:   clock,
+		client:  client,
+	}
+}
+
+// Enqueue is an [outbox.Consumer] recording a delivery for every webhook subscribed to the event.
+func (d *Deliverer) Enqueue(ctx context.Context, event outbox.Event) error {
+	// the webhooks of a deleted game still receive the event of its deletion
+	game, err := d.querier.GameGetByIdWithDeleted(ctx, event.GameID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		return fmt.Errorf("failed to retrieve game: %w", err)
+	}
+
+	webhooks, err := d.querier.WebhookListByGame(ctx, db.WebhookListByGameParams{
+		GameID:   sql.NullString{String: game.ID, Valid: true},
+		SeriesID: game.SeriesID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list webhooks: %w", err)
+	}
+
+	body, err := json.Marshal(Payload{
+		EventID:   event.ID,
+		Type:      event.Type,
+		GameID:    event.GameID,
+		CreatedAt: event.CreatedAt,
+		Data:      event.Payload,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode payload: %w", err)
+	}
+
+	for _, webhook := range webhooks {
+		if !slices.Contains(ParseEventTypes(webhook.EventTypes), event.Type) {
+			continue
+		}
+		if err := d.querier.WebhookDeliveryCreate(ctx, db.WebhookDeliveryCreateParams{
+			WebhookID: webhook.ID,
+			EventID:   event.ID,
+			EventType: string(event.Type),
+			Payload:   string(body),
+		}); err != nil {
+			return fmt.Errorf("failed to record delivery to webhook %d: %w", webhook.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// Run attempts the pending deliveries every [pollInterval] until ctx is cancelled.
+func (d *Deliverer) Run(ctx context.Context) {
+	ticker := time.NewTicker(*pollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := d.Deliver(ctx); err != nil {
+			log.FromCtx(ctx).ErrorContext(ctx, "Failed to deliver webhooks", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Deliver attempts the pending deliveries that are due. Failed deliveries are retried with an exponential backoff,
+// they aren't reported as errors since they are recorded in the delivery log.
+func (d *Deliverer) Deliver(ctx context.Context) error {
+	deliveries, err := d.querier.WebhookDeliveryListPending(ctx, db.WebhookDeliveryListPendingParams{
+		Now:   sql.NullTime{Time: d.clock.Now().UTC(), Valid: true},
+		Limit: int64(*batchSize),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list pending deliveries: %w", err)
+	}
+
+	var errs []error
+	for _, delivery := range deliveries {
+		if err := d.attempt(ctx, delivery); err != nil {
+			errs = append(errs, fmt.Errorf("delivery %d: %w", delivery.WebhookDelivery.ID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (d *Deliverer) attempt(ctx context.Context, delivery db.WebhookDeliveryListPendingRow) error {
+	statusCode, sendErr := d.send(ctx, delivery)
+
+	now := d.clock.Now()
+	lastStatusCode := sql.NullInt64{Int64: int64(statusCode), Valid: statusCode != 0}
+	if sendErr == nil {
+		if err := d.querier.WebhookDeliveryMarkDelivered(ctx, db.WebhookDeliveryMarkDeliveredParams{
+			ID:             delivery.WebhookDelivery.ID,
+			DeliveredAt:    sql.NullTime{Time: now, Valid: true},
+			LastStatusCode: lastStatusCode,
+		}); err != nil {
+			return fmt.Errorf("failed to mark delivery as delivered: %w", err)
+		}
+		return nil
+	}
+
+	attempts := delivery.WebhookDelivery.Attempts + 1
+	params := db.WebhookDeliveryMarkAttemptFailedParams{
+		ID:             delivery.WebhookDelivery.ID,
+		NextAttemptAt:  sql.NullTime{Time: now.Add(backoff(attempts)).UTC(), Valid: true},
+		LastStatusCode: lastStatusCode,
+		LastError:      sql.NullString{String: sendErr.Error(), Valid: true},
+	}
+	if attempts >= int64(*maxAttempts) {
+		params.FailedAt = sql.NullTime{Time: now, Valid: true}
+		log.FromCtx(ctx).WarnContext(ctx, "Giving up on a webhook delivery", "delivery_id", delivery.WebhookDelivery.ID, "webhook_id", delivery.WebhookDelivery.WebhookID, "error", sendErr)
+	}
+	if err := d.querier.WebhookDeliveryMarkAttemptFailed(ctx, params); err != nil {
+		return fmt.Errorf("failed to record failed attempt: %w", err)
+	}
+
+	return nil
+}
+
+// send posts the delivery to the webhook, returning the status code of the response if there was one.
+func (d *Deliverer) send(ctx context.Context, delivery db.WebhookDeliveryListPendingRow) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, *timeout)
+	defer cancel()
+
+	body := []byte(delivery.WebhookDelivery.Payload)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.Url, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	timestamp := d.clock.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "opengym-webhook")
+	req.Header.Set(HeaderEvent, delivery.WebhookDelivery.EventType)
+	req.Header.Set(HeaderDelivery, strconv.FormatInt(delivery.WebhookDelivery.ID, 10))
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(delivery.Secret, body, timestamp))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodyBytes))
+		return resp.StatusCode, fmt.Errorf("unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(respBody)))
+	}
+	return resp.StatusCode, nil
+}
+
+// backoff returns how long to wait before the next attempt, doubling after each failed attempt.
+func backoff(attempts int64) time.Duration {
+	wait := initialBackoff
+	for range attempts - 1 {
+		wait *= 2
+		if wait >= maxBackoff {
+			return maxBackoff
+		}
+	}
+	return wait
+}
@@ -0,0 +1,331 @@
+package webhook_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"flag"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/dmateusp/opengym/clock"
+	"github.com/dmateusp/opengym/db"
+	dbtesting "github.com/dmateusp/opengym/db/testing"
+	"github.com/dmateusp/opengym/outbox"
+	"github.com/dmateusp/opengym/webhook"
+)
+
+type receivedRequest struct {
+	header http.Header
+	body   []byte
+}
+
+// receiver is a webhook endpoint responding with the given status codes in order, then 200.
+type receiver struct {
+	mu       sync.Mutex
+	statuses []int
+	requests []receivedRequest
+}
+
+func (rc *receiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.requests = append(rc.requests, receivedRequest{header: r.Header.Clone(), body: body})
+
+	status := http.StatusOK
+	if len(rc.statuses) > 0 {
+		status, rc.statuses = rc.statuses[0], rc.statuses[1:]
+	}
+	w.WriteHeader(status)
+}
+
+func (rc *receiver) received() []receivedRequest {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	return rc.requests
+}
+
+func setup(t *testing.T, testClock clock.Clock) (*sql.DB, *db.Queries, *outbox.Dispatcher, *webhook.Deliverer) {
+	t.Helper()
+
+	sqlDB := dbtesting.SetupTestDB(t)
+	t.Cleanup(func() { sqlDB.Close() })
+	querier := db.New(sqlDB)
+
+	if _, err := querier.GameCreate(context.Background(), db.GameCreateParams{
+		ID:              "g1",
+		OrganizerID:     1,
+		Name:            "Test Game",
+		DurationMinutes: 60,
+		MaxPlayers:      10,
+		GameSpotsLeft:   10,
+		SeriesID:        sql.NullString{String: "s1", Valid: true},
+	}); err != nil {
+		t.Fatalf("failed to create game: %v", err)
+	}
+
+	deliverer := webhook.NewDeliverer(db.NewQuerierWrapper(querier), testClock, http.DefaultClient)
+	dispatcher := outbox.NewDispatcher(db.NewQuerierWrapper(querier), testClock)
+	dispatcher.Register("webhooks", deliverer.Enqueue)
+	return sqlDB, querier, dispatcher, deliverer
+}
+
+func createWebhook(t *testing.T, querier *db.Queries, params db.WebhookCreateParams) db.Webhook {
+	t.Helper()
+
+	params.OrganizerID = 1
+	hook, err := querier.WebhookCreate(context.Background(), params)
+	if err != nil {
+		t.Fatalf("failed to create webhook: %v", err)
+	}
+	return hook
+}
+
+func TestDeliverer_DeliversSignedPayloads(t *testing.T) {
+	testClock := &clock.StaticClock{Time: time.Date(2026, time.March, 2, 12, 0, 0, 0, time.UTC)}
+	_, querier, dispatcher, deliverer := setup(t, testClock)
+
+	gameReceiver := &receiver{}
+	gameServer := httptest.NewServer(gameReceiver)
+	defer gameServer.Close()
+	seriesReceiver := &receiver{}
+	seriesServer := httptest.NewServer(seriesReceiver)
+	defer seriesServer.Close()
+
+	createWebhook(t, querier, db.WebhookCreateParams{
+		GameID:     sql.NullString{String: "g1", Valid: true},
+		Url:        gameServer.URL,
+		Secret:     "game-secret",
+		EventTypes: webhook.FormatEventTypes([]outbox.EventType{outbox.EventParticipantJoined}),
+	})
+	createWebhook(t, querier, db.WebhookCreateParams{
+		SeriesID:   sql.NullString{String: "s1", Valid: true},
+		Url:        seriesServer.URL,
+		Secret:     "series-secret",
+		EventTypes: webhook.FormatEventTypes([]outbox.EventType{outbox.EventParticipantJoined, outbox.EventParticipantLeft}),
+	})
+
+	if err := outbox.Publish(t.Context(), querier, "g1", outbox.ParticipantJoined{UserID: 7}); err != nil {
+		t.Fatalf("failed to publish: %v", err)
+	}
+	if err := outbox.Publish(t.Context(), querier, "g1", outbox.ParticipantLeft{UserID: 7}); err != nil {
+		t.Fatalf("failed to publish: %v", err)
+	}
+	if err := dispatcher.Dispatch(t.Context()); err != nil {
+		t.Fatalf("failed to dispatch: %v", err)
+	}
+	if err := deliverer.Deliver(t.Context()); err != nil {
+		t.Fatalf("failed to deliver: %v", err)
+	}
+
+	if got := len(gameReceiver.received()); got != 1 {
+		t.Fatalf("expected the game webhook to receive 1 delivery, got %d", got)
+	}
+	if got := len(seriesReceiver.received()); got != 2 {
+		t.Fatalf("expected the series webhook to receive 2 deliveries, got %d", got)
+	}
+
+	request := gameReceiver.received()[0]
+	timestamp, err := strconv.ParseInt(request.header.Get(webhook.HeaderTimestamp), 10, 64)
+	if err != nil {
+		t.Fatalf("invalid timestamp: %v", err)
+	}
+	if timestamp != testClock.Time.Unix() {
+		t.Errorf("expected timestamp %d, got %d", testClock.Time.Unix(), timestamp)
+	}
+	if !webhook.Verify("game-secret", request.body, timestamp, request.header.Get(webhook.HeaderSignature)) {
+		t.Errorf("expected a valid signature")
+	}
+	if webhook.Verify("series-secret", request.body, timestamp, request.header.Get(webhook.HeaderSignature)) {
+		t.Errorf("expected the signature to depend on the secret")
+	}
+	if got := request.header.Get(webhook.HeaderEvent); got != string(outbox.EventParticipantJoined) {
+		t.Errorf("expected event header %s, got %s", outbox.EventParticipantJoined, got)
+	}
+
+	var payload webhook.Payload
+	if err := json.Unmarshal(request.body, &payload); err != nil {
+		t.Fatalf("failed to decode payload: %v", err)
+	}
+	var joined outbox.ParticipantJoined
+	if err := json.Unmarshal(payload.Data, &joined); err != nil {
+		t.Fatalf("failed to decode data: %v", err)
+	}
+	if payload.Type != outbox.EventParticipantJoined || payload.GameID != "g1" || joined.UserID != 7 {
+		t.Errorf("unexpected payload %+v, data %+v", payload, joined)
+	}
+
+	// delivered deliveries aren't sent again
+	if err := deliverer.Deliver(t.Context()); err != nil {
+		t.Fatalf("failed to deliver: %v", err)
+	}
+	if got := len(gameReceiver.received()); got != 1 {
+		t.Fatalf("expected the delivery to be sent once, got %d", got)
+	}
+}
+
+func TestDeliverer_RetriesWithBackoff(t *testing.T) {
+	now := time.Date(2026, time.March, 2, 12, 0, 0, 0, time.UTC)
+	testClock := &clock.StaticClock{Time: now}
+	sqlDB, querier, dispatcher, deliverer := setup(t, testClock)
+
+	gameReceiver := &receiver{statuses: []int{http.StatusInternalServerError}}
+	gameServer := httptest.NewServer(gameReceiver)
+	defer gameServer.Close()
+
+	hook := createWebhook(t, querier, db.WebhookCreateParams{
+		GameID:     sql.NullString{String: "g1", Valid: true},
+		Url:        gameServer.URL,
+		Secret:     "secret",
+		EventTypes: webhook.FormatEventTypes([]outbox.EventType{outbox.EventParticipantJoined}),
+	})
+
+	if err := outbox.Publish(t.Context(), querier, "g1", outbox.ParticipantJoined{UserID: 7}); err != nil {
+		t.Fatalf("failed to publish: %v", err)
+	}
+	if err := dispatcher.Dispatch(t.Context()); err != nil {
+		t.Fatalf("failed to dispatch: %v", err)
+	}
+	if err := deliverer.Deliver(t.Context()); err != nil {
+		t.Fatalf("failed to deliver: %v", err)
+	}
+
+	deliveries, err := querier.WebhookDeliveryListByWebhook(t.Context(), db.WebhookDeliveryListByWebhookParams{WebhookID: hook.ID, Limit: 10})
+	if err != nil {
+		t.Fatalf("failed to list deliveries: %v", err)
+	}
+	if len(deliveries) != 1 || deliveries[0].Attempts != 1 || deliveries[0].LastStatusCode.Int64 != http.StatusInternalServerError || deliveries[0].DeliveredAt.Valid {
+		t.Fatalf("expected a failed attempt to be logged, got %+v", deliveries)
+	}
+
+	// the delivery isn't retried before the backoff
+	testClock.Time = now.Add(time.Second)
+	if err := deliverer.Deliver(t.Context()); err != nil {
+		t.Fatalf("failed to deliver: %v", err)
+	}
+	if got := len(gameReceiver.received()); got != 1 {
+		t.Fatalf("expected the retry to wait for the backoff, got %d requests", got)
+	}
+
+	testClock.Time = now.Add(time.Minute)
+	if err := deliverer.Deliver(t.Context()); err != nil {
+		t.Fatalf("failed to deliver: %v", err)
+	}
+	if got := len(gameReceiver.received()); got != 2 {
+		t.Fatalf("expected the delivery to be retried after the backoff, got %d requests", got)
+	}
+
+	var delivered bool
+	if err := sqlDB.QueryRow("select delivered_at is not null from webhook_deliveries").Scan(&delivered); err != nil {
+		t.Fatalf("failed to read delivery: %v", err)
+	}
+	if !delivered {
+		t.Fatalf("expected the delivery to be delivered")
+	}
+}
+
+func TestDeliverer_GivesUpAfterMaxAttempts(t *testing.T) {
+	testClock := &clock.StaticClock{Time: time.Date(2026, time.March, 2, 12, 0, 0, 0, time.UTC)}
+	sqlDB, querier, dispatcher, deliverer := setup(t, testClock)
+
+	createWebhook(t, querier, db.WebhookCreateParams{
+		GameID:     sql.NullString{String: "g1", Valid: true},
+		Url:        "http://127.0.0.1:0", // nothing listens on port 0
+		Secret:     "secret",
+		EventTypes: webhook.FormatEventTypes([]outbox.EventType{outbox.EventParticipantJoined}),
+	})
+
+	if err := outbox.Publish(t.Context(), querier, "g1", outbox.ParticipantJoined{UserID: 7}); err != nil {
+		t.Fatalf("failed to publish: %v", err)
+	}
+	if err := dispatcher.Dispatch(t.Context()); err != nil {
+		t.Fatalf("failed to dispatch: %v", err)
+	}
+
+	for range 20 {
+		if err := deliverer.Deliver(t.Context()); err != nil {
+			t.Fatalf("failed to deliver: %v", err)
+		}
+		testClock.Time = testClock.Time.Add(12 * time.Hour)
+	}
+
+	var attempts int
+	var failed, hasStatus bool
+	if err := sqlDB.QueryRow("select attempts, failed_at is not null, last_status_code is not null from webhook_deliveries").Scan(&attempts, &failed, &hasStatus); err != nil {
+		t.Fatalf("failed to read delivery: %v", err)
+	}
+	if attempts != 8 || !failed || hasStatus {
+		t.Fatalf("expected the deliverer to give up after 8 attempts without a response, got %d attempts (failed: %v, status: %v)", attempts, failed, hasStatus)
+	}
+}
+
+func TestDeliverer_BackedOffDeliveriesDontHoldBackNewerOnes(t *testing.T) {
+	now := time.Date(2026, time.March, 2, 12, 0, 0, 0, time.UTC)
+	testClock := &clock.StaticClock{Time: now}
+	_, querier, dispatcher, deliverer := setup(t, testClock)
+
+	previous := flag.Lookup("webhook.batch-size").Value.String()
+	if err := flag.Set("webhook.batch-size", "2"); err != nil {
+		t.Fatalf("failed to set the batch size: %v", err)
+	}
+	t.Cleanup(func() { _ = flag.Set("webhook.batch-size", previous) })
+
+	failingReceiver := &receiver{statuses: []int{500, 500, 500, 500, 500}}
+	failingServer := httptest.NewServer(failingReceiver)
+	defer failingServer.Close()
+	healthyReceiver := &receiver{}
+	healthyServer := httptest.NewServer(healthyReceiver)
+	defer healthyServer.Close()
+
+	createWebhook(t, querier, db.WebhookCreateParams{
+		GameID:     sql.NullString{String: "g1", Valid: true},
+		Url:        failingServer.URL,
+		Secret:     "secret",
+		EventTypes: webhook.FormatEventTypes([]outbox.EventType{outbox.EventParticipantJoined}),
+	})
+	createWebhook(t, querier, db.WebhookCreateParams{
+		GameID:     sql.NullString{String: "g1", Valid: true},
+		Url:        healthyServer.URL,
+		Secret:     "secret",
+		EventTypes: webhook.FormatEventTypes([]outbox.EventType{outbox.EventParticipantLeft}),
+	})
+
+	// every delivery to the failing webhook is waiting for its backoff, more of them than a batch
+	for range 3 {
+		if err := outbox.Publish(t.Context(), querier, "g1", outbox.ParticipantJoined{UserID: 7}); err != nil {
+			t.Fatalf("failed to publish: %v", err)
+		}
+	}
+	if err := dispatcher.Dispatch(t.Context()); err != nil {
+		t.Fatalf("failed to dispatch: %v", err)
+	}
+	for range 2 {
+		if err := deliverer.Deliver(t.Context()); err != nil {
+			t.Fatalf("failed to deliver: %v", err)
+		}
+	}
+
+	if err := outbox.Publish(t.Context(), querier, "g1", outbox.ParticipantLeft{UserID: 7}); err != nil {
+		t.Fatalf("failed to publish: %v", err)
+	}
+	if err := dispatcher.Dispatch(t.Context()); err != nil {
+		t.Fatalf("failed to dispatch: %v", err)
+	}
+
+	testClock.Time = now.Add(time.Second)
+	if err := deliverer.Deliver(t.Context()); err != nil {
+		t.Fatalf("failed to deliver: %v", err)
+	}
+	if got := len(healthyReceiver.received()); got != 1 {
+		t.Fatalf("expected the newer delivery to be attempted while the others back off, got %d requests", got)
+	}
+}